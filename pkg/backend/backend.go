@@ -169,8 +169,9 @@ type Backend interface {
 	// GetHistory returns all updates for the stack. The returned UpdateInfo slice will be in
 	// descending order (newest first).
 	GetHistory(ctx context.Context, stackRef StackReference) ([]UpdateInfo, error)
-	// GetLogs fetches a list of log entries for the given stack, with optional filtering/querying.
-	GetLogs(ctx context.Context, stack Stack, cfg StackConfiguration,
+	// GetLogs fetches a list of log entries for the given stack, with optional filtering/querying. If logProviders is
+	// not nil, the stack's providers are loaded from and cached in it.
+	GetLogs(ctx context.Context, stack Stack, cfg StackConfiguration, logProviders *LogProviders,
 		query operations.LogQuery) ([]operations.LogEntry, error)
 	// Get the configuration from the most recent deployment of the stack.
 	GetLatestConfiguration(ctx context.Context, stack Stack) (config.Map, error)
@@ -224,8 +225,9 @@ type QueryOperation struct {
 
 // StackConfiguration holds the configuration for a stack and it's associated decrypter.
 type StackConfiguration struct {
-	Config    config.Map
-	Decrypter config.Decrypter
+	Config      config.Map
+	Decrypter   config.Decrypter
+	RetryPolicy *resource.RetryPolicy
 }
 
 // UpdateOptions is the full set of update options, including backend and engine options.
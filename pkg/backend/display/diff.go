@@ -115,6 +115,9 @@ func RenderDiffEvent(action apitype.UpdateKind, event engine.Event,
 		return renderDiffDiagEvent(event.Payload.(engine.DiagEventPayload), opts)
 	case engine.PolicyViolationEvent:
 		return renderDiffPolicyViolationEvent(event.Payload.(engine.PolicyViolationEventPayload), opts)
+	case engine.ResourceRetryEvent:
+		// Retries are also reported as warnings, which are rendered as diagnostics.
+		return ""
 
 	default:
 		contract.Failf("unknown event type '%s'", event.Type)
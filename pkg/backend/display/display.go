@@ -15,15 +15,14 @@
 package display
 
 import (
-	"encoding/json"
 	"fmt"
 	"io"
-	"os"
-	"time"
 
 	"github.com/pulumi/pulumi/pkg/v2/engine"
 	"github.com/pulumi/pulumi/pkg/v2/resource/deploy"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/diag/colors"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/tokens"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/contract"
@@ -37,8 +36,8 @@ func ShowEvents(
 	op string, action apitype.UpdateKind, stack tokens.QName, proj tokens.PackageName,
 	events <-chan engine.Event, done chan<- bool, opts Options, isPreview bool) {
 
-	if opts.EventLogPath != "" {
-		events, done = startEventLogger(events, done, opts.EventLogPath)
+	if opts.EventStream != nil {
+		events, done = startEventStream(events, done, opts.EventStream)
 	}
 
 	if opts.JSONDisplay {
@@ -63,36 +62,36 @@ func ShowEvents(
 	}
 }
 
-func startEventLogger(events <-chan engine.Event, done chan<- bool, path string) (<-chan engine.Event, chan<- bool) {
-	// Before moving further, attempt to open the log file.
-	logFile, err := os.Create(path)
-	if err != nil {
-		logging.V(7).Infof("could not create event log: %v", err)
-		return events, done
-	}
+// startEventStream writes each event to the given stream before passing it on to the display. The stream is left open
+// so that the events of later operations may be written to it. The first time that the stream drops an event or fails
+// to write one, a warning is displayed.
+func startEventStream(events <-chan engine.Event, done chan<- bool,
+	stream *EventStream) (<-chan engine.Event, chan<- bool) {
 
 	outEvents, outDone := make(chan engine.Event), make(chan bool)
 	go func() {
 		defer close(done)
-		defer func() {
-			contract.IgnoreError(logFile.Close())
-		}()
-
-		sequence := 0
-		encoder := json.NewEncoder(logFile)
-		logEvent := func(e engine.Event) error {
-			apiEvent, err := ConvertEngineEvent(e)
-			if err != nil {
-				return err
-			}
-			apiEvent.Sequence, sequence = sequence, sequence+1
-			apiEvent.Timestamp = int(time.Now().Unix())
-			return encoder.Encode(apiEvent)
-		}
 
+		warned := false
 		for e := range events {
-			if err = logEvent(e); err != nil {
-				logging.V(7).Infof("failed to log event: %v", err)
+			if err := stream.Write(e); err != nil {
+				logging.V(7).Infof("failed to write event to stream: %v", err)
+				if !warned {
+					message := "failed to write to the event stream: " + err.Error()
+					if err == errEventDropped {
+						message = "the event stream's consumer is not keeping up, so some events were not written to it"
+					}
+					outEvents <- engine.Event{
+						Type: engine.DiagEvent,
+						Payload: engine.DiagEventPayload{
+							Prefix:   colors.SpecWarning + "warning: " + colors.Reset,
+							Message:  message + "\n",
+							Color:    colors.Raw,
+							Severity: diag.Warning,
+						},
+					}
+					warned = true
+				}
 			}
 
 			outEvents <- e
@@ -129,6 +129,19 @@ func ConvertEngineEvent(e engine.Event) (apitype.EngineEvent, error) {
 			Steps:    p.Steps,
 		}
 
+	case engine.ResourceRetryEvent:
+		p, ok := e.Payload.(engine.ResourceRetryEventPayload)
+		if !ok {
+			return apiEvent, eventTypePayloadMismatch
+		}
+		apiEvent.ResRetryEvent = &apitype.ResRetryEvent{
+			Metadata:     convertStepEventMetadata(p.Metadata),
+			Attempt:      p.Attempt,
+			MaxAttempts:  p.MaxAttempts,
+			DelaySeconds: p.Delay.Seconds(),
+			Error:        p.Error,
+		}
+
 	default:
 		return apiEvent, errors.Errorf("unknown event type %q", e.Type)
 	}
@@ -186,6 +199,8 @@ func convertStepEventMetadata(md engine.StepEventMetadata) apitype.StepEventMeta
 		DetailedDiff: detailedDiff,
 		Logical:      md.Logical,
 		Provider:     md.Provider,
+
+		DeleteSkipReason: md.DeleteSkipReason,
 	}
 }
 
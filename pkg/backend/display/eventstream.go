@@ -0,0 +1,200 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package display
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/pulumi/pulumi/pkg/v2/engine"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/apitype"
+)
+
+// eventStreamBufferSize is the number of events that an event stream buffers for a slow consumer.
+const eventStreamBufferSize = 4096
+
+// errEventDropped is returned by EventStream.Write when an event is dropped because the stream's consumer has fallen
+// too far behind.
+var errEventDropped = errors.New("the event stream's consumer is not keeping up")
+
+// EventStream writes engine events to an external consumer in real time, as newline-delimited JSON
+// apitype.EngineEvent values. A single stream may be shared by several operations (e.g. a preview and the update that
+// follows it); sequence numbers keep increasing across them.
+//
+// Events are written by a separate goroutine and buffered, so that a briefly slow consumer does not block the engine.
+// If the consumer of a stream that does not drop events falls too far behind, the engine waits for it. A stream that
+// drops events instead drops all but prelude, summary, and cancellation events, which it always waits to write, so
+// that a consumer can always tell when an operation starts and ends.
+type EventStream struct {
+	m            sync.Mutex
+	w            io.WriteCloser
+	dropWhenFull bool // true if events other than prelude, summary, and cancellation events may be dropped.
+	sequence     int
+	closed       bool
+	events       chan apitype.EngineEvent // the events waiting to be written.
+	done         chan error               // receives the result of writing the events once the stream is closed.
+}
+
+// OpenEventStream opens an event stream. The target is one of:
+//
+//   - "fd:<n>", to write to the already-open file descriptor n, which the stream takes ownership of. Standard output
+//     and standard error may be used, but are not closed with the stream; standard input may not be used,
+//   - "unix:<path>", to connect to the Unix domain socket at path, or
+//   - a file path, which is created or truncated.
+//
+// Streams that write to files and file descriptors never drop events. Streams that write to sockets drop events other
+// than prelude, summary, and cancellation events if their consumer falls too far behind, so that a consumer that
+// stops reading cannot hold up the engine indefinitely.
+func OpenEventStream(target string) (*EventStream, error) {
+	var w io.WriteCloser
+	dropWhenFull := false
+	switch {
+	case strings.HasPrefix(target, "fd:"):
+		fd, err := strconv.ParseUint(strings.TrimPrefix(target, "fd:"), 10, 0)
+		if err != nil {
+			return nil, errors.Errorf("invalid event stream file descriptor %q", target)
+		}
+		switch fd {
+		case 0:
+			return nil, errors.New("the event stream may not be written to standard input")
+		case 1:
+			w = nopCloser{os.Stdout}
+		case 2:
+			w = nopCloser{os.Stderr}
+		default:
+			w = os.NewFile(uintptr(fd), target)
+		}
+	case strings.HasPrefix(target, "unix:"):
+		conn, err := net.Dial("unix", strings.TrimPrefix(target, "unix:"))
+		if err != nil {
+			return nil, errors.Wrapf(err, "connecting to event stream socket")
+		}
+		w, dropWhenFull = conn, true
+	default:
+		f, err := os.Create(target)
+		if err != nil {
+			return nil, errors.Wrapf(err, "creating event stream file")
+		}
+		w = f
+	}
+	return NewEventStream(w, dropWhenFull), nil
+}
+
+// nopCloser wraps a writer that an event stream must not close.
+type nopCloser struct {
+	io.Writer
+}
+
+func (nopCloser) Close() error {
+	return nil
+}
+
+// NewEventStream creates an event stream that writes to the given writer. Closing the stream closes the writer. If
+// dropWhenFull is true, events other than prelude, summary, and cancellation events are dropped rather than waiting
+// for a consumer that has fallen too far behind.
+func NewEventStream(w io.WriteCloser, dropWhenFull bool) *EventStream {
+	s := &EventStream{
+		w:            w,
+		dropWhenFull: dropWhenFull,
+		events:       make(chan apitype.EngineEvent, eventStreamBufferSize),
+		done:         make(chan error, 1),
+	}
+	go s.writeEvents()
+	return s
+}
+
+// writeEvents writes buffered events to the stream's writer until the stream is closed. The output is flushed
+// whenever there are no more events waiting, so the consumer sees each event as soon as the engine is idle.
+func (s *EventStream) writeEvents() {
+	var err error
+	w := bufio.NewWriter(s.w)
+	encoder := json.NewEncoder(w)
+	for e := range s.events {
+		if err != nil {
+			// Keep draining the buffer so that writers are not blocked.
+			continue
+		}
+		if err = encoder.Encode(e); err == nil && len(s.events) == 0 {
+			err = w.Flush()
+		}
+	}
+	if err == nil {
+		err = w.Flush()
+	}
+	s.done <- err
+}
+
+// Write queues a single engine event to be written to the stream. If the stream's consumer has fallen too far behind,
+// Write waits for it, unless the stream drops events, in which case it drops the event and returns errEventDropped.
+func (s *EventStream) Write(e engine.Event) error {
+	apiEvent, err := ConvertEngineEvent(e)
+	if err != nil {
+		return err
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if s.closed {
+		return errors.New("the event stream has been closed")
+	}
+
+	apiEvent.Version = apitype.EngineEventStreamVersion
+	apiEvent.Sequence, s.sequence = s.sequence, s.sequence+1
+	apiEvent.Timestamp = int(time.Now().Unix())
+
+	switch e.Type {
+	case engine.PreludeEvent, engine.SummaryEvent, engine.CancelEvent:
+		s.events <- apiEvent
+		return nil
+	}
+	if !s.dropWhenFull {
+		s.events <- apiEvent
+		return nil
+	}
+	select {
+	case s.events <- apiEvent:
+		return nil
+	default:
+		return errEventDropped
+	}
+}
+
+// Close writes any buffered events and closes the stream.
+func (s *EventStream) Close() error {
+	s.m.Lock()
+	if s.closed {
+		s.m.Unlock()
+		return nil
+	}
+	s.closed = true
+	close(s.events)
+	s.m.Unlock()
+
+	err := <-s.done
+	if closeErr := s.w.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
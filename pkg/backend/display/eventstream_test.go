@@ -0,0 +1,280 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package display
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/pkg/v2/engine"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/diag"
+)
+
+func writeTestEvents(t *testing.T, stream *EventStream) {
+	assert.NoError(t, stream.Write(engine.Event{
+		Type:    engine.DiagEvent,
+		Payload: engine.DiagEventPayload{Message: "hello", Severity: diag.Info},
+	}))
+	assert.NoError(t, stream.Write(engine.Event{
+		Type:    engine.SummaryEvent,
+		Payload: engine.SummaryEventPayload{},
+	}))
+	assert.NoError(t, stream.Write(engine.Event{Type: engine.CancelEvent}))
+}
+
+func readTestEvents(t *testing.T, r io.Reader) []apitype.EngineEvent {
+	var events []apitype.EngineEvent
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		var e apitype.EngineEvent
+		assert.NoError(t, json.Unmarshal(scanner.Bytes(), &e))
+		events = append(events, e)
+	}
+	assert.NoError(t, scanner.Err())
+	return events
+}
+
+func assertTestEvents(t *testing.T, events []apitype.EngineEvent) {
+	if !assert.Len(t, events, 3) {
+		return
+	}
+	for i, e := range events {
+		assert.Equal(t, i, e.Sequence)
+		assert.Equal(t, apitype.EngineEventStreamVersion, e.Version)
+	}
+	assert.Equal(t, "hello", events[0].DiagnosticEvent.Message)
+	assert.NotNil(t, events[1].SummaryEvent)
+	assert.NotNil(t, events[2].CancelEvent)
+}
+
+func TestEventStreamFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "event-stream")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "events.json")
+	stream, err := OpenEventStream(path)
+	assert.NoError(t, err)
+	writeTestEvents(t, stream)
+	assert.NoError(t, stream.Close())
+
+	f, err := os.Open(path)
+	assert.NoError(t, err)
+	defer f.Close()
+	assertTestEvents(t, readTestEvents(t, f))
+}
+
+func TestEventStreamUnixSocket(t *testing.T) {
+	dir, err := ioutil.TempDir("", "event-stream")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "events.sock")
+	listener, err := net.Listen("unix", path)
+	assert.NoError(t, err)
+	defer listener.Close()
+
+	received := make(chan []apitype.EngineEvent)
+	go func() {
+		conn, err := listener.Accept()
+		assert.NoError(t, err)
+		defer conn.Close()
+		received <- readTestEvents(t, conn)
+	}()
+
+	stream, err := OpenEventStream("unix:" + path)
+	assert.NoError(t, err)
+	writeTestEvents(t, stream)
+	assert.NoError(t, stream.Close())
+
+	assertTestEvents(t, <-received)
+}
+
+func TestEventStreamInvalidFileDescriptor(t *testing.T) {
+	_, err := OpenEventStream("fd:stdout")
+	assert.Error(t, err)
+}
+
+func TestEventStreamStandardInput(t *testing.T) {
+	_, err := OpenEventStream("fd:0")
+	assert.Error(t, err)
+}
+
+// blockingWriter is a writer whose writes block until it is released.
+type blockingWriter struct {
+	blocked     chan struct{} // closed once a write is waiting to be released.
+	blockedOnce sync.Once
+	release     chan struct{}
+	w           io.Writer
+}
+
+func (w *blockingWriter) Write(b []byte) (int, error) {
+	w.blockedOnce.Do(func() { close(w.blocked) })
+	<-w.release
+	return w.w.Write(b)
+}
+
+func (w *blockingWriter) Close() error {
+	return nil
+}
+
+// startSlowConsumer returns an event stream whose consumer does not read any events until it is released, along with
+// functions that wait until the stream is blocked on the consumer, that release the consumer, and that close the stream
+// and return the events that the consumer read.
+func startSlowConsumer(t *testing.T,
+	dropWhenFull bool) (*EventStream, func(), func(), func() []apitype.EngineEvent) {
+
+	r, pw := io.Pipe()
+	w := &blockingWriter{blocked: make(chan struct{}), release: make(chan struct{}), w: pw}
+	stream := NewEventStream(w, dropWhenFull)
+
+	received := make(chan []apitype.EngineEvent)
+	go func() {
+		received <- readTestEvents(t, r)
+	}()
+
+	var once sync.Once
+	release := func() {
+		once.Do(func() { close(w.release) })
+	}
+	wait := func() {
+		<-w.blocked
+	}
+	return stream, wait, release, func() []apitype.EngineEvent {
+		release()
+		assert.NoError(t, stream.Close())
+		assert.NoError(t, pw.Close())
+		return <-received
+	}
+}
+
+func TestEventStreamSlowConsumer(t *testing.T) {
+	stream, wait, release, finish := startSlowConsumer(t, true)
+
+	// Once the buffer is full, writes of ordinary events do not block on the consumer, and the events are dropped.
+	event := engine.Event{Type: engine.DiagEvent, Payload: engine.DiagEventPayload{Message: "hello"}}
+	written, dropped := 0, 0
+	for i := 0; i < 2*eventStreamBufferSize; i++ {
+		written++
+		if err := stream.Write(event); err != nil {
+			assert.Equal(t, errEventDropped, err)
+			dropped++
+		}
+	}
+	assert.NotZero(t, dropped)
+
+	// Refill the buffer once the stream is blocked on the consumer, so that no more events can be queued.
+	wait()
+	for {
+		written++
+		if err := stream.Write(event); err != nil {
+			dropped++
+			break
+		}
+	}
+
+	// Summary events are never dropped: the write waits for the consumer instead.
+	summary := make(chan error)
+	go func() {
+		summary <- stream.Write(engine.Event{Type: engine.SummaryEvent, Payload: engine.SummaryEventPayload{}})
+	}()
+	select {
+	case <-summary:
+		assert.Fail(t, "the summary event was written before the consumer caught up")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	release()
+	assert.NoError(t, <-summary)
+	events := finish()
+	assert.Equal(t, written-dropped+1, len(events))
+	for i := 1; i < len(events); i++ {
+		assert.True(t, events[i].Sequence > events[i-1].Sequence)
+	}
+	assert.NotNil(t, events[len(events)-1].SummaryEvent)
+}
+
+func TestEventStreamSlowConsumerBackpressure(t *testing.T) {
+	stream, _, release, finish := startSlowConsumer(t, false)
+
+	// Once the buffer is full, writes wait for the consumer rather than dropping events.
+	written := make(chan error)
+	go func() {
+		event := engine.Event{Type: engine.DiagEvent, Payload: engine.DiagEventPayload{Message: "hello"}}
+		for i := 0; i < 2*eventStreamBufferSize; i++ {
+			if err := stream.Write(event); err != nil {
+				written <- err
+				return
+			}
+		}
+		written <- nil
+	}()
+	select {
+	case <-written:
+		assert.Fail(t, "the events were written before the consumer caught up")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	release()
+	assert.NoError(t, <-written)
+	events := finish()
+	assert.Equal(t, 2*eventStreamBufferSize, len(events))
+	for i, e := range events {
+		assert.Equal(t, i, e.Sequence)
+	}
+}
+
+func TestEventStreamDropWarning(t *testing.T) {
+	stream, _, release, finish := startSlowConsumer(t, true)
+
+	events, done := make(chan engine.Event), make(chan bool)
+	displayed, displayDone := startEventStream(events, done, stream)
+
+	var warnings []string
+	go func() {
+		for e := range displayed {
+			if p, ok := e.Payload.(engine.DiagEventPayload); ok && p.Severity == diag.Warning {
+				warnings = append(warnings, p.Message)
+			}
+			if e.Type == engine.CancelEvent {
+				close(displayDone)
+				return
+			}
+		}
+	}()
+
+	// Overflow the stream's buffer. The display shows a single warning about the dropped events.
+	for i := 0; i < 2*eventStreamBufferSize; i++ {
+		events <- engine.Event{Type: engine.DiagEvent, Payload: engine.DiagEventPayload{Message: "hello"}}
+	}
+	release()
+	events <- engine.Event{Type: engine.CancelEvent}
+	<-done
+	finish()
+	assert.Equal(t, []string{
+		"the event stream's consumer is not keeping up, so some events were not written to it\n",
+	}, warnings)
+}
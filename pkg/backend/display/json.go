@@ -86,7 +86,7 @@ func stateForJSONOutput(s *resource.State, opts Options) *resource.State {
 	return resource.NewState(s.Type, s.URN, s.Custom, s.Delete, s.ID, inputs,
 		outputs, s.Parent, s.Protect, s.External, s.Dependencies, s.InitErrors, s.Provider,
 		s.PropertyDependencies, s.PendingReplacement, s.AdditionalSecretOutputs, s.Aliases, &s.CustomTimeouts,
-		s.ImportID)
+		s.ImportID, &s.RetryPolicy, s.RetainOnDelete, s.DeletedWith)
 }
 
 // ShowJSONEvents renders engine events from a preview into a well-formed JSON document. Note that this does not
@@ -175,7 +175,7 @@ func ShowJSONEvents(op string, action apitype.UpdateKind, events <-chan engine.E
 
 				digest.Steps = append(digest.Steps, step)
 			}
-		case engine.ResourceOutputsEvent, engine.ResourceOperationFailed:
+		case engine.ResourceOutputsEvent, engine.ResourceOperationFailed, engine.ResourceRetryEvent:
 			// Because we are only JSON serializing previews, we don't need to worry about outputs
 			// resolving or operations failing. In the future, if we serialize actual deployments, we will
 			// need to come up with a scheme for matching the failure to the associated step.
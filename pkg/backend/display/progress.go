@@ -992,6 +992,9 @@ func (display *ProgressDisplay) processNormalEvent(event engine.Event) {
 	case engine.StdoutColorEvent:
 		display.handleSystemEvent(event.Payload.(engine.StdoutEventPayload))
 		return
+	case engine.ResourceRetryEvent:
+		// Retries are also reported as warnings, which are displayed alongside the resource's row.
+		return
 	}
 
 	// At this point, all events should relate to resources.
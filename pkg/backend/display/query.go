@@ -88,7 +88,7 @@ func renderQueryEvent(event engine.Event, opts Options) string {
 		return renderQueryDiagEvent(event.Payload.(engine.DiagEventPayload), opts)
 
 	case engine.PreludeEvent, engine.SummaryEvent, engine.ResourceOperationFailed,
-		engine.ResourceOutputsEvent, engine.ResourcePreEvent:
+		engine.ResourceOutputsEvent, engine.ResourcePreEvent, engine.ResourceRetryEvent:
 
 		contract.Failf("query mode does not support resource operations")
 		return ""
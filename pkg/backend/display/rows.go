@@ -347,6 +347,10 @@ func (data *resourceRowData) getInfoColumn() string {
 		appendDiagMessage("[" + changes + "]")
 	}
 
+	if step.DeleteSkipReason != "" {
+		appendDiagMessage("[" + step.DeleteSkipReason + "]")
+	}
+
 	diagInfo := data.diagInfo
 	if data.display.done {
 		// If we are done, show a summary of how many messages were printed.
@@ -77,6 +77,12 @@ func ShowWatchEvents(op string, action apitype.UpdateKind, events <-chan engine.
 				PrintfWithWatchPrefix(time.Now(), string(p.Metadata.URN.Name()),
 					"failed %s %s\n", p.Metadata.Op, p.Metadata.URN.Type())
 			}
+		case engine.ResourceRetryEvent:
+			p := e.Payload.(engine.ResourceRetryEventPayload)
+			if shouldShow(p.Metadata, opts) {
+				PrintfWithWatchPrefix(time.Now(), string(p.Metadata.URN.Name()),
+					"retrying %s %s in %v\n", p.Metadata.Op, p.Metadata.URN.Type(), p.Delay)
+			}
 		default:
 			contract.Failf("unknown event type '%s'", e.Type)
 		}
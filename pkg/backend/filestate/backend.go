@@ -614,7 +614,7 @@ func (b *localBackend) GetHistory(ctx context.Context, stackRef backend.StackRef
 }
 
 func (b *localBackend) GetLogs(ctx context.Context, stack backend.Stack, cfg backend.StackConfiguration,
-	query operations.LogQuery) ([]operations.LogEntry, error) {
+	logProviders *backend.LogProviders, query operations.LogQuery) ([]operations.LogEntry, error) {
 
 	stackName := stack.Ref().Name()
 	target, err := b.getTarget(stackName, cfg.Config, cfg.Decrypter)
@@ -622,11 +622,14 @@ func (b *localBackend) GetLogs(ctx context.Context, stack backend.Stack, cfg bac
 		return nil, err
 	}
 
-	return GetLogsForTarget(target, query)
+	return GetLogsForTarget(target, logProviders, query)
 }
 
-// GetLogsForTarget fetches stack logs using the config, decrypter, and checkpoint in the given target.
-func GetLogsForTarget(target *deploy.Target, query operations.LogQuery) ([]operations.LogEntry, error) {
+// GetLogsForTarget fetches stack logs using the config, decrypter, and checkpoint in the given target. If logProviders
+// is not nil, the stack's providers are loaded from and cached in it; otherwise they are loaded for this query alone.
+func GetLogsForTarget(target *deploy.Target, logProviders *backend.LogProviders,
+	query operations.LogQuery) ([]operations.LogEntry, error) {
+
 	contract.Assert(target != nil)
 	contract.Assert(target.Snapshot != nil)
 
@@ -635,8 +638,22 @@ func GetLogsForTarget(target *deploy.Target, query operations.LogQuery) ([]opera
 		return nil, err
 	}
 
+	// Load the stack's providers so that they can answer queries about the resources they manage. If this fails we
+	// can still fall back to the built-in operations providers.
+	if logProviders == nil {
+		logProviders = backend.NewLogProviders()
+		defer contract.IgnoreClose(logProviders)
+	}
+	var providerSource operations.ProviderSource
+	registry, err := logProviders.Registry(target.Snapshot.Resources)
+	if err != nil {
+		logging.V(3).Infof("GetLogs: could not load providers: %v", err)
+	} else {
+		providerSource = registry
+	}
+
 	components := operations.NewResourceTree(target.Snapshot.Resources)
-	ops := components.OperationsProvider(config)
+	ops := components.OperationsProviderWithPlugins(config, providerSource)
 	logs, err := ops.GetLogs(query)
 	if logs == nil {
 		return nil, err
@@ -84,9 +84,9 @@ func (s *localStack) Watch(ctx context.Context, op backend.UpdateOperation) resu
 	return backend.WatchStack(ctx, s, op)
 }
 
-func (s *localStack) GetLogs(ctx context.Context, cfg backend.StackConfiguration,
+func (s *localStack) GetLogs(ctx context.Context, cfg backend.StackConfiguration, logProviders *backend.LogProviders,
 	query operations.LogQuery) ([]operations.LogEntry, error) {
-	return backend.GetStackLogs(ctx, s, cfg, query)
+	return backend.GetStackLogs(ctx, s, cfg, logProviders, query)
 }
 
 func (s *localStack) ExportDeployment(ctx context.Context) (*apitype.UntypedDeployment, error) {
@@ -1150,13 +1150,13 @@ func convertConfig(apiConfig map[string]apitype.ConfigValue) (config.Map, error)
 }
 
 func (b *cloudBackend) GetLogs(ctx context.Context, stack backend.Stack, cfg backend.StackConfiguration,
-	logQuery operations.LogQuery) ([]operations.LogEntry, error) {
+	logProviders *backend.LogProviders, logQuery operations.LogQuery) ([]operations.LogEntry, error) {
 
 	target, targetErr := b.getTarget(ctx, stack.Ref(), cfg.Config, cfg.Decrypter)
 	if targetErr != nil {
 		return nil, targetErr
 	}
-	return filestate.GetLogsForTarget(target, logQuery)
+	return filestate.GetLogsForTarget(target, logProviders, logQuery)
 }
 
 func (b *cloudBackend) ExportDeployment(ctx context.Context,
@@ -14,7 +14,7 @@ import (
 
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/archive"
 
-	"github.com/pulumi/pulumi/pkg/v2/npm"
+	"github.com/pulumi/pulumi/sdk/v2/nodejs/npm"
 
 	"github.com/pkg/errors"
 	"github.com/pulumi/pulumi/pkg/v2/backend"
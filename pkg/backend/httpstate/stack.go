@@ -160,9 +160,9 @@ func (s *cloudStack) Watch(ctx context.Context, op backend.UpdateOperation) resu
 	return backend.WatchStack(ctx, s, op)
 }
 
-func (s *cloudStack) GetLogs(ctx context.Context, cfg backend.StackConfiguration,
+func (s *cloudStack) GetLogs(ctx context.Context, cfg backend.StackConfiguration, logProviders *backend.LogProviders,
 	query operations.LogQuery) ([]operations.LogEntry, error) {
-	return backend.GetStackLogs(ctx, s, cfg, query)
+	return backend.GetStackLogs(ctx, s, cfg, logProviders, query)
 }
 
 func (s *cloudStack) ExportDeployment(ctx context.Context) (*apitype.UntypedDeployment, error) {
@@ -0,0 +1,107 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/pulumi/pulumi/pkg/v2/resource/deploy/providers"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/cmdutil"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/contract"
+)
+
+// LogProviders caches the plugin host and provider registry used to answer log queries for a stack, so that callers
+// which poll for logs do not relaunch the stack's providers on every query. The registry is reloaded if the stack's
+// providers change between queries.
+//
+// Callers pass a LogProviders to each call to GetLogs, and close it once they are done polling.
+type LogProviders struct {
+	m        sync.Mutex
+	closed   bool                // true if the cache has been closed.
+	loaded   bool                // true if the registry below has been loaded.
+	key      string              // the provider references the registry was loaded from.
+	ctx      *plugin.Context     // the plugin context that hosts the providers.
+	registry *providers.Registry // the cached provider registry.
+}
+
+// NewLogProviders creates an empty provider cache for log queries.
+func NewLogProviders() *LogProviders {
+	return &LogProviders{}
+}
+
+// Registry returns a registry of the providers referenced by the given resources. The cached registry is returned if
+// the resources reference the same providers as the last time a registry was loaded.
+func (p *LogProviders) Registry(resources []*resource.State) (*providers.Registry, error) {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	if p.closed {
+		return nil, errors.New("the log providers have been closed")
+	}
+
+	key := providerKey(resources)
+	if p.loaded && p.key == key {
+		return p.registry, nil
+	}
+	if err := p.closeLocked(); err != nil {
+		return nil, err
+	}
+
+	ctx, err := plugin.NewContext(cmdutil.Diag(), cmdutil.Diag(), nil, nil, "", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	registry, err := providers.NewRegistry(ctx.Host, resources, false, nil)
+	if err != nil {
+		contract.IgnoreClose(ctx)
+		return nil, err
+	}
+
+	p.loaded, p.key, p.ctx, p.registry = true, key, ctx, registry
+	return registry, nil
+}
+
+// Close shuts down any providers launched for the cached registry.
+func (p *LogProviders) Close() error {
+	p.m.Lock()
+	defer p.m.Unlock()
+	p.closed = true
+	return p.closeLocked()
+}
+
+func (p *LogProviders) closeLocked() error {
+	ctx := p.ctx
+	p.loaded, p.key, p.ctx, p.registry = false, "", nil, nil
+	if ctx == nil {
+		return nil
+	}
+	return ctx.Close()
+}
+
+// providerKey returns a string that identifies the provider resources among the given resources.
+func providerKey(resources []*resource.State) string {
+	var refs []string
+	for _, res := range resources {
+		if providers.IsProviderType(res.Type) {
+			refs = append(refs, string(res.URN)+"::"+string(res.ID))
+		}
+	}
+	return strings.Join(refs, ",")
+}
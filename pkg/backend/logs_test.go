@@ -0,0 +1,39 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+)
+
+func TestLogProvidersCache(t *testing.T) {
+	logProviders := NewLogProviders()
+
+	// Repeated queries against the same providers reuse the cached registry.
+	first, err := logProviders.Registry([]*resource.State{})
+	assert.NoError(t, err)
+	second, err := logProviders.Registry([]*resource.State{})
+	assert.NoError(t, err)
+	assert.True(t, first == second)
+
+	// Once closed, the cache refuses to load providers again.
+	assert.NoError(t, logProviders.Close())
+	_, err = logProviders.Registry([]*resource.State{})
+	assert.Error(t, err)
+}
@@ -64,7 +64,7 @@ type MockBackend struct {
 		UpdateOperation) (engine.ResourceChanges, result.Result)
 	WatchF func(context.Context, Stack,
 		UpdateOperation) result.Result
-	GetLogsF func(context.Context, Stack, StackConfiguration,
+	GetLogsF func(context.Context, Stack, StackConfiguration, *LogProviders,
 		operations.LogQuery) ([]operations.LogEntry, error)
 }
 
@@ -231,11 +231,11 @@ func (be *MockBackend) GetHistory(ctx context.Context, stackRef StackReference)
 	panic("not implemented")
 }
 
-func (be *MockBackend) GetLogs(ctx context.Context, stack Stack, cfg StackConfiguration,
+func (be *MockBackend) GetLogs(ctx context.Context, stack Stack, cfg StackConfiguration, logProviders *LogProviders,
 	query operations.LogQuery) ([]operations.LogEntry, error) {
 
 	if be.GetLogsF != nil {
-		return be.GetLogsF(ctx, stack, cfg, query)
+		return be.GetLogsF(ctx, stack, cfg, logProviders, query)
 	}
 	panic("not implemented")
 }
@@ -316,7 +316,7 @@ type MockStack struct {
 	QueryF    func(ctx context.Context, op UpdateOperation) result.Result
 	RemoveF   func(ctx context.Context, force bool) (bool, error)
 	RenameF   func(ctx context.Context, newName tokens.QName) error
-	GetLogsF  func(ctx context.Context, cfg StackConfiguration,
+	GetLogsF  func(ctx context.Context, cfg StackConfiguration, logProviders *LogProviders,
 		query operations.LogQuery) ([]operations.LogEntry, error)
 	ExportDeploymentF func(ctx context.Context) (*apitype.UntypedDeployment, error)
 	ImportDeploymentF func(ctx context.Context, deployment *apitype.UntypedDeployment) error
@@ -408,10 +408,10 @@ func (ms *MockStack) Rename(ctx context.Context, newName tokens.QName) error {
 	panic("not implemented")
 }
 
-func (ms *MockStack) GetLogs(ctx context.Context, cfg StackConfiguration,
+func (ms *MockStack) GetLogs(ctx context.Context, cfg StackConfiguration, logProviders *LogProviders,
 	query operations.LogQuery) ([]operations.LogEntry, error) {
 	if ms.GetLogsF != nil {
-		return ms.GetLogsF(ctx, cfg, query)
+		return ms.GetLogsF(ctx, cfg, logProviders, query)
 	}
 	panic("not implemented")
 }
@@ -107,6 +107,14 @@ func (sm *SnapshotManager) mutate(mutator func() bool) error {
 	}
 }
 
+// SetBaseSnapshot replaces the base snapshot for this plan, and persists the resulting snapshot.
+func (sm *SnapshotManager) SetBaseSnapshot(snap *deploy.Snapshot) error {
+	return sm.mutate(func() bool {
+		sm.baseSnapshot = snap
+		return true
+	})
+}
+
 // RegisterResourceOutputs handles the registering of outputs on a Step that has already
 // completed. This is accomplished by doing an in-place mutation of the resources currently
 // resident in the snapshot.
@@ -54,8 +54,9 @@ type Stack interface {
 	Remove(ctx context.Context, force bool) (bool, error)
 	// rename this stack.
 	Rename(ctx context.Context, newName tokens.QName) error
-	// list log entries for this stack.
-	GetLogs(ctx context.Context, cfg StackConfiguration, query operations.LogQuery) ([]operations.LogEntry, error)
+	// list log entries for this stack, caching its providers in logProviders if it is not nil.
+	GetLogs(ctx context.Context, cfg StackConfiguration, logProviders *LogProviders,
+		query operations.LogQuery) ([]operations.LogEntry, error)
 	// export this stack's deployment.
 	ExportDeployment(ctx context.Context) (*apitype.UntypedDeployment, error)
 	// import the given deployment into this stack.
@@ -104,9 +105,9 @@ func GetLatestConfiguration(ctx context.Context, s Stack) (config.Map, error) {
 }
 
 // GetStackLogs fetches a list of log entries for the current stack in the current backend.
-func GetStackLogs(ctx context.Context, s Stack, cfg StackConfiguration,
+func GetStackLogs(ctx context.Context, s Stack, cfg StackConfiguration, logProviders *LogProviders,
 	query operations.LogQuery) ([]operations.LogEntry, error) {
-	return s.Backend().GetLogs(ctx, s, cfg, query)
+	return s.Backend().GetLogs(ctx, s, cfg, logProviders, query)
 }
 
 // ExportStackDeployment exports the given stack's deployment as an opaque JSON message.
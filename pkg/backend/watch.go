@@ -26,6 +26,7 @@ import (
 	"github.com/pulumi/pulumi/pkg/v2/operations"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/apitype"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/diag/colors"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/contract"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/logging"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/result"
 )
@@ -41,10 +42,23 @@ func Watch(ctx context.Context, b Backend, stack Stack, op UpdateOperation, appl
 
 	startTime := time.Now()
 
+	// Reuse the stack's providers across log polls. Once we stop watching, stop polling and wait for any in-flight
+	// query to finish before shutting the providers down.
+	logProviders := NewLogProviders()
+	logsCtx, cancelLogs := context.WithCancel(ctx)
+	logsDone := make(chan struct{})
+	defer func() {
+		cancelLogs()
+		<-logsDone
+		contract.IgnoreClose(logProviders)
+	}()
+
 	go func() {
+		defer close(logsDone)
+
 		shown := map[operations.LogEntry]bool{}
-		for {
-			logs, err := b.GetLogs(ctx, stack, op.StackConfiguration, operations.LogQuery{
+		for logsCtx.Err() == nil {
+			logs, err := b.GetLogs(logsCtx, stack, op.StackConfiguration, logProviders, operations.LogQuery{
 				StartTime: &startTime,
 			})
 			if err != nil {
@@ -60,7 +74,11 @@ func Watch(ctx context.Context, b Backend, stack Stack, op UpdateOperation, appl
 					shown[logEntry] = true
 				}
 			}
-			time.Sleep(10 * time.Second)
+
+			select {
+			case <-logsCtx.Done():
+			case <-time.After(10 * time.Second):
+			}
 		}
 	}()
 
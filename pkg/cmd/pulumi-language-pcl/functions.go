@@ -0,0 +1,185 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"io/ioutil"
+	"mime"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/function"
+	"github.com/zclconf/go-cty/cty/function/stdlib"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+)
+
+// functions returns the implementations of the PCL builtin functions, including invoke.
+func (i *interpreter) functions() map[string]function.Function {
+	pathParam := []function.Parameter{{Name: "path", Type: cty.String}}
+
+	return map[string]function.Function{
+		"element": stdlib.ElementFunc,
+		"entries": function.New(&function.Spec{
+			Params: []function.Parameter{{Name: "collection", Type: cty.DynamicPseudoType}},
+			Type:   function.StaticReturnType(cty.DynamicPseudoType),
+			Impl: func(args []cty.Value, retType cty.Type) (cty.Value, error) {
+				collection := args[0]
+				if !collection.CanIterateElements() {
+					return cty.NilVal, errors.Errorf("cannot iterate over a value of type %v",
+						collection.Type().FriendlyName())
+				}
+				var entries []cty.Value
+				for it := collection.ElementIterator(); it.Next(); {
+					k, v := it.Element()
+					entries = append(entries, cty.TupleVal([]cty.Value{k, v}))
+				}
+				if len(entries) == 0 {
+					return cty.EmptyTupleVal, nil
+				}
+				return cty.TupleVal(entries), nil
+			},
+		}),
+		"fileArchive": function.New(&function.Spec{
+			Params: pathParam,
+			Type:   function.StaticReturnType(archiveType),
+			Impl: func(args []cty.Value, retType cty.Type) (cty.Value, error) {
+				archive, err := resource.NewPathArchive(i.path(args[0].AsString()))
+				if err != nil {
+					return cty.NilVal, err
+				}
+				return cty.CapsuleVal(archiveType, archive), nil
+			},
+		}),
+		"fileAsset": function.New(&function.Spec{
+			Params: pathParam,
+			Type:   function.StaticReturnType(assetType),
+			Impl: func(args []cty.Value, retType cty.Type) (cty.Value, error) {
+				asset, err := resource.NewPathAsset(i.path(args[0].AsString()))
+				if err != nil {
+					return cty.NilVal, err
+				}
+				return cty.CapsuleVal(assetType, asset), nil
+			},
+		}),
+		"invoke": function.New(&function.Spec{
+			Params: []function.Parameter{{Name: "token", Type: cty.String}},
+			VarParam: &function.Parameter{
+				Name:         "args",
+				Type:         cty.DynamicPseudoType,
+				AllowNull:    true,
+				AllowUnknown: true,
+			},
+			Type: function.StaticReturnType(cty.DynamicPseudoType),
+			Impl: func(args []cty.Value, retType cty.Type) (cty.Value, error) {
+				return i.invoke(args[0].AsString(), args[1:])
+			},
+		}),
+		"length": function.New(&function.Spec{
+			Params: []function.Parameter{{Name: "value", Type: cty.DynamicPseudoType}},
+			Type:   function.StaticReturnType(cty.Number),
+			Impl: func(args []cty.Value, retType cty.Type) (cty.Value, error) {
+				switch t := args[0].Type(); {
+				case t == cty.String:
+					return stdlib.Strlen(args[0])
+				case t.IsObjectType():
+					return cty.NumberIntVal(int64(len(t.AttributeTypes()))), nil
+				case args[0].CanIterateElements():
+					return args[0].Length(), nil
+				default:
+					return cty.NilVal, errors.Errorf("cannot compute the length of a value of type %v", t.FriendlyName())
+				}
+			},
+		}),
+		"lookup": function.New(&function.Spec{
+			Params: []function.Parameter{
+				{Name: "map", Type: cty.DynamicPseudoType},
+				{Name: "key", Type: cty.String},
+			},
+			VarParam: &function.Parameter{Name: "default", Type: cty.DynamicPseudoType, AllowNull: true},
+			Type:     function.StaticReturnType(cty.DynamicPseudoType),
+			Impl: func(args []cty.Value, retType cty.Type) (cty.Value, error) {
+				m, key := args[0], args[1].AsString()
+				switch t := m.Type(); {
+				case t.IsObjectType():
+					if t.HasAttribute(key) {
+						return m.GetAttr(key), nil
+					}
+				case t.IsMapType():
+					if v := cty.StringVal(key); m.HasIndex(v).True() {
+						return m.Index(v), nil
+					}
+				default:
+					return cty.NilVal, errors.Errorf("cannot look up a key in a value of type %v", t.FriendlyName())
+				}
+				if len(args) > 2 {
+					return args[2], nil
+				}
+				return cty.NilVal, errors.Errorf("missing key %q", key)
+			},
+		}),
+		"mimeType": function.New(&function.Spec{
+			Params: pathParam,
+			Type:   function.StaticReturnType(cty.String),
+			Impl: func(args []cty.Value, retType cty.Type) (cty.Value, error) {
+				if mimeType := mime.TypeByExtension(filepath.Ext(args[0].AsString())); mimeType != "" {
+					return cty.StringVal(mimeType), nil
+				}
+				return cty.NullVal(cty.String), nil
+			},
+		}),
+		"range": stdlib.RangeFunc,
+		"readDir": function.New(&function.Spec{
+			Params: pathParam,
+			Type:   function.StaticReturnType(cty.List(cty.String)),
+			Impl: func(args []cty.Value, retType cty.Type) (cty.Value, error) {
+				entries, err := ioutil.ReadDir(i.path(args[0].AsString()))
+				if err != nil {
+					return cty.NilVal, err
+				}
+				if len(entries) == 0 {
+					return cty.ListValEmpty(cty.String), nil
+				}
+				names := make([]cty.Value, len(entries))
+				for j, e := range entries {
+					names[j] = cty.StringVal(e.Name())
+				}
+				return cty.ListVal(names), nil
+			},
+		}),
+		"readFile": function.New(&function.Spec{
+			Params: pathParam,
+			Type:   function.StaticReturnType(cty.String),
+			Impl: func(args []cty.Value, retType cty.Type) (cty.Value, error) {
+				contents, err := ioutil.ReadFile(i.path(args[0].AsString()))
+				if err != nil {
+					return cty.NilVal, err
+				}
+				return cty.StringVal(string(contents)), nil
+			},
+		}),
+		"split":  stdlib.SplitFunc,
+		"toJSON": stdlib.JSONEncodeFunc,
+	}
+}
+
+// path resolves the given path relative to the program's directory.
+func (i *interpreter) path(path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(i.root, path)
+}
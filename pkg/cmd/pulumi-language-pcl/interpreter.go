@@ -0,0 +1,754 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/pkg/errors"
+	"github.com/zclconf/go-cty/cty"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+
+	"github.com/pulumi/pulumi/pkg/v2/codegen/hcl2"
+	"github.com/pulumi/pulumi/pkg/v2/codegen/hcl2/model"
+	"github.com/pulumi/pulumi/pkg/v2/codegen/schema"
+	"github.com/pulumi/pulumi/pkg/v2/resource/deploy/providers"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/contract"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+// interpreter evaluates a bound PCL program against a resource monitor.
+//
+// Each of the program's nodes is evaluated as soon as the nodes it references have been evaluated, so independent
+// resources are registered concurrently. Values are represented as cty values: the outputs of resources and invokes
+// that are not yet known (e.g. during a preview) are represented as unknown values, and any expression that depends on
+// an unknown value evaluates to an unknown value in turn. Secretness is tracked per node: if a node's value contains
+// any secrets, every value that references that node is treated as secret.
+type interpreter struct {
+	ctx     context.Context
+	monitor pulumirpc.ResourceMonitorClient
+	engine  pulumirpc.EngineClient // the engine, if any, to which diagnostics are logged.
+
+	// mu guards the interpreter's mutable state. It is held while a node is evaluated, and released while waiting for
+	// the resource monitor to register a resource.
+	mu sync.Mutex
+
+	project string            // the name of the project.
+	stack   string            // the name of the stack.
+	root    string            // the directory that contains the program.
+	config  map[string]string // the program's configuration.
+	dryRun  bool              // true if this is a preview.
+
+	tokens      map[string]string // a map from canonical resource and function tokens to schema tokens.
+	keepSecrets bool              // true if the resource monitor supports secrets.
+	stackURN    string            // the URN of the stack resource.
+	evalContext *hcl.EvalContext  // the context in which the program's expressions are evaluated.
+
+	// dependencies maps each evaluated node to the URNs of the resources it depends upon, either directly or
+	// through other nodes.
+	dependencies map[hcl2.Node][]string
+	// secrets records the evaluated nodes whose values contain secrets.
+	secrets map[hcl2.Node]bool
+}
+
+func newInterpreter(ctx context.Context, monitor pulumirpc.ResourceMonitorClient, engine pulumirpc.EngineClient,
+	project, stack, root string, config map[string]string, dryRun bool) *interpreter {
+
+	i := &interpreter{
+		ctx:          ctx,
+		monitor:      monitor,
+		engine:       engine,
+		project:      project,
+		stack:        stack,
+		root:         root,
+		config:       config,
+		dryRun:       dryRun,
+		dependencies: map[hcl2.Node][]string{},
+		secrets:      map[hcl2.Node]bool{},
+	}
+	i.evalContext = &hcl.EvalContext{
+		Variables: map[string]cty.Value{},
+		Functions: i.functions(),
+	}
+	return i
+}
+
+// run evaluates the given program: it registers the stack resource, registers the program's resources, and
+// finally registers the program's outputs as the outputs of the stack.
+func (i *interpreter) run(program *hcl2.Program) error {
+	i.tokens = schemaTokens(program.Packages())
+
+	supportsSecrets, err := i.monitor.SupportsFeature(i.ctx, &pulumirpc.SupportsFeatureRequest{Id: "secrets"})
+	if err != nil {
+		return err
+	}
+	i.keepSecrets = supportsSecrets.GetHasSupport()
+
+	stack, err := i.monitor.RegisterResource(i.ctx, &pulumirpc.RegisterResourceRequest{
+		Type: "pulumi:pulumi:Stack",
+		Name: fmt.Sprintf("%s-%s", i.project, i.stack),
+	})
+	if err != nil {
+		return errors.Wrap(err, "registering stack")
+	}
+	i.stackURN = stack.GetUrn()
+
+	outputs := resource.PropertyMap{}
+	if err := i.evalNodes(hcl2.Linearize(program), outputs); err != nil {
+		return err
+	}
+
+	outs, err := plugin.MarshalProperties(outputs, plugin.MarshalOptions{
+		Label:        "stack.outputs",
+		KeepUnknowns: true,
+		KeepSecrets:  i.keepSecrets,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = i.monitor.RegisterResourceOutputs(i.ctx, &pulumirpc.RegisterResourceOutputsRequest{
+		Urn:     i.stackURN,
+		Outputs: outs,
+	})
+	return err
+}
+
+// evalNodes evaluates the given nodes, each in its own goroutine. A node is evaluated once all of the nodes it
+// references have been evaluated; if any of those nodes fails, the node is not evaluated. The values of the program's
+// outputs are added to the given map. The returned error combines the errors of all of the nodes that failed.
+func (i *interpreter) evalNodes(nodes []hcl2.Node, outputs resource.PropertyMap) error {
+	type nodeState struct {
+		done   chan struct{} // closed once the node has been evaluated or skipped.
+		failed bool          // true if the node or one of its dependencies failed. Read only after done is closed.
+	}
+	states := map[hcl2.Node]*nodeState{}
+	for _, n := range nodes {
+		states[n] = &nodeState{done: make(chan struct{})}
+	}
+
+	var result error
+	var wg sync.WaitGroup
+	for _, n := range nodes {
+		wg.Add(1)
+		go func(n hcl2.Node, state *nodeState) {
+			defer wg.Done()
+			defer close(state.done)
+
+			for _, d := range nodeDependencies(n) {
+				if dependency, ok := states[d]; ok && d != n {
+					<-dependency.done
+					if dependency.failed {
+						state.failed = true
+						return
+					}
+				}
+			}
+
+			i.mu.Lock()
+			defer i.mu.Unlock()
+			if err := i.evalNode(n, outputs); err != nil {
+				state.failed = true
+				result = multierror.Append(result, err)
+			}
+		}(n, states[n])
+	}
+	wg.Wait()
+
+	return result
+}
+
+// evalNode evaluates a single node. The caller must hold the interpreter's lock.
+func (i *interpreter) evalNode(n hcl2.Node, outputs resource.PropertyMap) error {
+	switch n := n.(type) {
+	case *hcl2.ConfigVariable:
+		return i.evalConfigVariable(n)
+	case *hcl2.LocalVariable:
+		return i.evalLocalVariable(n)
+	case *hcl2.Resource:
+		return i.registerResource(n)
+	case *hcl2.OutputVariable:
+		output, err := i.evalProperty(i.evalContext, n.Value)
+		if err != nil {
+			return err
+		}
+		outputs[resource.PropertyKey(n.Name())] = output
+	}
+	return nil
+}
+
+// nodeDependencies returns the nodes referenced by the expressions that make up a node.
+func nodeDependencies(n hcl2.Node) []hcl2.Node {
+	var nodes []hcl2.Node
+	diags := n.VisitExpressions(model.IdentityVisitor, func(x model.Expression) (model.Expression, hcl.Diagnostics) {
+		if traversal, ok := x.(*model.ScopeTraversalExpression); ok {
+			if n, ok := traversal.Parts[0].(hcl2.Node); ok {
+				nodes = append(nodes, n)
+			}
+		}
+		return x, nil
+	})
+	contract.Assert(len(diags) == 0)
+	return nodes
+}
+
+// log sends a message to the engine's log.
+func (i *interpreter) log(severity pulumirpc.LogSeverity, urn, format string, args ...interface{}) {
+	logMessage(i.ctx, i.engine, severity, urn, fmt.Sprintf(format, args...))
+}
+
+// schemaTokens returns a map from the canonical form of each resource and function token in the given packages to
+// the token as it appears in the package's schema. The binder canonicalizes the tokens in a program (e.g.
+// `aws:index:getAmi` becomes `aws::getAmi`), but the resource monitor requires the schema's tokens.
+func schemaTokens(packages []*schema.Package) map[string]string {
+	tokens := map[string]string{}
+	canonicalize := func(pkg *schema.Package, token string) string {
+		components := strings.Split(token, ":")
+		if len(components) != 3 {
+			return token
+		}
+		return fmt.Sprintf("%s:%s:%s", pkg.Name, pkg.TokenToModule(token), components[2])
+	}
+	for _, pkg := range packages {
+		for _, r := range pkg.Resources {
+			tokens[canonicalize(pkg, r.Token)] = r.Token
+		}
+		for _, f := range pkg.Functions {
+			tokens[canonicalize(pkg, f.Token)] = f.Token
+		}
+	}
+	return tokens
+}
+
+// schemaToken returns the schema token for the given canonical token.
+func (i *interpreter) schemaToken(token string) string {
+	if schemaToken, ok := i.tokens[token]; ok {
+		return schemaToken
+	}
+	return token
+}
+
+// define sets the value of the given node.
+func (i *interpreter) define(n hcl2.Node, value cty.Value) {
+	i.evalContext.Variables[n.Name()] = value
+}
+
+// eval evaluates an expression in the given context.
+func (i *interpreter) eval(context *hcl.EvalContext, x model.Expression) (cty.Value, error) {
+	value, diags := x.Evaluate(context)
+	if diags.HasErrors() {
+		return cty.NilVal, diags
+	}
+	return value, nil
+}
+
+// evalProperty evaluates an expression in the given context and converts the result to a resource property value.
+// If the expression references any nodes whose values contain secrets, the result is marked as secret.
+func (i *interpreter) evalProperty(context *hcl.EvalContext, x model.Expression) (resource.PropertyValue, error) {
+	value, err := i.eval(context, x)
+	if err != nil {
+		return resource.PropertyValue{}, err
+	}
+	property, err := ctyToPropertyValue(value)
+	if err != nil {
+		rng := x.SyntaxNode().Range()
+		return resource.PropertyValue{}, errors.Wrapf(err, "%v", rng)
+	}
+	if !property.IsNull() && i.isSecret(x) {
+		property = resource.MakeSecret(property)
+	}
+	return property, nil
+}
+
+// referencedNodes returns the nodes referenced by an expression.
+func referencedNodes(x model.Expression) []hcl2.Node {
+	var nodes []hcl2.Node
+	_, diags := model.VisitExpression(x, func(x model.Expression) (model.Expression, hcl.Diagnostics) {
+		if traversal, ok := x.(*model.ScopeTraversalExpression); ok {
+			if n, ok := traversal.Parts[0].(hcl2.Node); ok {
+				nodes = append(nodes, n)
+			}
+		}
+		return x, nil
+	}, model.IdentityVisitor)
+	contract.Assert(len(diags) == 0)
+	return nodes
+}
+
+// isSecret returns true if the given expression references any nodes whose values contain secrets.
+func (i *interpreter) isSecret(x model.Expression) bool {
+	for _, n := range referencedNodes(x) {
+		if i.secrets[n] {
+			return true
+		}
+	}
+	return false
+}
+
+// resourceDependencies returns the URNs of the resources an expression depends upon.
+func (i *interpreter) resourceDependencies(x model.Expression) []string {
+	set := map[string]struct{}{}
+	for _, n := range referencedNodes(x) {
+		for _, urn := range i.dependencies[n] {
+			set[urn] = struct{}{}
+		}
+	}
+	urns := make([]string, 0, len(set))
+	for urn := range set {
+		urns = append(urns, urn)
+	}
+	sort.Strings(urns)
+	return urns
+}
+
+// evalConfigVariable reads the value of a config variable from the program's configuration, falling back to the
+// variable's default value if the configuration does not contain a value.
+func (i *interpreter) evalConfigVariable(c *hcl2.ConfigVariable) error {
+	key := c.Name()
+	if !strings.Contains(key, ":") {
+		key = i.project + ":" + key
+	}
+
+	raw, ok := i.config[key]
+	if !ok {
+		if c.DefaultValue == nil {
+			return errors.Errorf("missing required configuration variable '%s'; run `pulumi config` to set", key)
+		}
+		value, err := i.eval(i.evalContext, c.DefaultValue)
+		if err != nil {
+			return err
+		}
+		i.define(c, value)
+		return nil
+	}
+
+	value, err := parseConfigValue(raw, model.ResolveOutputs(c.Type()))
+	if err != nil {
+		return errors.Wrapf(err, "configuration variable '%s'", key)
+	}
+	i.define(c, value)
+	return nil
+}
+
+// parseConfigValue parses the raw value of a config variable according to the variable's type. Values of complex
+// types are parsed as JSON.
+func parseConfigValue(raw string, t model.Type) (cty.Value, error) {
+	switch t {
+	case model.StringType:
+		return cty.StringVal(raw), nil
+	case model.NumberType, model.IntType:
+		f, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return cty.NilVal, err
+		}
+		return cty.NumberFloatVal(f), nil
+	case model.BoolType:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return cty.NilVal, err
+		}
+		return cty.BoolVal(b), nil
+	default:
+		impliedType, err := ctyjson.ImpliedType([]byte(raw))
+		if err != nil {
+			return cty.NilVal, err
+		}
+		return ctyjson.Unmarshal([]byte(raw), impliedType)
+	}
+}
+
+// evalLocalVariable evaluates the definition of a local variable.
+func (i *interpreter) evalLocalVariable(l *hcl2.LocalVariable) error {
+	value, err := i.eval(i.evalContext, l.Definition.Value)
+	if err != nil {
+		return err
+	}
+	i.define(l, value)
+	i.dependencies[l] = i.resourceDependencies(l.Definition.Value)
+	i.secrets[l] = i.isSecret(l.Definition.Value)
+	return nil
+}
+
+// registerResource registers the instances of a resource with the resource monitor. A resource that does not have a
+// range option has a single instance. If a resource has a range option, the number of instances depends on the
+// range's value:
+//
+// - if the range is a bool, the resource has a single instance if the value is true and no instances otherwise
+// - if the range is a number, the resource has that many instances, named `<name>-<index>`
+// - if the range is a collection, the resource has one instance per element, named `<name>-<key>`
+//
+// If the range's value is unknown, no instances are registered and the value of the resource is unknown.
+func (i *interpreter) registerResource(r *hcl2.Resource) error {
+	if r.Options == nil || r.Options.Range == nil {
+		value, err := i.registerResourceInstance(r, r.Name(), i.evalContext)
+		if err != nil {
+			return err
+		}
+		i.define(r, value)
+		return nil
+	}
+
+	rangeValue, err := i.eval(i.evalContext, r.Options.Range)
+	if err != nil {
+		return err
+	}
+	switch {
+	case !rangeValue.IsKnown():
+		i.log(pulumirpc.LogSeverity_DEBUG, "", "not registering %s: range is unknown", r.Name())
+		i.define(r, cty.DynamicVal)
+		return nil
+	case rangeValue.IsNull():
+		i.define(r, cty.NullVal(cty.DynamicPseudoType))
+		return nil
+	case rangeValue.Type() == cty.Bool:
+		value := cty.NullVal(cty.DynamicPseudoType)
+		if rangeValue.True() {
+			if value, err = i.registerResourceInstance(r, r.Name(), i.evalContext); err != nil {
+				return err
+			}
+		}
+		i.define(r, value)
+		return nil
+	}
+
+	var instances []cty.Value
+	registerInstance := func(key, value cty.Value) error {
+		context := i.evalContext.NewChild()
+		context.Variables = map[string]cty.Value{
+			"range": cty.ObjectVal(map[string]cty.Value{"key": key, "value": value}),
+		}
+		instance, err := i.registerResourceInstance(r, r.Name()+"-"+ctyKeyString(key), context)
+		if err != nil {
+			return err
+		}
+		instances = append(instances, instance)
+		return nil
+	}
+
+	switch {
+	case rangeValue.Type() == cty.Number:
+		count, err := ctyInt(rangeValue)
+		if err != nil {
+			return errors.Wrapf(err, "%v: invalid range", r.Options.Range.SyntaxNode().Range())
+		}
+		for index := 0; index < count; index++ {
+			if err = registerInstance(cty.NumberIntVal(int64(index)), cty.NumberIntVal(int64(index))); err != nil {
+				return err
+			}
+		}
+	case rangeValue.CanIterateElements():
+		for it := rangeValue.ElementIterator(); it.Next(); {
+			if err = registerInstance(it.Element()); err != nil {
+				return err
+			}
+		}
+	default:
+		return errors.Errorf("%v: cannot range over a value of type %v", r.Options.Range.SyntaxNode().Range(),
+			rangeValue.Type().FriendlyName())
+	}
+
+	if len(instances) == 0 {
+		i.define(r, cty.EmptyTupleVal)
+	} else {
+		i.define(r, cty.TupleVal(instances))
+	}
+	return nil
+}
+
+// registerResourceInstance registers a single instance of a resource with the given name. The resource's inputs are
+// evaluated in the given context. The result is an object that contains the resource's outputs, its URN, and its ID.
+func (i *interpreter) registerResourceInstance(r *hcl2.Resource, name string,
+	context *hcl.EvalContext) (cty.Value, error) {
+
+	dependencies := map[string]struct{}{}
+	addDependencies := func(urns []string) {
+		for _, urn := range urns {
+			dependencies[urn] = struct{}{}
+		}
+	}
+
+	inputs := resource.PropertyMap{}
+	propertyDependencies := map[string]*pulumirpc.RegisterResourceRequest_PropertyDependencies{}
+	for _, attr := range r.Inputs {
+		input, err := i.evalProperty(context, attr.Value)
+		if err != nil {
+			return cty.NilVal, err
+		}
+		if input.IsNull() {
+			continue
+		}
+		inputs[resource.PropertyKey(attr.Name)] = input
+
+		urns := i.resourceDependencies(attr.Value)
+		propertyDependencies[attr.Name] = &pulumirpc.RegisterResourceRequest_PropertyDependencies{Urns: urns}
+		addDependencies(urns)
+	}
+
+	var provider string
+	var protect bool
+	var ignoreChanges []string
+	if options := r.Options; options != nil {
+		if options.Range != nil {
+			addDependencies(i.resourceDependencies(options.Range))
+		}
+		if options.Provider != nil {
+			value, err := i.eval(context, options.Provider)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			if provider, err = providerReference(value); err != nil {
+				return cty.NilVal, errors.Wrapf(err, "%v", options.Provider.SyntaxNode().Range())
+			}
+			addDependencies(i.resourceDependencies(options.Provider))
+		}
+		if options.DependsOn != nil {
+			addDependencies(i.resourceDependencies(options.DependsOn))
+		}
+		if options.Protect != nil {
+			value, err := i.eval(context, options.Protect)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			protect = value.IsKnown() && !value.IsNull() && value.True()
+		}
+		if options.IgnoreChanges != nil {
+			paths, err := ignoreChangesPaths(options.IgnoreChanges)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			ignoreChanges = paths
+		}
+	}
+
+	deps := make([]string, 0, len(dependencies))
+	for urn := range dependencies {
+		deps = append(deps, urn)
+	}
+	sort.Strings(deps)
+
+	object, err := plugin.MarshalProperties(inputs, plugin.MarshalOptions{
+		Label:        name + ".inputs",
+		KeepUnknowns: true,
+		KeepSecrets:  i.keepSecrets,
+	})
+	if err != nil {
+		return cty.NilVal, err
+	}
+
+	// Release the lock while the resource is registered so that other nodes may be evaluated in the meantime.
+	i.mu.Unlock()
+	resp, err := i.monitor.RegisterResource(i.ctx, &pulumirpc.RegisterResourceRequest{
+		Type:                  i.schemaToken(r.Token),
+		Name:                  name,
+		Parent:                i.stackURN,
+		Custom:                true,
+		Object:                object,
+		Protect:               protect,
+		Dependencies:          deps,
+		Provider:              provider,
+		PropertyDependencies:  propertyDependencies,
+		IgnoreChanges:         ignoreChanges,
+		AcceptSecrets:         true,
+		SupportsPartialValues: true,
+	})
+	i.mu.Lock()
+	if err != nil {
+		return cty.NilVal, errors.Wrapf(err, "registering resource %s", name)
+	}
+
+	outputs, err := plugin.UnmarshalProperties(resp.GetObject(), plugin.MarshalOptions{
+		Label:        name + ".outputs",
+		KeepUnknowns: true,
+		KeepSecrets:  true,
+	})
+	if err != nil {
+		return cty.NilVal, err
+	}
+
+	attributes := map[string]cty.Value{}
+	for k, v := range outputs {
+		value, secret := propertyValueToCty(v)
+		attributes[string(k)] = value
+		if secret {
+			i.secrets[r] = true
+		}
+	}
+
+	// Fill in any output properties that were not returned by the resource monitor. These properties are unknown
+	// during a preview and null otherwise.
+	if outputType, ok := r.OutputType.(*model.ObjectType); ok {
+		for k := range outputType.Properties {
+			if _, has := attributes[k]; !has {
+				if i.dryRun {
+					attributes[k] = cty.DynamicVal
+				} else {
+					attributes[k] = cty.NullVal(cty.DynamicPseudoType)
+				}
+			}
+		}
+	}
+
+	attributes["urn"] = cty.StringVal(resp.GetUrn())
+	if id := resp.GetId(); id != "" || !i.dryRun {
+		attributes["id"] = cty.StringVal(id)
+	} else {
+		attributes["id"] = cty.UnknownVal(cty.String)
+	}
+
+	i.dependencies[r] = append(i.dependencies[r], resp.GetUrn())
+	return cty.ObjectVal(attributes), nil
+}
+
+// providerReference returns the provider reference for the given provider resource value.
+func providerReference(v cty.Value) (string, error) {
+	if v.IsNull() {
+		return "", nil
+	}
+
+	t := v.Type()
+	if !t.IsObjectType() || !t.HasAttribute("urn") || !t.HasAttribute("id") {
+		return "", errors.New("provider must be a provider resource")
+	}
+	urn, id := v.GetAttr("urn"), v.GetAttr("id")
+	if !urn.IsKnown() || urn.Type() != cty.String {
+		return "", errors.New("provider must be a provider resource")
+	}
+
+	providerID := providers.UnknownID
+	if id.IsKnown() && !id.IsNull() {
+		providerID = id.AsString()
+	}
+	ref, err := providers.NewReference(resource.URN(urn.AsString()), resource.ID(providerID))
+	if err != nil {
+		return "", err
+	}
+	return ref.String(), nil
+}
+
+// ignoreChangesPaths returns the property paths listed by an ignoreChanges option.
+func ignoreChangesPaths(x model.Expression) ([]string, error) {
+	list, ok := x.(*model.TupleConsExpression)
+	if !ok {
+		return nil, errors.Errorf("%v: ignoreChanges must be a list of properties", x.SyntaxNode().Range())
+	}
+
+	paths := make([]string, len(list.Expressions))
+	for index, element := range list.Expressions {
+		traversal, ok := element.(*model.ScopeTraversalExpression)
+		if !ok {
+			return nil, errors.Errorf("%v: ignoreChanges must be a list of properties", element.SyntaxNode().Range())
+		}
+
+		var path strings.Builder
+		for _, traverser := range traversal.Traversal {
+			switch traverser := traverser.(type) {
+			case hcl.TraverseRoot:
+				path.WriteString(traverser.Name)
+			case hcl.TraverseAttr:
+				fmt.Fprintf(&path, ".%s", traverser.Name)
+			case hcl.TraverseIndex:
+				if traverser.Key.Type() == cty.String {
+					fmt.Fprintf(&path, "[%q]", traverser.Key.AsString())
+				} else {
+					fmt.Fprintf(&path, "[%s]", ctyKeyString(traverser.Key))
+				}
+			}
+		}
+		paths[index] = path.String()
+	}
+	return paths, nil
+}
+
+// invoke calls the function with the given token. The first argument, if any, is the object that contains the
+// function's arguments. The second argument, if any, is the provider to use. If any of the arguments are not known,
+// the function is not called and its result is unknown.
+func (i *interpreter) invoke(token string, args []cty.Value) (cty.Value, error) {
+	if len(args) > 2 {
+		return cty.NilVal, errors.Errorf("too many arguments to invoke %s", token)
+	}
+	for _, arg := range args {
+		if !arg.IsWhollyKnown() {
+			return cty.DynamicVal, nil
+		}
+	}
+
+	inputs := resource.PropertyMap{}
+	if len(args) > 0 && !args[0].IsNull() {
+		if t := args[0].Type(); !t.IsObjectType() && !t.IsMapType() {
+			return cty.NilVal, errors.Errorf("the arguments to %s must be an object", token)
+		}
+		m, err := ctyToPropertyMap(args[0])
+		if err != nil {
+			return cty.NilVal, err
+		}
+		inputs = m
+	}
+
+	var provider string
+	if len(args) > 1 && !args[1].IsNull() {
+		if args[1].Type() == cty.String {
+			provider = args[1].AsString()
+		} else {
+			ref, err := providerReference(args[1])
+			if err != nil {
+				return cty.NilVal, err
+			}
+			provider = ref
+		}
+	}
+
+	argsStruct, err := plugin.MarshalProperties(inputs, plugin.MarshalOptions{
+		Label:       token + ".args",
+		KeepSecrets: i.keepSecrets,
+	})
+	if err != nil {
+		return cty.NilVal, err
+	}
+
+	resp, err := i.monitor.Invoke(i.ctx, &pulumirpc.InvokeRequest{
+		Tok:      i.schemaToken(token),
+		Args:     argsStruct,
+		Provider: provider,
+	})
+	if err != nil {
+		return cty.NilVal, errors.Wrapf(err, "invoking %s", token)
+	}
+	if len(resp.GetFailures()) > 0 {
+		var ferr error
+		for _, failure := range resp.GetFailures() {
+			ferr = multierror.Append(ferr,
+				errors.Errorf("%s invoke failed: %s (%s)", token, failure.Reason, failure.Property))
+		}
+		return cty.NilVal, ferr
+	}
+
+	ret, err := plugin.UnmarshalProperties(resp.GetReturn(), plugin.MarshalOptions{
+		Label:        token + ".return",
+		KeepUnknowns: true,
+	})
+	if err != nil {
+		return cty.NilVal, err
+	}
+	value, _ := propertyMapToCty(ret)
+	return value, nil
+}
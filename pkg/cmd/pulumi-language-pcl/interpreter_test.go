@@ -0,0 +1,311 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/blang/semver"
+	pbempty "github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/pulumi/pulumi/pkg/v2/codegen/hcl2"
+	"github.com/pulumi/pulumi/pkg/v2/codegen/hcl2/syntax"
+	"github.com/pulumi/pulumi/pkg/v2/resource/deploy/deploytest"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+const testSchema = `{
+	"name": "test",
+	"resources": {
+		"test:index:Bucket": {
+			"properties": {
+				"name": {"type": "string"},
+				"region": {"type": "string"}
+			},
+			"inputProperties": {
+				"name": {"type": "string"}
+			}
+		},
+		"test:index:Object": {
+			"properties": {
+				"bucket": {"type": "string"},
+				"key": {"type": "string"},
+				"size": {"type": "integer"}
+			},
+			"inputProperties": {
+				"bucket": {"type": "string"},
+				"key": {"type": "string"}
+			}
+		}
+	},
+	"functions": {
+		"test:index:getRegion": {
+			"inputs": {
+				"properties": {
+					"bucket": {"type": "string"}
+				}
+			},
+			"outputs": {
+				"properties": {
+					"region": {"type": "string"}
+				}
+			}
+		}
+	}
+}`
+
+const testProgram = `
+config prefix "string" {
+	default = "my"
+}
+
+resource bucket "test:index:Bucket" {
+	name = "${prefix}-bucket"
+}
+
+resource objects "test:index:Object" {
+	options {
+		range = ["a", "b"]
+	}
+	bucket = bucket.id
+	key = "${range.key}/${range.value}"
+}
+
+objectKeys = [for o in objects: o.key]
+regionInfo = invoke("test:index:getRegion", {
+	bucket = bucket.name
+})
+
+output bucketId { value = bucket.id }
+output keys { value = objectKeys }
+output region { value = regionInfo.region }
+output sizes { value = objects[*].size }
+`
+
+// registration records a resource registration.
+type registration struct {
+	name         string
+	inputs       resource.PropertyMap
+	dependencies []string
+}
+
+// mockMonitor is a resource monitor that records registrations. During an update, the outputs of each resource are
+// its inputs plus the resource's name. During a preview, the outputs of a resource are its inputs.
+type mockMonitor struct {
+	pulumirpc.ResourceMonitorClient
+
+	dryRun        bool
+	barrier       *sync.WaitGroup // if non-nil, registrations block until the barrier's count of them is in flight
+	m             sync.Mutex
+	registrations []registration
+	outputs       resource.PropertyMap
+}
+
+func (m *mockMonitor) SupportsFeature(ctx context.Context, req *pulumirpc.SupportsFeatureRequest,
+	opts ...grpc.CallOption) (*pulumirpc.SupportsFeatureResponse, error) {
+
+	return &pulumirpc.SupportsFeatureResponse{HasSupport: true}, nil
+}
+
+func (m *mockMonitor) RegisterResource(ctx context.Context, req *pulumirpc.RegisterResourceRequest,
+	opts ...grpc.CallOption) (*pulumirpc.RegisterResourceResponse, error) {
+
+	urn := "urn:pulumi:stack::project::" + req.GetType() + "::" + req.GetName()
+	if req.GetType() == "pulumi:pulumi:Stack" {
+		return &pulumirpc.RegisterResourceResponse{Urn: urn}, nil
+	}
+
+	inputs, err := plugin.UnmarshalProperties(req.GetObject(), plugin.MarshalOptions{KeepUnknowns: true})
+	if err != nil {
+		return nil, err
+	}
+	m.m.Lock()
+	m.registrations = append(m.registrations, registration{
+		name:         req.GetName(),
+		inputs:       inputs,
+		dependencies: req.GetDependencies(),
+	})
+	m.m.Unlock()
+
+	if m.barrier != nil {
+		m.barrier.Done()
+		done := make(chan struct{})
+		go func() {
+			m.barrier.Wait()
+			close(done)
+		}()
+		select {
+		case <-done:
+		case <-time.After(10 * time.Second):
+			return nil, errors.Errorf("timed out waiting for concurrent registrations")
+		}
+	}
+
+	outputs, id := inputs.Copy(), ""
+	if !m.dryRun {
+		outputs["size"] = resource.NewNumberProperty(float64(len(req.GetName())))
+		id = req.GetName() + "-id"
+	}
+	object, err := plugin.MarshalProperties(outputs, plugin.MarshalOptions{KeepUnknowns: true})
+	if err != nil {
+		return nil, err
+	}
+	return &pulumirpc.RegisterResourceResponse{Urn: urn, Id: id, Object: object}, nil
+}
+
+func (m *mockMonitor) Invoke(ctx context.Context, req *pulumirpc.InvokeRequest,
+	opts ...grpc.CallOption) (*pulumirpc.InvokeResponse, error) {
+
+	args, err := plugin.UnmarshalProperties(req.GetArgs(), plugin.MarshalOptions{})
+	if err != nil {
+		return nil, err
+	}
+	ret, err := plugin.MarshalProperties(resource.PropertyMap{
+		"region": resource.NewStringProperty(args["bucket"].StringValue() + "-region"),
+	}, plugin.MarshalOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return &pulumirpc.InvokeResponse{Return: ret}, nil
+}
+
+func (m *mockMonitor) RegisterResourceOutputs(ctx context.Context, req *pulumirpc.RegisterResourceOutputsRequest,
+	opts ...grpc.CallOption) (*pbempty.Empty, error) {
+
+	outputs, err := plugin.UnmarshalProperties(req.GetOutputs(), plugin.MarshalOptions{KeepUnknowns: true})
+	if err != nil {
+		return nil, err
+	}
+	m.outputs = outputs
+	return &pbempty.Empty{}, nil
+}
+
+func bindTestProgram(t *testing.T, source string) *hcl2.Program {
+	parser := syntax.NewParser()
+	err := parser.ParseFile(strings.NewReader(source), "main.pp")
+	require.NoError(t, err)
+	require.False(t, parser.Diagnostics.HasErrors(), parser.Diagnostics.Error())
+
+	host := deploytest.NewPluginHost(nil, nil, nil,
+		deploytest.NewProviderLoader("test", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				GetSchemaF: func(version int) ([]byte, error) {
+					return []byte(testSchema), nil
+				},
+			}, nil
+		}))
+
+	program, diags, err := hcl2.BindProgram(parser.Files, hcl2.PluginHost(host))
+	require.NoError(t, err)
+	require.False(t, diags.HasErrors(), diags.Error())
+	return program
+}
+
+func TestInterpreterUpdate(t *testing.T) {
+	program := bindTestProgram(t, testProgram)
+
+	monitor := &mockMonitor{}
+	interp := newInterpreter(context.Background(), monitor, nil, "project", "stack", ".", nil, false)
+	require.NoError(t, interp.run(program))
+
+	bucketURN := "urn:pulumi:stack::project::test:index:Bucket::bucket"
+	assert.Equal(t, []registration{
+		{
+			name:         "bucket",
+			inputs:       resource.PropertyMap{"name": resource.NewStringProperty("my-bucket")},
+			dependencies: []string{},
+		},
+		{
+			name: "objects-0",
+			inputs: resource.PropertyMap{
+				"bucket": resource.NewStringProperty("bucket-id"),
+				"key":    resource.NewStringProperty("0/a"),
+			},
+			dependencies: []string{bucketURN},
+		},
+		{
+			name: "objects-1",
+			inputs: resource.PropertyMap{
+				"bucket": resource.NewStringProperty("bucket-id"),
+				"key":    resource.NewStringProperty("1/b"),
+			},
+			dependencies: []string{bucketURN},
+		},
+	}, monitor.registrations)
+
+	assert.Equal(t, resource.PropertyMap{
+		"bucketId": resource.NewStringProperty("bucket-id"),
+		"keys": resource.NewArrayProperty([]resource.PropertyValue{
+			resource.NewStringProperty("0/a"),
+			resource.NewStringProperty("1/b"),
+		}),
+		"region": resource.NewStringProperty("my-bucket-region"),
+		"sizes": resource.NewArrayProperty([]resource.PropertyValue{
+			resource.NewNumberProperty(9),
+			resource.NewNumberProperty(9),
+		}),
+	}, monitor.outputs)
+}
+
+func TestInterpreterPreview(t *testing.T) {
+	program := bindTestProgram(t, testProgram)
+
+	monitor := &mockMonitor{dryRun: true}
+	config := map[string]string{"project:prefix": "your"}
+	interp := newInterpreter(context.Background(), monitor, nil, "project", "stack", ".", config, true)
+	require.NoError(t, interp.run(program))
+
+	require.Len(t, monitor.registrations, 3)
+	assert.Equal(t, resource.NewStringProperty("your-bucket"), monitor.registrations[0].inputs["name"])
+	assert.True(t, monitor.registrations[1].inputs["bucket"].IsComputed())
+	assert.Equal(t, resource.NewStringProperty("0/a"), monitor.registrations[1].inputs["key"])
+
+	assert.True(t, monitor.outputs["bucketId"].IsComputed())
+	assert.Equal(t, resource.NewStringProperty("your-bucket-region"), monitor.outputs["region"])
+	assert.Equal(t, resource.NewArrayProperty([]resource.PropertyValue{
+		resource.NewStringProperty("0/a"),
+		resource.NewStringProperty("1/b"),
+	}), monitor.outputs["keys"])
+	assert.True(t, monitor.outputs["sizes"].ArrayValue()[0].IsComputed())
+}
+
+func TestInterpreterRegistersIndependentResourcesConcurrently(t *testing.T) {
+	program := bindTestProgram(t, `
+resource first "test:index:Bucket" {
+	name = "first"
+}
+
+resource second "test:index:Bucket" {
+	name = "second"
+}
+`)
+
+	var barrier sync.WaitGroup
+	barrier.Add(2)
+	monitor := &mockMonitor{barrier: &barrier}
+	interp := newInterpreter(context.Background(), monitor, nil, "project", "stack", ".", nil, false)
+	require.NoError(t, interp.run(program))
+	assert.Len(t, monitor.registrations, 2)
+}
@@ -0,0 +1,221 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// pulumi-language-pcl is a language host for programs written in the Pulumi Configuration Language (PCL). Rather
+// than generating code in another language, the language host binds the program and evaluates it directly against
+// the resource monitor.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	pbempty "github.com/golang/protobuf/ptypes/empty"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+
+	"github.com/pulumi/pulumi/pkg/v2/codegen/hcl2"
+	"github.com/pulumi/pulumi/pkg/v2/version"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/cmdutil"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/contract"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/logging"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/rpcutil"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+// Launches the language host, which in turn fires up an RPC server implementing the LanguageRuntimeServer endpoint.
+func main() {
+	var tracing string
+	flag.StringVar(&tracing, "tracing", "", "Emit tracing to a Zipkin-compatible tracing endpoint")
+
+	flag.Parse()
+	args := flag.Args()
+	logging.InitLogging(false, 0, false)
+	cmdutil.InitTracing("pulumi-language-pcl", "pulumi-language-pcl", tracing)
+
+	// Pluck out the engine so we can do logging, etc.
+	if len(args) == 0 {
+		cmdutil.Exit(errors.New("missing required engine RPC address argument"))
+	}
+	engineAddress := args[0]
+
+	// Fire up a gRPC server, letting the kernel choose a free port.
+	port, done, err := rpcutil.Serve(0, nil, []func(*grpc.Server) error{
+		func(srv *grpc.Server) error {
+			host := newLanguageHost(engineAddress, tracing)
+			pulumirpc.RegisterLanguageRuntimeServer(srv, host)
+			return nil
+		},
+	}, nil)
+	if err != nil {
+		cmdutil.Exit(errors.Wrapf(err, "could not start language host RPC server"))
+	}
+
+	// Otherwise, print out the port so that the spawner knows how to reach us.
+	fmt.Printf("%d\n", port)
+
+	// And finally wait for the server to stop serving.
+	if err := <-done; err != nil {
+		cmdutil.Exit(errors.Wrapf(err, "language host RPC stopped serving"))
+	}
+}
+
+// pclLanguageHost implements the LanguageRuntimeServer interface for use as an API endpoint.
+type pclLanguageHost struct {
+	engineAddress string
+	tracing       string
+
+	// pluginHost, if set, is used to load the schemas of the program's packages. Otherwise, packages are loaded from
+	// the plugins installed in the workspace.
+	pluginHost plugin.Host
+}
+
+func newLanguageHost(engineAddress, tracing string) pulumirpc.LanguageRuntimeServer {
+	return &pclLanguageHost{
+		engineAddress: engineAddress,
+		tracing:       tracing,
+	}
+}
+
+// GetRequiredPlugins computes the complete set of anticipated plugins required by a program. The set of plugins is
+// determined by the packages that define the program's resources and functions.
+func (host *pclLanguageHost) GetRequiredPlugins(ctx context.Context,
+	req *pulumirpc.GetRequiredPluginsRequest) (*pulumirpc.GetRequiredPluginsResponse, error) {
+
+	files, diags, err := parseProgram(req.GetPwd(), req.GetProgram())
+	if err != nil {
+		return nil, err
+	}
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	var plugins []*pulumirpc.PluginDependency
+	for _, pkg := range requiredPackages(files) {
+		logging.V(5).Infof("GetRequiredPlugins: Found plugin name: %s", pkg)
+		plugins = append(plugins, &pulumirpc.PluginDependency{
+			Name: pkg,
+			Kind: "resource",
+		})
+	}
+	return &pulumirpc.GetRequiredPluginsResponse{Plugins: plugins}, nil
+}
+
+// RPC endpoint for LanguageRuntimeServer::Run
+func (host *pclLanguageHost) Run(ctx context.Context, req *pulumirpc.RunRequest) (*pulumirpc.RunResponse, error) {
+	// Connect to the engine so that diagnostics are reported along with the rest of the update's output.
+	var engine pulumirpc.EngineClient
+	if host.engineAddress != "" {
+		engineConn, err := grpc.Dial(host.engineAddress, grpc.WithInsecure(), rpcutil.GrpcChannelOptions())
+		if err != nil {
+			return nil, errors.Wrap(err, "connecting to engine over RPC")
+		}
+		defer contract.IgnoreClose(engineConn)
+		engine = pulumirpc.NewEngineClient(engineConn)
+	}
+
+	files, diags, err := parseProgram(req.GetPwd(), req.GetProgram())
+	if err != nil {
+		return nil, err
+	}
+	if diags.HasErrors() {
+		return &pulumirpc.RunResponse{Error: diags.Error()}, nil
+	}
+	logWarnings(ctx, engine, diags)
+
+	pluginHost := host.pluginHost
+	if pluginHost == nil {
+		pluginCtx, err := plugin.NewContext(nil, nil, nil, nil, req.GetPwd(), nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		defer contract.IgnoreClose(pluginCtx)
+		pluginHost = pluginCtx.Host
+	}
+
+	program, diags, err := hcl2.BindProgram(files, hcl2.PluginHost(pluginHost))
+	if err != nil {
+		return nil, err
+	}
+	if diags.HasErrors() {
+		return &pulumirpc.RunResponse{Error: diags.Error()}, nil
+	}
+	logWarnings(ctx, engine, diags)
+
+	conn, err := grpc.Dial(req.GetMonitorAddress(), grpc.WithInsecure(), rpcutil.GrpcChannelOptions())
+	if err != nil {
+		return nil, errors.Wrap(err, "connecting to resource monitor over RPC")
+	}
+	defer contract.IgnoreClose(conn)
+
+	root := programDirectory(req.GetPwd(), req.GetProgram())
+	interp := newInterpreter(ctx, pulumirpc.NewResourceMonitorClient(conn), engine, req.GetProject(), req.GetStack(),
+		root, req.GetConfig(), req.GetDryRun())
+	if err := interp.run(program); err != nil {
+		return &pulumirpc.RunResponse{Error: err.Error()}, nil
+	}
+	return &pulumirpc.RunResponse{}, nil
+}
+
+// logWarnings reports the warnings in the given diagnostics. Errors are returned to the engine as the result of the
+// run instead.
+func logWarnings(ctx context.Context, engine pulumirpc.EngineClient, diags hcl.Diagnostics) {
+	for _, d := range diags {
+		if d.Severity == hcl.DiagWarning {
+			logMessage(ctx, engine, pulumirpc.LogSeverity_WARNING, "", d.Error())
+		}
+	}
+}
+
+// logMessage sends a message to the engine's log. If the language host is not connected to an engine, the message is
+// written to the language host's own log instead.
+func logMessage(ctx context.Context, engine pulumirpc.EngineClient, severity pulumirpc.LogSeverity, urn,
+	message string) {
+
+	if engine != nil {
+		_, err := engine.Log(ctx, &pulumirpc.LogRequest{Severity: severity, Message: message, Urn: urn})
+		if err == nil {
+			return
+		}
+		logging.V(5).Infof("failed to log message to engine: %v", err)
+	}
+
+	switch severity {
+	case pulumirpc.LogSeverity_DEBUG:
+		logging.V(7).Infof("%s", message)
+	case pulumirpc.LogSeverity_INFO:
+		logging.Infof("%s", message)
+	case pulumirpc.LogSeverity_WARNING:
+		logging.Warningf("%s", message)
+	default:
+		logging.Errorf("%s", message)
+	}
+}
+
+func (host *pclLanguageHost) GetPluginInfo(ctx context.Context, req *pbempty.Empty) (*pulumirpc.PluginInfo, error) {
+	return &pulumirpc.PluginInfo{
+		Version: version.Version,
+	}, nil
+}
+
+// InstallDependencies is a no-op: PCL programs have no dependencies other than resource plugins, which are installed
+// by the engine.
+func (host *pclLanguageHost) InstallDependencies(
+	req *pulumirpc.InstallDependenciesRequest, server pulumirpc.LanguageRuntime_InstallDependenciesServer) error {
+
+	return nil
+}
@@ -0,0 +1,194 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/blang/semver"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v2/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/v2/resource/deploy/deploytest"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/cmdutil"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/workspace"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+var testdataPath = filepath.Join("..", "..", "codegen", "internal", "test", "testdata")
+
+// resourceOutputs holds the outputs that the test AWS provider adds to the inputs of the resources it creates, keyed
+// by type name.
+var resourceOutputs = map[string]resource.PropertyMap{
+	"Cluster": {
+		"certificateAuthority": resource.NewObjectProperty(resource.PropertyMap{
+			"data": resource.NewStringProperty("Y2VydGlmaWNhdGU="),
+		}),
+		"endpoint": resource.NewStringProperty("https://cluster.example.com"),
+	},
+}
+
+// invokeResults holds the results returned by the test AWS provider's functions, keyed by function name.
+var invokeResults = map[string]resource.PropertyMap{
+	"getAmi": {"id": resource.NewStringProperty("ami-0123456789")},
+	"getAvailabilityZones": {"names": resource.NewArrayProperty([]resource.PropertyValue{
+		resource.NewStringProperty("us-west-2a"),
+		resource.NewStringProperty("us-west-2b"),
+	})},
+	"getSubnetIds": {"ids": resource.NewArrayProperty([]resource.PropertyValue{
+		resource.NewStringProperty("subnet-a"),
+		resource.NewStringProperty("subnet-b"),
+	})},
+	"getVpc": {"id": resource.NewStringProperty("vpc-0123456789")},
+}
+
+// newAWSProvider returns a fake AWS provider that serves the AWS schema from the codegen testdata. The provider
+// records the URNs of the resources it checks, creates resources by echoing their inputs plus any resourceOutputs, and
+// answers invokes from invokeResults.
+func newAWSProvider(m *sync.Mutex, checked *[]resource.URN) (plugin.Provider, error) {
+	schema, err := ioutil.ReadFile(filepath.Join(testdataPath, "aws.json"))
+	if err != nil {
+		return nil, err
+	}
+	return &deploytest.Provider{
+		GetSchemaF: func(version int) ([]byte, error) {
+			return schema, nil
+		},
+		CheckF: func(urn resource.URN,
+			olds, news resource.PropertyMap) (resource.PropertyMap, []plugin.CheckFailure, error) {
+
+			m.Lock()
+			defer m.Unlock()
+			*checked = append(*checked, urn)
+			return news, nil, nil
+		},
+		CreateF: func(urn resource.URN, inputs resource.PropertyMap,
+			timeout float64) (resource.ID, resource.PropertyMap, resource.Status, error) {
+
+			outputs := inputs.Copy()
+			for k, v := range resourceOutputs[string(urn.Type().Name())] {
+				outputs[k] = v
+			}
+			return resource.ID(string(urn.Name()) + "-id"), outputs, resource.StatusOK, nil
+		},
+		InvokeF: func(tok tokens.ModuleMember,
+			inputs resource.PropertyMap) (resource.PropertyMap, []plugin.CheckFailure, error) {
+
+			return invokeResults[string(tok.Name())], nil, nil
+		},
+	}, nil
+}
+
+// runTestProgram runs the given PCL program against a fake AWS provider and returns the URNs of the resources that
+// the program registered.
+func runTestProgram(t *testing.T, path string, dryRun bool) []resource.URN {
+	// Lay the program out in its own directory alongside the content that it reads.
+	dir, err := ioutil.TempDir("", "pcl-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	source, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	err = ioutil.WriteFile(filepath.Join(dir, "main.pp"), source, 0600)
+	require.NoError(t, err)
+	err = os.Mkdir(filepath.Join(dir, "www"), 0700)
+	require.NoError(t, err)
+	err = ioutil.WriteFile(filepath.Join(dir, "www", "index.html"), []byte("<h1>Hello, world!</h1>"), 0600)
+	require.NoError(t, err)
+
+	var m sync.Mutex
+	var checked []resource.URN
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("aws", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return newAWSProvider(&m, &checked)
+		}),
+	}
+	schemaHost := deploytest.NewPluginHost(nil, nil, nil, loaders...)
+
+	program := deploytest.NewLanguageRuntime(func(info plugin.RunInfo, _ *deploytest.ResourceMonitor) error {
+		config := map[string]string{}
+		for k, v := range info.Config {
+			config[k.String()] = v
+		}
+		resp, err := (&pclLanguageHost{pluginHost: schemaHost}).Run(context.Background(), &pulumirpc.RunRequest{
+			MonitorAddress: info.MonitorAddress,
+			Project:        info.Project,
+			Stack:          info.Stack,
+			Pwd:            info.Pwd,
+			Program:        info.Program,
+			Config:         config,
+			DryRun:         info.DryRun,
+		})
+		if err != nil {
+			return err
+		}
+		if resp.GetError() != "" {
+			return errors.New(resp.GetError())
+		}
+		return nil
+	})
+
+	sink := cmdutil.Diag()
+	host := deploytest.NewPluginHost(sink, sink, program, loaders...)
+	ctx, err := plugin.NewContext(sink, sink, host, nil, dir, nil, nil)
+	require.NoError(t, err)
+	defer ctx.Close()
+
+	runInfo := &deploy.EvalRunInfo{
+		Proj:    &workspace.Project{Name: "test"},
+		Pwd:     dir,
+		Program: ".",
+		Target:  &deploy.Target{Name: "test"},
+	}
+	plan, err := deploy.NewPlan(ctx, runInfo.Target, nil, deploy.NewEvalSource(ctx, runInfo, nil, dryRun), nil,
+		dryRun, nil)
+	require.NoError(t, err)
+	res := plan.Execute(context.Background(), deploy.Options{}, dryRun)
+	require.Nil(t, res)
+
+	return checked
+}
+
+func TestTestdataPrograms(t *testing.T) {
+	files, err := ioutil.ReadDir(testdataPath)
+	require.NoError(t, err)
+
+	for _, f := range files {
+		if filepath.Ext(f.Name()) != ".pp" {
+			continue
+		}
+
+		path := filepath.Join(testdataPath, f.Name())
+		t.Run(strings.TrimSuffix(f.Name(), ".pp"), func(t *testing.T) {
+			t.Run("preview", func(t *testing.T) {
+				assert.NotEmpty(t, runTestProgram(t, path, true))
+			})
+			t.Run("update", func(t *testing.T) {
+				assert.NotEmpty(t, runTestProgram(t, path, false))
+			})
+		})
+	}
+}
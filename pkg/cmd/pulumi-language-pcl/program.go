@@ -0,0 +1,129 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/pkg/errors"
+
+	"github.com/pulumi/pulumi/pkg/v2/codegen"
+	"github.com/pulumi/pulumi/pkg/v2/codegen/hcl2/syntax"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/contract"
+)
+
+// programDirectory returns the directory that contains the program. If the program names a single file, this is the
+// directory that contains that file.
+func programDirectory(pwd, program string) string {
+	path := filepath.Join(pwd, program)
+	if filepath.Ext(path) == ".pp" {
+		return filepath.Dir(path)
+	}
+	return path
+}
+
+// parseProgram parses the program's source files. If the program names a single `.pp` file, only that file is
+// parsed. Otherwise, the program names a directory, and all of the `.pp` files in that directory are parsed.
+func parseProgram(pwd, program string) ([]*syntax.File, hcl.Diagnostics, error) {
+	path := filepath.Join(pwd, program)
+
+	paths := []string{path}
+	if filepath.Ext(path) != ".pp" {
+		matches, err := filepath.Glob(filepath.Join(path, "*.pp"))
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(matches) == 0 {
+			return nil, nil, errors.Errorf("no PCL source files (*.pp) found in %s", path)
+		}
+		paths = matches
+	}
+
+	parser := syntax.NewParser()
+	for _, path := range paths {
+		if err := parseFile(parser, path); err != nil {
+			return nil, nil, err
+		}
+	}
+	return parser.Files, parser.Diagnostics, nil
+}
+
+func parseFile(parser *syntax.Parser, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer contract.IgnoreClose(f)
+
+	return parser.ParseFile(f, filepath.Base(path))
+}
+
+// requiredPackages returns the names of the packages that define the resources and functions used by the given
+// files. Packages are found by inspecting the tokens of resources and invokes without binding the program, so this
+// does not require the packages' plugins to be installed.
+func requiredPackages(files []*syntax.File) []string {
+	packages := codegen.StringSet{}
+	addToken := func(token string) {
+		components := strings.Split(token, ":")
+		if len(components) != 3 {
+			return
+		}
+		pkg := components[0]
+		if pkg == "pulumi" && components[1] == "providers" {
+			pkg = components[2]
+		}
+		if pkg != "pulumi" && pkg != "" {
+			packages.Add(pkg)
+		}
+	}
+
+	for _, f := range files {
+		diags := hclsyntax.VisitAll(f.Body, func(n hclsyntax.Node) hcl.Diagnostics {
+			switch n := n.(type) {
+			case *hclsyntax.Block:
+				if n.Type == "resource" && len(n.Labels) == 2 {
+					addToken(n.Labels[1])
+				}
+			case *hclsyntax.FunctionCallExpr:
+				if n.Name == "invoke" && len(n.Args) > 0 {
+					if token, ok := stringLiteral(n.Args[0]); ok {
+						addToken(token)
+					}
+				}
+			}
+			return nil
+		})
+		contract.Assert(len(diags) == 0)
+	}
+
+	return packages.SortedValues()
+}
+
+// stringLiteral returns the value of the given expression if it is a string literal.
+func stringLiteral(x hclsyntax.Expression) (string, bool) {
+	template, ok := x.(*hclsyntax.TemplateExpr)
+	if !ok || !template.IsStringLiteral() {
+		return "", false
+	}
+	value, diags := template.Value(nil)
+	if diags.HasErrors() {
+		return "", false
+	}
+	return value.AsString(), true
+}
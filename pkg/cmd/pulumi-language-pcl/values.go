@@ -0,0 +1,168 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"math/big"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+)
+
+var (
+	// assetType is the type of asset values.
+	assetType = cty.Capsule("asset", reflect.TypeOf(resource.Asset{}))
+	// archiveType is the type of archive values.
+	archiveType = cty.Capsule("archive", reflect.TypeOf(resource.Archive{}))
+)
+
+// propertyValueToCty converts a resource property value into a cty value. Computed and output values are converted to
+// unknown values. Secrets are replaced with their underlying values; the second result reports whether or not the
+// value contained any secrets.
+func propertyValueToCty(v resource.PropertyValue) (cty.Value, bool) {
+	switch {
+	case v.IsNull():
+		return cty.NullVal(cty.DynamicPseudoType), false
+	case v.IsBool():
+		return cty.BoolVal(v.BoolValue()), false
+	case v.IsNumber():
+		return cty.NumberFloatVal(v.NumberValue()), false
+	case v.IsString():
+		return cty.StringVal(v.StringValue()), false
+	case v.IsArray():
+		arr := v.ArrayValue()
+		if len(arr) == 0 {
+			return cty.EmptyTupleVal, false
+		}
+		elements, secret := make([]cty.Value, len(arr)), false
+		for i, e := range arr {
+			element, elementSecret := propertyValueToCty(e)
+			elements[i], secret = element, secret || elementSecret
+		}
+		return cty.TupleVal(elements), secret
+	case v.IsObject():
+		return propertyMapToCty(v.ObjectValue())
+	case v.IsAsset():
+		return cty.CapsuleVal(assetType, v.AssetValue()), false
+	case v.IsArchive():
+		return cty.CapsuleVal(archiveType, v.ArchiveValue()), false
+	case v.IsSecret():
+		element, _ := propertyValueToCty(v.SecretValue().Element)
+		return element, true
+	default:
+		// Computed and output values are unknown.
+		return cty.DynamicVal, false
+	}
+}
+
+// propertyMapToCty converts a resource property map into a cty object.
+func propertyMapToCty(m resource.PropertyMap) (cty.Value, bool) {
+	if len(m) == 0 {
+		return cty.EmptyObjectVal, false
+	}
+	attributes, secret := map[string]cty.Value{}, false
+	for k, v := range m {
+		attribute, attributeSecret := propertyValueToCty(v)
+		attributes[string(k)], secret = attribute, secret || attributeSecret
+	}
+	return cty.ObjectVal(attributes), secret
+}
+
+// ctyToPropertyValue converts a cty value into a resource property value. Unknown values are converted to computed
+// values.
+func ctyToPropertyValue(v cty.Value) (resource.PropertyValue, error) {
+	if !v.IsKnown() {
+		return resource.MakeComputed(resource.NewStringProperty("")), nil
+	}
+	if v.IsNull() {
+		return resource.NewNullProperty(), nil
+	}
+
+	switch t := v.Type(); {
+	case t == cty.Bool:
+		return resource.NewBoolProperty(v.True()), nil
+	case t == cty.Number:
+		f, _ := v.AsBigFloat().Float64()
+		return resource.NewNumberProperty(f), nil
+	case t == cty.String:
+		return resource.NewStringProperty(v.AsString()), nil
+	case t.Equals(assetType):
+		return resource.NewAssetProperty(v.EncapsulatedValue().(*resource.Asset)), nil
+	case t.Equals(archiveType):
+		return resource.NewArchiveProperty(v.EncapsulatedValue().(*resource.Archive)), nil
+	case t.IsListType() || t.IsTupleType() || t.IsSetType():
+		arr := make([]resource.PropertyValue, 0, v.LengthInt())
+		for it := v.ElementIterator(); it.Next(); {
+			_, e := it.Element()
+			element, err := ctyToPropertyValue(e)
+			if err != nil {
+				return resource.PropertyValue{}, err
+			}
+			arr = append(arr, element)
+		}
+		return resource.NewArrayProperty(arr), nil
+	case t.IsMapType() || t.IsObjectType():
+		m, err := ctyToPropertyMap(v)
+		if err != nil {
+			return resource.PropertyValue{}, err
+		}
+		return resource.NewObjectProperty(m), nil
+	default:
+		return resource.PropertyValue{}, errors.Errorf("cannot convert value of type %v", t.FriendlyName())
+	}
+}
+
+// ctyToPropertyMap converts a known cty map or object into a resource property map.
+func ctyToPropertyMap(v cty.Value) (resource.PropertyMap, error) {
+	m := resource.PropertyMap{}
+	for it := v.ElementIterator(); it.Next(); {
+		k, e := it.Element()
+		element, err := ctyToPropertyValue(e)
+		if err != nil {
+			return nil, err
+		}
+		m[resource.PropertyKey(k.AsString())] = element
+	}
+	return m, nil
+}
+
+// ctyKeyString returns the string form of a collection key. Numeric keys are formatted as integers where possible.
+func ctyKeyString(k cty.Value) string {
+	if k.Type() == cty.Number {
+		f := k.AsBigFloat()
+		if f.IsInt() {
+			i, _ := f.Int(nil)
+			return i.String()
+		}
+		return f.Text('f', -1)
+	}
+	return k.AsString()
+}
+
+// ctyInt returns the integer value of a known cty number.
+func ctyInt(v cty.Value) (int, error) {
+	f := v.AsBigFloat()
+	if !f.IsInt() {
+		return 0, errors.Errorf("%v is not an integer", f.Text('f', -1))
+	}
+	i, acc := f.Int64()
+	if acc != big.Exact {
+		return 0, errors.Errorf("%v is out of range", f.Text('f', -1))
+	}
+	return int(i), nil
+}
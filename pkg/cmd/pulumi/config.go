@@ -31,9 +31,11 @@ import (
 	"github.com/pulumi/pulumi/pkg/v2/backend"
 	"github.com/pulumi/pulumi/pkg/v2/backend/display"
 	"github.com/pulumi/pulumi/pkg/v2/secrets"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/config"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/tokens"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/cmdutil"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/contract"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/workspace"
 )
 
@@ -376,6 +378,25 @@ func saveProjectStack(stack backend.Stack, ps *workspace.ProjectStack) error {
 	return ps.Save(stackConfigFile)
 }
 
+// enableDeterministicArchives opts the given stack into reproducible archives.
+func enableDeterministicArchives(stack backend.Stack) error {
+	ps, err := loadProjectStack(stack)
+	if err != nil {
+		return errors.Wrap(err, "loading stack configuration")
+	}
+	ps.DeterministicArchives = true
+	return saveProjectStack(stack, ps)
+}
+
+// configureDeterministicArchives requests reproducible archives from this process, and from any plugins it launches,
+// if the stack has opted into them. A value already present in the environment always takes precedence.
+func configureDeterministicArchives(ps *workspace.ProjectStack) {
+	if _, has := os.LookupEnv(resource.DeterministicArchivesEnvVar); has || !ps.DeterministicArchives {
+		return
+	}
+	contract.IgnoreError(os.Setenv(resource.DeterministicArchivesEnvVar, "true"))
+}
+
 func parseConfigKey(key string) (config.Key, error) {
 	// As a convience, we'll treat any key with no delimiter as if:
 	// <program-name>:<key> had been written instead
@@ -599,13 +620,22 @@ func getStackConfiguration(stack backend.Stack, sm secrets.Manager) (backend.Sta
 		return backend.StackConfiguration{}, errors.Wrap(err, "loading stack configuration")
 	}
 
+	configureDeterministicArchives(workspaceStack)
+
+	if workspaceStack.Retry != nil {
+		if err = workspaceStack.Retry.Validate(); err != nil {
+			return backend.StackConfiguration{}, errors.Wrap(err, "validating stack retry policy")
+		}
+	}
+
 	// If there are no secrets in the configuration, we should never use the decrypter, so it is safe to return
 	// one which panics if it is used. This provides for some nice UX in the common case (since, for example, building
 	// the correct decrypter for the local backend would involve prompting for a passphrase)
 	if !workspaceStack.Config.HasSecureValue() {
 		return backend.StackConfiguration{
-			Config:    workspaceStack.Config,
-			Decrypter: config.NewPanicCrypter(),
+			Config:      workspaceStack.Config,
+			Decrypter:   config.NewPanicCrypter(),
+			RetryPolicy: workspaceStack.Retry,
 		}, nil
 	}
 
@@ -615,7 +645,8 @@ func getStackConfiguration(stack backend.Stack, sm secrets.Manager) (backend.Sta
 	}
 
 	return backend.StackConfiguration{
-		Config:    workspaceStack.Config,
-		Decrypter: crypter,
+		Config:      workspaceStack.Config,
+		Decrypter:   crypter,
+		RetryPolicy: workspaceStack.Retry,
 	}, nil
 }
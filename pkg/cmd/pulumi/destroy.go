@@ -37,7 +37,7 @@ func newDestroyCmd() *cobra.Command {
 
 	// Flags for engine.UpdateOptions.
 	var diffDisplay bool
-	var eventLogPath string
+	var eventStreamTarget string
 	var parallel int
 	var refresh bool
 	var showConfig bool
@@ -48,6 +48,7 @@ func newDestroyCmd() *cobra.Command {
 	var yes bool
 	var targets *[]string
 	var targetDependents bool
+	var continueOnError bool
 
 	var cmd = &cobra.Command{
 		Use:        "destroy",
@@ -77,6 +78,12 @@ func newDestroyCmd() *cobra.Command {
 				displayType = display.DisplayDiff
 			}
 
+			eventStream, closeEventStream, err := openEventStream(eventStreamTarget)
+			if err != nil {
+				return result.FromError(err)
+			}
+			defer closeEventStream()
+
 			opts.Display = display.Options{
 				Color:                cmdutil.GetGlobalColorization(),
 				ShowConfig:           showConfig,
@@ -85,7 +92,7 @@ func newDestroyCmd() *cobra.Command {
 				SuppressOutputs:      suppressOutputs,
 				IsInteractive:        interactive,
 				Type:                 displayType,
-				EventLogPath:         eventLogPath,
+				EventStream:          eventStream,
 				Debug:                debug,
 			}
 
@@ -125,6 +132,9 @@ func newDestroyCmd() *cobra.Command {
 				DestroyTargets:   targetUrns,
 				TargetDependents: targetDependents,
 				UseLegacyDiff:    useLegacyDiff(),
+				ContinueOnError:  continueOnError,
+
+				DefaultRetryPolicy: cfg.RetryPolicy,
 			}
 
 			_, res := s.Destroy(commandContext(), backend.UpdateOperation{
@@ -168,6 +178,9 @@ func newDestroyCmd() *cobra.Command {
 	cmd.PersistentFlags().BoolVar(
 		&targetDependents, "target-dependents", false,
 		"Allows destroying of dependent targets discovered but not specified in --target list")
+	cmd.PersistentFlags().BoolVar(
+		&continueOnError, "continue-on-error", false,
+		"Continue deleting resources that no failed resource depends on instead of stopping at the first failure")
 
 	// Flags for engine.UpdateOptions.
 	cmd.PersistentFlags().BoolVar(
@@ -199,10 +212,6 @@ func newDestroyCmd() *cobra.Command {
 		&yes, "yes", "y", false,
 		"Automatically approve and perform the destroy after previewing it")
 
-	if hasDebugCommands() {
-		cmd.PersistentFlags().StringVar(
-			&eventLogPath, "event-log", "",
-			"Log events to a file at this path")
-	}
+	addEventStreamFlags(cmd, &eventStreamTarget)
 	return cmd
 }
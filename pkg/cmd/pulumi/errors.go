@@ -57,9 +57,8 @@ operations listed completed successfully by checking the state of the appropriat
 For example, if you are using AWS, you can confirm using the AWS Console.
 
 Once you have confirmed the status of the interrupted operations, you can repair your stack
-using 'pulumi stack export' to export your stack to a file. For each operation that succeeded,
-remove that operation from the "pending_operations" section of the file. Once this is complete,
-use 'pulumi stack import' to import the repaired stack.
+using 'pulumi state repair'. For each operation, you can choose to keep the resource with a
+given ID, to discard it, or to read its current state from its provider.
 
 refusing to proceed`)
 	contract.IgnoreError(writer.Flush())
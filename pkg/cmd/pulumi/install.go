@@ -0,0 +1,44 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/cmdutil"
+)
+
+func newInstallCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "install",
+		Short: "Install packages and plugins for the current program",
+		Long: "Install packages and plugins for the current program.\n" +
+			"\n" +
+			"This command asks the program's language host to install the program's dependencies\n" +
+			"(for example, by running `npm install`, `pip install` or `go mod download`), and then\n" +
+			"installs any resource plugins the program requires.",
+		Args: cmdutil.NoArgs,
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			proj, root, err := readProject()
+			if err != nil {
+				return err
+			}
+
+			return installProjectDependencies(proj, root)
+		}),
+	}
+
+	return cmd
+}
@@ -23,10 +23,12 @@ import (
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
+	"github.com/pulumi/pulumi/pkg/v2/backend"
 	"github.com/pulumi/pulumi/pkg/v2/backend/display"
 	"github.com/pulumi/pulumi/pkg/v2/operations"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/diag/colors"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/cmdutil"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/contract"
 )
 
 // We use RFC 5424 timestamps with millisecond precision for displaying time stamps on log entries. Go does not
@@ -91,8 +93,12 @@ func newLogsCmd() *cobra.Command {
 			// displayed before previously rendered log entries, but weren't available at the time, so still need to be
 			// rendered now even though they are technically out of order.
 			shown := map[operations.LogEntry]bool{}
+
+			// Reuse the stack's providers across polls rather than relaunching them for every query.
+			logProviders := backend.NewLogProviders()
+			defer contract.IgnoreClose(logProviders)
 			for {
-				logs, err := s.GetLogs(commandContext(), cfg, operations.LogQuery{
+				logs, err := s.GetLogs(commandContext(), cfg, logProviders, operations.LogQuery{
 					StartTime:      startTime,
 					ResourceFilter: resourceFilter,
 				})
@@ -0,0 +1,60 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/v2/codegen/hcl2/lsp"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/cmdutil"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/contract"
+)
+
+// newLSPCmd returns a new command that runs a language server for PCL programs. It is hidden by default since it is
+// intended to be launched by editors rather than by users.
+func newLSPCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "lsp",
+		Args:  cmdutil.NoArgs,
+		Short: "Run a language server for Pulumi programs written in PCL",
+		Long: "Run a language server for Pulumi programs written in PCL.\n" +
+			"\n" +
+			"This command serves the Language Server Protocol over stdin and stdout. The server\n" +
+			"reports diagnostics as `.pp` files change and offers hover documentation, completion,\n" +
+			"go-to-definition, and formatting. Package schemas are loaded from the installed resource\n" +
+			"plugins.",
+		Hidden: true,
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			pwd, err := os.Getwd()
+			if err != nil {
+				return err
+			}
+
+			// Stdout carries the protocol, so all diagnostics are written to stderr.
+			sink := diag.DefaultSink(os.Stderr, os.Stderr, diag.FormatOptions{Color: cmdutil.GetGlobalColorization()})
+			plugctx, err := plugin.NewContext(sink, sink, nil, nil, pwd, nil, nil)
+			if err != nil {
+				return err
+			}
+			defer contract.IgnoreClose(plugctx)
+
+			return lsp.NewServer(plugctx.Host).Serve(os.Stdin, os.Stdout)
+		}),
+	}
+}
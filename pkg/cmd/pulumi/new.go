@@ -36,16 +36,18 @@ import (
 	"github.com/pulumi/pulumi/pkg/v2/backend/httpstate"
 	"github.com/pulumi/pulumi/pkg/v2/backend/state"
 	"github.com/pulumi/pulumi/pkg/v2/engine"
-	"github.com/pulumi/pulumi/pkg/v2/npm"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/diag"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/diag/colors"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/config"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/tokens"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/cmdutil"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/contract"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/executable"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/logging"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/workspace"
+	"github.com/pulumi/pulumi/sdk/v2/nodejs/npm"
 )
 
 type promptForValueFunc func(yes bool, valueType string, defaultValue string, secret bool,
@@ -559,45 +561,20 @@ func saveConfig(stack backend.Stack, c config.Map) error {
 	return saveProjectStack(stack, ps)
 }
 
-// installDependencies will install dependencies for the project, e.g. by running `npm install` for nodejs projects.
+// installDependencies installs the dependencies of a newly created project.
 func installDependencies(proj *workspace.Project, root string) error {
-	// TODO[pulumi/pulumi#1307]: move to the language plugins so we don't have to hard code here.
-	if strings.EqualFold(proj.Runtime.Name(), "nodejs") {
-		if bin, err := nodeInstallDependencies(); err != nil {
-			return errors.Wrapf(err, "%s install failed; rerun manually to try again, "+
-				"then run 'pulumi up' to perform an initial deployment", bin)
-		}
-	} else if strings.EqualFold(proj.Runtime.Name(), "dotnet") {
-		return dotnetInstallDependenciesAndBuild(proj, root)
-	} else if strings.EqualFold(proj.Runtime.Name(), "go") {
-		if err := goInstallDependencies(); err != nil {
-			return errors.Wrapf(err, "`go mod download` failed to install dependencies; rerun manually to try again, "+
-				"then run 'pulumi up' to perform an initial deployment")
-		}
-	}
-
-	return nil
-}
-
-// nodeInstallDependencies will install dependencies for the project or Policy Pack by running `npm install` or
-// `yarn install`.
-func nodeInstallDependencies() (string, error) {
-	fmt.Println("Installing dependencies...")
-	fmt.Println()
-
-	bin, err := npm.Install("", os.Stdout, os.Stderr)
-	if err != nil {
-		return bin, err
+	// Python dependencies are installed into a virtual environment that the user activates in their own shell, so
+	// we leave the installation to `pulumi install` once that environment exists.
+	if strings.EqualFold(proj.Runtime.Name(), "python") {
+		return nil
 	}
 
-	fmt.Println("Finished installing dependencies")
-	fmt.Println()
-
-	return bin, nil
+	return installProjectDependencies(proj, root)
 }
 
-// dotnetInstallDependenciesAndBuild will install dependencies and build the project.
-func dotnetInstallDependenciesAndBuild(proj *workspace.Project, root string) error {
+// installProjectDependencies asks the project's language host to install the program's dependencies, and then
+// installs any resource plugins the program requires.
+func installProjectDependencies(proj *workspace.Project, root string) error {
 	contract.Assert(proj != nil)
 
 	fmt.Println("Installing dependencies...")
@@ -610,9 +587,22 @@ func dotnetInstallDependenciesAndBuild(proj *workspace.Project, root string) err
 	}
 	defer plugctx.Close()
 
-	// Call RunInstallPlugins, which will run `dotnet build`. This will automatically
-	// restore dependencies, install any plugins, and build the project so it will be
-	// prepped and ready to go for a faster initial `pulumi up`.
+	lang, err := plugctx.Host.LanguageRuntime(proj.Runtime.Name())
+	if err != nil {
+		return errors.Wrapf(err, "failed to load language plugin %s", proj.Runtime.Name())
+	}
+
+	err = lang.InstallDependencies(pwd, cmdutil.Interactive(), os.Stdout, os.Stderr)
+	if err == plugin.ErrInstallDependenciesNotSupported {
+		// Older language hosts cannot install dependencies, so fall back to installing them ourselves.
+		err = legacyInstallDependencies(proj, pwd)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "installing dependencies failed; rerun 'pulumi install' to try again, "+
+			"then run 'pulumi up' to perform an initial deployment")
+	}
+
+	// Now that the program's dependencies are in place, install any plugins it requires.
 	if err = engine.RunInstallPlugins(proj, pwd, main, nil, plugctx); err != nil {
 		return err
 	}
@@ -623,28 +613,45 @@ func dotnetInstallDependenciesAndBuild(proj *workspace.Project, root string) err
 	return nil
 }
 
-// goInstallDependencies will install dependencies for the project by running `go mod download`
-func goInstallDependencies() error {
-	fmt.Println("Installing dependencies...")
-	fmt.Println()
-
-	gobin, err := executable.FindExecutable("go")
-	if err != nil {
+// legacyInstallDependencies installs the dependencies of a program whose language host does not support installing
+// them itself. Node.js and Go dependencies are installed directly; for other languages, the user is warned that they
+// must install the program's dependencies by hand.
+func legacyInstallDependencies(proj *workspace.Project, pwd string) error {
+	switch {
+	case strings.EqualFold(proj.Runtime.Name(), "nodejs"):
+		_, err := npm.Install(pwd, os.Stdout, os.Stderr)
 		return err
+	case strings.EqualFold(proj.Runtime.Name(), "go"):
+		gobin, err := executable.FindExecutable("go")
+		if err != nil {
+			return err
+		}
+		cmd := exec.Command(gobin, "mod", "download")
+		cmd.Dir, cmd.Env = pwd, os.Environ()
+		cmd.Stdout, cmd.Stderr = os.Stdout, os.Stderr
+		return cmd.Run()
+	default:
+		cmdutil.Diag().Warningf(diag.Message("", "the %s language plugin cannot install this program's dependencies; "+
+			"please install them by hand"), proj.Runtime.Name())
+		return nil
 	}
+}
 
-	cmd := exec.Command(gobin, "mod", "download")
-	cmd.Env = os.Environ()
-	cmd.Stdout, cmd.Stderr = os.Stdout, os.Stderr
+// nodeInstallDependencies will install dependencies for the project or Policy Pack by running `npm install` or
+// `yarn install`.
+func nodeInstallDependencies() (string, error) {
+	fmt.Println("Installing dependencies...")
+	fmt.Println()
 
-	if err := cmd.Run(); err != nil {
-		return err
+	bin, err := npm.Install("", os.Stdout, os.Stderr)
+	if err != nil {
+		return bin, err
 	}
 
 	fmt.Println("Finished installing dependencies")
 	fmt.Println()
 
-	return nil
+	return bin, nil
 }
 
 // printNextSteps prints out a series of commands that the user needs to run before their stack is able to be updated.
@@ -679,6 +686,7 @@ func printNextSteps(proj *workspace.Project, originalCwd, cwd string, generateOn
 		// If we're generating a Python project, instruct the user to set up and activate a virtual
 		// environment.
 		commands = append(commands, pythonCommands()...)
+		commands = append(commands, "pulumi install")
 	}
 
 	// If we didn't create a stack, show that as a command to run before `pulumi up`.
@@ -717,8 +725,7 @@ func printNextSteps(proj *workspace.Project, originalCwd, cwd string, generateOn
 	fmt.Println()
 }
 
-// pythonCommands returns the set of Python commands to create a virtual environment, activate it, and
-// install dependencies.
+// pythonCommands returns the set of Python commands to create a virtual environment and activate it.
 func pythonCommands() []string {
 	var commands []string
 
@@ -739,9 +746,6 @@ func pythonCommands() []string {
 		commands = append(commands, "source venv/bin/activate")
 	}
 
-	// Install dependencies within the virtualenv
-	commands = append(commands, "pip3 install -r requirements.txt")
-
 	return commands
 }
 
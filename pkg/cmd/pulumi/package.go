@@ -0,0 +1,367 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/blang/semver"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	yaml "gopkg.in/yaml.v3"
+
+	"github.com/pulumi/pulumi/pkg/v2/codegen/schema"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/encoding"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/cmdutil"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/contract"
+)
+
+func newPackageCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "package",
+		Short: "Work with Pulumi packages",
+		Long: "Work with Pulumi packages.\n" +
+			"\n" +
+			"A package is described by a schema, which may be read from a JSON or YAML file, or from a\n" +
+			"resource provider plugin. Wherever a command accepts a schema source, it may be either the path\n" +
+			"to a schema file, or the name of an installed provider plugin with an optional version\n" +
+			"(e.g. `aws` or `aws@2.0.0`), in which case the schema is obtained from the plugin.",
+		Args: cmdutil.NoArgs,
+	}
+
+	cmd.AddCommand(newPackageDiffSchemaCmd())
+	cmd.AddCommand(newPackageGenSDKCmd())
+
+	return cmd
+}
+
+// packageSchema is a package schema along with a description of its source.
+type packageSchema struct {
+	Spec   schema.PackageSpec
+	Source string // a description of the schema's source, used in error messages.
+	Text   []byte // the text of the schema file, if any, used to report the positions of errors.
+	YAML   bool   // true if the schema file is a YAML file rather than a JSON file.
+}
+
+// hasSchemaFileExt returns true if the given path has the extension of a JSON or YAML schema file.
+func hasSchemaFileExt(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json", ".yaml", ".yml":
+		return true
+	default:
+		return false
+	}
+}
+
+// isSchemaFile returns true if the given schema source refers to a schema file rather than a provider plugin. Schema
+// files are regular files with a .json, .yaml or .yml extension.
+func isSchemaFile(source string) bool {
+	if !hasSchemaFileExt(source) {
+		return false
+	}
+	info, err := os.Stat(source)
+	return err == nil && info.Mode().IsRegular()
+}
+
+// loadPackageSchema loads a package schema from a schema file or from a provider plugin.
+func loadPackageSchema(source string) (*packageSchema, error) {
+	if isSchemaFile(source) {
+		return readPackageSchemaFile(source)
+	}
+	if hasSchemaFileExt(source) {
+		// Plugin names never carry a file extension, so the source was meant to be a schema file.
+		return nil, errors.Errorf("schema file %s does not exist or is not a regular file", source)
+	}
+
+	name, version := source, ""
+	if at := strings.Index(source, "@"); at != -1 {
+		name, version = source[:at], source[at+1:]
+	}
+	return readPackageSchemaFromPlugin(name, version)
+}
+
+// readPackageSchemaFile reads a package schema from a JSON or YAML file.
+func readPackageSchemaFile(path string) (*packageSchema, error) {
+	text, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading schema")
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		// The schema types only carry JSON tags, so we convert YAML schemas to JSON before decoding them. The offsets
+		// of JSON decoding errors do not correspond to positions in the YAML text, so they are not reported.
+		var v interface{}
+		if err := encoding.YAML.Unmarshal(text, &v); err != nil {
+			return nil, errors.Wrapf(err, "parsing %s", path)
+		}
+		jsonText, err := json.Marshal(yamlToJSON(v))
+		if err != nil {
+			return nil, errors.Wrapf(err, "converting %s to JSON", path)
+		}
+		var spec schema.PackageSpec
+		if err := json.Unmarshal(jsonText, &spec); err != nil {
+			return nil, errors.Wrapf(err, "decoding %s", path)
+		}
+		return &packageSchema{Spec: spec, Source: path, Text: text, YAML: true}, nil
+	default:
+		pkg, err := decodePackageSchema(path, text)
+		if err != nil {
+			return nil, err
+		}
+		pkg.Text = text
+		return pkg, nil
+	}
+}
+
+// readPackageSchemaFromPlugin loads a provider plugin and calls its GetSchema method.
+func readPackageSchemaFromPlugin(name, version string) (*packageSchema, error) {
+	var v *semver.Version
+	if version != "" {
+		parsed, err := semver.ParseTolerant(version)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid plugin version %q", version)
+		}
+		v = &parsed
+	}
+
+	plugctx, err := newPluginContext()
+	if err != nil {
+		return nil, err
+	}
+	defer contract.IgnoreClose(plugctx)
+
+	provider, err := plugctx.Host.Provider(tokens.Package(name), v)
+	if err != nil {
+		return nil, errors.Wrapf(err, "loading provider plugin %s", name)
+	}
+	text, err := provider.GetSchema(0)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting schema from provider plugin %s", name)
+	}
+	return decodePackageSchema(fmt.Sprintf("schema for provider %s", name), text)
+}
+
+// newPluginContext returns a plugin context rooted at the current working directory.
+func newPluginContext() (*plugin.Context, error) {
+	pwd, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+	return plugin.NewContext(cmdutil.Diag(), cmdutil.Diag(), nil, nil, pwd, nil, nil)
+}
+
+// decodePackageSchema decodes a JSON package schema. Syntax and type errors are reported with their position in the
+// text.
+func decodePackageSchema(source string, text []byte) (*packageSchema, error) {
+	var spec schema.PackageSpec
+	if err := json.Unmarshal(text, &spec); err != nil {
+		switch err := err.(type) {
+		case *json.SyntaxError:
+			line, col := offsetToPosition(text, int(err.Offset))
+			return nil, errors.Errorf("%s:%d:%d: %v", source, line, col, err)
+		case *json.UnmarshalTypeError:
+			line, col := offsetToPosition(text, int(err.Offset))
+			return nil, errors.Errorf("%s:%d:%d: %v", source, line, col, err)
+		}
+		return nil, errors.Wrapf(err, "decoding %s", source)
+	}
+	return &packageSchema{Spec: spec, Source: source}, nil
+}
+
+// Bind binds the package schema. If binding fails, the returned error identifies the path of the schema element that
+// caused the failure, along with its position if the schema was read from a file. References to types and resources
+// defined by other packages are resolved using the schemas of the corresponding provider plugins.
+func (s *packageSchema) Bind() (*schema.Package, error) {
+	plugctx, err := newPluginContext()
+	if err != nil {
+		return nil, err
+	}
+	defer contract.IgnoreClose(plugctx)
+
+	pkg, err := schema.ImportSpecWithLoader(s.Spec, nil, schema.NewPluginLoader(plugctx.Host))
+	if err != nil {
+		return nil, s.errorf(schema.ErrorPath(err), "%v", err)
+	}
+	return pkg, nil
+}
+
+// errorf returns an error annotated with the path, as a JSON pointer, of the schema element at the given path. If the
+// schema was read from a file, the error is also annotated with the element's position in the file.
+func (s *packageSchema) errorf(path []string, format string, args ...interface{}) error {
+	message := fmt.Sprintf(format, args...)
+	if len(path) == 0 {
+		return errors.Errorf("%s: %s", s.Source, message)
+	}
+	if line, col, ok := s.position(path); ok {
+		return errors.Errorf("%s:%d:%d: %s: %s", s.Source, line, col, jsonPointer(path), message)
+	}
+	return errors.Errorf("%s: %s: %s", s.Source, jsonPointer(path), message)
+}
+
+// position returns the 1-based line and column in the schema's text of the element at the given path. If the path does
+// not exist, the position of the deepest element along it is returned.
+func (s *packageSchema) position(path []string) (int, int, bool) {
+	if s.Text == nil {
+		return 0, 0, false
+	}
+	if s.YAML {
+		return yamlPosition(s.Text, path)
+	}
+	offset, ok := jsonOffset(json.NewDecoder(bytes.NewReader(s.Text)), s.Text, path)
+	if !ok {
+		return 0, 0, false
+	}
+	line, col := offsetToPosition(s.Text, offset)
+	return line, col, true
+}
+
+// jsonOffset returns the offset in a JSON document of the element at the given path within the next value read by the
+// decoder. If the path does not exist, the offset of the deepest element along it is returned.
+func jsonOffset(dec *json.Decoder, text []byte, path []string) (int, bool) {
+	// The decoder's offset is the end of the previous token, so skip any separators that follow it.
+	start := int(dec.InputOffset())
+	for start < len(text) && strings.IndexByte(" \t\r\n,:", text[start]) != -1 {
+		start++
+	}
+	if len(path) == 0 {
+		return start, true
+	}
+
+	tok, err := dec.Token()
+	if err != nil {
+		return 0, false
+	}
+	switch tok {
+	case json.Delim('{'):
+		for dec.More() {
+			key, err := dec.Token()
+			if err != nil {
+				return 0, false
+			}
+			if key == path[0] {
+				return jsonOffset(dec, text, path[1:])
+			}
+			if !skipJSONValue(dec) {
+				return 0, false
+			}
+		}
+	case json.Delim('['):
+		index, err := strconv.Atoi(path[0])
+		if err != nil {
+			break
+		}
+		for i := 0; dec.More(); i++ {
+			if i == index {
+				return jsonOffset(dec, text, path[1:])
+			}
+			if !skipJSONValue(dec) {
+				return 0, false
+			}
+		}
+	}
+	return start, true
+}
+
+// skipJSONValue reads the next value from the decoder and discards it.
+func skipJSONValue(dec *json.Decoder) bool {
+	var v json.RawMessage
+	return dec.Decode(&v) == nil
+}
+
+// yamlPosition returns the 1-based line and column in a YAML document of the element at the given path. If the path
+// does not exist, the position of the deepest element along it is returned.
+func yamlPosition(text []byte, path []string) (int, int, bool) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(text, &doc); err != nil || len(doc.Content) == 0 {
+		return 0, 0, false
+	}
+
+	node := doc.Content[0]
+	for _, p := range path {
+		for node.Kind == yaml.AliasNode {
+			node = node.Alias
+		}
+
+		var next *yaml.Node
+		switch node.Kind {
+		case yaml.MappingNode:
+			for i := 0; i+1 < len(node.Content); i += 2 {
+				if node.Content[i].Value == p {
+					next = node.Content[i+1]
+					break
+				}
+			}
+		case yaml.SequenceNode:
+			if index, err := strconv.Atoi(p); err == nil && index >= 0 && index < len(node.Content) {
+				next = node.Content[index]
+			}
+		}
+		if next == nil {
+			break
+		}
+		node = next
+	}
+	return node.Line, node.Column, true
+}
+
+// jsonPointer formats a path as a JSON pointer fragment (e.g. "#/resources/aws:s3~1bucket:Bucket").
+func jsonPointer(path []string) string {
+	var buf bytes.Buffer
+	buf.WriteString("#")
+	for _, p := range path {
+		buf.WriteString("/")
+		buf.WriteString(strings.Replace(strings.Replace(p, "~", "~0", -1), "/", "~1", -1))
+	}
+	return buf.String()
+}
+
+// yamlToJSON converts a value decoded from YAML into a value that can be encoded as JSON.
+func yamlToJSON(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			m[fmt.Sprint(k)] = yamlToJSON(e)
+		}
+		return m
+	case []interface{}:
+		for i, e := range v {
+			v[i] = yamlToJSON(e)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// offsetToPosition converts a byte offset into 1-based line and column numbers.
+func offsetToPosition(text []byte, offset int) (int, int) {
+	if offset > len(text) {
+		offset = len(text)
+	}
+	line := bytes.Count(text[:offset], []byte{'\n'}) + 1
+	col := offset - bytes.LastIndexByte(text[:offset], '\n')
+	return line, col
+}
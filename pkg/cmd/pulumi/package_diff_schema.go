@@ -0,0 +1,173 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/v2/codegen/schema"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/cmdutil"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/result"
+)
+
+// breakingSchemaChangesExitCode is the exit code of `pulumi package diff-schema` when there are breaking changes. It is
+// distinct from the exit code of commands that fail, so that scripts can tell the two apart.
+const breakingSchemaChangesExitCode = 1
+
+// schemaChangeJSON is the JSON form of a change between two versions of a package schema.
+type schemaChangeJSON struct {
+	Path     string `json:"path"`
+	Breaking bool   `json:"breaking"`
+	Message  string `json:"message"`
+}
+
+// schemaDiffJSON is the JSON form of the output of `pulumi package diff-schema`.
+type schemaDiffJSON struct {
+	Changes            []schemaChangeJSON `json:"changes"`
+	BreakingChanges    int                `json:"breakingChanges"`
+	NonBreakingChanges int                `json:"nonBreakingChanges"`
+}
+
+func newPackageDiffSchemaCmd() *cobra.Command {
+	var jsonOut bool
+	cmd := &cobra.Command{
+		Use:   "diff-schema <old-schema-source> <new-schema-source>",
+		Short: "Check two versions of a package schema for breaking changes",
+		Long: "Check two versions of a package schema for breaking changes.\n" +
+			"\n" +
+			"This command compares two versions of a package's schema and lists the changes between them,\n" +
+			"classified as breaking or non-breaking. A change is breaking if programs written against the old\n" +
+			"version of the package may fail to compile or may behave differently with the new version: for\n" +
+			"example, if a resource, function, type, or property is removed or moved to a different module, if\n" +
+			"the type of a property changes, if a new input property is required, if the default value of a\n" +
+			"property changes, or if changes to a property newly require its resource to be replaced.\n" +
+			"\n" +
+			"The command exits with exit code 0 if there are no breaking changes, and with exit code 1 if\n" +
+			"there are any breaking changes. If the command fails, for example because a schema cannot be\n" +
+			"loaded or bound, it exits with a non-zero exit code other than 1.",
+		Args: cmdutil.ExactArgs(2),
+		Run: cmdutil.RunResultFunc(func(cmd *cobra.Command, args []string) result.Result {
+			oldPkg, err := bindPackageSchema(args[0])
+			if err != nil {
+				return result.FromError(err)
+			}
+			newPkg, err := bindPackageSchema(args[1])
+			if err != nil {
+				return result.FromError(err)
+			}
+			if oldPkg.Name != newPkg.Name {
+				return result.Errorf("cannot compare different packages %s and %s", oldPkg.Name, newPkg.Name)
+			}
+
+			changes := schema.DiffPackages(oldPkg, newPkg)
+			if jsonOut {
+				err = printSchemaChangesJSON(changes)
+			} else {
+				printSchemaChanges(os.Stdout, changes)
+			}
+			if err != nil {
+				return result.FromError(err)
+			}
+
+			for _, change := range changes {
+				if change.Breaking {
+					return cmdutil.BailWithExitCode(breakingSchemaChangesExitCode)
+				}
+			}
+			return nil
+		}),
+	}
+
+	cmd.PersistentFlags().BoolVarP(
+		&jsonOut, "json", "j", false, "Emit output as JSON")
+
+	return cmd
+}
+
+// bindPackageSchema loads and binds the package schema from the given source.
+func bindPackageSchema(source string) (*schema.Package, error) {
+	pkgSchema, err := loadPackageSchema(source)
+	if err != nil {
+		return nil, err
+	}
+	pkg, err := pkgSchema.Bind()
+	if err != nil {
+		return nil, errors.Wrapf(err, "binding %s", source)
+	}
+	return pkg, nil
+}
+
+// printSchemaChanges prints the breaking changes in a list of schema changes followed by the non-breaking changes.
+func printSchemaChanges(w io.Writer, changes []*schema.Change) {
+	if len(changes) == 0 {
+		fmt.Fprintf(w, "No changes.\n")
+		return
+	}
+
+	var breaking, nonBreaking []*schema.Change
+	for _, change := range changes {
+		if change.Breaking {
+			breaking = append(breaking, change)
+		} else {
+			nonBreaking = append(nonBreaking, change)
+		}
+	}
+
+	printChanges := func(title string, changes []*schema.Change) {
+		if len(changes) == 0 {
+			return
+		}
+		fmt.Fprintf(w, "%s:\n", title)
+		for _, change := range changes {
+			fmt.Fprintf(w, "    %s: %s\n", jsonPointer(change.Path), change.Message)
+		}
+		fmt.Fprintf(w, "\n")
+	}
+	printChanges("Breaking changes", breaking)
+	printChanges("Non-breaking changes", nonBreaking)
+
+	fmt.Fprintf(w, "%d breaking %s, %d non-breaking %s\n", len(breaking), changesNoun(len(breaking)),
+		len(nonBreaking), changesNoun(len(nonBreaking)))
+}
+
+func changesNoun(count int) string {
+	if count == 1 {
+		return "change"
+	}
+	return "changes"
+}
+
+// printSchemaChangesJSON prints a list of schema changes as JSON.
+func printSchemaChangesJSON(changes []*schema.Change) error {
+	out := schemaDiffJSON{Changes: []schemaChangeJSON{}}
+	for _, change := range changes {
+		out.Changes = append(out.Changes, schemaChangeJSON{
+			Path:     jsonPointer(change.Path),
+			Breaking: change.Breaking,
+			Message:  change.Message,
+		})
+		if change.Breaking {
+			out.BreakingChanges++
+		} else {
+			out.NonBreakingChanges++
+		}
+	}
+	return printJSON(out)
+}
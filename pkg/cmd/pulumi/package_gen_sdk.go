@@ -0,0 +1,213 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/v2/codegen/docs"
+	"github.com/pulumi/pulumi/pkg/v2/codegen/dotnet"
+	gogen "github.com/pulumi/pulumi/pkg/v2/codegen/go"
+	"github.com/pulumi/pulumi/pkg/v2/codegen/nodejs"
+	"github.com/pulumi/pulumi/pkg/v2/codegen/python"
+	"github.com/pulumi/pulumi/pkg/v2/codegen/schema"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/cmdutil"
+)
+
+// sdkGeneratorTool is the name of the tool reported in the headers of generated files.
+const sdkGeneratorTool = "Pulumi SDK Generator"
+
+// sdkGenerator generates the files for a package's SDK in a single language. Overlay files are included in the SDK.
+type sdkGenerator func(pkg *schema.Package, overlays map[string][]byte) (map[string][]byte, error)
+
+// sdkGenerators maps the name of each language for which an SDK may be generated to its generator.
+var sdkGenerators = map[string]sdkGenerator{
+	"dotnet": func(pkg *schema.Package, overlays map[string][]byte) (map[string][]byte, error) {
+		return dotnet.GeneratePackage(sdkGeneratorTool, pkg, overlays)
+	},
+	"go": func(pkg *schema.Package, overlays map[string][]byte) (map[string][]byte, error) {
+		return withOverlays(gogen.GeneratePackage(sdkGeneratorTool, pkg))(overlays)
+	},
+	"nodejs": func(pkg *schema.Package, overlays map[string][]byte) (map[string][]byte, error) {
+		return nodejs.GeneratePackage(sdkGeneratorTool, pkg, overlays)
+	},
+	"python": func(pkg *schema.Package, overlays map[string][]byte) (map[string][]byte, error) {
+		return python.GeneratePackage(sdkGeneratorTool, pkg, overlays)
+	},
+	"docs": func(pkg *schema.Package, overlays map[string][]byte) (map[string][]byte, error) {
+		return withOverlays(docs.GeneratePackage(sdkGeneratorTool, pkg))(overlays)
+	},
+	"docs-site": func(pkg *schema.Package, overlays map[string][]byte) (map[string][]byte, error) {
+		return withOverlays(docs.GenerateSite(sdkGeneratorTool, pkg))(overlays)
+	},
+}
+
+// defaultSDKLanguages is the list of languages for which SDKs are generated by default.
+var defaultSDKLanguages = []string{"dotnet", "go", "nodejs", "python"}
+
+// withOverlays adds overlay files to the output of a generator that does not accept them itself.
+func withOverlays(files map[string][]byte, err error) func(overlays map[string][]byte) (map[string][]byte, error) {
+	return func(overlays map[string][]byte) (map[string][]byte, error) {
+		if err != nil {
+			return nil, err
+		}
+		for path, contents := range overlays {
+			if _, has := files[path]; has {
+				return nil, errors.Errorf("overlay file %s conflicts with a generated file", path)
+			}
+			files[path] = contents
+		}
+		return files, nil
+	}
+}
+
+func newPackageGenSDKCmd() *cobra.Command {
+	var languages []string
+	var out string
+	var overlays string
+	cmd := &cobra.Command{
+		Use:   "gen-sdk <schema-source>",
+		Short: "Generate SDKs for a package",
+		Long: "Generate SDKs for a package.\n" +
+			"\n" +
+			"This command generates the SDKs for a package from its schema, which is read from a JSON or\n" +
+			"YAML file or obtained from an installed provider plugin. The SDK for each language is written to\n" +
+			"a subdirectory of the output directory that is named after the language. Existing files are\n" +
+			"overwritten, but files that are no longer generated are not removed.\n" +
+			"\n" +
+			"Hand-written files may be added to the generated SDKs with --overlay. Files in the\n" +
+			"subdirectory of the overlay directory that is named after a language are included in that\n" +
+			"language's SDK.\n" +
+			"\n" +
+			"The supported languages are dotnet, go, nodejs, python and docs. The docs-site language\n" +
+			"generates the docs as a standalone HTML site instead of the fragments used by the Pulumi website.",
+		Args: cmdutil.ExactArgs(1),
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			for _, language := range languages {
+				if _, ok := sdkGenerators[language]; !ok {
+					return errors.Errorf("unsupported language %q", language)
+				}
+			}
+
+			pkgSchema, err := loadPackageSchema(args[0])
+			if err != nil {
+				return err
+			}
+			pkg, err := pkgSchema.Bind()
+			if err != nil {
+				return err
+			}
+
+			for _, language := range languages {
+				if err := genSDK(pkg, language, out, overlays); err != nil {
+					return err
+				}
+				fmt.Printf("Generated %s SDK in %s\n", language, filepath.Join(out, language))
+			}
+			return nil
+		}),
+	}
+
+	cmd.PersistentFlags().StringSliceVarP(&languages, "language", "l", defaultSDKLanguages,
+		"The languages for which to generate SDKs")
+	cmd.PersistentFlags().StringVarP(&out, "out", "o", "sdk",
+		"The directory into which the SDKs are written")
+	cmd.PersistentFlags().StringVar(&overlays, "overlay", "",
+		"A directory of hand-written files to include in the SDKs, with a subdirectory per language")
+
+	return cmd
+}
+
+// genSDK generates the SDK for a package in a single language and writes it to the language's subdirectory of out.
+func genSDK(pkg *schema.Package, language, out, overlays string) error {
+	var overlayFiles map[string][]byte
+	if overlays != "" {
+		files, err := readOverlays(filepath.Join(overlays, language))
+		if err != nil {
+			return err
+		}
+		overlayFiles = files
+	}
+
+	files, err := sdkGenerators[language](pkg, overlayFiles)
+	if err != nil {
+		return errors.Wrapf(err, "generating %s SDK", language)
+	}
+	return writeSDK(filepath.Join(out, language), files)
+}
+
+// readOverlays reads the files in an overlay directory, keyed by their slash-separated paths relative to the directory.
+// A missing directory has no files.
+func readOverlays(dir string) (map[string][]byte, error) {
+	files := map[string][]byte{}
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) && path == dir {
+				return nil
+			}
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		contents, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(rel)] = contents
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "reading overlays")
+	}
+	return files, nil
+}
+
+// writeSDK writes a set of generated files, keyed by their slash-separated paths, to a directory.
+func writeSDK(dir string, files map[string][]byte) error {
+	paths := make([]string, 0, len(files))
+	for path := range files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		if strings.HasPrefix(path, "/") || strings.Contains(path, "..") {
+			return errors.Errorf("invalid generated file path %s", path)
+		}
+
+		p := filepath.Join(dir, filepath.FromSlash(path))
+		if err := os.MkdirAll(filepath.Dir(p), os.ModePerm); err != nil {
+			return errors.Wrap(err, "creating SDK directory")
+		}
+		if err := ioutil.WriteFile(p, files[path], 0666); err != nil {
+			return errors.Wrap(err, "writing SDK file")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,230 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/pkg/v2/codegen/schema"
+)
+
+const testSchemaJSON = `{
+    "name": "xyz",
+    "resources": {
+        "xyz:index:Widget": {
+            "inputProperties": {
+                "size": {"type": "integer", "default": "big"},
+                "tags": {"type": "array", "items": {"type": "strang"}}
+            },
+            "requiredInputs": ["size", "colour"]
+        }
+    },
+    "language": {"nodejs": {"d": "a\"b", "c": [1, true, null]}}
+}`
+
+func TestIsSchemaFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "package-schema")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	jsonPath := filepath.Join(dir, "schema.json")
+	assert.NoError(t, ioutil.WriteFile(jsonPath, []byte(testSchemaJSON), 0600))
+	assert.True(t, isSchemaFile(jsonPath))
+
+	// Directories and files without a schema extension refer to plugins.
+	pluginDir := filepath.Join(dir, "aws")
+	assert.NoError(t, os.Mkdir(pluginDir, 0700))
+	assert.False(t, isSchemaFile(pluginDir))
+	assert.False(t, isSchemaFile("aws@2.0.0"))
+
+	yamlDir := filepath.Join(dir, "schema.yaml")
+	assert.NoError(t, os.Mkdir(yamlDir, 0700))
+	assert.False(t, isSchemaFile(yamlDir))
+	_, err = loadPackageSchema(yamlDir)
+	assert.EqualError(t, err, "schema file "+yamlDir+" does not exist or is not a regular file")
+}
+
+func TestPackageSchemaBindErrors(t *testing.T) {
+	dir, err := ioutil.TempDir("", "package-schema")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	jsonPath := filepath.Join(dir, "schema.json")
+	assert.NoError(t, ioutil.WriteFile(jsonPath, []byte(testSchemaJSON), 0600))
+
+	pkgSchema, err := loadPackageSchema(jsonPath)
+	assert.NoError(t, err)
+	_, err = pkgSchema.Bind()
+	assert.Error(t, err)
+	// Properties are bound in map order, so either of the bad properties may be reported.
+	assert.Regexp(t, `^.*schema\.json:(`+
+		`6:56: #/resources/xyz:index:Widget/inputProperties/size/default: .*invalid default|`+
+		`7:60: #/resources/xyz:index:Widget/inputProperties/tags/items/type: .*unknown type kind strang)`, err.Error())
+
+	yamlPath := filepath.Join(dir, "schema.yaml")
+	assert.NoError(t, ioutil.WriteFile(yamlPath, []byte(
+		"name: xyz\n"+
+			"resources:\n"+
+			"  xyz:index:Widget:\n"+
+			"    inputProperties:\n"+
+			"      size: {type: integer}\n"+
+			"    requiredInputs: [colour]\n"), 0600))
+
+	pkgSchema, err = loadPackageSchema(yamlPath)
+	assert.NoError(t, err)
+	_, err = pkgSchema.Bind()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "schema.yaml:6:22: #/resources/xyz:index:Widget/requiredInputs/0: ")
+	assert.Contains(t, err.Error(), "unknown required property colour")
+
+	badPath := filepath.Join(dir, "bad.json")
+	assert.NoError(t, ioutil.WriteFile(badPath, []byte("{\n  \"name\": xyz\n}"), 0600))
+	_, err = loadPackageSchema(badPath)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "bad.json:2:12: ")
+
+	assert.NoError(t, ioutil.WriteFile(badPath, []byte("{\n  \"name\": 42\n}"), 0600))
+	_, err = loadPackageSchema(badPath)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "bad.json:2:13: ")
+	assert.Contains(t, err.Error(), "cannot unmarshal number")
+}
+
+func TestPackageSchemaPosition(t *testing.T) {
+	jsonSchema := &packageSchema{Text: []byte(testSchemaJSON)}
+	yamlSchema := &packageSchema{YAML: true, Text: []byte(
+		"name: xyz\n" +
+			"resources:\n" +
+			"  xyz:index:Widget:\n" +
+			"    inputProperties:\n" +
+			"      size: &size {type: integer}\n" +
+			"      count: *size\n" +
+			"    requiredInputs:\n" +
+			"      - size\n" +
+			"      - colour\n")}
+
+	cases := []struct {
+		schema    *packageSchema
+		path      []string
+		line, col int
+	}{
+		{jsonSchema, []string{"name"}, 2, 13},
+		{jsonSchema, []string{"resources", "xyz:index:Widget", "requiredInputs", "1"}, 9, 40},
+		{jsonSchema, []string{"language", "nodejs", "c", "2"}, 12, 57},
+		// Paths that do not exist refer to the deepest element along them.
+		{jsonSchema, []string{"resources", "xyz:index:Widget", "outputProperties", "size"}, 4, 29},
+		{jsonSchema, []string{"resources", "xyz:index:Widget", "requiredInputs", "2"}, 9, 31},
+		{yamlSchema, []string{"name"}, 1, 7},
+		{yamlSchema, []string{"resources", "xyz:index:Widget", "requiredInputs", "1"}, 9, 9},
+		{yamlSchema, []string{"resources", "xyz:index:Widget", "inputProperties", "count", "type"}, 5, 26},
+		{yamlSchema, []string{"resources", "xyz:index:Widget", "outputProperties", "size"}, 4, 5},
+	}
+	for _, c := range cases {
+		line, col, ok := c.schema.position(c.path)
+		assert.True(t, ok, "%v", c.path)
+		assert.Equal(t, []int{c.line, c.col}, []int{line, col}, "%v", c.path)
+	}
+
+	_, _, ok := (&packageSchema{}).position([]string{"name"})
+	assert.False(t, ok)
+}
+
+func TestJSONPointer(t *testing.T) {
+	assert.Equal(t, "#/resources/aws:s3~1bucket:Bucket/a~0b",
+		jsonPointer([]string{"resources", "aws:s3/bucket:Bucket", "a~b"}))
+}
+
+func TestWithOverlays(t *testing.T) {
+	files, err := withOverlays(map[string][]byte{"a.go": nil}, nil)(map[string][]byte{"b.go": nil})
+	assert.NoError(t, err)
+	assert.Len(t, files, 2)
+
+	_, err = withOverlays(map[string][]byte{"a.go": nil}, nil)(map[string][]byte{"a.go": nil})
+	assert.Error(t, err)
+}
+
+// TestPackageDiffSchemaExitCode runs `pulumi package diff-schema` in a child process, which runs the command when
+// PULUMI_TEST_DIFF_SCHEMA_ARGS is set, and checks the exit codes that scripts rely upon.
+func TestPackageDiffSchemaExitCode(t *testing.T) {
+	if args := os.Getenv("PULUMI_TEST_DIFF_SCHEMA_ARGS"); args != "" {
+		cmd := newPackageDiffSchemaCmd()
+		cmd.SetArgs(strings.Split(args, string(filepath.ListSeparator)))
+		if err := cmd.Execute(); err != nil {
+			os.Exit(3)
+		}
+		os.Exit(0)
+	}
+
+	dir, err := ioutil.TempDir("", "package-schema")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	writeSchema := func(name, text string) string {
+		path := filepath.Join(dir, name)
+		assert.NoError(t, ioutil.WriteFile(path, []byte(text), 0600))
+		return path
+	}
+	oldPath := writeSchema("old.json", `{"name": "xyz", "resources": {"xyz:index:Widget": {}}}`)
+	addedPath := writeSchema("added.json",
+		`{"name": "xyz", "resources": {"xyz:index:Widget": {}, "xyz:index:Gadget": {}}}`)
+	removedPath := writeSchema("removed.json", `{"name": "xyz"}`)
+	invalidPath := writeSchema("invalid.json", testSchemaJSON)
+
+	diffSchema := func(oldPath, newPath string) int {
+		cmd := exec.Command(os.Args[0], "-test.run=^TestPackageDiffSchemaExitCode$")
+		cmd.Env = append(os.Environ(),
+			"PULUMI_TEST_DIFF_SCHEMA_ARGS="+oldPath+string(filepath.ListSeparator)+newPath)
+		err := cmd.Run()
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			return exitErr.ExitCode()
+		}
+		assert.NoError(t, err)
+		return 0
+	}
+
+	// Non-breaking changes succeed, breaking changes exit with exit code 1, and failures exit with another exit code.
+	assert.Equal(t, 0, diffSchema(oldPath, addedPath))
+	assert.Equal(t, breakingSchemaChangesExitCode, diffSchema(oldPath, removedPath))
+	code := diffSchema(oldPath, invalidPath)
+	assert.NotEqual(t, 0, code)
+	assert.NotEqual(t, breakingSchemaChangesExitCode, code)
+}
+
+func TestPrintSchemaChanges(t *testing.T) {
+	var buf bytes.Buffer
+	printSchemaChanges(&buf, []*schema.Change{
+		{Path: []string{"resources", "xyz:index:Gadget"}, Message: "resource added"},
+		{Path: []string{"resources", "xyz:index:Widget"}, Breaking: true, Message: "resource removed"},
+	})
+	assert.Equal(t, "Breaking changes:\n"+
+		"    #/resources/xyz:index:Widget: resource removed\n"+
+		"\n"+
+		"Non-breaking changes:\n"+
+		"    #/resources/xyz:index:Gadget: resource added\n"+
+		"\n"+
+		"1 breaking change, 1 non-breaking change\n", buf.String())
+
+	buf.Reset()
+	printSchemaChanges(&buf, nil)
+	assert.Equal(t, "No changes.\n", buf.String())
+}
@@ -205,6 +205,7 @@ func printPolicyPackNextSteps(proj *workspace.PolicyPackProject, root string, ge
 		// If we're generating a Python policy pack, instruct the user to set up and
 		// activate a virtual environment.
 		commands = append(commands, pythonCommands()...)
+		commands = append(commands, "pip3 install -r requirements.txt")
 	}
 
 	if len(commands) == 1 {
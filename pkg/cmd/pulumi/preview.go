@@ -39,7 +39,7 @@ func newPreviewCmd() *cobra.Command {
 	var policyPackPaths []string
 	var policyPackConfigPaths []string
 	var diffDisplay bool
-	var eventLogPath string
+	var eventStreamTarget string
 	var parallel int
 	var refresh bool
 	var showConfig bool
@@ -51,6 +51,7 @@ func newPreviewCmd() *cobra.Command {
 	var replaces []string
 	var targetReplaces []string
 	var targetDependents bool
+	var skipSchemaValidation []string
 
 	var cmd = &cobra.Command{
 		Use:        "preview",
@@ -75,6 +76,12 @@ func newPreviewCmd() *cobra.Command {
 				displayType = display.DisplayDiff
 			}
 
+			eventStream, closeEventStream, err := openEventStream(eventStreamTarget)
+			if err != nil {
+				return result.FromError(err)
+			}
+			defer closeEventStream()
+
 			displayOpts := display.Options{
 				Color:                cmdutil.GetGlobalColorization(),
 				ShowConfig:           showConfig,
@@ -85,7 +92,7 @@ func newPreviewCmd() *cobra.Command {
 				IsInteractive:        cmdutil.Interactive(),
 				Type:                 displayType,
 				JSONDisplay:          jsonDisplay,
-				EventLogPath:         eventLogPath,
+				EventStream:          eventStream,
 				Debug:                debug,
 			}
 
@@ -148,6 +155,8 @@ func newPreviewCmd() *cobra.Command {
 					UseLegacyDiff:    useLegacyDiff(),
 					UpdateTargets:    targetURNs,
 					TargetDependents: targetDependents,
+
+					SkipSchemaValidation: packageTokens(skipSchemaValidation),
 				},
 				Display: displayOpts,
 			}
@@ -210,6 +219,10 @@ func newPreviewCmd() *cobra.Command {
 	cmd.PersistentFlags().BoolVar(
 		&targetDependents, "target-dependents", false,
 		"Allows updating of dependent targets discovered but not specified in --target list")
+	cmd.PersistentFlags().StringSliceVar(
+		&skipSchemaValidation, "skip-schema-validation", []string{},
+		"Do not validate the inputs of resources from the given packages (e.g. aws,azure) against their providers' "+
+			"schemas. Validation binds the schema of each package, which may be slow for large packages")
 
 	// Flags for engine.UpdateOptions.
 	cmd.PersistentFlags().StringSliceVar(
@@ -248,10 +261,6 @@ func newPreviewCmd() *cobra.Command {
 		&suppressOutputs, "suppress-outputs", false,
 		"Suppress display of stack outputs (in case they contain sensitive values)")
 
-	if hasDebugCommands() {
-		cmd.PersistentFlags().StringVar(
-			&eventLogPath, "event-log", "",
-			"Log events to a file at this path")
-	}
+	addEventStreamFlags(cmd, &eventStreamTarget)
 	return cmd
 }
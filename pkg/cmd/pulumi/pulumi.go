@@ -172,7 +172,9 @@ func NewPulumiCmd() *cobra.Command {
 	cmd.PersistentFlags().BoolVar(&cmdutil.DisableInteractive, "non-interactive", false,
 		"Disable interactive mode for all commands")
 	cmd.PersistentFlags().StringVar(&tracing, "tracing", "",
-		"Emit tracing to the specified endpoint. Use the `file:` scheme to write tracing data to a local file")
+		"Emit tracing to the specified endpoint. Use the `file:` scheme to write tracing data to a local file, "+
+			"`otlp://host:port` or `otlp+http://host:port` to send spans to an OpenTelemetry collector, "+
+			"or `otlp+file:` to write OTLP/JSON spans to a local file")
 	cmd.PersistentFlags().StringVar(&profiling, "profiling", "",
 		"Emit CPU and memory profiles and an execution trace to '[filename].[pid].{cpu,mem,trace}', respectively")
 	cmd.PersistentFlags().IntVarP(&verbose, "verbose", "v", 0,
@@ -183,6 +185,7 @@ func NewPulumiCmd() *cobra.Command {
 	// Common commands:
 	//     - Getting Started Commands:
 	cmd.AddCommand(newNewCmd())
+	cmd.AddCommand(newInstallCmd())
 	//     - Deploy Commands:
 	cmd.AddCommand(newUpCmd())
 	cmd.AddCommand(newPreviewCmd())
@@ -204,12 +207,14 @@ func NewPulumiCmd() *cobra.Command {
 	//     - Other Commands:
 	cmd.AddCommand(newLogsCmd())
 	cmd.AddCommand(newPluginCmd())
+	cmd.AddCommand(newPackageCmd())
 	cmd.AddCommand(newVersionCmd())
 	cmd.AddCommand(newHistoryCmd())
 
 	// Less common, and thus hidden, commands:
 	cmd.AddCommand(newGenCompletionCmd(cmd))
 	cmd.AddCommand(newGenMarkdownCmd(cmd))
+	cmd.AddCommand(newLSPCmd())
 
 	// We have a set of commands that are still experimental and that we add only when PULUMI_EXPERIMENTAL is set
 	// to true.
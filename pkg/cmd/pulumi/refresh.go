@@ -36,7 +36,7 @@ func newRefreshCmd() *cobra.Command {
 
 	// Flags for engine.UpdateOptions.
 	var diffDisplay bool
-	var eventLogPath string
+	var eventStreamTarget string
 	var parallel int
 	var showConfig bool
 	var showReplacementSteps bool
@@ -75,6 +75,12 @@ func newRefreshCmd() *cobra.Command {
 				displayType = display.DisplayDiff
 			}
 
+			eventStream, closeEventStream, err := openEventStream(eventStreamTarget)
+			if err != nil {
+				return result.FromError(err)
+			}
+			defer closeEventStream()
+
 			opts.Display = display.Options{
 				Color:                cmdutil.GetGlobalColorization(),
 				ShowConfig:           showConfig,
@@ -83,7 +89,7 @@ func newRefreshCmd() *cobra.Command {
 				SuppressOutputs:      suppressOutputs,
 				IsInteractive:        interactive,
 				Type:                 displayType,
-				EventLogPath:         eventLogPath,
+				EventStream:          eventStream,
 				Debug:                debug,
 			}
 
@@ -191,10 +197,6 @@ func newRefreshCmd() *cobra.Command {
 		&yes, "yes", "y", false,
 		"Automatically approve and perform the refresh after previewing it")
 
-	if hasDebugCommands() {
-		cmd.PersistentFlags().StringVar(
-			&eventLogPath, "event-log", "",
-			"Log events to a file at this path")
-	}
+	addEventStreamFlags(cmd, &eventStreamTarget)
 	return cmd
 }
@@ -0,0 +1,352 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	survey "gopkg.in/AlecAivazis/survey.v1"
+	surveycore "gopkg.in/AlecAivazis/survey.v1/core"
+
+	"github.com/pulumi/pulumi/pkg/v2/backend"
+	"github.com/pulumi/pulumi/pkg/v2/backend/display"
+	"github.com/pulumi/pulumi/pkg/v2/engine"
+	"github.com/pulumi/pulumi/pkg/v2/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/v2/resource/edit"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/diag/colors"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/cmdutil"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/result"
+)
+
+func newStateRepairCommand() *cobra.Command {
+	var stack string
+	var yes bool
+	var keeps []string
+	var discards []string
+	var reads []string
+
+	cmd := &cobra.Command{
+		Use:   "repair",
+		Short: "Resolve the operations left pending by an interrupted update",
+		Long: `Resolve the operations left pending by an interrupted update
+
+If an update is interrupted, the resources that were being created, updated, or deleted at the time are left in an
+unknown state, and the stack cannot be updated until each of these pending operations is resolved. This command lists
+the pending operations and resolves each of them in one of three ways:
+
+  - keep:    the resource exists, and has the given ID
+  - discard: the resource does not exist, and is removed from the stack's state
+  - read:    the resource's provider is asked whether the resource exists, and for its current state
+
+When run interactively, this command prompts for the resolution of each pending operation. Otherwise, each pending
+operation must be resolved using the --keep, --discard, or --read flags. The resulting state is saved by running a
+refresh of the stack's root resource and of any resources that are resolved by reading them.`,
+		Args: cmdutil.NoArgs,
+		Run: cmdutil.RunResultFunc(func(cmd *cobra.Command, args []string) result.Result {
+			interactive := cmdutil.Interactive()
+			opts, err := updateFlagsToOptions(interactive, false /*skipPreview*/, yes)
+			if err != nil {
+				return result.FromError(err)
+			}
+			opts.Display = display.Options{
+				Color:         cmdutil.GetGlobalColorization(),
+				IsInteractive: interactive,
+				Type:          display.DisplayProgress,
+			}
+
+			s, err := requireStack(stack, true, opts.Display, true /*setCurrent*/)
+			if err != nil {
+				return result.FromError(err)
+			}
+			snap, err := s.Snapshot(commandContext())
+			if err != nil {
+				return result.FromError(err)
+			}
+			if snap == nil || len(snap.PendingOperations) == 0 {
+				fmt.Println("The stack has no pending operations")
+				return nil
+			}
+
+			var resolutions []edit.PendingOperationResolution
+			if len(keeps) != 0 || len(discards) != 0 || len(reads) != 0 {
+				resolutions, err = parsePendingOperationResolutions(snap.PendingOperations, keeps, discards, reads)
+			} else if interactive {
+				resolutions, err = promptForPendingOperationResolutions(opts.Display, snap.PendingOperations)
+			} else {
+				err = errors.New("--keep, --discard, or --read must be passed in non-interactive mode")
+			}
+			if err != nil {
+				return result.FromError(err)
+			}
+
+			proj, root, err := readProject()
+			if err != nil {
+				return result.FromError(err)
+			}
+
+			// Refreshing the stack's root resource is a no-op, but ensures that the resolved state is saved. The engine
+			// adds the resources that are resolved by reading them to these targets.
+			targets := []resource.URN{resource.DefaultRootStackURN(s.Ref().Name(), proj.Name)}
+			if rootStack := findRootStackResource(snap); rootStack != nil {
+				targets = []resource.URN{rootStack.URN}
+			}
+
+			m, err := getUpdateMetadata("Repair pending operations", root)
+			if err != nil {
+				return result.FromError(errors.Wrap(err, "gathering environment metadata"))
+			}
+			sm, err := getStackSecretsManager(s)
+			if err != nil {
+				return result.FromError(errors.Wrap(err, "getting secrets manager"))
+			}
+			cfg, err := getStackConfiguration(s, sm)
+			if err != nil {
+				return result.FromError(errors.Wrap(err, "getting stack configuration"))
+			}
+
+			opts.Engine = engine.UpdateOptions{
+				Parallel:                    defaultParallel,
+				UseLegacyDiff:               useLegacyDiff(),
+				RefreshTargets:              targets,
+				PendingOperationResolutions: resolutions,
+			}
+
+			_, res := s.Refresh(commandContext(), backend.UpdateOperation{
+				Proj:               proj,
+				Root:               root,
+				M:                  m,
+				Opts:               opts,
+				StackConfiguration: cfg,
+				SecretsManager:     sm,
+				Scopes:             cancellationScopes,
+			})
+			switch {
+			case res != nil && res.Error() == context.Canceled:
+				return result.FromError(errors.New("repair cancelled"))
+			case res != nil:
+				return PrintEngineResult(res)
+			default:
+				return nil
+			}
+		}),
+	}
+
+	cmd.PersistentFlags().StringVarP(
+		&stack, "stack", "s", "",
+		"The name of the stack to operate on. Defaults to the current stack")
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false,
+		"Automatically approve and perform the repair after previewing it")
+	cmd.Flags().StringArrayVar(&keeps, "keep", nil,
+		"Keep the resource with the given URN, with the given ID: --keep <urn>=<id>. The ID is optional if the resource "+
+			"already has one")
+	cmd.Flags().StringArrayVar(&discards, "discard", nil,
+		"Discard the resource with the given URN: --discard <urn>")
+	cmd.Flags().StringArrayVar(&reads, "read", nil,
+		"Read the resource with the given URN from its provider: --read <urn>. Resources that were being created "+
+			"have no ID to read them by, so must be kept or discarded instead")
+
+	return cmd
+}
+
+// findRootStackResource returns the root stack resource in the given snapshot, if any.
+func findRootStackResource(snap *deploy.Snapshot) *resource.State {
+	for _, res := range snap.Resources {
+		if res.Type == resource.RootStackType && res.Parent == "" {
+			return res
+		}
+	}
+	return nil
+}
+
+// describePendingOperation returns a human-readable description of a pending operation.
+func describePendingOperation(op resource.Operation) string {
+	return fmt.Sprintf("%s, interrupted while %s", op.Resource.URN, op.Type)
+}
+
+// parsePendingOperationResolutions parses the resolutions for the given pending operations from the values of the
+// --keep, --discard, and --read flags.
+func parsePendingOperationResolutions(ops []resource.Operation,
+	keeps, discards, reads []string) ([]edit.PendingOperationResolution, error) {
+
+	actions := make(map[resource.URN]edit.PendingOperationAction)
+	ids := make(map[resource.URN]resource.ID)
+	add := func(action edit.PendingOperationAction, urn resource.URN, id resource.ID) error {
+		if _, has := actions[urn]; has {
+			return errors.Errorf("more than one resolution given for resource %s", urn)
+		}
+		actions[urn], ids[urn] = action, id
+		return nil
+	}
+	for _, v := range keeps {
+		urn, id, err := parseKeepFlag(ops, v)
+		if err != nil {
+			return nil, err
+		}
+		if err = add(edit.PendingOperationKeep, urn, id); err != nil {
+			return nil, err
+		}
+	}
+	for _, v := range discards {
+		if err := add(edit.PendingOperationDiscard, resource.URN(v), ""); err != nil {
+			return nil, err
+		}
+	}
+	for _, v := range reads {
+		if err := add(edit.PendingOperationRead, resource.URN(v), ""); err != nil {
+			return nil, err
+		}
+	}
+
+	for urn := range actions {
+		if !hasPendingOperation(ops, urn) {
+			return nil, errors.Errorf("resource %s has no pending operation", urn)
+		}
+	}
+
+	resolutions := make([]edit.PendingOperationResolution, 0, len(ops))
+	for _, op := range ops {
+		action, ok := actions[op.Resource.URN]
+		if !ok {
+			return nil, errors.Errorf("no resolution given for %s", describePendingOperation(op))
+		}
+		resolutions = append(resolutions, edit.PendingOperationResolution{
+			URN:    op.Resource.URN,
+			Type:   op.Type,
+			Action: action,
+			ID:     ids[op.Resource.URN],
+		})
+	}
+	return resolutions, nil
+}
+
+// parseKeepFlag splits the value of a --keep flag, <urn>[=<id>], into a URN and an optional ID. Both URNs and IDs may
+// contain "=", so rather than splitting the value at a particular "=", the value is matched against the URNs of the
+// pending operations.
+func parseKeepFlag(ops []resource.Operation, v string) (resource.URN, resource.ID, error) {
+	for _, op := range ops {
+		urn := string(op.Resource.URN)
+		switch {
+		case v == urn:
+			return op.Resource.URN, "", nil
+		case strings.HasPrefix(v, urn+"="):
+			return op.Resource.URN, resource.ID(v[len(urn)+1:]), nil
+		}
+	}
+	return "", "", errors.Errorf("%s does not name a resource with a pending operation", v)
+}
+
+// hasPendingOperation returns true if the resource with the given URN has a pending operation.
+func hasPendingOperation(ops []resource.Operation, urn resource.URN) bool {
+	for _, op := range ops {
+		if op.Resource.URN == urn {
+			return true
+		}
+	}
+	return false
+}
+
+// promptToResolvePendingOperations checks whether the given stack has any pending operations and, if so, asks the user
+// whether they would like to resolve them before proceeding. If they would, it prompts for the resolution of each.
+func promptToResolvePendingOperations(s backend.Stack, opts display.Options) ([]edit.PendingOperationResolution, error) {
+	snap, err := s.Snapshot(commandContext())
+	if err != nil {
+		return nil, err
+	}
+	if snap == nil || len(snap.PendingOperations) == 0 {
+		return nil, nil
+	}
+
+	fmt.Printf("The last update of this stack was interrupted, and left %d pending operation(s):\n",
+		len(snap.PendingOperations))
+	for _, op := range snap.PendingOperations {
+		fmt.Printf("  * %s\n", describePendingOperation(op))
+	}
+	fmt.Println()
+
+	confirm := false
+	surveycore.DisableColor = true
+	surveycore.QuestionIcon = ""
+	surveycore.SelectFocusIcon = opts.Color.Colorize(colors.BrightGreen + ">" + colors.Reset)
+	cmdutil.EndKeypadTransmitMode()
+	if err = survey.AskOne(&survey.Confirm{
+		Message: "Would you like to resolve them before proceeding?",
+		Default: true,
+	}, &confirm, nil); err != nil {
+		return nil, err
+	}
+	if !confirm {
+		return nil, nil
+	}
+	return promptForPendingOperationResolutions(opts, snap.PendingOperations)
+}
+
+// promptForPendingOperationResolutions interactively prompts the user to choose a resolution for each of the given
+// pending operations.
+func promptForPendingOperationResolutions(opts display.Options,
+	ops []resource.Operation) ([]edit.PendingOperationResolution, error) {
+
+	surveycore.DisableColor = true
+	surveycore.QuestionIcon = ""
+	surveycore.SelectFocusIcon = opts.Color.Colorize(colors.BrightGreen + ">" + colors.Reset)
+	cmdutil.EndKeypadTransmitMode()
+
+	const (
+		readOption    = "read: ask the resource's provider whether it exists"
+		keepOption    = "keep: the resource exists"
+		discardOption = "discard: the resource does not exist"
+	)
+	actions := map[string]edit.PendingOperationAction{
+		readOption:    edit.PendingOperationRead,
+		keepOption:    edit.PendingOperationKeep,
+		discardOption: edit.PendingOperationDiscard,
+	}
+
+	resolutions := make([]edit.PendingOperationResolution, 0, len(ops))
+	for _, op := range ops {
+		prompt := opts.Color.Colorize(colors.SpecPrompt + describePendingOperation(op) + colors.Reset)
+
+		var option string
+		if err := survey.AskOne(&survey.Select{
+			Message: prompt,
+			Options: []string{readOption, keepOption, discardOption},
+		}, &option, nil); err != nil {
+			return nil, errors.New("no resolution selected")
+		}
+		action := actions[option]
+
+		// Resources that were being created have no ID yet, so we need the user to provide one.
+		var id string
+		if action != edit.PendingOperationDiscard && op.Resource.ID == "" {
+			if err := survey.AskOne(&survey.Input{
+				Message: opts.Color.Colorize(colors.SpecPrompt + "ID of the created resource:" + colors.Reset),
+			}, &id, survey.Required); err != nil {
+				return nil, errors.New("no ID provided")
+			}
+		}
+
+		resolutions = append(resolutions, edit.PendingOperationResolution{
+			URN:    op.Resource.URN,
+			Type:   op.Type,
+			Action: action,
+			ID:     resource.ID(id),
+		})
+	}
+	return resolutions, nil
+}
@@ -0,0 +1,77 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/pkg/v2/resource/edit"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/tokens"
+)
+
+func pendingOperation(name string, typ resource.OperationType) resource.Operation {
+	urn := resource.NewURN("test", "test", "", "pkgA:m:typA", tokens.QName(name))
+	return resource.Operation{
+		Resource: &resource.State{URN: urn, Type: "pkgA:m:typA"},
+		Type:     typ,
+	}
+}
+
+func TestParsePendingOperationResolutions(t *testing.T) {
+	ops := []resource.Operation{
+		pendingOperation("resA", resource.OperationTypeCreating),
+		pendingOperation("resB", resource.OperationTypeUpdating),
+		pendingOperation("resC", resource.OperationTypeDeleting),
+		pendingOperation("resD", resource.OperationTypeUpdating),
+	}
+	urnA, urnB, urnC, urnD := ops[0].Resource.URN, ops[1].Resource.URN, ops[2].Resource.URN, ops[3].Resource.URN
+
+	resolutions, err := parsePendingOperationResolutions(ops,
+		[]string{string(urnA) + "=YWJjZA==", string(urnB)}, []string{string(urnC)}, []string{string(urnD)})
+	assert.NoError(t, err)
+	assert.Equal(t, []edit.PendingOperationResolution{
+		{URN: urnA, Type: resource.OperationTypeCreating, Action: edit.PendingOperationKeep, ID: "YWJjZA=="},
+		{URN: urnB, Type: resource.OperationTypeUpdating, Action: edit.PendingOperationKeep},
+		{URN: urnC, Type: resource.OperationTypeDeleting, Action: edit.PendingOperationDiscard},
+		{URN: urnD, Type: resource.OperationTypeUpdating, Action: edit.PendingOperationRead},
+	}, resolutions)
+}
+
+func TestParsePendingOperationResolutionsErrors(t *testing.T) {
+	ops := []resource.Operation{
+		pendingOperation("resA", resource.OperationTypeCreating),
+		pendingOperation("resB", resource.OperationTypeUpdating),
+	}
+	urnA, urnB := string(ops[0].Resource.URN), string(ops[1].Resource.URN)
+
+	// Values passed to --discard and --read are whole URNs, and are not split at "=".
+	_, err := parsePendingOperationResolutions(ops, []string{urnA + "=id"}, []string{urnB + "=id"}, nil)
+	assert.EqualError(t, err, "resource "+urnB+"=id has no pending operation")
+	_, err = parsePendingOperationResolutions(ops, []string{urnA + "=id"}, nil, []string{urnB + "=id"})
+	assert.EqualError(t, err, "resource "+urnB+"=id has no pending operation")
+
+	// Values passed to --keep must name a resource with a pending operation.
+	_, err = parsePendingOperationResolutions(ops, []string{urnA + "x=id"}, []string{urnB}, nil)
+	assert.EqualError(t, err, urnA+"x=id does not name a resource with a pending operation")
+
+	// Each pending operation must be resolved exactly once.
+	_, err = parsePendingOperationResolutions(ops, []string{urnA + "=id"}, []string{urnA}, nil)
+	assert.EqualError(t, err, "more than one resolution given for resource "+urnA)
+	_, err = parsePendingOperationResolutions(ops, []string{urnA + "=id"}, nil, nil)
+	assert.Error(t, err)
+}
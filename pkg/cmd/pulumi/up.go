@@ -26,6 +26,7 @@ import (
 	"github.com/pulumi/pulumi/pkg/v2/backend/display"
 	"github.com/pulumi/pulumi/pkg/v2/engine"
 	"github.com/pulumi/pulumi/pkg/v2/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/v2/resource/edit"
 	"github.com/pulumi/pulumi/pkg/v2/resource/stack"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/config"
@@ -55,7 +56,7 @@ func newUpCmd() *cobra.Command {
 	var policyPackPaths []string
 	var policyPackConfigPaths []string
 	var diffDisplay bool
-	var eventLogPath string
+	var eventStreamTarget string
 	var parallel int
 	var refresh bool
 	var showConfig bool
@@ -70,6 +71,8 @@ func newUpCmd() *cobra.Command {
 	var replaces []string
 	var targetReplaces []string
 	var targetDependents bool
+	var continueOnError bool
+	var skipSchemaValidation []string
 
 	// up implementation used when the source of the Pulumi program is in the current working directory.
 	upWorkingDirectory := func(opts backend.UpdateOptions) result.Result {
@@ -118,16 +121,28 @@ func newUpCmd() *cobra.Command {
 			replaceURNs = append(replaceURNs, resource.URN(tr))
 		}
 
+		// If the last update was interrupted, give the user a chance to resolve its pending operations.
+		var resolutions []edit.PendingOperationResolution
+		if cmdutil.Interactive() && !yes {
+			if resolutions, err = promptToResolvePendingOperations(s, opts.Display); err != nil {
+				return result.FromError(err)
+			}
+		}
+
 		opts.Engine = engine.UpdateOptions{
-			LocalPolicyPacks: engine.MakeLocalPolicyPacks(policyPackPaths, policyPackConfigPaths),
-			Parallel:         parallel,
-			Debug:            debug,
-			Refresh:          refresh,
-			RefreshTargets:   targetURNs,
-			ReplaceTargets:   replaceURNs,
-			UseLegacyDiff:    useLegacyDiff(),
-			UpdateTargets:    targetURNs,
-			TargetDependents: targetDependents,
+			LocalPolicyPacks:            engine.MakeLocalPolicyPacks(policyPackPaths, policyPackConfigPaths),
+			Parallel:                    parallel,
+			Debug:                       debug,
+			Refresh:                     refresh,
+			RefreshTargets:              targetURNs,
+			ReplaceTargets:              replaceURNs,
+			UseLegacyDiff:               useLegacyDiff(),
+			UpdateTargets:               targetURNs,
+			TargetDependents:            targetDependents,
+			ContinueOnError:             continueOnError,
+			SkipSchemaValidation:        packageTokens(skipSchemaValidation),
+			DefaultRetryPolicy:          cfg.RetryPolicy,
+			PendingOperationResolutions: resolutions,
 		}
 
 		changes, res := s.Update(commandContext(), backend.UpdateOperation{
@@ -285,6 +300,10 @@ func newUpCmd() *cobra.Command {
 			Parallel:         parallel,
 			Debug:            debug,
 			Refresh:          refresh,
+			ContinueOnError:  continueOnError,
+
+			SkipSchemaValidation: packageTokens(skipSchemaValidation),
+			DefaultRetryPolicy:   cfg.RetryPolicy,
 		}
 
 		// TODO for the URL case:
@@ -350,6 +369,12 @@ func newUpCmd() *cobra.Command {
 				displayType = display.DisplayDiff
 			}
 
+			eventStream, closeEventStream, err := openEventStream(eventStreamTarget)
+			if err != nil {
+				return result.FromError(err)
+			}
+			defer closeEventStream()
+
 			opts.Display = display.Options{
 				Color:                cmdutil.GetGlobalColorization(),
 				ShowConfig:           showConfig,
@@ -359,7 +384,7 @@ func newUpCmd() *cobra.Command {
 				SuppressOutputs:      suppressOutputs,
 				IsInteractive:        interactive,
 				Type:                 displayType,
-				EventLogPath:         eventLogPath,
+				EventStream:          eventStream,
 				Debug:                debug,
 			}
 
@@ -412,6 +437,13 @@ func newUpCmd() *cobra.Command {
 	cmd.PersistentFlags().BoolVar(
 		&targetDependents, "target-dependents", false,
 		"Allows updating of dependent targets discovered but not specified in --target list")
+	cmd.PersistentFlags().BoolVar(
+		&continueOnError, "continue-on-error", false,
+		"Continue updating resources that do not depend on a failed resource instead of stopping at the first failure")
+	cmd.PersistentFlags().StringSliceVar(
+		&skipSchemaValidation, "skip-schema-validation", []string{},
+		"Do not validate the inputs of resources from the given packages (e.g. aws,azure) against their providers' "+
+			"schemas. Validation binds the schema of each package, which may be slow for large packages")
 
 	// Flags for engine.UpdateOptions.
 	cmd.PersistentFlags().StringSliceVar(
@@ -453,11 +485,7 @@ func newUpCmd() *cobra.Command {
 		&yes, "yes", "y", false,
 		"Automatically approve and perform the update after previewing it")
 
-	if hasDebugCommands() {
-		cmd.PersistentFlags().StringVar(
-			&eventLogPath, "event-log", "",
-			"Log events to a file at this path")
-	}
+	addEventStreamFlags(cmd, &eventStreamTarget)
 	return cmd
 }
 
@@ -31,6 +31,8 @@ import (
 	multierror "github.com/hashicorp/go-multierror"
 	opentracing "github.com/opentracing/opentracing-go"
 	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	survey "gopkg.in/AlecAivazis/survey.v1"
 	surveycore "gopkg.in/AlecAivazis/survey.v1/core"
 	git "gopkg.in/src-d/go-git.v4"
@@ -45,6 +47,7 @@ import (
 	"github.com/pulumi/pulumi/pkg/v2/util/cancel"
 	"github.com/pulumi/pulumi/pkg/v2/util/tracing"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/diag/colors"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/tokens"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/ciutil"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/cmdutil"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/contract"
@@ -57,6 +60,33 @@ func hasDebugCommands() bool {
 	return cmdutil.IsTruthy(os.Getenv("PULUMI_DEBUG_COMMANDS"))
 }
 
+// openEventStream opens the event stream named by the --event-stream flag, if any. The returned function closes it.
+func openEventStream(target string) (*display.EventStream, func(), error) {
+	if target == "" {
+		return nil, func() {}, nil
+	}
+	stream, err := display.OpenEventStream(target)
+	if err != nil {
+		return nil, nil, err
+	}
+	return stream, func() { contract.IgnoreClose(stream) }, nil
+}
+
+// addEventStreamFlags registers the flag that writes engine events to an event stream. --event-log is accepted as an
+// alias for --event-stream.
+func addEventStreamFlags(cmd *cobra.Command, target *string) {
+	cmd.PersistentFlags().StringVar(
+		target, "event-stream", "",
+		"Write engine events as newline-delimited JSON to a file path, an open file descriptor (fd:<n>), "+
+			"or a Unix domain socket (unix:<path>). --event-log is an alias for this flag")
+	cmd.SetGlobalNormalizationFunc(func(f *pflag.FlagSet, name string) pflag.NormalizedName {
+		if name == "event-log" {
+			name = "event-stream"
+		}
+		return pflag.NormalizedName(name)
+	})
+}
+
 func hasExperimentalCommands() bool {
 	return cmdutil.IsTruthy(os.Getenv("PULUMI_EXPERIMENTAL"))
 }
@@ -65,6 +95,15 @@ func useLegacyDiff() bool {
 	return cmdutil.IsTruthy(os.Getenv("PULUMI_ENABLE_LEGACY_DIFF"))
 }
 
+// packageTokens converts a list of package names passed on the command line into package tokens.
+func packageTokens(names []string) []tokens.Package {
+	var pkgs []tokens.Package
+	for _, name := range names {
+		pkgs = append(pkgs, tokens.Package(name))
+	}
+	return pkgs
+}
+
 // backendInstance is used to inject a backend mock from tests.
 var backendInstance backend.Backend
 
@@ -156,6 +195,12 @@ func createStack(
 		return nil, errors.Wrapf(err, "could not create stack")
 	}
 
+	// New stacks produce reproducible archives by default. Existing stacks are left alone, as switching would change
+	// the bytes and hashes of their archives and cause spurious updates.
+	if err = enableDeterministicArchives(stack); err != nil {
+		return nil, err
+	}
+
 	if setCurrent {
 		if err = state.SetCurrentStack(stack.Ref().String()); err != nil {
 			return nil, err
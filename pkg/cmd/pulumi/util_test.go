@@ -20,6 +20,7 @@ import (
 	"github.com/pulumi/pulumi/pkg/v2/backend"
 	pul_testing "github.com/pulumi/pulumi/sdk/v2/go/common/testing"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/gitutil"
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -226,3 +227,14 @@ func TestReadingGitLabMetadata(t *testing.T) {
 		assertEnvValue(t, test, backend.VCSRepoKind, gitutil.GitLabHostName)
 	}
 }
+
+func TestEventStreamFlags(t *testing.T) {
+	for _, flag := range []string{"--event-stream", "--event-log"} {
+		var target string
+		cmd := &cobra.Command{Run: func(*cobra.Command, []string) {}}
+		addEventStreamFlags(cmd, &target)
+		cmd.SetArgs([]string{flag, "events.json"})
+		assert.NoError(t, cmd.Execute())
+		assert.Equal(t, "events.json", target, flag)
+	}
+}
@@ -15,30 +15,59 @@
 package main
 
 import (
+	"bufio"
+	"bytes"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"os"
 
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"sourcegraph.com/sourcegraph/appdash"
+	appdash_opentracing "sourcegraph.com/sourcegraph/appdash/opentracing"
 	"sourcegraph.com/sourcegraph/appdash/traceapp"
 
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/cmdutil"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/contract"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/otlp"
 )
 
-func readTrace(path string, store io.ReaderFrom) error {
+// otlpTracePrefix is the prefix of an OTLP/JSON trace file.
+var otlpTracePrefix = []byte(`{"resourceSpans"`)
+
+// readTrace reads a trace file into an appdash store. The file may be either an appdash trace, as written by
+// --tracing=file:<path>, or an OTLP/JSON trace, as written by --tracing=otlp+file:<path> or by an OpenTelemetry
+// collector's file exporter.
+func readTrace(path string, store *appdash.MemoryStore) error {
 	f, err := os.Open(path)
 	if err != nil {
 		return err
 	}
 	defer contract.IgnoreClose(f)
-	_, err = store.ReadFrom(f)
+
+	r := bufio.NewReader(f)
+	if prefix, err := r.Peek(len(otlpTracePrefix)); err == nil && bytes.Equal(prefix, otlpTracePrefix) {
+		return readOTLPTrace(r, store)
+	}
+	_, err = store.ReadFrom(r)
 	return err
 }
 
+// readOTLPTrace reads an OTLP/JSON trace into an appdash store.
+func readOTLPTrace(r io.Reader, store *appdash.MemoryStore) error {
+	spans, err := otlp.ReadTraces(r)
+	if err != nil {
+		return errors.Wrap(err, "reading OTLP trace")
+	}
+	recorder := appdash_opentracing.NewRecorder(appdash.NewLocalCollector(store), appdash_opentracing.DefaultOptions())
+	for _, span := range spans {
+		recorder.RecordSpan(span)
+	}
+	return nil
+}
+
 func newViewTraceCmd() *cobra.Command {
 	var port int
 	var cmd = &cobra.Command{
@@ -47,7 +76,8 @@ func newViewTraceCmd() *cobra.Command {
 		Long: "Display a trace from the Pulumi CLI.\n" +
 			"\n" +
 			"This command is used to display execution traces collected by a prior\n" +
-			"invocation of the Pulumi CLI.\n" +
+			"invocation of the Pulumi CLI. Both appdash traces (--tracing=file:<path>)\n" +
+			"and OTLP/JSON traces (--tracing=otlp+file:<path>) are supported.\n" +
 			"\n" +
 			"This command loads trace data from the indicated file and starts a\n" +
 			"webserver to display the trace. By default, this server will listen\n" +
@@ -50,6 +50,8 @@ type DocLanguageHelper interface {
 	GetDocLinkForFunctionInputOrOutputType(pkg *schema.Package, moduleName, typeName string, input bool) string
 	GetDocLinkForBuiltInType(typeName string) string
 	GetLanguageTypeString(pkg *schema.Package, moduleName string, t schema.Type, input, optional bool) string
+	// GetEnumName returns the name of the given member of the enum type with the given name.
+	GetEnumName(e *schema.Enum, typeName string) (string, error)
 
 	GetFunctionName(modName string, f *schema.Function) string
 	// GetResourceFunctionResultName returns the name of the result type when a static resource function is used to lookup
@@ -197,3 +199,36 @@ func StripNonRelevantExamples(description string, lang string) string {
 
 	return strings.ReplaceAll(newDescription, "{{ .Examples }}", builder.String())
 }
+
+// Example is a single example for a particular language that is contained in the examples section of a description.
+type Example struct {
+	// Title is the title of the example, without its Markdown heading marker.
+	Title string
+	// Snippet is the example's code, without its code fence.
+	Snippet string
+}
+
+// GetExamples returns the examples for the given language from the examples section of a resource's or function's
+// description.
+func GetExamples(description string, lang string) []Example {
+	examplesContent := extractExamplesSection(description)
+	if examplesContent == nil {
+		return nil
+	}
+
+	var examples []Example
+	for _, ex := range getExamplesForLang(*examplesContent, lang) {
+		snippet := strings.TrimPrefix(ex.Snippet, "```"+lang)
+		snippet = strings.TrimSuffix(snippet, "```")
+		examples = append(examples, Example{
+			Title:   strings.TrimSpace(strings.TrimPrefix(ex.Title, "###")),
+			Snippet: strings.Trim(snippet, "\n"),
+		})
+	}
+	return examples
+}
+
+// StripExamples removes the examples section from a resource's or function's description.
+func StripExamples(description string) string {
+	return strings.TrimSpace(surroundingTextRE.ReplaceAllString(description, ""))
+}
@@ -154,12 +154,22 @@ type apiTypeDocLinks struct {
 	OutputType string
 }
 
-// docNestedType represents a complex type.
+// docNestedType represents a complex type or an enum type.
 type docNestedType struct {
 	Name        string
 	AnchorID    string
 	APIDocLinks map[string]apiTypeDocLinks
 	Properties  map[string][]property
+	EnumValues  map[string][]enum
+}
+
+// enum represents a member of an enum type.
+type enum struct {
+	// Name is the language-specific name of the member.
+	Name               string
+	Value              string
+	Comment            string
+	DeprecationMessage string
 }
 
 // propertyType represents the type of a property.
@@ -240,6 +250,13 @@ type resourceDocArgs struct {
 	// output properties.
 	NestedTypes []docNestedType
 
+	// IsComponent is true if the resource is a component resource that is
+	// constructed by its provider.
+	IsComponent bool
+	// ChildResources is the list of the child resources that a component
+	// resource exposes as output properties.
+	ChildResources []indexEntry
+
 	PackageDetails packageDetails
 }
 
@@ -294,6 +311,10 @@ type modContext struct {
 	functions []*schema.Function
 	children  []*modContext
 	tool      string
+	// sitePages maps the tokens of the resources and functions of a package that is documented as a standalone static
+	// site to the paths of their pages relative to the root of the site. It is nil if the docs are generated for the
+	// Pulumi website.
+	sitePages map[string]string
 }
 
 func resourceName(r *schema.Resource) string {
@@ -413,6 +434,11 @@ func (mod *modContext) cleanTypeString(t schema.Type, langTypeString, lang, modN
 		if objTypeModName != mod.mod {
 			modName = getLanguageModuleName(mod.pkg, objTypeModName, lang)
 		}
+	case *schema.EnumType:
+		enumTypeModName := mod.pkg.TokenToModule(t.Token)
+		if enumTypeModName != mod.mod {
+			modName = getLanguageModuleName(mod.pkg, enumTypeModName, lang)
+		}
 	}
 
 	if lang == "nodejs" {
@@ -423,6 +449,22 @@ func (mod *modContext) cleanTypeString(t schema.Type, langTypeString, lang, modN
 	return strings.ReplaceAll(langTypeString, modName, "")
 }
 
+// isExternalType returns true if the given type is a resource, object, or enum type defined by a package other than
+// the one being documented. The names of such types are always fully qualified.
+func (mod *modContext) isExternalType(t schema.Type) bool {
+	switch t := t.(type) {
+	case *schema.ObjectType:
+		return t.Package != nil && t.Package != mod.pkg
+	case *schema.EnumType:
+		return t.Package != nil && t.Package != mod.pkg
+	case *schema.ResourceType:
+		// Resources are always referred to by their fully-qualified names, even if they are defined by this package.
+		return true
+	default:
+		return false
+	}
+}
+
 // typeString returns a property type suitable for docs with its display name and the anchor link to
 // a type if the type of the property is an array or an object.
 func (mod *modContext) typeString(t schema.Type, lang string, characteristics propertyCharacteristics, insertWordBreaks bool) propertyType {
@@ -438,9 +480,20 @@ func (mod *modContext) typeString(t schema.Type, lang string, characteristics pr
 		elementLangType := mod.typeString(t.ElementType, lang, characteristics, false)
 		href = elementLangType.Link
 	case *schema.ObjectType:
-		tokenName := tokenToName(t.Token)
-		// Links to anchor tags on the same page must be lower-cased.
-		href = "#" + strings.ToLower(tokenName)
+		// Types defined by other packages are documented by those packages rather than on this page.
+		if !mod.isExternalType(t) {
+			tokenName := tokenToName(t.Token)
+			// Links to anchor tags on the same page must be lower-cased.
+			href = "#" + strings.ToLower(tokenName)
+		}
+	case *schema.EnumType:
+		if !mod.isExternalType(t) {
+			href = "#" + strings.ToLower(tokenToName(t.Token))
+		}
+	case *schema.ResourceType:
+		if t.Resource != nil {
+			href = mod.resourceLink(t.Resource)
+		}
 	default:
 		// Check if type is primitive/built-in type if no match for cases listed above.
 		if schema.IsPrimitiveType(t) {
@@ -450,7 +503,7 @@ func (mod *modContext) typeString(t schema.Type, lang string, characteristics pr
 
 	// Strip the namespace/module prefix for the type's display name.
 	displayName := langTypeString
-	if !schema.IsPrimitiveType(t) {
+	if !schema.IsPrimitiveType(t) && !mod.isExternalType(t) {
 		displayName = mod.cleanTypeString(t, langTypeString, lang, modName, characteristics.input)
 	}
 
@@ -702,6 +755,11 @@ func (mod *modContext) genNestedTypes(member interface{}, resourceType bool) []d
 	var objs []docNestedType
 	for token, tyUsage := range tokens {
 		for _, t := range mod.pkg.Types {
+			if enum, ok := t.(*schema.EnumType); ok && enum.Token == token {
+				objs = append(objs, mod.genEnumType(enum))
+				continue
+			}
+
 			obj, ok := t.(*schema.ObjectType)
 			if !ok || obj.Token != token {
 				continue
@@ -786,6 +844,39 @@ func (mod *modContext) genNestedTypes(member interface{}, resourceType bool) []d
 	return objs
 }
 
+// genEnumType returns the docs for an enum type, which list the language-specific name and the value of each of the
+// type's members.
+func (mod *modContext) genEnumType(enumType *schema.EnumType) docNestedType {
+	name := strings.Title(tokenToName(enumType.Token))
+
+	values := make(map[string][]enum)
+	for _, lang := range supportedLanguages {
+		docLangHelper := getLanguageDocHelper(lang)
+		typeName := tokenToName(enumType.Token)
+
+		members := make([]enum, 0, len(enumType.Elements))
+		for _, e := range enumType.Elements {
+			memberName, err := docLangHelper.GetEnumName(e, typeName)
+			if err != nil {
+				panic(err)
+			}
+			members = append(members, enum{
+				Name:               memberName,
+				Value:              fmt.Sprintf("%v", e.Value),
+				Comment:            e.Comment,
+				DeprecationMessage: e.DeprecationMessage,
+			})
+		}
+		values[lang] = members
+	}
+
+	return docNestedType{
+		Name:       wbr(name),
+		AnchorID:   strings.ToLower(name),
+		EnumValues: values,
+	}
+}
+
 // getProperties returns a slice of properties that can be rendered for docs for
 // the provided slice of properties in the schema.
 func (mod *modContext) getProperties(properties []*schema.Property, lang string, input, nested bool) []property {
@@ -1235,13 +1326,15 @@ func (mod *modContext) genResource(r *schema.Resource) resourceDocArgs {
 		filteredOutputProps = filterOutputProperties(r.InputProperties, r.Properties)
 	}
 
-	// All resources have an implicit `id` output property, that we must inject into the docs.
-	filteredOutputProps = append(filteredOutputProps, &schema.Property{
-		Name:       "id",
-		Comment:    "The provider-assigned unique ID for this managed resource.",
-		Type:       schema.StringType,
-		IsRequired: true,
-	})
+	// All custom resources have an implicit `id` output property, that we must inject into the docs.
+	if !r.IsComponent {
+		filteredOutputProps = append(filteredOutputProps, &schema.Property{
+			Name:       "id",
+			Comment:    "The provider-assigned unique ID for this managed resource.",
+			Type:       schema.StringType,
+			IsRequired: true,
+		})
+	}
 
 	for _, lang := range supportedLanguages {
 		inputProps[lang] = mod.getProperties(r.InputProperties, lang, true, false)
@@ -1294,12 +1387,68 @@ func (mod *modContext) genResource(r *schema.Resource) resourceDocArgs {
 		StateParam:       stateParam,
 		NestedTypes:      mod.genNestedTypes(r, true /*resourceType*/),
 
+		IsComponent: r.IsComponent,
+
 		PackageDetails: packageDetails,
 	}
+	if r.IsComponent {
+		data.ChildResources = mod.genChildResources(r)
+	}
 
 	return data
 }
 
+// genChildResources returns the resources that the given component resource exposes as output properties.
+func (mod *modContext) genChildResources(r *schema.Resource) []indexEntry {
+	var children []indexEntry
+	seen := map[string]bool{}
+	var visit func(t schema.Type)
+	visit = func(t schema.Type) {
+		switch t := t.(type) {
+		case *schema.ArrayType:
+			visit(t.ElementType)
+		case *schema.MapType:
+			visit(t.ElementType)
+		case *schema.UnionType:
+			for _, e := range t.ElementTypes {
+				visit(e)
+			}
+		case *schema.ResourceType:
+			if t.Resource == nil || seen[t.Token] {
+				return
+			}
+			seen[t.Token] = true
+
+			// Child resources that are defined by other packages are qualified with the names of their packages.
+			res := t.Resource
+			displayName := resourceName(res)
+			if res.Package != mod.pkg {
+				displayName = res.Package.Name + "." + displayName
+			}
+			children = append(children, indexEntry{Link: mod.resourceLink(res), DisplayName: displayName})
+		}
+	}
+	for _, p := range r.Properties {
+		visit(p.Type)
+	}
+	sortIndexEntries(children)
+	return children
+}
+
+// resourceLink returns the link to the docs for the given resource. Links within a static site are relative to the
+// directory of the current module. Resources that are defined by other packages are not part of a static site, so
+// there are no links to them.
+func (mod *modContext) resourceLink(res *schema.Resource) string {
+	if mod.sitePages == nil {
+		return path.Join("/docs/reference/pkg", res.Package.Name, res.Package.TokenToModule(res.Token),
+			strings.ToLower(resourceName(res))) + "/"
+	}
+	if page, ok := mod.sitePages[res.Token]; ok && res.Package == mod.pkg {
+		return mod.siteRoot() + page
+	}
+	return ""
+}
+
 func (mod *modContext) getNestedTypes(t schema.Type, types nestedTypeUsageInfo, input bool) {
 	switch t := t.(type) {
 	case *schema.ArrayType:
@@ -1335,6 +1484,9 @@ func (mod *modContext) getNestedTypes(t schema.Type, types nestedTypeUsageInfo,
 			glog.V(4).Infof("visiting object property %s\n", p.Type.String())
 			mod.getNestedTypes(p.Type, types, input)
 		}
+	case *schema.EnumType:
+		glog.V(4).Infof("visiting enum %s\n", t.Token)
+		types.add(t.Token, input)
 	case *schema.UnionType:
 		glog.V(4).Infof("visiting union type %s\n", t.String())
 		for _, e := range t.ElementTypes {
@@ -1702,15 +1854,19 @@ func generateModulesFromSchemaPackage(tool string, pkg *schema.Package) map[stri
 	glog.V(3).Infoln("done scanning resources")
 
 	for _, f := range pkg.Functions {
+		// Resource methods are served only through Call, and are not documented as functions.
+		if f.IsMethod {
+			continue
+		}
+
 		mod := getMod(pkg, f.Token, modules, tool)
 		mod.functions = append(mod.functions, f)
 	}
 	return modules
 }
 
-// GeneratePackage generates the docs package with docs for each resource given the Pulumi
-// schema.
-func GeneratePackage(tool string, pkg *schema.Package) (map[string][]byte, error) {
+// initTemplates parses the packaged templates.
+func initTemplates() {
 	templates = template.New("").Funcs(template.FuncMap{
 		"htmlSafe": func(html string) template.HTML {
 			// Markdown fragments in the templates need to be rendered as-is,
@@ -1723,11 +1879,22 @@ func GeneratePackage(tool string, pkg *schema.Package) (map[string][]byte, error
 			_, ok := m[lang]
 			return ok
 		},
+		"markdown": renderMarkdown,
 	})
 
 	for name, b := range packagedTemplates {
+		// The static assets of the standalone docs site are packaged alongside the templates.
+		if path.Ext(name) != ".tmpl" {
+			continue
+		}
 		template.Must(templates.New(name).Parse(string(b)))
 	}
+}
+
+// GeneratePackage generates the docs package with docs for each resource given the Pulumi
+// schema.
+func GeneratePackage(tool string, pkg *schema.Package) (map[string][]byte, error) {
+	initTemplates()
 
 	defer glog.Flush()
 
@@ -65,56 +65,64 @@ func initTestPackageSpec(t *testing.T) {
 		Meta: &schema.MetadataSpec{
 			ModuleFormat: "(.*)(?:/[^/]*)",
 		},
-		Types: map[string]schema.ObjectTypeSpec{
+		Types: map[string]schema.ComplexTypeSpec{
 			// Package-level types.
 			"prov:/getPackageResourceOptions:getPackageResourceOptions": {
-				Description: "Options object for the package-level function getPackageResource.",
-				Type:        "object",
-				Properties:  simpleProperties,
+				ObjectTypeSpec: schema.ObjectTypeSpec{
+					Description: "Options object for the package-level function getPackageResource.",
+					Type:        "object",
+					Properties:  simpleProperties,
+				},
 			},
 
 			// Module-level types.
 			"prov:module/getModuleResourceOptions:getModuleResourceOptions": {
-				Description: "Options object for the module-level function getModuleResource.",
-				Type:        "object",
-				Properties:  simpleProperties,
+				ObjectTypeSpec: schema.ObjectTypeSpec{
+					Description: "Options object for the module-level function getModuleResource.",
+					Type:        "object",
+					Properties:  simpleProperties,
+				},
 			},
 			"prov:module/ResourceOptions:ResourceOptions": {
-				Description: "The resource options object.",
-				Type:        "object",
-				Properties: map[string]schema.PropertySpec{
-					"stringProp": {
-						Description: "A string prop.",
-						Language:    pythonMapCase,
-						TypeSpec: schema.TypeSpec{
-							Type: "string",
+				ObjectTypeSpec: schema.ObjectTypeSpec{
+					Description: "The resource options object.",
+					Type:        "object",
+					Properties: map[string]schema.PropertySpec{
+						"stringProp": {
+							Description: "A string prop.",
+							Language:    pythonMapCase,
+							TypeSpec: schema.TypeSpec{
+								Type: "string",
+							},
 						},
-					},
-					"boolProp": {
-						Description: "A bool prop.",
-						Language:    pythonMapCase,
-						TypeSpec: schema.TypeSpec{
-							Type: "boolean",
+						"boolProp": {
+							Description: "A bool prop.",
+							Language:    pythonMapCase,
+							TypeSpec: schema.TypeSpec{
+								Type: "boolean",
+							},
 						},
-					},
-					"recursiveType": {
-						Description: "I am a recursive type.",
-						Language:    pythonMapCase,
-						TypeSpec: schema.TypeSpec{
-							Ref: "#/types/prov:module/ResourceOptions:ResourceOptions",
+						"recursiveType": {
+							Description: "I am a recursive type.",
+							Language:    pythonMapCase,
+							TypeSpec: schema.TypeSpec{
+								Ref: "#/types/prov:module/ResourceOptions:ResourceOptions",
+							},
 						},
 					},
 				},
 			},
 			"prov:module/ResourceOptions2:ResourceOptions2": {
-				Description: "The resource options object.",
-				Type:        "object",
-				Properties: map[string]schema.PropertySpec{
-					"uniqueProp": {
-						Description: "This is a property unique to this type.",
-						Language:    pythonMapCase,
-						TypeSpec: schema.TypeSpec{
-							Type: "number",
+				ObjectTypeSpec: schema.ObjectTypeSpec{
+					Description: "The resource options object.",
+					Type:        "object",
+					Properties: map[string]schema.PropertySpec{
+						"uniqueProp": {
+							Description: "This is a property unique to this type.",
+							Language:    pythonMapCase,
+							TypeSpec: schema.TypeSpec{
+								Type: "number",
+							},
 						},
 					},
 				},
@@ -357,3 +365,179 @@ func TestResourceDocHeader(t *testing.T) {
 		})
 	}
 }
+
+func TestComponentResourceDocs(t *testing.T) {
+	spec := schema.PackageSpec{
+		Name:    providerPackage,
+		Version: "0.0.1",
+		Meta: &schema.MetadataSpec{
+			ModuleFormat: "(.*)(?:/[^/]*)",
+		},
+		Resources: map[string]schema.ResourceSpec{
+			"prov:module/Bucket:Bucket": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{
+					Description: "A bucket.",
+					Properties:  simpleProperties,
+				},
+			},
+			"prov:module/Site:Site": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{
+					Description: "A static website.",
+					Properties: map[string]schema.PropertySpec{
+						"bucket": {
+							Description: "The bucket that holds the site's content.",
+							TypeSpec:    schema.TypeSpec{Ref: "#/resources/prov:module/Bucket:Bucket"},
+						},
+						"logBuckets": {
+							Description: "The buckets that hold the site's logs.",
+							TypeSpec: schema.TypeSpec{
+								Type:  "array",
+								Items: &schema.TypeSpec{Ref: "#/resources/prov:module/Bucket:Bucket"},
+							},
+						},
+					},
+				},
+				IsComponent: true,
+			},
+		},
+	}
+
+	schemaPkg, err := schema.ImportSpec(spec, nil)
+	if !assert.NoError(t, err, "importing spec") {
+		return
+	}
+
+	files, err := GeneratePackage(unitTestTool, schemaPkg)
+	if !assert.NoError(t, err) {
+		return
+	}
+	if assert.Contains(t, files, "module/site.md") {
+		doc := string(files["module/site.md"])
+		assert.Contains(t, doc, "## Child Resources {#child-resources}")
+		assert.Contains(t, doc, `<a href="/docs/reference/pkg/prov/module/bucket/" title="Bucket">`)
+	}
+	if assert.Contains(t, files, "module/bucket.md") {
+		assert.NotContains(t, string(files["module/bucket.md"]), "Child Resources")
+	}
+
+	mod := generateModulesFromSchemaPackage(unitTestTool, schemaPkg)["module"]
+	r := getResourceFromModule("Site", mod)
+	if !assert.NotNil(t, r) {
+		return
+	}
+
+	data := mod.genResource(r)
+	assert.True(t, data.IsComponent)
+	assert.Equal(t, []indexEntry{{Link: "/docs/reference/pkg/prov/module/bucket/", DisplayName: "Bucket"}},
+		data.ChildResources)
+
+	// Component resources do not have provider-assigned IDs.
+	for _, p := range data.OutputProperties["nodejs"] {
+		assert.NotEqual(t, "id", p.Name)
+	}
+}
+
+func TestGenerateSite(t *testing.T) {
+	initTestPackageSpec(t)
+
+	version := "0.0.1"
+	spec := testPackageSpec
+	spec.Version = version
+	spec.Resources = map[string]schema.ResourceSpec{}
+	for token, r := range testPackageSpec.Resources {
+		spec.Resources[token] = r
+	}
+	spec.Resources["prov:module/resource:Resource"] = schema.ResourceSpec{
+		ObjectTypeSpec: schema.ObjectTypeSpec{
+			Description: "This is a module-level resource called Resource.\n\n" +
+				"{{% examples %}}\n{{% example %}}\n### Basic Example\n\n" +
+				"```typescript\nconst resource = new prov.module.Resource(\"resource\");\n```\n" +
+				"```python\nresource = prov.module.Resource(\"resource\")\n```\n" +
+				"{{% /example %}}\n{{% /examples %}}",
+		},
+		InputProperties: testPackageSpec.Resources["prov:module/resource:Resource"].InputProperties,
+	}
+	spec.Resources["prov:/packageLevelResource:PackageLevelResource"] = schema.ResourceSpec{
+		ObjectTypeSpec: schema.ObjectTypeSpec{
+			Description: "This is a package-level resource.",
+		},
+		InputProperties: map[string]schema.PropertySpec{
+			"resource": {
+				Description: "A `Resource` that this resource depends on.",
+				TypeSpec:    schema.TypeSpec{Ref: "#/resources/prov:module/resource:Resource"},
+			},
+		},
+	}
+
+	schemaPkg, err := schema.ImportSpec(spec, nil)
+	if !assert.NoError(t, err, "importing spec") {
+		return
+	}
+
+	files, err := GenerateSite(unitTestTool, schemaPkg)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	for _, name := range []string{
+		"index.html", "site.css", "site.js", "search-index.js",
+		"packagelevelresource.html", "getpackageresource.html",
+		"module/index.html", "module/resource.html", "module/getmoduleresource.html",
+	} {
+		assert.Contains(t, files, name)
+	}
+	for name := range files {
+		assert.False(t, strings.HasSuffix(name, ".md"), "unexpected markdown file %s", name)
+	}
+
+	// Pages link to the site's assets and to one another relative to their own locations.
+	resource := string(files["module/resource.html"])
+	assert.Contains(t, resource, `<link rel="stylesheet" href="../site.css">`)
+	assert.Contains(t, resource, `<a href="../index.html">`)
+	assert.Contains(t, resource, `<a href="../module/resource.html">`)
+	pkgResource := string(files["packagelevelresource.html"])
+	assert.Contains(t, pkgResource, `<link rel="stylesheet" href="site.css">`)
+	assert.Contains(t, pkgResource, `<a href="module/resource.html">`)
+
+	// Descriptions are rendered as HTML, and examples are split out per language.
+	assert.Contains(t, pkgResource, "<p>A <code>Resource</code> that this resource depends on.</p>")
+	assert.Contains(t, resource, "<p>This is a module-level resource called Resource.</p>")
+	assert.NotContains(t, resource, "{{% examples %}}")
+	assert.Contains(t, resource, `<div class="choosable" data-lang="nodejs">`)
+	assert.Contains(t, resource, "const resource = new prov.module.Resource(&#34;resource&#34;);")
+	assert.Contains(t, resource, "resource = prov.module.Resource(&#34;resource&#34;)")
+
+	// There are no Hugo shortcodes or links to the package's API docs on the Pulumi website.
+	for name, contents := range files {
+		assert.NotContains(t, string(contents), "{{%", "unexpected shortcode in %s", name)
+		assert.NotContains(t, string(contents), `href="/docs/`, "unexpected website link in %s", name)
+	}
+	assert.Contains(t, resource,
+		`<a href="https://www.pulumi.com/docs/reference/pkg/nodejs/pulumi/pulumi/#CustomResourceOptions">`)
+
+	// Nested types are linked to their anchors on the same page.
+	assert.Contains(t, resource, `<a href="#resourceoptions">`)
+	assert.Contains(t, resource, `<h3 id="resourceoptions">`)
+
+	// The search index lists the package's modules, resources and functions.
+	index := strings.TrimSuffix(strings.TrimPrefix(string(files["search-index.js"]), "var searchIndex = "), ";\n")
+	var entries []siteSearchEntry
+	if assert.NoError(t, json.Unmarshal([]byte(index), &entries)) {
+		assert.Contains(t, entries, siteSearchEntry{
+			Name:        "Resource",
+			Kind:        "resource",
+			Module:      "module",
+			URL:         "module/resource.html",
+			Description: "This is a module-level resource called Resource.",
+		})
+		assert.Contains(t, entries, siteSearchEntry{
+			Name:        "GetModuleResource",
+			Kind:        "function",
+			Module:      "module",
+			URL:         "module/getmoduleresource.html",
+			Description: "A module-level function.",
+		})
+		assert.Contains(t, entries, siteSearchEntry{Name: "module", Kind: "module", Module: "module",
+			URL: "module/index.html"})
+	}
+}
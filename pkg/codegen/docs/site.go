@@ -0,0 +1,383 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package docs
+
+import (
+	"bytes"
+	"encoding/json"
+	"html/template"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/russross/blackfriday/v2"
+
+	"github.com/pulumi/pulumi/pkg/v2/codegen"
+	"github.com/pulumi/pulumi/pkg/v2/codegen/schema"
+)
+
+var (
+	// siteLanguages are the languages that may be chosen on the pages of a standalone docs site, in order.
+	siteLanguages = []siteLanguage{
+		{Name: "nodejs", DisplayName: "TypeScript", ExampleLanguage: "typescript"},
+		{Name: "python", DisplayName: "Python", ExampleLanguage: "python"},
+		{Name: "go", DisplayName: "Go", ExampleLanguage: "go"},
+		{Name: "csharp", DisplayName: "C#", ExampleLanguage: "csharp"},
+	}
+
+	// siteAssets are the names of the packaged static files that are copied into the root of a standalone docs site.
+	siteAssets = []string{"site.css", "site.js"}
+
+	// anchorRegexp matches the links that are rendered by the templates.
+	anchorRegexp = regexp.MustCompile(`<a href="([^"]*)">(.*?)</a>`)
+	// sdkDocsRegexp matches the paths of the docs for the Pulumi SDKs on the Pulumi website.
+	sdkDocsRegexp = regexp.MustCompile(`^/docs/reference/pkg/(nodejs/pulumi/pulumi|python/pulumi|dotnet/Pulumi)/`)
+)
+
+// siteLanguage represents a language that may be chosen on the pages of a standalone docs site.
+type siteLanguage struct {
+	// Name is the name of the language in the maps of per-language doc args.
+	Name        string
+	DisplayName string
+	// ExampleLanguage is the language of the code fences of the examples in descriptions.
+	ExampleLanguage string
+}
+
+// siteModule represents a module in the navigation menu of a standalone docs site.
+type siteModule struct {
+	// Dir is the directory of the module's pages relative to the root of the site.
+	Dir         string
+	DisplayName string
+	// Link is the path of the module's index page relative to the root of the site.
+	Link string
+
+	Resources []indexEntry
+	Functions []indexEntry
+}
+
+// sitePage represents the parts of a page of a standalone docs site that are common to every page.
+type sitePage struct {
+	Tool        string
+	Title       string
+	PackageName string
+
+	// Root is the path of the root of the site relative to the page, e.g. "../".
+	Root string
+	// Module is the directory of the module to which the page belongs, relative to the root of the site.
+	Module string
+
+	Languages []siteLanguage
+	Modules   []siteModule
+}
+
+// siteResourceArgs represents the args that the resource page template of a standalone docs site needs.
+type siteResourceArgs struct {
+	resourceDocArgs
+
+	Page sitePage
+	// Description is the resource's comment without its examples.
+	Description string
+	// Examples is a map per language of the examples in the resource's comment.
+	Examples map[string][]codegen.Example
+}
+
+// siteFunctionArgs represents the args that the function page template of a standalone docs site needs.
+type siteFunctionArgs struct {
+	functionDocArgs
+
+	Page sitePage
+	// Description is the function's comment without its examples.
+	Description string
+	// Examples is a map per language of the examples in the function's comment.
+	Examples map[string][]codegen.Example
+}
+
+// siteIndexArgs represents the args that the module index page template of a standalone docs site needs.
+type siteIndexArgs struct {
+	indexData
+
+	Page sitePage
+}
+
+// siteSearchEntry represents an entry in the search index of a standalone docs site.
+type siteSearchEntry struct {
+	Name string `json:"name"`
+	// Kind is one of "module", "resource" or "function".
+	Kind   string `json:"kind"`
+	Module string `json:"module"`
+	// URL is the path of the entry's page relative to the root of the site.
+	URL         string `json:"url"`
+	Description string `json:"description,omitempty"`
+}
+
+// renderMarkdown renders Markdown text, such as the comment of a resource or a property, as HTML.
+func renderMarkdown(text string) template.HTML {
+	// Descriptions are authored by the package's maintainers, so any HTML within them is rendered as-is.
+	// nolint gosec
+	return template.HTML(blackfriday.Run([]byte(text)))
+}
+
+// siteSummary returns the first line of a comment, which is used to describe search results.
+func siteSummary(comment string) string {
+	return metaDescriptionRegexp.FindString(codegen.StripExamples(comment))
+}
+
+// siteExamples returns a map per language of the examples in a comment.
+func siteExamples(comment string) map[string][]codegen.Example {
+	examples := map[string][]codegen.Example{}
+	for _, lang := range siteLanguages {
+		if langExamples := codegen.GetExamples(comment, lang.ExampleLanguage); len(langExamples) > 0 {
+			examples[lang.Name] = langExamples
+		}
+	}
+	return examples
+}
+
+// sitePageName returns the file name of the page for a resource or function with the given name.
+func sitePageName(name string) string {
+	name = strings.ToLower(name)
+	// Every module has an index page, so members that are named "index" need a different name.
+	if name == "index" {
+		name += "_"
+	}
+	return name + ".html"
+}
+
+// siteDir returns the directory of the module's pages relative to the root of the site.
+func (mod *modContext) siteDir() string {
+	return strings.ToLower(mod.getModuleFileName())
+}
+
+// siteRoot returns the path of the root of the site relative to the directory of the module's pages.
+func (mod *modContext) siteRoot() string {
+	dir := mod.siteDir()
+	if dir == "" {
+		return ""
+	}
+	return strings.Repeat("../", strings.Count(dir, "/")+1)
+}
+
+// rewriteSiteLinks adjusts the links in a page that are generated for the Pulumi website. Links to the docs for the
+// Pulumi SDKs are made absolute. Links to the language-specific API docs of the package itself are removed, as a
+// package that is documented by a standalone site is not expected to be published elsewhere.
+func (mod *modContext) rewriteSiteLinks(page string) string {
+	goDocsPrefix := "https://pkg.go.dev/github.com/pulumi/pulumi-" + mod.pkg.Name + "/"
+	return anchorRegexp.ReplaceAllStringFunc(page, func(anchor string) string {
+		match := anchorRegexp.FindStringSubmatch(anchor)
+		link, text := match[1], match[2]
+		switch {
+		case sdkDocsRegexp.MatchString(link):
+			return `<a href="https://www.pulumi.com` + link + `">` + text + `</a>`
+		case strings.HasPrefix(link, "/docs/"), strings.HasPrefix(link, goDocsPrefix):
+			return text
+		default:
+			return anchor
+		}
+	})
+}
+
+// sitePage returns the common parts of the module's page with the given title.
+func (mod *modContext) sitePage(title string, modules []siteModule) sitePage {
+	return sitePage{
+		Tool:        mod.tool,
+		Title:       title,
+		PackageName: formatTitleText(mod.pkg.Name),
+		Root:        mod.siteRoot(),
+		Module:      mod.siteDir(),
+		Languages:   siteLanguages,
+		Modules:     modules,
+	}
+}
+
+// genSite generates the pages of a standalone docs site for the module's resources and functions, as well as the
+// module's index page, and returns the module's entries in the site's search index.
+func (mod *modContext) genSite(fs fs, modules []siteModule) ([]siteSearchEntry, error) {
+	dir := mod.siteDir()
+	var entries []siteSearchEntry
+
+	addPage := func(name, tmpl string, data interface{}) error {
+		buffer := &bytes.Buffer{}
+		if err := templates.ExecuteTemplate(buffer, tmpl, data); err != nil {
+			return err
+		}
+		fs.add(path.Join(dir, name), []byte(mod.rewriteSiteLinks(buffer.String())))
+		return nil
+	}
+
+	// Resources
+	for _, r := range mod.resources {
+		data := mod.genResource(r)
+		args := siteResourceArgs{
+			resourceDocArgs: data,
+			Page:            mod.sitePage(data.Header.Title, modules),
+			Description:     codegen.StripExamples(r.Comment),
+			Examples:        siteExamples(r.Comment),
+		}
+		if err := addPage(path.Base(mod.sitePages[r.Token]), "site_resource.tmpl", args); err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, siteSearchEntry{
+			Name:        data.Header.Title,
+			Kind:        "resource",
+			Module:      dir,
+			URL:         mod.sitePages[r.Token],
+			Description: siteSummary(r.Comment),
+		})
+	}
+
+	// Functions
+	for _, f := range mod.functions {
+		data := mod.genFunction(f)
+		args := siteFunctionArgs{
+			functionDocArgs: data,
+			Page:            mod.sitePage(data.Header.Title, modules),
+			Description:     codegen.StripExamples(f.Comment),
+			Examples:        siteExamples(f.Comment),
+		}
+		if err := addPage(path.Base(mod.sitePages[f.Token]), "site_function.tmpl", args); err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, siteSearchEntry{
+			Name:        data.Header.Title,
+			Kind:        "function",
+			Module:      dir,
+			URL:         mod.sitePages[f.Token],
+			Description: siteSummary(f.Comment),
+		})
+	}
+
+	// The links on the index page are relative to the module's directory.
+	idxData := mod.genIndex()
+	for i := range idxData.Modules {
+		idxData.Modules[i].Link += "index.html"
+	}
+	for i := range idxData.Resources {
+		idxData.Resources[i].Link += ".html"
+	}
+	for i := range idxData.Functions {
+		idxData.Functions[i].Link += ".html"
+	}
+	if err := addPage("index.html", "site_index.tmpl", siteIndexArgs{
+		indexData: idxData,
+		Page:      mod.sitePage(idxData.Title, modules),
+	}); err != nil {
+		return nil, err
+	}
+
+	if dir != "" {
+		entries = append(entries, siteSearchEntry{
+			Name:   idxData.Title,
+			Kind:   "module",
+			Module: dir,
+			URL:    path.Join(dir, "index.html"),
+		})
+	}
+	return entries, nil
+}
+
+// genSiteModules returns the navigation menu of a standalone docs site, which lists every module in the order of
+// their directories.
+func genSiteModules(modules []*modContext) []siteModule {
+	var siteModules []siteModule
+	for _, mod := range modules {
+		dir := mod.siteDir()
+		displayName := mod.getModuleFileName()
+		if displayName == "" {
+			displayName = formatTitleText(mod.pkg.Name)
+		}
+
+		resources := make([]indexEntry, 0, len(mod.resources))
+		for _, r := range mod.resources {
+			resources = append(resources, indexEntry{Link: mod.sitePages[r.Token], DisplayName: resourceName(r)})
+		}
+		sortIndexEntries(resources)
+
+		functions := make([]indexEntry, 0, len(mod.functions))
+		for _, f := range mod.functions {
+			functions = append(functions, indexEntry{
+				Link:        mod.sitePages[f.Token],
+				DisplayName: strings.Title(tokenToName(f.Token)),
+			})
+		}
+		sortIndexEntries(functions)
+
+		siteModules = append(siteModules, siteModule{
+			Dir:         dir,
+			DisplayName: displayName,
+			Link:        path.Join(dir, "index.html"),
+			Resources:   resources,
+			Functions:   functions,
+		})
+	}
+	return siteModules
+}
+
+// GenerateSite generates a standalone static HTML site that documents the given package. Unlike the docs generated
+// by GeneratePackage, which are rendered by the Pulumi website, the site is self-contained: it includes a navigation
+// menu of the package's modules, a language chooser, and a client-side search index.
+func GenerateSite(tool string, pkg *schema.Package) (map[string][]byte, error) {
+	initTemplates()
+
+	defer glog.Flush()
+
+	modules := generateModulesFromSchemaPackage(tool, pkg)
+
+	// Decide where the page of each resource and function goes up front, so that pages can link to one another.
+	sortedModules := make([]*modContext, 0, len(modules))
+	sitePages := map[string]string{}
+	for _, mod := range modules {
+		dir := mod.siteDir()
+		for _, r := range mod.resources {
+			sitePages[r.Token] = path.Join(dir, sitePageName(resourceName(r)))
+		}
+		for _, f := range mod.functions {
+			sitePages[f.Token] = path.Join(dir, sitePageName(tokenToName(f.Token)))
+		}
+		mod.sitePages = sitePages
+		sortedModules = append(sortedModules, mod)
+	}
+	sort.Slice(sortedModules, func(i, j int) bool {
+		return sortedModules[i].siteDir() < sortedModules[j].siteDir()
+	})
+
+	glog.V(3).Infoln("generating site now...")
+	siteModules := genSiteModules(sortedModules)
+	files := fs{}
+	var searchIndex []siteSearchEntry
+	for _, mod := range sortedModules {
+		entries, err := mod.genSite(files, siteModules)
+		if err != nil {
+			return nil, err
+		}
+		searchIndex = append(searchIndex, entries...)
+	}
+
+	// The search index is loaded by a script rather than fetched so that the site can be browsed from the file system.
+	index, err := json.Marshal(searchIndex)
+	if err != nil {
+		return nil, err
+	}
+	files.add("search-index.js", []byte("var searchIndex = "+string(index)+";\n"))
+
+	for _, name := range siteAssets {
+		files.add(name, packagedTemplates[name])
+	}
+
+	return files, nil
+}
@@ -0,0 +1,134 @@
+// Scripts for the standalone docs site generated by the Pulumi docs generator.
+(function () {
+    "use strict";
+
+    var languageKey = "pulumi-docs-language";
+    var maxResults = 20;
+
+    // The path of the root of the site relative to the current page.
+    var root = document.body.getAttribute("data-root") || "";
+
+    // selectLanguage shows the content for the given language and hides the content for the others.
+    function selectLanguage(lang) {
+        var tabs = document.querySelectorAll(".language-tabs button");
+        for (var i = 0; i < tabs.length; i++) {
+            tabs[i].classList.toggle("active", tabs[i].getAttribute("data-lang") === lang);
+        }
+        var choosables = document.querySelectorAll(".choosable");
+        for (var j = 0; j < choosables.length; j++) {
+            choosables[j].hidden = choosables[j].getAttribute("data-lang") !== lang;
+        }
+        try {
+            window.localStorage.setItem(languageKey, lang);
+        } catch (e) {
+            // Local storage may be unavailable for pages that are opened from the file system.
+        }
+    }
+
+    function initLanguageTabs() {
+        var tabs = document.querySelectorAll(".language-tabs button");
+        if (tabs.length === 0) {
+            return;
+        }
+        for (var i = 0; i < tabs.length; i++) {
+            tabs[i].addEventListener("click", function (event) {
+                selectLanguage(event.currentTarget.getAttribute("data-lang"));
+            });
+        }
+
+        var lang = tabs[0].getAttribute("data-lang");
+        try {
+            lang = window.localStorage.getItem(languageKey) || lang;
+        } catch (e) {
+            // Fall back to the first language.
+        }
+        selectLanguage(lang);
+    }
+
+    // search returns the entries of the search index that match the query, best matches first.
+    function search(query) {
+        var terms = query.toLowerCase().split(/\s+/).filter(function (term) { return term !== ""; });
+        if (terms.length === 0) {
+            return [];
+        }
+
+        var results = [];
+        (window.searchIndex || []).forEach(function (entry) {
+            var name = entry.name.toLowerCase();
+            var text = (entry.module + " " + name + " " + (entry.description || "")).toLowerCase();
+            var score = 0;
+            for (var i = 0; i < terms.length; i++) {
+                if (text.indexOf(terms[i]) < 0) {
+                    return;
+                }
+                if (name === terms[i]) {
+                    score += 3;
+                } else if (name.indexOf(terms[i]) === 0) {
+                    score += 2;
+                } else if (name.indexOf(terms[i]) >= 0) {
+                    score += 1;
+                }
+            }
+            results.push({ entry: entry, score: score });
+        });
+        results.sort(function (a, b) {
+            return b.score - a.score || a.entry.name.localeCompare(b.entry.name);
+        });
+        return results.slice(0, maxResults).map(function (result) { return result.entry; });
+    }
+
+    function initSearch() {
+        var input = document.getElementById("search");
+        var list = document.getElementById("search-results");
+        if (!input || !list) {
+            return;
+        }
+
+        input.addEventListener("input", function () {
+            var results = search(input.value);
+            list.innerHTML = "";
+            results.forEach(function (entry) {
+                var link = document.createElement("a");
+                link.href = root + entry.url;
+
+                var kind = document.createElement("span");
+                kind.className = "search-kind";
+                kind.textContent = entry.kind + (entry.module ? " in " + entry.module : "");
+                link.appendChild(kind);
+                link.appendChild(document.createTextNode(entry.name));
+                if (entry.description) {
+                    var description = document.createElement("span");
+                    description.className = "search-description";
+                    description.textContent = entry.description;
+                    link.appendChild(description);
+                }
+
+                var item = document.createElement("li");
+                item.appendChild(link);
+                list.appendChild(item);
+            });
+            list.hidden = results.length === 0;
+        });
+
+        input.addEventListener("keydown", function (event) {
+            if (event.key === "Enter") {
+                var first = list.querySelector("a");
+                if (first) {
+                    window.location.href = first.href;
+                }
+            } else if (event.key === "Escape") {
+                input.value = "";
+                list.hidden = true;
+            }
+        });
+
+        document.addEventListener("click", function (event) {
+            if (!input.parentNode.contains(event.target)) {
+                list.hidden = true;
+            }
+        });
+    }
+
+    initLanguageTabs();
+    initSearch();
+})();
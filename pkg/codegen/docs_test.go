@@ -134,3 +134,40 @@ func fakeFunc() {
 			"unexpected Example 2 section. section should have been excluded")
 	})
 }
+
+func TestGetExamples(t *testing.T) {
+	leadingDescription := "This is a leading description for this resource."
+	description := leadingDescription + `
+
+{{% examples %}}
+{{% example %}}
+### Example 1
+
+` + codeFence + `typescript
+const bucket = new Bucket("bucket");
+` + codeFence + `
+` + codeFence + `go
+bucket, err := NewBucket(ctx, "bucket", nil)
+` + codeFence + `
+{{% /example %}}
+{{% example %}}
+### Example 2
+
+` + codeFence + `typescript
+const object = new BucketObject("object");
+` + codeFence + `
+{{% /example %}}
+{{% /examples %}}`
+
+	assert.Equal(t, []Example{
+		{Title: "Example 1", Snippet: `const bucket = new Bucket("bucket");`},
+		{Title: "Example 2", Snippet: `const object = new BucketObject("object");`},
+	}, GetExamples(description, "typescript"))
+	assert.Equal(t, []Example{
+		{Title: "Example 1", Snippet: `bucket, err := NewBucket(ctx, "bucket", nil)`},
+	}, GetExamples(description, "go"))
+	assert.Empty(t, GetExamples(description, "python"))
+	assert.Empty(t, GetExamples(leadingDescription, "typescript"))
+
+	assert.Equal(t, leadingDescription, StripExamples(description))
+}
@@ -84,6 +84,11 @@ func (d DocLanguageHelper) GetLanguageTypeString(pkg *schema.Package, moduleName
 	return mod.typeString(t, qualifier, input, false /*state*/, false /*wrapInput*/, true /*requireInitializers*/, optional)
 }
 
+// GetEnumName returns the name of the static property that holds the given enum member.
+func (d DocLanguageHelper) GetEnumName(e *schema.Enum, typeName string) (string, error) {
+	return enumMemberName(e), nil
+}
+
 func (d DocLanguageHelper) GetFunctionName(modName string, f *schema.Function) string {
 	return tokenToFunctionName(f.Token)
 }
@@ -28,15 +28,17 @@ var testPackageSpec = schema.PackageSpec{
 	Meta: &schema.MetadataSpec{
 		ModuleFormat: "(.*)(?:/[^/]*)",
 	},
-	Types: map[string]schema.ObjectTypeSpec{
+	Types: map[string]schema.ComplexTypeSpec{
 		"aws:s3/BucketCorsRule:BucketCorsRule": {
-			Description: "The resource options object.",
-			Type:        "object",
-			Properties: map[string]schema.PropertySpec{
-				"stringProp": {
-					Description: "A string prop.",
-					TypeSpec: schema.TypeSpec{
-						Type: "string",
+			ObjectTypeSpec: schema.ObjectTypeSpec{
+				Description: "The resource options object.",
+				Type:        "object",
+				Properties: map[string]schema.PropertySpec{
+					"stringProp": {
+						Description: "A string prop.",
+						TypeSpec: schema.TypeSpec{
+							Type: "string",
+						},
 					},
 				},
 			},
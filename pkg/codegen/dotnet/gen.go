@@ -105,6 +105,9 @@ func isImmutableArrayType(t schema.Type, wrapInput bool) bool {
 }
 
 func isValueType(t schema.Type) bool {
+	if _, ok := t.(*schema.EnumType); ok {
+		return true
+	}
 	switch t {
 	case schema.BoolType, schema.IntType, schema.NumberType:
 		return true
@@ -125,6 +128,7 @@ type modContext struct {
 	mod           string
 	propertyNames map[*schema.Property]string
 	types         []*schema.ObjectType
+	enums         []*schema.EnumType
 	resources     []*schema.Resource
 	functions     []*schema.Function
 	typeDetails   map[*schema.ObjectType]*typeDetails
@@ -182,6 +186,48 @@ func (mod *modContext) tokenToNamespace(tok string) string {
 	return pkg + "." + namespaceName(mod.namespaces, nsName)
 }
 
+// isExternalType returns true if the given type is an object or enum type defined by a package other than the given
+// package.
+func isExternalType(t schema.Type, pkg *schema.Package) bool {
+	switch t := t.(type) {
+	case *schema.ObjectType:
+		return t.Package != nil && t.Package != pkg
+	case *schema.EnumType:
+		return t.Package != nil && t.Package != pkg
+	default:
+		return false
+	}
+}
+
+// typeNamespace returns the namespace of the member of the given package with the given token. Members of other
+// packages are placed in namespaces according to those packages' .NET-specific information.
+func (mod *modContext) typeNamespace(pkg *schema.Package, tok string) string {
+	if pkg == nil || pkg == mod.pkg {
+		return mod.tokenToNamespace(tok)
+	}
+	info, _ := pkg.Language["csharp"].(CSharpPackageInfo)
+	ext := &modContext{pkg: pkg, namespaces: info.Namespaces}
+	return ext.tokenToNamespace(tok)
+}
+
+// isFunctionType returns true if the given object type is referred to by the inputs or outputs of a function in the
+// package that defines it.
+func (mod *modContext) isFunctionType(t *schema.ObjectType) bool {
+	if !isExternalType(t, mod.pkg) {
+		return mod.details(t).functionType
+	}
+
+	functionType := false
+	for _, f := range t.Package.Functions {
+		for _, obj := range []*schema.ObjectType{f.Inputs, f.Outputs} {
+			if obj != nil {
+				visitObjectTypes(obj, func(u *schema.ObjectType) { functionType = functionType || u == t })
+			}
+		}
+	}
+	return functionType
+}
+
 func (mod *modContext) typeString(t schema.Type, qualifier string, input, state, wrapInput, requireInitializers, optional bool) string {
 	var typ string
 	switch t := t.(type) {
@@ -212,7 +258,7 @@ func (mod *modContext) typeString(t schema.Type, qualifier string, input, state,
 		wrapInput = false
 		typ = fmt.Sprintf(mapFmt, mod.typeString(t.ElementType, qualifier, input, state, false, false, false))
 	case *schema.ObjectType:
-		typ = mod.tokenToNamespace(t.Token)
+		typ = mod.typeNamespace(t.Package, t.Token)
 		if typ == mod.namespaceName {
 			typ = qualifier
 		} else if qualifier != "" {
@@ -227,9 +273,26 @@ func (mod *modContext) typeString(t schema.Type, qualifier string, input, state,
 			typ += "GetArgs"
 		case input:
 			typ += "Args"
-		case mod.details(t).functionType:
+		case mod.isFunctionType(t):
 			typ += "Result"
 		}
+	case *schema.EnumType:
+		typ = tokenToName(t.Token)
+		if ns := mod.typeNamespace(t.Package, t.Token); ns != mod.namespaceName {
+			typ = ns + "." + typ
+		}
+	case *schema.ResourceType:
+		// Resources are referred to by their IDs. Inputs also accept the resources themselves, which are serialized as
+		// their IDs.
+		typ = "string"
+		if input && t.Resource != nil {
+			unionT := "Union"
+			if wrapInput {
+				unionT, wrapInput = "InputUnion", false
+			}
+			resourceType := mod.typeNamespace(t.Resource.Package, t.Token) + "." + resourceName(t.Resource)
+			typ = fmt.Sprintf("%s<string, %s>", unionT, resourceType)
+		}
 	case *schema.TokenType:
 		// Use the underlying type for now.
 		if t.UnderlyingType != nil {
@@ -506,6 +569,14 @@ func primitiveValue(value interface{}) (string, error) {
 
 func (mod *modContext) getDefaultValue(dv *schema.DefaultValue, t schema.Type) (string, error) {
 	var val string
+	if enum, ok := t.(*schema.EnumType); ok && dv.Value != nil {
+		for _, e := range enum.Elements {
+			if e.Value == dv.Value {
+				return mod.typeString(enum, "", false, false, false, false, false) + "." + enumMemberName(e), nil
+			}
+		}
+		return "", errors.Errorf("default value %v is not a member of enum %s", dv.Value, enum.Token)
+	}
 	if dv.Value != nil {
 		v, err := primitiveValue(dv.Value)
 		if err != nil {
@@ -541,6 +612,81 @@ func (mod *modContext) getDefaultValue(dv *schema.DefaultValue, t schema.Type) (
 	return val, nil
 }
 
+// enumMemberName returns the name of the static property that holds the given enum member.
+func enumMemberName(e *schema.Enum) string {
+	var name string
+	for _, word := range codegen.EnumValueWords(e) {
+		name += Title(word)
+	}
+	if name[0] >= '0' && name[0] <= '9' {
+		name = "_" + name
+	}
+	return name
+}
+
+// genEnum emits an enum type as a struct with a static property for each member. The struct converts explicitly to
+// the enum's underlying type, which is how the SDK serializes its values.
+func (mod *modContext) genEnum(w io.Writer, enum *schema.EnumType) error {
+	name := tokenToName(enum.Token)
+	underlyingType := mod.typeString(enum.ElementType, "", false, false, false, false, false)
+
+	fmt.Fprintf(w, "\n")
+	printComment(w, enum.Comment, "    ")
+	fmt.Fprintf(w, "    [EnumType]\n")
+	fmt.Fprintf(w, "    public readonly struct %[1]s : IEquatable<%[1]s>\n", name)
+	fmt.Fprintf(w, "    {\n")
+	fmt.Fprintf(w, "        private readonly %s _value;\n", underlyingType)
+	fmt.Fprintf(w, "\n")
+	fmt.Fprintf(w, "        private %s(%s value)\n", name, underlyingType)
+	fmt.Fprintf(w, "        {\n")
+	if enum.ElementType == schema.StringType {
+		fmt.Fprintf(w, "            _value = value ?? throw new ArgumentNullException(nameof(value));\n")
+	} else {
+		fmt.Fprintf(w, "            _value = value;\n")
+	}
+	fmt.Fprintf(w, "        }\n")
+	fmt.Fprintf(w, "\n")
+
+	for _, e := range enum.Elements {
+		value, err := primitiveValue(e.Value)
+		if err != nil {
+			return err
+		}
+		printComment(w, e.Comment, "        ")
+		if e.DeprecationMessage != "" {
+			fmt.Fprintf(w, "        [Obsolete(@\"%s\")]\n", strings.Replace(e.DeprecationMessage, `"`, `""`, -1))
+		}
+		fmt.Fprintf(w, "        public static %[1]s %[2]s { get; } = new %[1]s(%[3]s);\n", name, enumMemberName(e), value)
+		fmt.Fprintf(w, "\n")
+	}
+
+	fmt.Fprintf(w, "        public static bool operator ==(%[1]s left, %[1]s right) => left.Equals(right);\n", name)
+	fmt.Fprintf(w, "        public static bool operator !=(%[1]s left, %[1]s right) => !left.Equals(right);\n", name)
+	fmt.Fprintf(w, "\n")
+	fmt.Fprintf(w, "        public static explicit operator %s(%s value) => value._value;\n", underlyingType, name)
+	fmt.Fprintf(w, "\n")
+	fmt.Fprintf(w, "        [EditorBrowsable(EditorBrowsableState.Never)]\n")
+	fmt.Fprintf(w, "        public override bool Equals(object? obj) => obj is %s other && Equals(other);\n", name)
+	if enum.ElementType == schema.StringType {
+		fmt.Fprintf(w, "        public bool Equals(%s other) => string.Equals(_value, other._value, StringComparison.Ordinal);\n", name)
+	} else {
+		fmt.Fprintf(w, "        public bool Equals(%s other) => _value.Equals(other._value);\n", name)
+	}
+	fmt.Fprintf(w, "\n")
+	fmt.Fprintf(w, "        [EditorBrowsable(EditorBrowsableState.Never)]\n")
+	if enum.ElementType == schema.StringType {
+		fmt.Fprintf(w, "        public override int GetHashCode() => _value?.GetHashCode() ?? 0;\n")
+		fmt.Fprintf(w, "\n")
+		fmt.Fprintf(w, "        public override string ToString() => _value;\n")
+	} else {
+		fmt.Fprintf(w, "        public override int GetHashCode() => _value.GetHashCode();\n")
+		fmt.Fprintf(w, "\n")
+		fmt.Fprintf(w, "        public override string ToString() => _value.ToString();\n")
+	}
+	fmt.Fprintf(w, "    }\n")
+	return nil
+}
+
 func genAlias(w io.Writer, alias *schema.Alias) {
 	fmt.Fprintf(w, "new Alias { ")
 
@@ -580,8 +726,11 @@ func (mod *modContext) genResource(w io.Writer, r *schema.Resource) error {
 	// Open the class.
 	className := name
 	baseType := "Pulumi.CustomResource"
-	if r.IsProvider {
+	switch {
+	case r.IsProvider:
 		baseType = "Pulumi.ProviderResource"
+	case r.IsComponent:
+		baseType = "Pulumi.ComponentResource"
 	}
 	if r.DeprecationMessage != "" {
 		fmt.Fprintf(w, "    [Obsolete(@\"%s\")]\n", strings.Replace(r.DeprecationMessage, `"`, `""`, -1))
@@ -630,6 +779,9 @@ func (mod *modContext) genResource(w io.Writer, r *schema.Resource) error {
 	}
 
 	optionsType := "CustomResourceOptions"
+	if r.IsComponent {
+		optionsType = "ComponentResourceOptions"
+	}
 
 	tok := r.Token
 	if r.IsProvider {
@@ -651,12 +803,18 @@ func (mod *modContext) genResource(w io.Writer, r *schema.Resource) error {
 	fmt.Fprintf(w, "        /// <param name=\"options\">A bag of options that control this resource's behavior</param>\n")
 
 	fmt.Fprintf(w, "        public %s(string name, %s args%s, %s? options = null)\n", className, argsType, argsDefault, optionsType)
-	fmt.Fprintf(w, "            : base(\"%s\", name, %s, MakeResourceOptions(options, \"\"))\n", tok, argsOverride)
+	if r.IsComponent {
+		// Component resources are constructed by their provider.
+		fmt.Fprintf(w, "            : base(\"%s\", name, %s, MakeResourceOptions(options, \"\"), remote: true)\n", tok,
+			argsOverride)
+	} else {
+		fmt.Fprintf(w, "            : base(\"%s\", name, %s, MakeResourceOptions(options, \"\"))\n", tok, argsOverride)
+	}
 	fmt.Fprintf(w, "        {\n")
 	fmt.Fprintf(w, "        }\n")
 
-	// Write a private constructor for the use of `Get`.
-	if !r.IsProvider {
+	// Write a private constructor for the use of `Get`. Existing component resources cannot be read.
+	if !r.IsProvider && !r.IsComponent {
 		stateParam, stateRef := "", "null"
 		if r.StateInputs != nil {
 			stateParam, stateRef = fmt.Sprintf("%sState? state = null, ", className), "state"
@@ -717,8 +875,8 @@ func (mod *modContext) genResource(w io.Writer, r *schema.Resource) error {
 	fmt.Fprintf(w, "            return merged;\n")
 	fmt.Fprintf(w, "        }\n")
 
-	// Write the `Get` method for reading instances of this resource unless this is a provider resource.
-	if !r.IsProvider {
+	// Write the `Get` method for reading instances of this resource unless this is a provider or component resource.
+	if !r.IsProvider && !r.IsComponent {
 		fmt.Fprintf(w, "        /// <summary>\n")
 		fmt.Fprintf(w, "        /// Get an existing %s resource's state with the given name, ID, and optional extra\n", className)
 		fmt.Fprintf(w, "        /// properties used to qualify the lookup.\n")
@@ -1156,6 +1314,23 @@ func (mod *modContext) gen(fs fs) error {
 		addFile(tokenToName(f.Token)+".cs", buffer.String())
 	}
 
+	// Enums
+	if len(mod.enums) > 0 {
+		buffer := &bytes.Buffer{}
+		mod.genHeader(buffer, []string{"System", "System.ComponentModel", "Pulumi"})
+
+		fmt.Fprintf(buffer, "namespace %s\n", mod.namespaceName)
+		fmt.Fprintf(buffer, "{\n")
+		for _, enum := range mod.enums {
+			if err := mod.genEnum(buffer, enum); err != nil {
+				return err
+			}
+		}
+		fmt.Fprintf(buffer, "}\n")
+
+		addFile("Enums.cs", buffer.String())
+	}
+
 	// Nested types
 	for _, t := range mod.types {
 		if mod.details(t).inputType {
@@ -1270,6 +1445,11 @@ func GeneratePackage(tool string, pkg *schema.Package, extraFiles map[string][]b
 		return nil, err
 	}
 	info, _ := pkg.Language["csharp"].(CSharpPackageInfo)
+	for _, dep := range pkg.Dependencies {
+		if err := dep.ImportLanguages(map[string]schema.Language{"csharp": Importer}); err != nil {
+			return nil, errors.Wrapf(err, "importing dependency %v", dep.Name)
+		}
+	}
 
 	propertyNames := map[*schema.Property]string{}
 	computePropertyNames(pkg.Config, propertyNames)
@@ -1282,6 +1462,11 @@ func GeneratePackage(tool string, pkg *schema.Package, extraFiles map[string][]b
 		}
 	}
 	for _, f := range pkg.Functions {
+		// Resource methods are served only through Call, and are not yet generated for this language.
+		if f.IsMethod {
+			continue
+		}
+
 		if f.Inputs != nil {
 			computePropertyNames(f.Inputs.Properties, propertyNames)
 		}
@@ -1340,8 +1525,17 @@ func GeneratePackage(tool string, pkg *schema.Package, extraFiles map[string][]b
 		cfg.namespaceName = assemblyName
 	}
 
+	// Types defined by other packages are generated as part of those packages.
+	visitLocalObjectTypes := func(t schema.Type, visitor func(*schema.ObjectType)) {
+		visitObjectTypes(t, func(t *schema.ObjectType) {
+			if !isExternalType(t, pkg) {
+				visitor(t)
+			}
+		})
+	}
+
 	for _, v := range pkg.Config {
-		visitObjectTypes(v.Type, func(t *schema.ObjectType) { getMod(t.Token).details(t).outputType = true })
+		visitLocalObjectTypes(v.Type, func(t *schema.ObjectType) { getMod(t.Token).details(t).outputType = true })
 	}
 
 	// Find input and output types referenced by resources.
@@ -1349,10 +1543,10 @@ func GeneratePackage(tool string, pkg *schema.Package, extraFiles map[string][]b
 		mod := getMod(r.Token)
 		mod.resources = append(mod.resources, r)
 		for _, p := range r.Properties {
-			visitObjectTypes(p.Type, func(t *schema.ObjectType) { getMod(t.Token).details(t).outputType = true })
+			visitLocalObjectTypes(p.Type, func(t *schema.ObjectType) { getMod(t.Token).details(t).outputType = true })
 		}
 		for _, p := range r.InputProperties {
-			visitObjectTypes(p.Type, func(t *schema.ObjectType) {
+			visitLocalObjectTypes(p.Type, func(t *schema.ObjectType) {
 				if r.IsProvider {
 					getMod(t.Token).details(t).outputType = true
 				}
@@ -1360,7 +1554,7 @@ func GeneratePackage(tool string, pkg *schema.Package, extraFiles map[string][]b
 			})
 		}
 		if r.StateInputs != nil {
-			visitObjectTypes(r.StateInputs, func(t *schema.ObjectType) {
+			visitLocalObjectTypes(r.StateInputs, func(t *schema.ObjectType) {
 				getMod(t.Token).details(t).inputType = true
 				getMod(t.Token).details(t).stateType = true
 			})
@@ -1374,16 +1568,21 @@ func GeneratePackage(tool string, pkg *schema.Package, extraFiles map[string][]b
 
 	// Find input and output types referenced by functions.
 	for _, f := range pkg.Functions {
+		// Resource methods are served only through Call, and are not yet generated for this language.
+		if f.IsMethod {
+			continue
+		}
+
 		mod := getMod(f.Token)
 		mod.functions = append(mod.functions, f)
 		if f.Inputs != nil {
-			visitObjectTypes(f.Inputs, func(t *schema.ObjectType) {
+			visitLocalObjectTypes(f.Inputs, func(t *schema.ObjectType) {
 				getMod(t.Token).details(t).inputType = true
 				getMod(t.Token).details(t).functionType = true
 			})
 		}
 		if f.Outputs != nil {
-			visitObjectTypes(f.Outputs, func(t *schema.ObjectType) {
+			visitLocalObjectTypes(f.Outputs, func(t *schema.ObjectType) {
 				getMod(t.Token).details(t).outputType = true
 				getMod(t.Token).details(t).functionType = true
 			})
@@ -1392,9 +1591,13 @@ func GeneratePackage(tool string, pkg *schema.Package, extraFiles map[string][]b
 
 	// Find nested types.
 	for _, t := range pkg.Types {
-		if obj, ok := t.(*schema.ObjectType); ok {
-			mod := getMod(obj.Token)
-			mod.types = append(mod.types, obj)
+		switch t := t.(type) {
+		case *schema.ObjectType:
+			mod := getMod(t.Token)
+			mod.types = append(mod.types, t)
+		case *schema.EnumType:
+			mod := getMod(t.Token)
+			mod.enums = append(mod.enums, t)
 		}
 	}
 
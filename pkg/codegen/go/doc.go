@@ -106,6 +106,11 @@ func (d DocLanguageHelper) GetPropertyName(p *schema.Property) (string, error) {
 	return strings.Title(p.Name), nil
 }
 
+// GetEnumName returns the name of the constant that holds the given enum member.
+func (d DocLanguageHelper) GetEnumName(e *schema.Enum, typeName string) (string, error) {
+	return enumMemberName(typeName, e), nil
+}
+
 func (d DocLanguageHelper) GetFunctionName(modName string, f *schema.Function) string {
 	funcName := tokenToName(f.Token)
 	pkg, ok := d.packages[modName]
@@ -96,8 +96,9 @@ type pkgContext struct {
 	pkg            *schema.Package
 	mod            string
 	importBasePath string
-	typeDetails    map[*schema.ObjectType]*typeDetails
+	typeDetails    map[schema.Type]*typeDetails
 	types          []*schema.ObjectType
+	enums          []*schema.EnumType
 	resources      []*schema.Resource
 	functions      []*schema.Function
 	names          stringSet
@@ -109,9 +110,17 @@ type pkgContext struct {
 	// Name overrides set in GoPackageInfo
 	modToPkg         map[string]string // Module name -> package name
 	pkgImportAliases map[string]string // Package name -> import alias
+
+	// Contexts for the packages that define types and resources referred to by this package, and the aliases with
+	// which they are imported. These are shared by all of the package's contexts.
+	externalPackages      map[*schema.Package]map[string]*pkgContext
+	externalImportAliases map[string]string // Package name -> import alias
+	// The pointer, array and map types that this package requires of the types defined by other packages. These are
+	// shared by all of the package's contexts.
+	externalDetails map[schema.Type]*typeDetails
 }
 
-func (pkg *pkgContext) details(t *schema.ObjectType) *typeDetails {
+func (pkg *pkgContext) details(t schema.Type) *typeDetails {
 	details, ok := pkg.typeDetails[t]
 	if !ok {
 		details = &typeDetails{}
@@ -152,6 +161,128 @@ func (pkg *pkgContext) tokenToType(tok string) string {
 	return strings.Replace(mod, "/", "", -1) + "." + name
 }
 
+// goPackageInfo returns the Go-specific information for the given package. If the package does not specify an import
+// base path, the import base path of the package's Pulumi-published SDK is used.
+func goPackageInfo(pkg *schema.Package) GoPackageInfo {
+	info, _ := pkg.Language["go"].(GoPackageInfo)
+	if info.ImportBasePath == "" {
+		if pkg.Version != nil && pkg.Version.Major > 1 {
+			info.ImportBasePath = fmt.Sprintf("github.com/pulumi/pulumi-%[1]s/sdk/v%[2]d/go/%[1]s", pkg.Name,
+				pkg.Version.Major)
+		} else {
+			info.ImportBasePath = fmt.Sprintf("github.com/pulumi/pulumi-%[1]s/sdk/go/%[1]s", pkg.Name)
+		}
+	}
+	return info
+}
+
+// isExternalType returns true if the given type is an object or enum type defined by a package other than the given
+// package.
+func isExternalType(t schema.Type, pkg *schema.Package) bool {
+	switch t := t.(type) {
+	case *schema.ObjectType:
+		return t.Package != nil && t.Package != pkg
+	case *schema.EnumType:
+		return t.Package != nil && t.Package != pkg
+	default:
+		return false
+	}
+}
+
+// externalPackage returns the context for the Go package that defines the type or resource with the given token in
+// another package.
+func (pkg *pkgContext) externalPackage(extPkg *schema.Package, tok string) *pkgContext {
+	info := goPackageInfo(extPkg)
+
+	packages, ok := pkg.externalPackages[extPkg]
+	if !ok {
+		packages = generatePackageContextMap(pkg.tool, extPkg, info)
+		pkg.externalPackages[extPkg] = packages
+	}
+
+	mod := extPkg.TokenToModule(tok)
+	if override, ok := info.ModuleToPackage[mod]; ok {
+		mod = override
+	}
+	modPkg, ok := packages[mod]
+	contract.Assertf(ok, "missing module %q for token %v", mod, tok)
+	return modPkg
+}
+
+// externalType returns the name of the given type from another package, qualified by the alias of the Go package that
+// defines it, and the import path of that Go package.
+func (pkg *pkgContext) externalType(extPkg *schema.Package, tok string) (string, string) {
+	modPkg := pkg.externalPackage(extPkg, tok)
+
+	// Mirror the type's name in its own package.
+	name := tokenToName(tok)
+	if modPkg.names.has(name) {
+		name += "Type"
+	}
+
+	importPath := path.Join(modPkg.importBasePath, modPkg.mod)
+	alias := strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, extPkg.Name+modPkg.mod)
+	pkg.externalImportAliases[importPath] = alias
+
+	return alias + "." + name, importPath
+}
+
+// namedType returns the name of the object or enum type with the given token that is defined by the given package.
+func (pkg *pkgContext) namedType(typePkg *schema.Package, tok string) string {
+	if typePkg != nil && typePkg != pkg.pkg {
+		name, _ := pkg.externalType(typePkg, tok)
+		return name
+	}
+	return pkg.tokenToType(tok)
+}
+
+// checkExternalTypes returns an error if the package uses a type defined by another package as a pointer, array or map
+// element, but the other package does not generate the corresponding Go type. Such types cannot be generated by the
+// package that uses them, as each element type may only have one registered output type.
+func (pkg *pkgContext) checkExternalTypes() error {
+	type externalRef struct {
+		typ schema.Type
+		pkg *schema.Package
+		tok string
+	}
+	var types []externalRef
+	for t := range pkg.externalDetails {
+		switch t := t.(type) {
+		case *schema.ObjectType:
+			types = append(types, externalRef{t, t.Package, t.Token})
+		case *schema.EnumType:
+			types = append(types, externalRef{t, t.Package, t.Token})
+		}
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i].tok < types[j].tok })
+
+	for _, t := range types {
+		required, generated := pkg.externalDetails[t.typ], pkg.externalPackage(t.pkg, t.tok).details(t.typ)
+
+		var missing []string
+		if required.ptrElement && !generated.ptrElement {
+			missing = append(missing, "Ptr")
+		}
+		if required.arrayElement && !generated.arrayElement {
+			missing = append(missing, "Array")
+		}
+		if required.mapElement && !generated.mapElement {
+			missing = append(missing, "Map")
+		}
+		if len(missing) != 0 {
+			return errors.Errorf("package %v uses %v, but package %v does not generate its %s Go types; "+
+				"set generateExtraInputTypes in the Go language options of package %v", pkg.pkg.Name, t.tok,
+				t.pkg.Name, strings.Join(missing, ", "), t.pkg.Name)
+		}
+	}
+	return nil
+}
+
 func tokenToName(tok string) string {
 	components := strings.Split(tok, ":")
 	contract.Assert(len(components) == 3)
@@ -173,7 +304,12 @@ func (pkg *pkgContext) plainType(t schema.Type, optional bool) string {
 	case *schema.MapType:
 		return "map[string]" + pkg.plainType(t.ElementType, false)
 	case *schema.ObjectType:
-		typ = pkg.tokenToType(t.Token)
+		typ = pkg.namedType(t.Package, t.Token)
+	case *schema.EnumType:
+		typ = pkg.namedType(t.Package, t.Token)
+	case *schema.ResourceType:
+		// Resources are referred to by their IDs.
+		typ = "pulumi.ID"
 	case *schema.TokenType:
 		// Use the underlying type for now.
 		if t.UnderlyingType != nil {
@@ -218,7 +354,12 @@ func (pkg *pkgContext) inputType(t schema.Type, optional bool) string {
 		en := pkg.inputType(t.ElementType, false)
 		return strings.TrimSuffix(en, "Input") + "MapInput"
 	case *schema.ObjectType:
-		typ = pkg.tokenToType(t.Token)
+		typ = pkg.namedType(t.Package, t.Token)
+	case *schema.EnumType:
+		typ = pkg.namedType(t.Package, t.Token)
+	case *schema.ResourceType:
+		// Resources are referred to by their IDs.
+		typ = "pulumi.ID"
 	case *schema.TokenType:
 		// Use the underlying type for now.
 		if t.UnderlyingType != nil {
@@ -269,7 +410,12 @@ func (pkg *pkgContext) outputType(t schema.Type, optional bool) string {
 		}
 		return en + "MapOutput"
 	case *schema.ObjectType:
-		typ = pkg.tokenToType(t.Token)
+		typ = pkg.namedType(t.Package, t.Token)
+	case *schema.EnumType:
+		typ = pkg.namedType(t.Package, t.Token)
+	case *schema.ResourceType:
+		// Resources are referred to by their IDs.
+		typ = "pulumi.ID"
 	case *schema.TokenType:
 		// Use the underlying type for now.
 		if t.UnderlyingType != nil {
@@ -322,7 +468,11 @@ func printComment(w io.Writer, comment string, indent bool) {
 }
 
 func genInputInterface(w io.Writer, name string) {
-	printComment(w, getInputUsage(name), false)
+	genInputInterfaceWithUsage(w, name, getInputUsage(name))
+}
+
+func genInputInterfaceWithUsage(w io.Writer, name, usage string) {
+	printComment(w, usage, false)
 	fmt.Fprintf(w, "type %sInput interface {\n", name)
 	fmt.Fprintf(w, "\tpulumi.Input\n\n")
 	fmt.Fprintf(w, "\tTo%sOutput() %sOutput\n", Title(name), name)
@@ -535,6 +685,11 @@ func (pkg *pkgContext) genOutputTypes(w io.Writer, t *schema.ObjectType, details
 		}
 	}
 
+	genCollectionOutputTypes(w, name, details)
+}
+
+// genCollectionOutputTypes generates the array and map output types for an element type, as required.
+func genCollectionOutputTypes(w io.Writer, name string, details *typeDetails) {
 	if details.arrayElement {
 		fmt.Fprintf(w, "type %sArrayOutput struct { *pulumi.OutputState }\n\n", name)
 
@@ -641,9 +796,12 @@ func (pkg *pkgContext) genResource(w io.Writer, r *schema.Resource) error {
 	printComment(w, codegen.StripNonRelevantExamples(r.Comment, "go"), false)
 	fmt.Fprintf(w, "type %s struct {\n", name)
 
-	if r.IsProvider {
+	switch {
+	case r.IsProvider:
 		fmt.Fprintf(w, "\tpulumi.ProviderResourceState\n\n")
-	} else {
+	case r.IsComponent:
+		fmt.Fprintf(w, "\tpulumi.ResourceState\n\n")
+	default:
 		fmt.Fprintf(w, "\tpulumi.CustomResourceState\n\n")
 	}
 	for _, p := range r.Properties {
@@ -722,17 +880,22 @@ func (pkg *pkgContext) genResource(w io.Writer, r *schema.Resource) error {
 		fmt.Fprintf(w, "\topts = append(opts, aliases)\n")
 	}
 
-	// Finally make the call to registration.
+	// Finally make the call to registration. Component resources are constructed by their provider.
+	register := "RegisterResource"
+	if r.IsComponent {
+		register = "RegisterRemoteComponentResource"
+	}
 	fmt.Fprintf(w, "\tvar resource %s\n", name)
-	fmt.Fprintf(w, "\terr := ctx.RegisterResource(\"%s\", name, args, &resource, opts...)\n", r.Token)
+	fmt.Fprintf(w, "\terr := ctx.%s(\"%s\", name, args, &resource, opts...)\n", register, r.Token)
 	fmt.Fprintf(w, "\tif err != nil {\n")
 	fmt.Fprintf(w, "\t\treturn nil, err\n")
 	fmt.Fprintf(w, "\t}\n")
 	fmt.Fprintf(w, "\treturn &resource, nil\n")
 	fmt.Fprintf(w, "}\n\n")
 
-	// Emit a factory function that reads existing instances of this resource.
-	if !r.IsProvider {
+	// Emit a factory function that reads existing instances of this resource. Existing component resources cannot be
+	// read.
+	if !r.IsProvider && !r.IsComponent {
 		fmt.Fprintf(w, "// Get%[1]s gets an existing %[1]s resource's state with the given name, ID, and optional\n", name)
 		fmt.Fprintf(w, "// state properties that are used to uniquely qualify the lookup (nil if not required).\n")
 		fmt.Fprintf(w, "func Get%s(ctx *pulumi.Context,\n", name)
@@ -786,9 +949,102 @@ func (pkg *pkgContext) genResource(w io.Writer, r *schema.Resource) error {
 	fmt.Fprintf(w, "\treturn reflect.TypeOf((*%sArgs)(nil)).Elem()\n", camel(name))
 	fmt.Fprintf(w, "}\n\n")
 
+	// Emit the resource's methods.
+	for _, m := range r.Methods {
+		pkg.genMethod(w, name, m)
+	}
+
 	return nil
 }
 
+func (pkg *pkgContext) genMethod(w io.Writer, resourceName string, m *schema.Method) {
+	f := m.Function
+	methodName := Title(m.Name)
+	typeName := resourceName + methodName
+
+	printComment(w, codegen.StripNonRelevantExamples(f.Comment, "go"), false)
+
+	// Emit the method signature.
+	argsig := "ctx *pulumi.Context"
+	if f.Inputs != nil {
+		argsig = fmt.Sprintf("%s, args *%sArgs", argsig, typeName)
+	}
+	var retty string
+	if f.Outputs == nil {
+		retty = "error"
+	} else {
+		retty = fmt.Sprintf("(%sResultOutput, error)", typeName)
+	}
+	fmt.Fprintf(w, "func (r *%s) %s(%s) %s {\n", resourceName, methodName, argsig, retty)
+
+	// Now simply call the runtime function with the arguments.
+	var inputsVar string
+	if f.Inputs == nil {
+		inputsVar = "nil"
+	} else {
+		fmt.Fprintf(w, "\tif args == nil {\n")
+		fmt.Fprintf(w, "\t\targs = &%sArgs{}\n", typeName)
+		fmt.Fprintf(w, "\t}\n")
+		inputsVar = "args"
+	}
+	if f.Outputs == nil {
+		fmt.Fprintf(w, "\t_, err := ctx.Call(\"%s\", %s, pulumi.AnyOutput{}, r)\n", f.Token, inputsVar)
+		fmt.Fprintf(w, "\treturn err\n")
+	} else {
+		fmt.Fprintf(w, "\tout, err := ctx.Call(\"%s\", %s, %sResultOutput{}, r)\n", f.Token, inputsVar, typeName)
+		fmt.Fprintf(w, "\tif err != nil {\n")
+		fmt.Fprintf(w, "\t\treturn %sResultOutput{}, err\n", typeName)
+		fmt.Fprintf(w, "\t}\n")
+		fmt.Fprintf(w, "\treturn out.(%sResultOutput), nil\n", typeName)
+	}
+	fmt.Fprintf(w, "}\n\n")
+
+	// If there are argument and/or return types, emit them.
+	if f.Inputs != nil {
+		fmt.Fprintf(w, "type %sArgs struct {\n", camel(typeName))
+		for _, p := range f.Inputs.Properties {
+			printComment(w, p.Comment, true)
+			fmt.Fprintf(w, "\t%s %s `pulumi:\"%s\"`\n", Title(p.Name), pkg.plainType(p.Type, !p.IsRequired), p.Name)
+		}
+		fmt.Fprintf(w, "}\n\n")
+
+		fmt.Fprintf(w, "// The set of arguments for the %s method of the %s resource.\n", methodName, resourceName)
+		fmt.Fprintf(w, "type %sArgs struct {\n", typeName)
+		for _, p := range f.Inputs.Properties {
+			printComment(w, p.Comment, true)
+			fmt.Fprintf(w, "\t%s %s\n", Title(p.Name), pkg.inputType(p.Type, !p.IsRequired))
+		}
+		fmt.Fprintf(w, "}\n\n")
+
+		fmt.Fprintf(w, "func (%sArgs) ElementType() reflect.Type {\n", typeName)
+		fmt.Fprintf(w, "\treturn reflect.TypeOf((*%sArgs)(nil)).Elem()\n", camel(typeName))
+		fmt.Fprintf(w, "}\n\n")
+	}
+	if f.Outputs != nil {
+		pkg.genPlainType(w, typeName+"Result", f.Outputs.Comment, f.Outputs.Properties)
+
+		fmt.Fprintf(w, "type %sResultOutput struct { *pulumi.OutputState }\n\n", typeName)
+
+		fmt.Fprintf(w, "func (%sResultOutput) ElementType() reflect.Type {\n", typeName)
+		fmt.Fprintf(w, "\treturn reflect.TypeOf((*%sResult)(nil)).Elem()\n", typeName)
+		fmt.Fprintf(w, "}\n\n")
+
+		for _, p := range f.Outputs.Properties {
+			printComment(w, p.Comment, false)
+			outputType, applyType := pkg.outputType(p.Type, !p.IsRequired), pkg.plainType(p.Type, !p.IsRequired)
+
+			fmt.Fprintf(w, "func (o %sResultOutput) %s() %s {\n", typeName, Title(p.Name), outputType)
+			fmt.Fprintf(w, "\treturn o.ApplyT(func (v %sResult) %s { return v.%s }).(%s)\n", typeName, applyType,
+				Title(p.Name), outputType)
+			fmt.Fprintf(w, "}\n\n")
+		}
+
+		fmt.Fprintf(w, "func init() {\n")
+		fmt.Fprintf(w, "\tpulumi.RegisterOutputType(%sResultOutput{})\n", typeName)
+		fmt.Fprintf(w, "}\n\n")
+	}
+}
+
 func (pkg *pkgContext) genFunction(w io.Writer, f *schema.Function) {
 	// If the function starts with New or Get, it will conflict; so rename them.
 	name := pkg.functionNames[f]
@@ -859,18 +1115,150 @@ func (pkg *pkgContext) genType(w io.Writer, obj *schema.ObjectType) {
 func (pkg *pkgContext) genTypeRegistrations(w io.Writer, types []*schema.ObjectType) {
 	fmt.Fprintf(w, "func init() {\n")
 	for _, obj := range types {
-		name, details := pkg.tokenToType(obj.Token), pkg.details(obj)
+		genOutputTypeRegistrations(w, pkg.tokenToType(obj.Token), pkg.details(obj))
+	}
+	fmt.Fprintf(w, "}\n")
+}
 
-		fmt.Fprintf(w, "\tpulumi.RegisterOutputType(%sOutput{})\n", name)
-		if details.ptrElement {
-			fmt.Fprintf(w, "\tpulumi.RegisterOutputType(%sPtrOutput{})\n", name)
-		}
-		if details.arrayElement {
-			fmt.Fprintf(w, "\tpulumi.RegisterOutputType(%sArrayOutput{})\n", name)
+func genOutputTypeRegistrations(w io.Writer, name string, details *typeDetails) {
+	fmt.Fprintf(w, "\tpulumi.RegisterOutputType(%sOutput{})\n", name)
+	if details.ptrElement {
+		fmt.Fprintf(w, "\tpulumi.RegisterOutputType(%sPtrOutput{})\n", name)
+	}
+	if details.arrayElement {
+		fmt.Fprintf(w, "\tpulumi.RegisterOutputType(%sArrayOutput{})\n", name)
+	}
+	if details.mapElement {
+		fmt.Fprintf(w, "\tpulumi.RegisterOutputType(%sMapOutput{})\n", name)
+	}
+}
+
+// enumElementTypes maps the element type of an enum to the names of its Go type and of the corresponding Pulumi type.
+var enumElementTypes = map[schema.Type][2]string{
+	schema.BoolType:   {"bool", "Bool"},
+	schema.IntType:    {"int", "Int"},
+	schema.NumberType: {"float64", "Float64"},
+	schema.StringType: {"string", "String"},
+}
+
+// enumMemberName returns the name of the constant that holds the given member of the named enum type.
+func enumMemberName(typeName string, e *schema.Enum) string {
+	name := typeName
+	for _, word := range codegen.EnumValueWords(e) {
+		name += Title(word)
+	}
+	return name
+}
+
+func (pkg *pkgContext) genEnum(w io.Writer, enum *schema.EnumType) error {
+	name, details := pkg.tokenToType(enum.Token), pkg.details(enum)
+	elementTypes := enumElementTypes[enum.ElementType]
+	goType, pulumiType := elementTypes[0], elementTypes[1]
+
+	printComment(w, enum.Comment, false)
+	fmt.Fprintf(w, "type %s %s\n\n", name, goType)
+
+	fmt.Fprintf(w, "const (\n")
+	for _, e := range enum.Elements {
+		v, err := goPrimitiveValue(e.Value)
+		if err != nil {
+			return err
 		}
-		if details.mapElement {
-			fmt.Fprintf(w, "\tpulumi.RegisterOutputType(%sMapOutput{})\n", name)
+
+		comment := e.Comment
+		if e.DeprecationMessage != "" {
+			if comment != "" {
+				comment += "\n\n"
+			}
+			comment += "Deprecated: " + e.DeprecationMessage
 		}
+		printComment(w, comment, true)
+
+		fmt.Fprintf(w, "\t%s = %s(%s)\n", enumMemberName(name, e), name, v)
+	}
+	fmt.Fprintf(w, ")\n\n")
+
+	// The enum type is itself an input, and may also be used wherever an input of its element type is accepted.
+	genInputMethods(w, name, name, name, details.ptrElement)
+
+	fmt.Fprintf(w, "func (e %s) To%sOutput() pulumi.%sOutput {\n", name, pulumiType, pulumiType)
+	fmt.Fprintf(w, "\treturn e.To%sOutputWithContext(context.Background())\n", pulumiType)
+	fmt.Fprintf(w, "}\n\n")
+
+	fmt.Fprintf(w, "func (e %s) To%sOutputWithContext(ctx context.Context) pulumi.%sOutput {\n", name, pulumiType,
+		pulumiType)
+	fmt.Fprintf(w, "\treturn pulumi.%[1]s(e).To%[1]sOutputWithContext(ctx)\n", pulumiType)
+	fmt.Fprintf(w, "}\n\n")
+
+	fmt.Fprintf(w, "func (e %s) To%sPtrOutput() pulumi.%sPtrOutput {\n", name, pulumiType, pulumiType)
+	fmt.Fprintf(w, "\treturn e.To%sPtrOutputWithContext(context.Background())\n", pulumiType)
+	fmt.Fprintf(w, "}\n\n")
+
+	fmt.Fprintf(w, "func (e %s) To%sPtrOutputWithContext(ctx context.Context) pulumi.%sPtrOutput {\n", name, pulumiType,
+		pulumiType)
+	fmt.Fprintf(w, "\treturn pulumi.%[1]s(e).To%[1]sPtrOutputWithContext(ctx)\n", pulumiType)
+	fmt.Fprintf(w, "}\n\n")
+
+	genInputInterfaceWithUsage(w, name, fmt.Sprintf("%[1]sInput is an input type that accepts %[1]s and %[1]sOutput "+
+		"values.", name))
+	if details.ptrElement {
+		genInputInterfaceWithUsage(w, name+"Ptr", fmt.Sprintf("%[1]sPtrInput is an input type that accepts %[1]s and "+
+			"%[1]sPtrOutput values.", name))
+	}
+	if details.arrayElement {
+		genInputInterfaceWithUsage(w, name+"Array", fmt.Sprintf("%[1]sArrayInput is an input type that accepts "+
+			"%[1]sArray and %[1]sArrayOutput values.", name))
+		fmt.Fprintf(w, "type %[1]sArray []%[1]sInput\n\n", name)
+		genInputMethods(w, name+"Array", name+"Array", "[]"+name, false)
+	}
+	if details.mapElement {
+		genInputInterfaceWithUsage(w, name+"Map", fmt.Sprintf("%[1]sMapInput is an input type that accepts "+
+			"%[1]sMap and %[1]sMapOutput values.", name))
+		fmt.Fprintf(w, "type %[1]sMap map[string]%[1]sInput\n\n", name)
+		genInputMethods(w, name+"Map", name+"Map", "map[string]"+name, false)
+	}
+
+	fmt.Fprintf(w, "type %sOutput struct { *pulumi.OutputState }\n\n", name)
+	genOutputMethods(w, name, name)
+
+	fmt.Fprintf(w, "func (o %sOutput) To%sOutput() pulumi.%sOutput {\n", name, pulumiType, pulumiType)
+	fmt.Fprintf(w, "\treturn o.To%sOutputWithContext(context.Background())\n", pulumiType)
+	fmt.Fprintf(w, "}\n\n")
+
+	fmt.Fprintf(w, "func (o %sOutput) To%sOutputWithContext(ctx context.Context) pulumi.%sOutput {\n", name,
+		pulumiType, pulumiType)
+	fmt.Fprintf(w, "\treturn o.ApplyT(func(e %s) %s { return %s(e) }).(pulumi.%sOutput)\n", name, goType, goType,
+		pulumiType)
+	fmt.Fprintf(w, "}\n\n")
+
+	if details.ptrElement {
+		fmt.Fprintf(w, "func (o %[1]sOutput) To%[2]sPtrOutput() %[1]sPtrOutput {\n", name, Title(name))
+		fmt.Fprintf(w, "\treturn o.To%sPtrOutputWithContext(context.Background())\n", Title(name))
+		fmt.Fprintf(w, "}\n\n")
+
+		fmt.Fprintf(w, "func (o %[1]sOutput) To%[2]sPtrOutputWithContext(ctx context.Context) %[1]sPtrOutput {\n",
+			name, Title(name))
+		fmt.Fprintf(w, "\treturn o.ApplyT(func(v %[1]s) *%[1]s {\n", name)
+		fmt.Fprintf(w, "\t\treturn &v\n")
+		fmt.Fprintf(w, "\t}).(%sPtrOutput)\n", name)
+		fmt.Fprintf(w, "}\n\n")
+
+		fmt.Fprintf(w, "type %sPtrOutput struct { *pulumi.OutputState }\n\n", name)
+		genOutputMethods(w, name+"Ptr", "*"+name)
+
+		fmt.Fprintf(w, "func (o %[1]sPtrOutput) Elem() %[1]sOutput {\n", name)
+		fmt.Fprintf(w, "\treturn o.ApplyT(func (v *%[1]s) %[1]s { return *v }).(%[1]sOutput)\n", name)
+		fmt.Fprintf(w, "}\n\n")
+	}
+
+	genCollectionOutputTypes(w, name, details)
+	return nil
+}
+
+func (pkg *pkgContext) genEnumRegistrations(w io.Writer, enums []*schema.EnumType) {
+	fmt.Fprintf(w, "func init() {\n")
+	for _, enum := range enums {
+		genOutputTypeRegistrations(w, pkg.tokenToType(enum.Token), pkg.details(enum))
 	}
 	fmt.Fprintf(w, "}\n")
 }
@@ -886,6 +1274,12 @@ func (pkg *pkgContext) getTypeImports(t schema.Type, recurse bool, imports strin
 	case *schema.MapType:
 		pkg.getTypeImports(t.ElementType, recurse, imports, seen)
 	case *schema.ObjectType:
+		if isExternalType(t, pkg.pkg) {
+			_, importPath := pkg.externalType(t.Package, t.Token)
+			imports.add(importPath)
+			return
+		}
+
 		mod := pkg.pkg.TokenToModule(t.Token)
 		if override, ok := pkg.modToPkg[mod]; ok {
 			mod = override
@@ -899,6 +1293,20 @@ func (pkg *pkgContext) getTypeImports(t schema.Type, recurse bool, imports strin
 				pkg.getTypeImports(p.Type, recurse, imports, seen)
 			}
 		}
+	case *schema.EnumType:
+		if isExternalType(t, pkg.pkg) {
+			_, importPath := pkg.externalType(t.Package, t.Token)
+			imports.add(importPath)
+			return
+		}
+
+		mod := pkg.pkg.TokenToModule(t.Token)
+		if override, ok := pkg.modToPkg[mod]; ok {
+			mod = override
+		}
+		if mod != pkg.mod {
+			imports.add(path.Join(pkg.importBasePath, mod))
+		}
 	case *schema.UnionType:
 		for _, e := range t.ElementTypes {
 			pkg.getTypeImports(e, recurse, imports, seen)
@@ -922,6 +1330,18 @@ func (pkg *pkgContext) getImports(member interface{}, imports stringSet) {
 				imports.add("github.com/pkg/errors")
 			}
 		}
+		for _, m := range member.Methods {
+			if m.Function.Inputs != nil {
+				for _, p := range m.Function.Inputs.Properties {
+					pkg.getTypeImports(p.Type, false, imports, seen)
+				}
+			}
+			if m.Function.Outputs != nil {
+				for _, p := range m.Function.Outputs.Properties {
+					pkg.getTypeImports(p.Type, false, imports, seen)
+				}
+			}
+		}
 	case *schema.Function:
 		if member.Inputs != nil {
 			pkg.getTypeImports(member.Inputs, false, imports, seen)
@@ -963,6 +1383,8 @@ func (pkg *pkgContext) genHeader(w io.Writer, goImports []string, importedPackag
 		for i, k := range imports {
 			if alias, ok := pkg.pkgImportAliases[k]; ok {
 				imports[i] = fmt.Sprintf(`%s "%s"`, alias, k)
+			} else if alias, ok := pkg.externalImportAliases[k]; ok {
+				imports[i] = fmt.Sprintf(`%s "%s"`, alias, k)
 			}
 		}
 	}
@@ -1050,6 +1472,16 @@ func (pkg *pkgContext) getPkg(mod string) *pkgContext {
 // generatePackageContextMap groups resources, types, and functions into Go packages.
 func generatePackageContextMap(tool string, pkg *schema.Package, goInfo GoPackageInfo) map[string]*pkgContext {
 	packages := map[string]*pkgContext{}
+	externalPackages, externalImportAliases := map[*schema.Package]map[string]*pkgContext{}, map[string]string{}
+	externalDetails := map[schema.Type]*typeDetails{}
+	getExternalDetails := func(t schema.Type) *typeDetails {
+		details, ok := externalDetails[t]
+		if !ok {
+			details = &typeDetails{}
+			externalDetails[t] = details
+		}
+		return details
+	}
 	getPkg := func(token string) *pkgContext {
 		mod := pkg.TokenToModule(token)
 		if override, ok := goInfo.ModuleToPackage[mod]; ok {
@@ -1062,13 +1494,17 @@ func generatePackageContextMap(tool string, pkg *schema.Package, goInfo GoPackag
 				pkg:              pkg,
 				mod:              mod,
 				importBasePath:   goInfo.ImportBasePath,
-				typeDetails:      map[*schema.ObjectType]*typeDetails{},
+				typeDetails:      map[schema.Type]*typeDetails{},
 				names:            stringSet{},
 				functionNames:    map[*schema.Function]string{},
 				tool:             tool,
 				modToPkg:         goInfo.ModuleToPackage,
 				pkgImportAliases: goInfo.PackageImportAliases,
 				packages:         packages,
+
+				externalPackages:      externalPackages,
+				externalImportAliases: externalImportAliases,
+				externalDetails:       externalDetails,
 			}
 			packages[mod] = pack
 		}
@@ -1086,14 +1522,25 @@ func generatePackageContextMap(tool string, pkg *schema.Package, goInfo GoPackag
 	var markOptionalPropertyTypesAsRequiringPtr func(seen stringSet, props []*schema.Property, parentOptional bool)
 	markOptionalPropertyTypesAsRequiringPtr = func(seen stringSet, props []*schema.Property, parentOptional bool) {
 		for _, p := range props {
-			if obj, ok := p.Type.(*schema.ObjectType); ok && (!p.IsRequired || parentOptional) {
-				if seen.has(obj.Token) {
+			if p.IsRequired && !parentOptional {
+				continue
+			}
+			// Types defined by other packages are generated with those packages, which must generate their pointer types.
+			if isExternalType(p.Type, pkg) {
+				getExternalDetails(p.Type).ptrElement = true
+				continue
+			}
+			switch t := p.Type.(type) {
+			case *schema.ObjectType:
+				if seen.has(t.Token) {
 					continue
 				}
 
-				seen.add(obj.Token)
-				getPkg(obj.Token).details(obj).ptrElement = true
-				markOptionalPropertyTypesAsRequiringPtr(seen, obj.Properties, true)
+				seen.add(t.Token)
+				getPkg(t.Token).details(t).ptrElement = true
+				markOptionalPropertyTypesAsRequiringPtr(seen, t.Properties, true)
+			case *schema.EnumType:
+				getPkg(t.Token).details(t).ptrElement = true
 			}
 		}
 	}
@@ -1105,17 +1552,34 @@ func generatePackageContextMap(tool string, pkg *schema.Package, goInfo GoPackag
 	for _, t := range pkg.Types {
 		switch t := t.(type) {
 		case *schema.ArrayType:
-			if obj, ok := t.ElementType.(*schema.ObjectType); ok {
-				getPkg(obj.Token).details(obj).arrayElement = true
+			if isExternalType(t.ElementType, pkg) {
+				getExternalDetails(t.ElementType).arrayElement = true
+				continue
+			}
+			switch e := t.ElementType.(type) {
+			case *schema.ObjectType:
+				getPkg(e.Token).details(e).arrayElement = true
+			case *schema.EnumType:
+				getPkg(e.Token).details(e).arrayElement = true
 			}
 		case *schema.MapType:
-			if obj, ok := t.ElementType.(*schema.ObjectType); ok {
-				getPkg(obj.Token).details(obj).mapElement = true
+			if isExternalType(t.ElementType, pkg) {
+				getExternalDetails(t.ElementType).mapElement = true
+				continue
+			}
+			switch e := t.ElementType.(type) {
+			case *schema.ObjectType:
+				getPkg(e.Token).details(e).mapElement = true
+			case *schema.EnumType:
+				getPkg(e.Token).details(e).mapElement = true
 			}
 		case *schema.ObjectType:
 			pkg := getPkg(t.Token)
 			pkg.types = append(pkg.types, t)
 			markOptionalPropertyTypesAsRequiringPtr(seenMap, t.Properties, false)
+		case *schema.EnumType:
+			pkg := getPkg(t.Token)
+			pkg.enums = append(pkg.enums, t)
 		}
 	}
 
@@ -1135,6 +1599,20 @@ func generatePackageContextMap(tool string, pkg *schema.Package, goInfo GoPackag
 
 		markOptionalPropertyTypesAsRequiringPtr(seenMap, r.InputProperties, !r.IsProvider)
 		markOptionalPropertyTypesAsRequiringPtr(seenMap, r.Properties, !r.IsProvider)
+
+		for _, m := range r.Methods {
+			methodName := resourceName(r) + Title(m.Name)
+			if m.Function.Inputs != nil {
+				pkg.names.add(methodName + "Args")
+				pkg.names.add(camel(methodName) + "Args")
+				markOptionalPropertyTypesAsRequiringPtr(seenMap, m.Function.Inputs.Properties, false)
+			}
+			if m.Function.Outputs != nil {
+				pkg.names.add(methodName + "Result")
+				pkg.names.add(methodName + "ResultOutput")
+				markOptionalPropertyTypesAsRequiringPtr(seenMap, m.Function.Outputs.Properties, false)
+			}
+		}
 	}
 
 	scanResource(pkg.Provider)
@@ -1142,7 +1620,31 @@ func generatePackageContextMap(tool string, pkg *schema.Package, goInfo GoPackag
 		scanResource(r)
 	}
 
+	// If requested, generate the pointer, array and map types for every type, so that other packages may use them.
+	if goInfo.GenerateExtraInputTypes {
+		for _, t := range pkg.Types {
+			switch t := t.(type) {
+			case *schema.ObjectType:
+				details := getPkg(t.Token).details(t)
+				details.ptrElement, details.arrayElement, details.mapElement = true, true, true
+				// The accessors of the pointer output type return pointers to the types of the properties.
+				if !seenMap.has(t.Token) {
+					seenMap.add(t.Token)
+					markOptionalPropertyTypesAsRequiringPtr(seenMap, t.Properties, true)
+				}
+			case *schema.EnumType:
+				details := getPkg(t.Token).details(t)
+				details.ptrElement, details.arrayElement, details.mapElement = true, true, true
+			}
+		}
+	}
+
 	for _, f := range pkg.Functions {
+		// Methods are generated along with the resources that define them.
+		if f.IsMethod {
+			continue
+		}
+
 		pkg := getPkg(f.Token)
 		pkg.functions = append(pkg.functions, f)
 
@@ -1221,6 +1723,11 @@ func GeneratePackage(tool string, pkg *schema.Package) (map[string][]byte, error
 	if err := pkg.ImportLanguages(map[string]schema.Language{"go": Importer}); err != nil {
 		return nil, err
 	}
+	for _, dep := range pkg.Dependencies {
+		if err := dep.ImportLanguages(map[string]schema.Language{"go": Importer}); err != nil {
+			return nil, errors.Wrapf(err, "importing dependency %v", dep.Name)
+		}
+	}
 
 	goInfo, _ := pkg.Language["go"].(GoPackageInfo)
 	packages := generatePackageContextMap(tool, pkg, goInfo)
@@ -1232,6 +1739,13 @@ func GeneratePackage(tool string, pkg *schema.Package) (map[string][]byte, error
 	}
 	sort.Strings(pkgMods)
 
+	// The types that the package requires of other packages are shared by all of its contexts.
+	if len(pkgMods) > 0 {
+		if err := packages[pkgMods[0]].checkExternalTypes(); err != nil {
+			return nil, err
+		}
+	}
+
 	files := map[string][]byte{}
 	setFile := func(relPath, contents string) {
 		relPath = path.Join(pkg.Name, relPath)
@@ -1325,6 +1839,22 @@ func GeneratePackage(tool string, pkg *schema.Package) (map[string][]byte, error
 			setFile(path.Join(mod, "pulumiTypes.go"), buffer.String())
 		}
 
+		// Enums
+		if len(pkg.enums) > 0 {
+			buffer := &bytes.Buffer{}
+			pkg.genHeader(buffer, []string{"context", "reflect"}, newStringSet("github.com/pulumi/pulumi/sdk/v2/go/pulumi"))
+
+			for _, e := range pkg.enums {
+				if err := pkg.genEnum(buffer, e); err != nil {
+					return nil, err
+				}
+			}
+
+			pkg.genEnumRegistrations(buffer, pkg.enums)
+
+			setFile(path.Join(mod, "pulumiEnums.go"), buffer.String())
+		}
+
 		// Utilities
 		if pkg.needsUtils {
 			buffer := &bytes.Buffer{}
@@ -1,9 +1,28 @@
 package gen
 
 import (
+	"context"
+	"encoding/json"
+	"go/ast"
+	goimporter "go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/pkg/v2/codegen/internal/test"
+	"github.com/pulumi/pulumi/pkg/v2/codegen/schema"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi/provider"
 )
 
 func TestInputUsage(t *testing.T) {
@@ -35,3 +54,367 @@ func TestInputUsage(t *testing.T) {
 			" of `FooInput` via:\n\n\t\t FooArgs{...}\n ",
 		usage)
 }
+
+type testBucketArgs struct {
+	Name string            `pulumi:"name" description:"The name of the bucket."`
+	Tags map[string]string `pulumi:"tags,optional"`
+}
+
+type testBucketState struct {
+	testBucketArgs
+
+	Size int `pulumi:"size"`
+}
+
+type testBucket struct{}
+
+func (testBucket) Create(ctx context.Context, name string, inputs testBucketArgs) (string, testBucketState, error) {
+	return name, testBucketState{testBucketArgs: inputs}, nil
+}
+
+// Tests that the schema of a provider written with the Go provider SDK can be used to generate a Go SDK.
+func TestGenerateProviderSDK(t *testing.T) {
+	p, err := provider.NewProvider("test", "1.0.0")
+	assert.NoError(t, err)
+	assert.NoError(t, p.RegisterResource("test:storage:Bucket", testBucket{}))
+
+	bytes, err := p.GetSchema(0)
+	assert.NoError(t, err)
+
+	var spec schema.PackageSpec
+	assert.NoError(t, json.Unmarshal(bytes, &spec))
+	spec.Language = map[string]json.RawMessage{
+		"go": json.RawMessage(`{"importBasePath": "github.com/example/pulumi-test/sdk/go/test"}`),
+	}
+
+	pkg, err := schema.ImportSpec(spec, map[string]schema.Language{"go": Importer})
+	assert.NoError(t, err)
+	if assert.Len(t, pkg.Resources, 1) {
+		assert.Equal(t, "test:storage:Bucket", pkg.Resources[0].Token)
+		assert.Len(t, pkg.Resources[0].InputProperties, 2)
+		assert.Len(t, pkg.Resources[0].Properties, 3)
+	}
+
+	files, err := GeneratePackage("test", pkg)
+	assert.NoError(t, err)
+	assert.Contains(t, files, "test/storage/bucket.go")
+}
+
+// Tests that resource methods are generated as methods of the resource type rather than as standalone functions.
+func TestGenerateMethods(t *testing.T) {
+	spec := schema.PackageSpec{
+		Name: "test",
+		Resources: map[string]schema.ResourceSpec{
+			"test:index:Cluster": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{
+					Properties: map[string]schema.PropertySpec{
+						"name": {TypeSpec: schema.TypeSpec{Type: "string"}},
+					},
+				},
+				Methods: map[string]string{"getKubeconfig": "test:index:Cluster/getKubeconfig"},
+			},
+		},
+		Functions: map[string]schema.FunctionSpec{
+			"test:index:Cluster/getKubeconfig": {
+				Inputs: &schema.ObjectTypeSpec{
+					Properties: map[string]schema.PropertySpec{
+						"profile": {TypeSpec: schema.TypeSpec{Type: "string"}},
+					},
+				},
+				Outputs: &schema.ObjectTypeSpec{
+					Properties: map[string]schema.PropertySpec{
+						"kubeconfig": {TypeSpec: schema.TypeSpec{Type: "string"}},
+					},
+					Required: []string{"kubeconfig"},
+				},
+			},
+		},
+		Language: map[string]json.RawMessage{
+			"go": json.RawMessage(`{"importBasePath": "github.com/example/pulumi-test/sdk/go/test"}`),
+		},
+	}
+
+	pkg, err := schema.ImportSpec(spec, map[string]schema.Language{"go": Importer})
+	assert.NoError(t, err)
+	if assert.Len(t, pkg.Resources, 1) && assert.Len(t, pkg.Resources[0].Methods, 1) {
+		method := pkg.Resources[0].Methods[0]
+		assert.Equal(t, "getKubeconfig", method.Name)
+		assert.True(t, method.Function.IsMethod)
+	}
+
+	files, err := GeneratePackage("test", pkg)
+	assert.NoError(t, err)
+	assert.Len(t, files, 3)
+	if assert.Contains(t, files, "test/cluster.go") {
+		code := string(files["test/cluster.go"])
+		assert.Contains(t, code, "func (r *Cluster) GetKubeconfig(ctx *pulumi.Context, args *ClusterGetKubeconfigArgs) "+
+			"(ClusterGetKubeconfigResultOutput, error) {")
+		assert.Contains(t, code, `ctx.Call("test:index:Cluster/getKubeconfig", args, ClusterGetKubeconfigResultOutput{}, r)`)
+		assert.Contains(t, code, "func (o ClusterGetKubeconfigResultOutput) Kubeconfig() pulumi.StringOutput {")
+	}
+
+	// Methods must refer to functions in the package.
+	spec.Resources["test:index:Cluster"] = schema.ResourceSpec{
+		Methods: map[string]string{"missing": "test:index:Cluster/missing"},
+	}
+	_, err = schema.ImportSpec(spec, nil)
+	assert.Error(t, err)
+}
+
+// Tests that enum types are generated as typed constants that may be used as inputs.
+func TestGenerateEnums(t *testing.T) {
+	spec := schema.PackageSpec{
+		Name: "test",
+		Types: map[string]schema.ComplexTypeSpec{
+			"test:index:Size": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{Type: "integer", Description: "Container sizes."},
+				Enum: []schema.EnumValueSpec{
+					{Name: "FourInch", Value: 4.0},
+					{Name: "SixInch", Value: 6.0, DeprecationMessage: "Six inch containers are no longer made."},
+				},
+			},
+		},
+		Resources: map[string]schema.ResourceSpec{
+			"test:index:Pot": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{
+					Properties: map[string]schema.PropertySpec{
+						"size": {TypeSpec: schema.TypeSpec{Ref: "#/types/test:index:Size"}},
+					},
+					Required: []string{"size"},
+				},
+				InputProperties: map[string]schema.PropertySpec{
+					"size": {TypeSpec: schema.TypeSpec{Ref: "#/types/test:index:Size"}},
+				},
+				RequiredInputs: []string{"size"},
+			},
+		},
+		Language: map[string]json.RawMessage{
+			"go": json.RawMessage(`{"importBasePath": "github.com/example/pulumi-test/sdk/go/test"}`),
+		},
+	}
+
+	pkg, err := schema.ImportSpec(spec, map[string]schema.Language{"go": Importer})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	files, err := GeneratePackage("test", pkg)
+	assert.NoError(t, err)
+	if assert.Contains(t, files, "test/pulumiEnums.go") {
+		code := string(files["test/pulumiEnums.go"])
+		assert.Contains(t, code, "// Container sizes.\ntype Size int\n")
+		assert.Contains(t, code, "\tSizeFourInch = Size(4)\n")
+		assert.Contains(t, code, "\t// Deprecated: Six inch containers are no longer made.\n\tSizeSixInch = Size(6)\n")
+		assert.Contains(t, code, "func (e Size) ToIntOutput() pulumi.IntOutput {")
+		assert.Contains(t, code, "func (o SizePtrOutput) Elem() SizeOutput {")
+	}
+	if assert.Contains(t, files, "test/pot.go") {
+		code := string(files["test/pot.go"])
+		assert.Contains(t, code, "Size SizeOutput `pulumi:\"size\"`")
+		assert.Contains(t, code, "Size SizeInput\n")
+	}
+
+	packages := map[string]map[string][]byte{}
+	addGoPackages(packages, pkg, files)
+	typeCheckGoPackages(t, packages)
+}
+
+func TestGenerateExternalRefs(t *testing.T) {
+	loader := schema.NewPluginLoader(test.NewHost(filepath.Join("..", "internal", "test", "testdata")))
+	pkg, err := loader.LoadPackage("backup", nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	files, err := GeneratePackage("test", pkg)
+	if !assert.NoError(t, err) {
+		return
+	}
+	if assert.Contains(t, files, "backup/policy.go") {
+		code := string(files["backup/policy.go"])
+		assert.Contains(t, code, "\tstorage \"github.com/example/pulumi-storage/sdk/go/storage\"\n")
+		assert.Regexp(t, "Container +pulumi.IDOutput +`pulumi:\"container\"`", code)
+		assert.Regexp(t, "Metadata +storage.MetadataPtrOutput +`pulumi:\"metadata\"`", code)
+		assert.Regexp(t, "Container +pulumi.IDInput\n", code)
+		assert.Regexp(t, "Metadata +storage.MetadataPtrInput\n", code)
+	}
+	if assert.Contains(t, files, "backup/pulumiTypes.go") {
+		code := string(files["backup/pulumiTypes.go"])
+		assert.Contains(t, code, "\tstorageblob \"github.com/example/pulumi-storage/sdk/go/storage/blob\"\n")
+		assert.Regexp(t, "Tier +\\*storageblob.Tier +`pulumi:\"tier\"`", code)
+		assert.Regexp(t, "Tier +storageblob.TierPtrInput +`pulumi:\"tier\"`", code)
+	}
+
+	// Types defined by other packages are not generated.
+	for name := range files {
+		assert.NotContains(t, name, "storage")
+	}
+
+}
+
+// Tests that types defined by other packages may be used as pointer, array and map elements if those packages generate
+// the corresponding types.
+func TestGenerateExternalContainerRefs(t *testing.T) {
+	metadataRef := schema.TypeSpec{Ref: "/storage/v1.0.0/schema.json#/types/storage:index:Metadata"}
+	tierRef := schema.TypeSpec{Ref: "/storage/v1.0.0/schema.json#/types/storage:blob:Tier"}
+	properties := map[string]schema.PropertySpec{
+		"metadata":      {TypeSpec: metadataRef},
+		"history":       {TypeSpec: schema.TypeSpec{Type: "array", Items: &metadataRef}},
+		"tierOverrides": {TypeSpec: schema.TypeSpec{Type: "object", AdditionalProperties: &tierRef}},
+	}
+	spec := schema.PackageSpec{
+		Name: "archive",
+		Resources: map[string]schema.ResourceSpec{
+			"archive:index:Vault": {
+				ObjectTypeSpec:  schema.ObjectTypeSpec{Properties: properties},
+				InputProperties: properties,
+			},
+		},
+		Language: map[string]json.RawMessage{
+			"go": json.RawMessage(`{"importBasePath": "github.com/example/pulumi-archive/sdk/go/archive"}`),
+		},
+	}
+	loader := schema.NewPluginLoader(test.NewHost(filepath.Join("..", "internal", "test", "testdata")))
+	pkg, err := schema.ImportSpecWithLoader(spec, map[string]schema.Language{"go": Importer}, loader)
+	if !assert.NoError(t, err) || !assert.Len(t, pkg.Dependencies, 1) {
+		return
+	}
+	storage := pkg.Dependencies[0]
+
+	files, err := GeneratePackage("test", pkg)
+	if !assert.NoError(t, err) {
+		return
+	}
+	if assert.Contains(t, files, "archive/vault.go") {
+		code := string(files["archive/vault.go"])
+		assert.Regexp(t, "History +storage.MetadataArrayOutput +`pulumi:\"history\"`", code)
+		assert.Regexp(t, "History +storage.MetadataArrayInput\n", code)
+		assert.Regexp(t, "TierOverrides +storageblob.TierMapOutput +`pulumi:\"tierOverrides\"`", code)
+		assert.Regexp(t, "TierOverrides +storageblob.TierMapInput\n", code)
+	}
+
+	storageFiles, err := GeneratePackage("test", storage)
+	if !assert.NoError(t, err) {
+		return
+	}
+	packages := map[string]map[string][]byte{}
+	addGoPackages(packages, pkg, files)
+	addGoPackages(packages, storage, storageFiles)
+	typeCheckGoPackages(t, packages)
+
+	// Packages that do not generate the types may not be used as pointer, array or map elements.
+	storageInfo := storage.Language["go"].(GoPackageInfo)
+	storageInfo.GenerateExtraInputTypes = false
+	storage.Language["go"] = storageInfo
+	_, err = GeneratePackage("test", pkg)
+	assert.EqualError(t, err, "package archive uses storage:blob:Tier, but package storage does not generate its "+
+		"Map Go types; set generateExtraInputTypes in the Go language options of package storage")
+}
+
+// Tests that component resources are registered as remote components and cannot be read.
+func TestGenerateComponentResource(t *testing.T) {
+	loader := schema.NewPluginLoader(test.NewHost(filepath.Join("..", "internal", "test", "testdata")))
+	pkg, err := loader.LoadPackage("storage", nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	files, err := GeneratePackage("test", pkg)
+	if !assert.NoError(t, err) {
+		return
+	}
+	if assert.Contains(t, files, "storage/site.go") {
+		code := string(files["storage/site.go"])
+		assert.Contains(t, code, "type Site struct {\n\tpulumi.ResourceState\n")
+		assert.Contains(t, code, `ctx.RegisterRemoteComponentResource("storage:index:Site", name, args, &resource, opts...)`)
+		assert.NotContains(t, code, "func GetSite(")
+		assert.NotContains(t, code, "SiteState")
+	}
+}
+
+// addGoPackages adds the files generated for a package to a map from import path to the files of each Go package.
+func addGoPackages(packages map[string]map[string][]byte, pkg *schema.Package, files map[string][]byte) {
+	importBasePath := pkg.Language["go"].(GoPackageInfo).ImportBasePath
+	for name, contents := range files {
+		dir, file := path.Split(strings.TrimPrefix(name, pkg.Name+"/"))
+		importPath := path.Join(importBasePath, dir)
+		if packages[importPath] == nil {
+			packages[importPath] = map[string][]byte{}
+		}
+		packages[importPath][file] = contents
+	}
+}
+
+// typeCheckGoPackages type checks generated Go packages. Packages that were not generated, such as the Pulumi SDK,
+// are imported from the export data produced by the go command.
+func typeCheckGoPackages(t *testing.T, packages map[string]map[string][]byte) {
+	out, err := exec.Command("go", "list", "-export", "-deps", "-f", "{{.ImportPath}} {{.Export}}",
+		"github.com/pulumi/pulumi/sdk/v2/go/pulumi").Output()
+	if !assert.NoError(t, err) {
+		return
+	}
+	exports := map[string]string{}
+	for _, line := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+		if fields := strings.Fields(line); len(fields) == 2 {
+			exports[fields[0]] = fields[1]
+		}
+	}
+
+	fset := token.NewFileSet()
+	imp := &generatedImporter{
+		fset:     fset,
+		packages: packages,
+		checked:  map[string]*types.Package{},
+		exports: goimporter.ForCompiler(fset, "gc", func(importPath string) (io.ReadCloser, error) {
+			export, ok := exports[importPath]
+			if !ok {
+				return nil, errors.Errorf("no export data for %s", importPath)
+			}
+			return os.Open(export)
+		}),
+	}
+
+	var importPaths []string
+	for importPath := range packages {
+		importPaths = append(importPaths, importPath)
+	}
+	sort.Strings(importPaths)
+	for _, importPath := range importPaths {
+		_, err := imp.Import(importPath)
+		assert.NoError(t, err, importPath)
+	}
+}
+
+// generatedImporter is a types.Importer that type checks generated packages from memory.
+type generatedImporter struct {
+	fset     *token.FileSet
+	packages map[string]map[string][]byte
+	checked  map[string]*types.Package
+	exports  types.Importer
+}
+
+func (imp *generatedImporter) Import(importPath string) (*types.Package, error) {
+	if pkg, ok := imp.checked[importPath]; ok {
+		return pkg, nil
+	}
+	files, ok := imp.packages[importPath]
+	if !ok {
+		return imp.exports.Import(importPath)
+	}
+
+	var asts []*ast.File
+	for name, contents := range files {
+		f, err := parser.ParseFile(imp.fset, path.Join(importPath, name), contents, 0)
+		if err != nil {
+			return nil, err
+		}
+		asts = append(asts, f)
+	}
+	config := types.Config{Importer: imp}
+	pkg, err := config.Check(importPath, imp.fset, asts, nil)
+	if err != nil {
+		return nil, err
+	}
+	imp.checked[importPath] = pkg
+	return pkg, nil
+}
@@ -38,6 +38,11 @@ type GoPackageInfo struct {
 	//    { "github.com/pulumi/pulumi-kubernetes/sdk/go/kubernetes/flowcontrol/v1alpha1": "flowcontrolv1alpha1" }
 	//
 	PackageImportAliases map[string]string `json:"packageImportAliases,omitempty"`
+
+	// Generate the pointer, array and map types for every object and enum type, rather than only for those that the
+	// package itself uses. Other packages can only use a type as a pointer, array or map element if the corresponding
+	// types are generated.
+	GenerateExtraInputTypes bool `json:"generateExtraInputTypes,omitempty"`
 }
 
 // Importer implements schema.Language for Go.
@@ -43,6 +43,7 @@ func (opts bindOptions) modelOptions() []model.BindOption {
 
 type binder struct {
 	options bindOptions
+	loader  schema.Loader
 
 	referencedPackages []*schema.Package
 	typeSchemas        map[model.Type]schema.Type
@@ -98,6 +99,7 @@ func BindProgram(files []*syntax.File, opts ...BindOption) (*Program, hcl.Diagno
 
 	b := &binder{
 		options:     options,
+		loader:      schema.NewPluginLoader(options.host),
 		tokens:      syntax.NewTokenMapForFiles(files),
 		typeSchemas: map[model.Type]schema.Type{},
 		root:        model.NewRootScope(syntax.None),
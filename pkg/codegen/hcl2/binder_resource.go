@@ -77,6 +77,7 @@ func (b *binder) bindResourceTypes(node *Resource) hcl.Diagnostics {
 			return hcl.Diagnostics{unknownResourceType(token, tokenRange)}
 		}
 		inputProperties, properties = res.InputProperties, res.Properties
+		node.Schema = res
 	} else {
 		inputProperties, properties = pkgSchema.schema.Config, pkgSchema.schema.Config
 	}
@@ -15,7 +15,6 @@
 package hcl2
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/hashicorp/hcl/v2"
@@ -23,7 +22,6 @@ import (
 	"github.com/pulumi/pulumi/pkg/v2/codegen"
 	"github.com/pulumi/pulumi/pkg/v2/codegen/hcl2/model"
 	"github.com/pulumi/pulumi/pkg/v2/codegen/schema"
-	"github.com/pulumi/pulumi/sdk/v2/go/common/tokens"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/contract"
 )
 
@@ -80,19 +78,30 @@ func (b *binder) loadReferencedPackageSchemas(n Node) error {
 	contract.Assert(len(diags) == 0)
 
 	for _, name := range packageNames.SortedValues() {
-		if _, ok := b.options.packageCache.entries[name]; ok {
-			continue
-		}
 		if err := b.loadPackageSchema(name); err != nil {
 			return err
 		}
-		b.referencedPackages = append(b.referencedPackages, b.options.packageCache.entries[name].schema)
+		pkg := b.options.packageCache.entries[name].schema
+		if !b.references(pkg) {
+			b.referencedPackages = append(b.referencedPackages, pkg)
+		}
 	}
 	return nil
 }
 
+// references returns true if the given package is among the packages referenced by the program.
+func (b *binder) references(pkg *schema.Package) bool {
+	for _, p := range b.referencedPackages {
+		if p == pkg {
+			return true
+		}
+	}
+	return false
+}
+
 // loadPackageSchema loads the schema for a given package by loading the corresponding provider and calling its
-// GetSchema method.
+// GetSchema method. Packages are loaded using the binder's loader, which also loads and caches any packages that the
+// schema refers to.
 //
 // TODO: schema and provider versions
 func (b *binder) loadPackageSchema(name string) error {
@@ -100,22 +109,7 @@ func (b *binder) loadPackageSchema(name string) error {
 		return nil
 	}
 
-	provider, err := b.options.host.Provider(tokens.Package(name), nil)
-	if err != nil {
-		return err
-	}
-
-	schemaBytes, err := provider.GetSchema(0)
-	if err != nil {
-		return err
-	}
-
-	var spec schema.PackageSpec
-	if err := json.Unmarshal(schemaBytes, &spec); err != nil {
-		return err
-	}
-
-	pkg, err := schema.ImportSpec(spec, nil)
+	pkg, err := b.loader.LoadPackage(name, nil)
 	if err != nil {
 		return err
 	}
@@ -158,6 +152,12 @@ func (b *binder) schemaTypeToType(src schema.Type) (result model.Type) {
 			properties[prop.Name] = t
 		}
 		return model.NewObjectType(properties, src)
+	case *schema.EnumType:
+		// Programs refer to enum values by their values, so an enum is typed as its element type.
+		return b.schemaTypeToType(src.ElementType)
+	case *schema.ResourceType:
+		// Resources are referred to by their IDs.
+		return model.StringType
 	case *schema.TokenType:
 		t, ok := model.GetOpaqueType(src.Token)
 		if !ok {
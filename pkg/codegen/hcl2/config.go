@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/pulumi/pulumi/pkg/v2/codegen/hcl2/model"
+	"github.com/zclconf/go-cty/cty"
 )
 
 // ConfigVariable represents a program- or component-scoped input variable. The value for a config variable may come
@@ -43,6 +44,11 @@ func (cv *ConfigVariable) Traverse(traverser hcl.Traverser) (model.Traversable,
 	return cv.typ.Traverse(traverser)
 }
 
+// Value returns the value of the config variable in the given evaluation context, if any.
+func (cv *ConfigVariable) Value(context *hcl.EvalContext) (cty.Value, hcl.Diagnostics) {
+	return nodeValue(context, cv.Name())
+}
+
 func (cv *ConfigVariable) VisitExpressions(pre, post model.ExpressionVisitor) hcl.Diagnostics {
 	return model.VisitExpressions(cv.Definition, pre, post)
 }
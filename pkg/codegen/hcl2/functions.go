@@ -15,6 +15,8 @@
 package hcl2
 
 import (
+	"sort"
+
 	"github.com/hashicorp/hcl/v2"
 	"github.com/pulumi/pulumi/pkg/v2/codegen/hcl2/model"
 )
@@ -217,3 +219,14 @@ var pulumiBuiltins = map[string]*model.Function{
 		ReturnType: model.StringType,
 	}),
 }
+
+// BuiltinFunctionNames returns the sorted names of the functions that are available to every program, including
+// invoke.
+func BuiltinFunctionNames() []string {
+	names := []string{"invoke"}
+	for name := range pulumiBuiltins {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/pulumi/pulumi/pkg/v2/codegen/hcl2/model"
+	"github.com/zclconf/go-cty/cty"
 )
 
 // LocalVariable represents a program- or component-scoped local variable.
@@ -39,6 +40,11 @@ func (lv *LocalVariable) Traverse(traverser hcl.Traverser) (model.Traversable, h
 	return lv.Type().Traverse(traverser)
 }
 
+// Value returns the value of the local variable in the given evaluation context, if any.
+func (lv *LocalVariable) Value(context *hcl.EvalContext) (cty.Value, hcl.Diagnostics) {
+	return nodeValue(context, lv.Name())
+}
+
 func (lv *LocalVariable) VisitExpressions(pre, post model.ExpressionVisitor) hcl.Diagnostics {
 	return model.VisitExpressions(lv.Definition, pre, post)
 }
@@ -0,0 +1,296 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lsp
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/pkg/errors"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/pulumi/pulumi/pkg/v2/codegen/hcl2"
+	"github.com/pulumi/pulumi/pkg/v2/codegen/hcl2/model"
+	"github.com/pulumi/pulumi/pkg/v2/codegen/hcl2/syntax"
+	"github.com/pulumi/pulumi/pkg/v2/codegen/schema"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/logging"
+)
+
+// analysis is the result of parsing and binding the program that contains a set of documents.
+type analysis struct {
+	// program is the bound program. This is nil if the program's files contain syntax errors or if the program could
+	// not be bound.
+	program *hcl2.Program
+	// sources maps the path of each of the program's files to its text.
+	sources map[string]string
+	// diagnostics holds the diagnostics produced by parsing and binding the program.
+	diagnostics hcl.Diagnostics
+}
+
+// sameProgram returns true if the two documents belong to the same program.
+func sameProgram(a, b *document) bool {
+	return filepath.Dir(a.path) == filepath.Dir(b.path)
+}
+
+// analyze parses and binds the program that contains the given document, then publishes the resulting diagnostics
+// for each of the program's open documents.
+func (s *Server) analyze(doc *document) error {
+	// Gather the program's sources. Open documents take precedence over the files on disk.
+	sources := map[string]string{}
+	var open []*document
+	for _, other := range s.documents {
+		if sameProgram(doc, other) {
+			sources[other.path] = other.text
+			open = append(open, other)
+		}
+	}
+	sort.Slice(open, func(i, j int) bool { return open[i].path < open[j].path })
+
+	dir := filepath.Dir(doc.path)
+	if entries, err := ioutil.ReadDir(dir); err == nil {
+		for _, e := range entries {
+			path := filepath.Join(dir, e.Name())
+			if _, ok := sources[path]; ok || e.IsDir() || filepath.Ext(path) != ".pp" {
+				continue
+			}
+			contents, err := ioutil.ReadFile(path)
+			if err != nil {
+				logging.V(5).Infof("lsp: reading %v: %v", path, err)
+				continue
+			}
+			sources[path] = string(contents)
+		}
+	}
+
+	a := &analysis{sources: sources}
+	files := map[string]*syntax.File{}
+	parser := syntax.NewParser()
+	for path, text := range sources {
+		if err := parser.ParseFile(strings.NewReader(text), path); err != nil {
+			return err
+		}
+	}
+	for _, f := range parser.Files {
+		files[f.Name] = f
+	}
+	a.diagnostics = parser.Diagnostics
+
+	if !parser.Diagnostics.HasErrors() {
+		program, diags, err := s.bind(parser.Files)
+		if err != nil {
+			a.diagnostics = append(a.diagnostics, &hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  err.Error(),
+			})
+		}
+		a.program, a.diagnostics = program, append(a.diagnostics, diags...)
+	}
+
+	for _, d := range open {
+		d.file, d.analysis = files[d.path], a
+		if a.program != nil {
+			d.program, d.programText = a.program, d.text
+		}
+		if err := s.publishDiagnostics(d); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// bind binds the given files. Because the server is long-lived, any panic that occurs during binding is reported as
+// an error rather than allowed to terminate the server.
+func (s *Server) bind(files []*syntax.File) (program *hcl2.Program, diags hcl.Diagnostics, err error) {
+	defer func() {
+		if v := recover(); v != nil {
+			program, diags, err = nil, nil, errors.Errorf("internal error binding program: %v", v)
+		}
+	}()
+	return hcl2.BindProgram(files, hcl2.PluginHost(s.host), hcl2.Cache(s.cache))
+}
+
+// publishDiagnostics publishes the diagnostics that apply to the given document. Diagnostics that do not have a
+// source range are reported at the start of the document.
+func (s *Server) publishDiagnostics(doc *document) error {
+	diagnostics := []diagnostic{}
+	for _, d := range doc.analysis.diagnostics {
+		var rng lspRange
+		if d.Subject != nil {
+			if d.Subject.Filename != doc.path {
+				continue
+			}
+			rng = doc.lspRange(*d.Subject)
+		}
+
+		severity := severityError
+		if d.Severity == hcl.DiagWarning {
+			severity = severityWarning
+		}
+		message := d.Summary
+		if d.Detail != "" {
+			message = fmt.Sprintf("%s: %s", d.Summary, d.Detail)
+		}
+		diagnostics = append(diagnostics, diagnostic{
+			Range:    rng,
+			Severity: severity,
+			Source:   "pcl",
+			Message:  message,
+		})
+	}
+	return s.conn.notify("textDocument/publishDiagnostics", publishDiagnosticsParams{
+		URI:         doc.uri,
+		Diagnostics: diagnostics,
+	})
+}
+
+// contains returns true if the given range contains the given byte offset. Unlike hcl.Range.ContainsOffset, the end
+// of the range is inclusive, so that positions immediately after an identifier are considered part of it.
+func contains(rng hcl.Range, filename string, offset int) bool {
+	return rng.Filename == filename && rng.Start.Byte <= offset && offset <= rng.End.Byte
+}
+
+// nodeAt returns the top-level node of the document's program that contains the given offset, if any.
+func (d *document) nodeAt(offset int) (hcl2.Node, bool) {
+	if d.analysis == nil || d.analysis.program == nil {
+		return nil, false
+	}
+	for _, n := range d.analysis.program.Nodes {
+		if contains(n.SyntaxNode().Range(), d.path, offset) {
+			return n, true
+		}
+	}
+	return nil, false
+}
+
+// expressionsAt returns the expressions of the document's program that contain the given offset, from outermost to
+// innermost.
+func (d *document) expressionsAt(offset int) []model.Expression {
+	n, ok := d.nodeAt(offset)
+	if !ok {
+		return nil
+	}
+
+	// Expressions are visited in pre-order, so each expression that contains the offset is nested within the
+	// expressions that contain the offset and were visited before it.
+	var path []model.Expression
+	n.VisitExpressions(func(x model.Expression) (model.Expression, hcl.Diagnostics) {
+		if contains(x.SyntaxNode().Range(), d.path, offset) {
+			path = append(path, x)
+		}
+		return x, nil
+	}, model.IdentityVisitor)
+	return path
+}
+
+// findNode returns the top-level node of the program with the given name, if any. Outputs are not considered, as
+// they cannot be referenced.
+func findNode(program *hcl2.Program, name string) (hcl2.Node, bool) {
+	if program == nil {
+		return nil, false
+	}
+	for _, n := range program.Nodes {
+		if _, isOutput := n.(*hcl2.OutputVariable); !isOutput && n.Name() == name {
+			return n, true
+		}
+	}
+	return nil, false
+}
+
+// findFunction returns the schema for the function with the given token, if any.
+func findFunction(program *hcl2.Program, token string) (*schema.Function, bool) {
+	if program == nil {
+		return nil, false
+	}
+	for _, pkg := range program.Packages() {
+		canonical := canonicalizeToken(token, pkg)
+		for _, f := range pkg.Functions {
+			if f.Token == token || canonicalizeToken(f.Token, pkg) == canonical {
+				return f, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// canonicalizeToken converts a Pulumi token into its canonical "pkg:module:member" form. This matches the form of
+// the tokens recorded by the binder.
+func canonicalizeToken(tok string, pkg *schema.Package) string {
+	pkgName, _, member, _ := hcl2.DecomposeToken(tok, hcl.Range{})
+	return fmt.Sprintf("%s:%s:%s", pkgName, pkg.TokenToModule(tok), member)
+}
+
+// definitionRange returns the range of the name of the given node's definition.
+func definitionRange(n hcl2.Node) (hcl.Range, bool) {
+	switch n := n.(type) {
+	case *hcl2.Resource:
+		return n.Definition.Syntax.LabelRanges[0], true
+	case *hcl2.ConfigVariable:
+		return n.Definition.Syntax.LabelRanges[0], true
+	case *hcl2.LocalVariable:
+		return n.Definition.Syntax.NameRange, true
+	case *hcl2.OutputVariable:
+		return n.Definition.Syntax.LabelRanges[0], true
+	}
+	return hcl.Range{}, false
+}
+
+// stringLiteral returns the value of the given expression if it is a string literal.
+func stringLiteral(expr hclsyntax.Expression) (string, bool) {
+	template, ok := expr.(*hclsyntax.TemplateExpr)
+	if !ok || len(template.Parts) != 1 {
+		return "", false
+	}
+	lit, ok := template.Parts[0].(*hclsyntax.LiteralValueExpr)
+	if !ok || lit.Val.Type() != cty.String || !lit.Val.IsKnown() || lit.Val.IsNull() {
+		return "", false
+	}
+	return lit.Val.AsString(), true
+}
+
+// definition returns the location of the definition of the node referenced at the given offset, if any.
+func (s *Server) definition(doc *document, offset int) []location {
+	path := doc.expressionsAt(offset)
+	if len(path) == 0 {
+		return nil
+	}
+	traversal, ok := path[len(path)-1].(*model.ScopeTraversalExpression)
+	if !ok || len(traversal.Parts) == 0 {
+		return nil
+	}
+	n, ok := traversal.Parts[0].(hcl2.Node)
+	if !ok {
+		return nil
+	}
+	rng, ok := definitionRange(n)
+	if !ok {
+		return nil
+	}
+
+	target := doc
+	if rng.Filename != doc.path {
+		source, ok := doc.analysis.sources[rng.Filename]
+		if !ok {
+			return nil
+		}
+		target = &document{uri: pathToURI(rng.Filename), path: rng.Filename}
+		target.setText(source)
+	}
+	return []location{{URI: target.uri, Range: target.lspRange(rng)}}
+}
@@ -0,0 +1,318 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lsp
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pulumi/pulumi/pkg/v2/codegen"
+	"github.com/pulumi/pulumi/pkg/v2/codegen/hcl2"
+	"github.com/pulumi/pulumi/pkg/v2/codegen/hcl2/model"
+	"github.com/pulumi/pulumi/pkg/v2/codegen/schema"
+)
+
+// The completion context is determined from the text that precedes the cursor on the current line. This allows
+// completions to be offered while the document contains the syntax errors that are typical of partially-written
+// code.
+var (
+	// resourceTypePrefix matches the partial type token of a resource block.
+	resourceTypePrefix = regexp.MustCompile(`^\s*resource\s+[\w-]+\s+"([^"]*)$`)
+	// invokeTokenPrefix matches the partial token argument of a call to invoke.
+	invokeTokenPrefix = regexp.MustCompile(`invoke\(\s*"([^"]*)$`)
+	// memberPrefix matches a partial attribute access.
+	memberPrefix = regexp.MustCompile(`\.[\w-]*$`)
+	// traversalPrefix matches a partial attribute access whose receiver is a chain of named attributes, and captures
+	// the receiver.
+	traversalPrefix = regexp.MustCompile(`(?:^|[^\w-])([A-Za-z_][\w-]*(?:\.[A-Za-z_][\w-]*)*)\.[\w-]*$`)
+	// namePrefix matches a partial attribute or block name.
+	namePrefix = regexp.MustCompile(`^\s*[\w-]*$`)
+)
+
+// optionNames is the list of attributes that may appear in a resource's options block.
+var optionNames = []string{"dependsOn", "ignoreChanges", "protect", "provider", "range"}
+
+// completion returns the completions for the given offset.
+func (s *Server) completion(doc *document, offset int) *completionList {
+	prefix := doc.linePrefix(offset)
+
+	items := []completionItem{}
+	switch {
+	case resourceTypePrefix.MatchString(prefix):
+		partial := resourceTypePrefix.FindStringSubmatch(prefix)[1]
+		items = s.resourceTypeCompletions(doc.tokenRange(offset, partial))
+	case invokeTokenPrefix.MatchString(prefix):
+		partial := invokeTokenPrefix.FindStringSubmatch(prefix)[1]
+		items = s.functionCompletions(doc.tokenRange(offset, partial))
+	case memberPrefix.MatchString(prefix):
+		if m := traversalPrefix.FindStringSubmatch(prefix); m != nil {
+			items = doc.attributeCompletions(strings.Split(m[1], "."))
+		}
+	case namePrefix.MatchString(prefix):
+		items = doc.bodyCompletions(offset)
+	default:
+		items = doc.expressionCompletions()
+	}
+	return &completionList{Items: items}
+}
+
+// tokenRange returns the range of the partial token that ends at the given offset.
+func (d *document) tokenRange(offset int, partial string) lspRange {
+	return lspRange{Start: d.position(offset - len(partial)), End: d.position(offset)}
+}
+
+// documentation returns the markdown documentation for a completion item, if any.
+func documentation(comment string) *markupContent {
+	if comment == "" {
+		return nil
+	}
+	return &markupContent{Kind: "markdown", Value: comment}
+}
+
+// resourceTypeCompletions returns completions for the type tokens of the resources provided by the installed
+// packages, including their providers.
+func (s *Server) resourceTypeCompletions(rng lspRange) []completionItem {
+	items := []completionItem{}
+	for _, pkg := range s.installedPackages() {
+		token := "pulumi:providers:" + pkg.Name
+		items = append(items, completionItem{
+			Label:    token,
+			Kind:     completionKindClass,
+			Detail:   fmt.Sprintf("The provider for the %s package", pkg.Name),
+			TextEdit: &textEdit{Range: rng, NewText: token},
+		})
+		for _, r := range pkg.Resources {
+			items = append(items, completionItem{
+				Label:         r.Token,
+				Kind:          completionKindClass,
+				Documentation: documentation(r.Comment),
+				TextEdit:      &textEdit{Range: rng, NewText: r.Token},
+			})
+		}
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].Label < items[j].Label })
+	return items
+}
+
+// functionCompletions returns completions for the tokens of the functions provided by the installed packages.
+func (s *Server) functionCompletions(rng lspRange) []completionItem {
+	items := []completionItem{}
+	for _, pkg := range s.installedPackages() {
+		for _, f := range pkg.Functions {
+			items = append(items, completionItem{
+				Label:         f.Token,
+				Kind:          completionKindFunction,
+				Documentation: documentation(f.Comment),
+				TextEdit:      &textEdit{Range: rng, NewText: f.Token},
+			})
+		}
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].Label < items[j].Label })
+	return items
+}
+
+// unwrapEventuals returns the element type of the given type if it is an output or a promise.
+func unwrapEventuals(t model.Type) model.Type {
+	for {
+		switch e := t.(type) {
+		case *model.OutputType:
+			t = e.ElementType
+		case *model.PromiseType:
+			t = e.ElementType
+		default:
+			return t
+		}
+	}
+}
+
+// attributeCompletions returns completions for the attributes of the value named by the given traversal.
+func (d *document) attributeCompletions(traversal []string) []completionItem {
+	n, ok := findNode(d.program, traversal[0])
+	if !ok {
+		return []completionItem{}
+	}
+
+	t := unwrapEventuals(n.Type())
+	for _, name := range traversal[1:] {
+		obj, ok := t.(*model.ObjectType)
+		if !ok {
+			return []completionItem{}
+		}
+		if t, ok = obj.Properties[name]; !ok {
+			return []completionItem{}
+		}
+		t = unwrapEventuals(t)
+	}
+	obj, ok := t.(*model.ObjectType)
+	if !ok {
+		return []completionItem{}
+	}
+
+	// Object types that were derived from a schema are annotated with the schema's type, which documents their
+	// properties.
+	var properties []*schema.Property
+	for _, a := range obj.Annotations {
+		if so, ok := a.(*schema.ObjectType); ok {
+			properties = so.Properties
+		}
+	}
+
+	items := []completionItem{}
+	for _, name := range sortedKeys(obj.Properties) {
+		item := completionItem{
+			Label:  name,
+			Kind:   completionKindProperty,
+			Detail: fmt.Sprintf("%v", unwrapEventuals(obj.Properties[name])),
+		}
+		if p, ok := findProperty(properties, name); ok {
+			item.Documentation = documentation(p.Comment)
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+// bodyCompletions returns completions for the names of the attributes and blocks that may appear in the body that
+// contains the given offset.
+func (d *document) bodyCompletions(offset int) []completionItem {
+	if d.program == nil {
+		return keywordCompletions("config", "output", "resource")
+	}
+
+	offset = d.programOffset(offset)
+	for _, n := range d.program.Nodes {
+		if !contains(n.SyntaxNode().Range(), d.path, offset) {
+			continue
+		}
+
+		switch n := n.(type) {
+		case *hcl2.Resource:
+			return d.resourceBodyCompletions(n, offset)
+		case *hcl2.ConfigVariable:
+			return keywordCompletions("default")
+		case *hcl2.OutputVariable:
+			return keywordCompletions("value")
+		}
+		return []completionItem{}
+	}
+	return keywordCompletions("config", "output", "resource")
+}
+
+// resourceBodyCompletions returns completions for the names of the attributes and blocks that may appear at the
+// given offset within a resource's body.
+func (d *document) resourceBodyCompletions(r *hcl2.Resource, offset int) []completionItem {
+	if !contains(r.Definition.Syntax.Body.SrcRange, d.path, offset) {
+		return []completionItem{}
+	}
+	if r.Options != nil && contains(r.Options.Definition.Syntax.Body.SrcRange, d.path, offset) {
+		return keywordCompletions(optionNames...)
+	}
+
+	// If the offset is within the value of one of the resource's inputs, offer the properties of the input's type.
+	properties, present := []*schema.Property(nil), codegen.StringSet{}
+	if r.Schema != nil {
+		properties = r.Schema.InputProperties
+	}
+	for _, input := range r.Inputs {
+		if !contains(input.Syntax.Expr.Range(), d.path, offset) {
+			present.Add(input.Name)
+			continue
+		}
+
+		p, ok := findProperty(properties, input.Name)
+		if !ok {
+			return []completionItem{}
+		}
+		t := p.Type
+		if array, ok := t.(*schema.ArrayType); ok {
+			t = array.ElementType
+		}
+		obj, ok := t.(*schema.ObjectType)
+		if !ok {
+			return []completionItem{}
+		}
+		return propertyCompletions(obj.Properties, nil)
+	}
+
+	items := propertyCompletions(properties, present)
+	if r.Options == nil {
+		items = append(items, keywordCompletions("options")...)
+	}
+	return items
+}
+
+// propertyCompletions returns completions for the given properties, excluding those that are already present.
+func propertyCompletions(properties []*schema.Property, present codegen.StringSet) []completionItem {
+	items := []completionItem{}
+	for _, p := range properties {
+		if present.Has(p.Name) {
+			continue
+		}
+		items = append(items, completionItem{
+			Label:         p.Name,
+			Kind:          completionKindField,
+			Detail:        fmt.Sprintf("%v", p.Type),
+			Documentation: documentation(p.Comment),
+		})
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].Label < items[j].Label })
+	return items
+}
+
+// expressionCompletions returns completions for the names that may be referenced by an expression: the program's
+// config variables, locals, and resources, and the builtin functions.
+func (d *document) expressionCompletions() []completionItem {
+	items := []completionItem{}
+	if d.program != nil {
+		for _, n := range d.program.Nodes {
+			if _, isOutput := n.(*hcl2.OutputVariable); isOutput {
+				continue
+			}
+			items = append(items, completionItem{
+				Label:  n.Name(),
+				Kind:   completionKindVariable,
+				Detail: fmt.Sprintf("%v", n.Type()),
+			})
+		}
+	}
+	for _, name := range hcl2.BuiltinFunctionNames() {
+		items = append(items, completionItem{
+			Label: name,
+			Kind:  completionKindFunction,
+		})
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].Label < items[j].Label })
+	return items
+}
+
+// keywordCompletions returns completions for the given keywords.
+func keywordCompletions(keywords ...string) []completionItem {
+	items := make([]completionItem, len(keywords))
+	for i, k := range keywords {
+		items[i] = completionItem{Label: k, Kind: completionKindKeyword}
+	}
+	return items
+}
+
+func sortedKeys(m map[string]model.Type) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,197 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lsp
+
+import (
+	"net/url"
+	"path/filepath"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/pkg/errors"
+
+	"github.com/pulumi/pulumi/pkg/v2/codegen/hcl2"
+	"github.com/pulumi/pulumi/pkg/v2/codegen/hcl2/syntax"
+)
+
+// document is an open text document.
+type document struct {
+	uri     string
+	path    string
+	version int
+	text    string
+
+	// lines holds the byte offset of the start of each line in the document's text.
+	lines []int
+
+	// file is the result of parsing the document's current text.
+	file *syntax.File
+	// analysis is the analysis of the program that contains the document, if the analysis reflects the document's
+	// current text.
+	analysis *analysis
+	// program is the most recently bound program that contains the document. Unlike analysis.program, this program
+	// may not reflect the document's current text: it is used to offer completions while the document is being
+	// edited, which often leaves it with syntax errors.
+	program *hcl2.Program
+	// programText is the text of the document when program was bound.
+	programText string
+}
+
+func newDocument(uri string, version int, text string) (*document, error) {
+	path, err := uriToPath(uri)
+	if err != nil {
+		return nil, err
+	}
+	doc := &document{uri: uri, path: path, version: version}
+	doc.setText(text)
+	return doc, nil
+}
+
+// setText replaces the document's text.
+func (d *document) setText(text string) {
+	d.text, d.lines, d.file, d.analysis = text, []int{0}, nil, nil
+	for i := 0; i < len(text); i++ {
+		if text[i] == '\n' {
+			d.lines = append(d.lines, i+1)
+		}
+	}
+}
+
+// applyChange applies a content change to the document's text.
+func (d *document) applyChange(change textDocumentContentChangeEvent) {
+	if change.Range == nil {
+		d.setText(change.Text)
+		return
+	}
+	start, end := d.offset(change.Range.Start), d.offset(change.Range.End)
+	if end < start {
+		start, end = end, start
+	}
+	d.setText(d.text[:start] + change.Text + d.text[end:])
+}
+
+// offset converts an LSP position into a byte offset into the document's text. Positions past the end of a line or
+// past the end of the document are clamped.
+func (d *document) offset(pos position) int {
+	if pos.Line < 0 {
+		return 0
+	}
+	if pos.Line >= len(d.lines) {
+		return len(d.text)
+	}
+
+	offset, units := d.lines[pos.Line], 0
+	for offset < len(d.text) && units < pos.Character {
+		r, size := utf8.DecodeRuneInString(d.text[offset:])
+		if r == '\n' {
+			break
+		}
+		units += utf16Len(r)
+		offset += size
+	}
+	return offset
+}
+
+// position converts a byte offset into the document's text into an LSP position.
+func (d *document) position(offset int) position {
+	if offset > len(d.text) {
+		offset = len(d.text)
+	}
+
+	line := 0
+	for line+1 < len(d.lines) && d.lines[line+1] <= offset {
+		line++
+	}
+
+	character := 0
+	for _, r := range d.text[d.lines[line]:offset] {
+		character += utf16Len(r)
+	}
+	return position{Line: line, Character: character}
+}
+
+// programOffset maps an offset into the document's current text to the corresponding offset into the text of the
+// document when its program was last bound. Offsets within the portion of the text that has changed since then are
+// mapped to the start of the change.
+func (d *document) programOffset(offset int) int {
+	old, cur := d.programText, d.text
+
+	prefix := 0
+	for prefix < len(old) && prefix < len(cur) && old[prefix] == cur[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(old)-prefix && suffix < len(cur)-prefix && old[len(old)-1-suffix] == cur[len(cur)-1-suffix] {
+		suffix++
+	}
+
+	switch {
+	case offset <= prefix:
+		return offset
+	case offset >= len(cur)-suffix:
+		return offset - len(cur) + len(old)
+	default:
+		return prefix
+	}
+}
+
+// utf16Len returns the number of UTF-16 code units needed to encode the given rune.
+func utf16Len(r rune) int {
+	if r1, _ := utf16.EncodeRune(r); r1 != unicode.ReplacementChar {
+		return 2
+	}
+	return 1
+}
+
+// lspRange converts an HCL source range within the document into an LSP range.
+func (d *document) lspRange(rng hcl.Range) lspRange {
+	return lspRange{Start: d.position(rng.Start.Byte), End: d.position(rng.End.Byte)}
+}
+
+// linePrefix returns the text of the line that contains the given offset up to the offset.
+func (d *document) linePrefix(offset int) string {
+	start := strings.LastIndexByte(d.text[:offset], '\n') + 1
+	return d.text[start:offset]
+}
+
+// uriToPath converts a file URI into a local path.
+func uriToPath(uri string) (string, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return "", err
+	}
+	if u.Scheme != "file" {
+		return "", errors.Errorf("unsupported URI scheme %q", u.Scheme)
+	}
+	path := u.Path
+	if len(path) >= 3 && path[0] == '/' && path[2] == ':' {
+		// Windows paths are of the form "/C:/path/to/file".
+		path = path[1:]
+	}
+	return filepath.FromSlash(path), nil
+}
+
+// pathToURI converts a local path into a file URI.
+func pathToURI(path string) string {
+	path = filepath.ToSlash(path)
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	u := url.URL{Scheme: "file", Path: path}
+	return u.String()
+}
@@ -0,0 +1,129 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lsp
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+
+	"github.com/pulumi/pulumi/pkg/v2/codegen/hcl2"
+	"github.com/pulumi/pulumi/pkg/v2/codegen/hcl2/model"
+	"github.com/pulumi/pulumi/pkg/v2/codegen/hcl2/syntax"
+)
+
+// format returns the edits that format the given document. The document is printed from its bound model, which
+// preserves comments, and the result is then normalized using the canonical HCL layout rules. Documents that contain
+// errors are not formatted.
+func (s *Server) format(doc *document, options formattingOptions) []textEdit {
+	a := doc.analysis
+	if a == nil || a.program == nil || doc.file == nil {
+		return nil
+	}
+	for _, d := range a.diagnostics {
+		if d.Severity == hcl.DiagError && (d.Subject == nil || d.Subject.Filename == doc.path) {
+			return nil
+		}
+	}
+
+	// Gather the definitions of the document's nodes in source order.
+	var nodes []hcl2.Node
+	for _, n := range a.program.Nodes {
+		if n.SyntaxNode().Range().Filename == doc.path {
+			nodes = append(nodes, n)
+		}
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].SyntaxNode().Range().Start.Byte < nodes[j].SyntaxNode().Range().Start.Byte
+	})
+	body := &model.Body{Syntax: doc.file.Body}
+	body.Tokens, _ = doc.file.Tokens.ForNode(doc.file.Body).(*syntax.BodyTokens)
+	for _, n := range nodes {
+		switch n := n.(type) {
+		case *hcl2.Resource:
+			body.Items = append(body.Items, n.Definition)
+		case *hcl2.ConfigVariable:
+			body.Items = append(body.Items, n.Definition)
+		case *hcl2.LocalVariable:
+			body.Items = append(body.Items, n.Definition)
+		case *hcl2.OutputVariable:
+			body.Items = append(body.Items, n.Definition)
+		}
+	}
+
+	formatted := reindent(hclwrite.Format([]byte(fmt.Sprintf("%v", body))), options)
+	if formatted == doc.text {
+		return []textEdit{}
+	}
+	return []textEdit{{
+		Range:   lspRange{Start: doc.position(0), End: doc.position(len(doc.text))},
+		NewText: formatted,
+	}}
+}
+
+// reindent replaces the two-space indentation produced by hclwrite with the indentation requested by the given
+// options. The contents of heredocs are left untouched.
+func reindent(src []byte, options formattingOptions) string {
+	indent := "\t"
+	if options.InsertSpaces {
+		tabSize := options.TabSize
+		if tabSize <= 0 {
+			tabSize = 4
+		}
+		indent = strings.Repeat(" ", tabSize)
+	}
+
+	// Find the ranges of any heredoc bodies.
+	var heredocs []hcl.Range
+	tokens, _ := hclsyntax.LexConfig(src, "", hcl.InitialPos)
+	for i, tok := range tokens {
+		if tok.Type != hclsyntax.TokenOHeredoc {
+			continue
+		}
+		for j := i + 1; j < len(tokens); j++ {
+			if tokens[j].Type == hclsyntax.TokenCHeredoc {
+				heredocs = append(heredocs, hcl.Range{Start: tok.Range.End, End: tokens[j].Range.End})
+				break
+			}
+		}
+	}
+	inHeredoc := func(offset int) bool {
+		for _, rng := range heredocs {
+			if rng.Start.Byte <= offset && offset < rng.End.Byte {
+				return true
+			}
+		}
+		return false
+	}
+
+	var buf bytes.Buffer
+	offset := 0
+	for _, line := range strings.SplitAfter(string(src), "\n") {
+		if inHeredoc(offset) {
+			buf.WriteString(line)
+		} else {
+			trimmed := strings.TrimLeft(line, " ")
+			depth := (len(line) - len(trimmed)) / 2
+			buf.WriteString(strings.Repeat(indent, depth) + trimmed)
+		}
+		offset += len(line)
+	}
+	return buf.String()
+}
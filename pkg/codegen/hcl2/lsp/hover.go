@@ -0,0 +1,194 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lsp
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+
+	"github.com/pulumi/pulumi/pkg/v2/codegen/hcl2"
+	"github.com/pulumi/pulumi/pkg/v2/codegen/hcl2/model"
+	"github.com/pulumi/pulumi/pkg/v2/codegen/schema"
+)
+
+// hoverText formats the contents of a hover: a signature in a code block followed by an optional comment.
+func hoverText(signature, comment string) string {
+	text := fmt.Sprintf("```\n%s\n```", signature)
+	if comment != "" {
+		text += "\n\n" + comment
+	}
+	return text
+}
+
+// propertyHover returns the hover text for a schema property.
+func propertyHover(p *schema.Property) string {
+	return hoverText(fmt.Sprintf("%s: %v", p.Name, p.Type), p.Comment)
+}
+
+// findProperty returns the property with the given name, if any.
+func findProperty(properties []*schema.Property, name string) (*schema.Property, bool) {
+	for _, p := range properties {
+		if p.Name == name {
+			return p, true
+		}
+	}
+	return nil, false
+}
+
+// nodeHover returns the hover text for a top-level node.
+func nodeHover(n hcl2.Node) string {
+	switch n := n.(type) {
+	case *hcl2.Resource:
+		comment := ""
+		if n.Schema != nil {
+			comment = n.Schema.Comment
+		}
+		return hoverText(fmt.Sprintf("resource %s %q", n.Name(), n.Definition.Labels[1]), comment)
+	case *hcl2.ConfigVariable:
+		return hoverText(fmt.Sprintf("config %s %v", n.Name(), n.Type()), "")
+	case *hcl2.OutputVariable:
+		return hoverText(fmt.Sprintf("output %s: %v", n.Name(), n.Type()), "")
+	default:
+		return hoverText(fmt.Sprintf("%s: %v", n.Name(), n.Type()), "")
+	}
+}
+
+// hover returns information about the symbol at the given offset, if any.
+func (s *Server) hover(doc *document, offset int) *hover {
+	n, ok := doc.nodeAt(offset)
+	if !ok {
+		return nil
+	}
+
+	// Check for the labels of the node's block or the names of a resource's input properties.
+	switch n := n.(type) {
+	case *hcl2.Resource:
+		for _, rng := range n.Definition.Syntax.LabelRanges {
+			if contains(rng, doc.path, offset) {
+				return doc.hoverAt(rng, nodeHover(n))
+			}
+		}
+		if n.Schema != nil {
+			for _, input := range n.Inputs {
+				if !contains(input.Syntax.NameRange, doc.path, offset) {
+					continue
+				}
+				if p, ok := findProperty(n.Schema.InputProperties, input.Name); ok {
+					return doc.hoverAt(input.Syntax.NameRange, propertyHover(p))
+				}
+			}
+		}
+	case *hcl2.ConfigVariable:
+		if rng := n.Definition.Syntax.LabelRanges[0]; contains(rng, doc.path, offset) {
+			return doc.hoverAt(rng, nodeHover(n))
+		}
+	case *hcl2.OutputVariable:
+		if rng := n.Definition.Syntax.LabelRanges[0]; contains(rng, doc.path, offset) {
+			return doc.hoverAt(rng, nodeHover(n))
+		}
+	case *hcl2.LocalVariable:
+		if rng := n.Definition.Syntax.NameRange; contains(rng, doc.path, offset) {
+			return doc.hoverAt(rng, nodeHover(n))
+		}
+	}
+
+	path := doc.expressionsAt(offset)
+	if len(path) == 0 {
+		return nil
+	}
+	switch x := path[len(path)-1].(type) {
+	case *model.ScopeTraversalExpression:
+		return doc.traversalHover(x, offset)
+	case *model.FunctionCallExpression:
+		if rng := x.Syntax.NameRange; contains(rng, doc.path, offset) {
+			return doc.hoverAt(rng, hoverText(functionSignature(x.Name, x.Signature), ""))
+		}
+	}
+
+	// Check for the token argument to a call to invoke.
+	for i := len(path) - 1; i >= 0; i-- {
+		call, ok := path[i].(*model.FunctionCallExpression)
+		if !ok || call.Name != "invoke" || len(call.Syntax.Args) == 0 {
+			continue
+		}
+		arg := call.Syntax.Args[0]
+		if tok, ok := stringLiteral(arg); ok && contains(arg.Range(), doc.path, offset) {
+			if f, ok := findFunction(doc.analysis.program, tok); ok {
+				return doc.hoverAt(arg.Range(), hoverText(fmt.Sprintf("function %q", f.Token), f.Comment))
+			}
+		}
+		break
+	}
+	return nil
+}
+
+// traversalHover returns the hover for the part of a scope traversal at the given offset.
+func (d *document) traversalHover(x *model.ScopeTraversalExpression, offset int) *hover {
+	for i, traverser := range x.Traversal {
+		rng := traverser.SourceRange()
+		if !contains(rng, d.path, offset) || i >= len(x.Parts) {
+			continue
+		}
+
+		if i == 0 {
+			switch root := x.Parts[0].(type) {
+			case hcl2.Node:
+				return d.hoverAt(rng, nodeHover(root))
+			case *model.Variable:
+				return d.hoverAt(rng, hoverText(fmt.Sprintf("%s: %v", root.Name, root.Type()), ""))
+			}
+			return nil
+		}
+
+		attr, ok := traverser.(hcl.TraverseAttr)
+		if !ok {
+			return nil
+		}
+		// Attributes of resources are documented by the resource's schema.
+		if r, ok := x.Parts[0].(*hcl2.Resource); ok && i == 1 && r.Schema != nil {
+			if p, ok := findProperty(r.Schema.Properties, attr.Name); ok {
+				return d.hoverAt(rng, propertyHover(p))
+			}
+		}
+		if t, ok := x.Parts[i].(model.Type); ok {
+			return d.hoverAt(rng, hoverText(fmt.Sprintf("%s: %v", attr.Name, t), ""))
+		}
+		return nil
+	}
+	return nil
+}
+
+// hoverAt returns a hover with the given markdown contents that applies to the given range.
+func (d *document) hoverAt(rng hcl.Range, contents string) *hover {
+	lspRange := d.lspRange(rng)
+	return &hover{
+		Contents: markupContent{Kind: "markdown", Value: contents},
+		Range:    &lspRange,
+	}
+}
+
+// functionSignature formats the signature of a function.
+func functionSignature(name string, signature model.StaticFunctionSignature) string {
+	var params []string
+	for _, p := range signature.Parameters {
+		params = append(params, fmt.Sprintf("%s: %v", p.Name, p.Type))
+	}
+	if p := signature.VarargsParameter; p != nil {
+		params = append(params, fmt.Sprintf("%s...: %v", p.Name, p.Type))
+	}
+	return fmt.Sprintf("%s(%s): %v", name, strings.Join(params, ", "), signature.ReturnType)
+}
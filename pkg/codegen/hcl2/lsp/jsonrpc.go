@@ -0,0 +1,144 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/textproto"
+	"strconv"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// JSON-RPC error codes.
+const (
+	parseError     = -32700
+	invalidRequest = -32600
+	methodNotFound = -32601
+	invalidParams  = -32602
+	internalError  = -32603
+)
+
+// message is a JSON-RPC 2.0 request, response, or notification. Requests carry an ID and a method, notifications
+// carry only a method, and responses carry only an ID.
+type message struct {
+	JSONRPC string           `json:"jsonrpc"`
+	ID      *json.RawMessage `json:"id,omitempty"`
+	Method  string           `json:"method,omitempty"`
+	Params  json.RawMessage  `json:"params,omitempty"`
+	Result  *json.RawMessage `json:"result,omitempty"`
+	Error   *responseError   `json:"error,omitempty"`
+}
+
+// responseError is the error member of a JSON-RPC response.
+type responseError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *responseError) Error() string {
+	return e.Message
+}
+
+// errorf returns a responseError with the given code and formatted message.
+func errorf(code int, format string, args ...interface{}) *responseError {
+	return &responseError{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+// conn reads and writes JSON-RPC messages using the base protocol of the Language Server Protocol: each message is
+// preceded by a header that gives the length of its content.
+type conn struct {
+	r *textproto.Reader
+
+	m sync.Mutex
+	w io.Writer
+}
+
+func newConn(r io.Reader, w io.Writer) *conn {
+	return &conn{r: textproto.NewReader(bufio.NewReader(r)), w: w}
+}
+
+// read reads the next message from the connection.
+func (c *conn) read() (*message, error) {
+	header, err := c.r.ReadMIMEHeader()
+	if err != nil {
+		return nil, err
+	}
+	length, err := strconv.Atoi(header.Get("Content-Length"))
+	if err != nil || length < 0 {
+		return nil, errors.Errorf("invalid Content-Length %q", header.Get("Content-Length"))
+	}
+
+	content := make([]byte, length)
+	if _, err := io.ReadFull(c.r.R, content); err != nil {
+		return nil, err
+	}
+
+	var msg message
+	if err := json.Unmarshal(content, &msg); err != nil {
+		return nil, errorf(parseError, "%v", err)
+	}
+	return &msg, nil
+}
+
+// write writes a message to the connection.
+func (c *conn) write(msg *message) error {
+	msg.JSONRPC = "2.0"
+	content, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	if _, err = fmt.Fprintf(c.w, "Content-Length: %d\r\n\r\n", len(content)); err != nil {
+		return err
+	}
+	_, err = c.w.Write(content)
+	return err
+}
+
+// reply writes the response to the request with the given ID. If err is non-nil, the response carries an error
+// rather than a result.
+func (c *conn) reply(id *json.RawMessage, result interface{}, err error) error {
+	if err != nil {
+		rerr, ok := err.(*responseError)
+		if !ok {
+			rerr = &responseError{Code: internalError, Message: err.Error()}
+		}
+		return c.write(&message{ID: id, Error: rerr})
+	}
+
+	content, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	raw := json.RawMessage(content)
+	return c.write(&message{ID: id, Result: &raw})
+}
+
+// notify writes a notification with the given method and parameters.
+func (c *conn) notify(method string, params interface{}) error {
+	content, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+	return c.write(&message{Method: method, Params: content})
+}
@@ -0,0 +1,272 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package lsp implements a Language Server Protocol server for programs written in the Pulumi Configuration Language
+// (PCL). The server publishes the diagnostics produced by the PCL binder as documents change, and offers hover,
+// completion, go-to-definition, and formatting. Package schemas are loaded from the installed resource plugins.
+package lsp
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/pulumi/pulumi/pkg/v2/codegen/hcl2"
+	"github.com/pulumi/pulumi/pkg/v2/codegen/schema"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/logging"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/workspace"
+)
+
+// Server is a language server for PCL programs. The server treats each directory as a single program: all of the
+// `.pp` files in the directory of a document are bound together, using the contents of open documents in place of
+// the contents of the corresponding files on disk.
+type Server struct {
+	host    plugin.Host
+	cache   *hcl2.PackageCache
+	loader  schema.Loader
+	plugins func() ([]workspace.PluginInfo, error)
+
+	conn      *conn
+	documents map[string]*document
+
+	// installed holds the schemas of the installed resource plugins' packages once they have been loaded.
+	installed []*schema.Package
+
+	shutdown bool
+}
+
+// NewServer creates a new language server that uses the given plugin host to load package schemas.
+func NewServer(host plugin.Host) *Server {
+	return &Server{
+		host:      host,
+		cache:     hcl2.NewPackageCache(),
+		loader:    schema.NewPluginLoader(host),
+		plugins:   workspace.GetPlugins,
+		documents: map[string]*document{},
+	}
+}
+
+// Serve reads requests from r and writes responses to w until the client sends an exit notification or r is closed.
+func (s *Server) Serve(r io.Reader, w io.Writer) error {
+	s.conn = newConn(r, w)
+	for {
+		msg, err := s.conn.read()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			if rerr, ok := err.(*responseError); ok {
+				if err = s.conn.reply(nil, nil, rerr); err != nil {
+					return err
+				}
+				continue
+			}
+			return err
+		}
+
+		if msg.Method == "exit" {
+			return nil
+		}
+
+		result, err := s.handle(msg)
+		if msg.ID == nil {
+			// Notifications have no responses.
+			if err != nil {
+				logging.V(5).Infof("lsp: %v: %v", msg.Method, err)
+			}
+			continue
+		}
+		if err = s.conn.reply(msg.ID, result, err); err != nil {
+			return err
+		}
+	}
+}
+
+// handle dispatches a request or notification to its handler.
+func (s *Server) handle(msg *message) (interface{}, error) {
+	if s.shutdown && msg.Method != "exit" {
+		return nil, errorf(invalidRequest, "the server is shutting down")
+	}
+
+	switch msg.Method {
+	case "initialize":
+		return initializeResult{
+			Capabilities: serverCapabilities{
+				TextDocumentSync: textDocumentSyncFull,
+				HoverProvider:    true,
+				CompletionProvider: &completionOptions{
+					TriggerCharacters: []string{".", "\""},
+				},
+				DefinitionProvider:         true,
+				DocumentFormattingProvider: true,
+			},
+			ServerInfo: &serverInfo{Name: "pulumi-pcl"},
+		}, nil
+	case "initialized":
+		return nil, nil
+	case "shutdown":
+		s.shutdown = true
+		return nil, nil
+	case "textDocument/didOpen":
+		var params didOpenTextDocumentParams
+		if err := unmarshalParams(msg, &params); err != nil {
+			return nil, err
+		}
+		return nil, s.didOpen(params)
+	case "textDocument/didChange":
+		var params didChangeTextDocumentParams
+		if err := unmarshalParams(msg, &params); err != nil {
+			return nil, err
+		}
+		return nil, s.didChange(params)
+	case "textDocument/didClose":
+		var params didCloseTextDocumentParams
+		if err := unmarshalParams(msg, &params); err != nil {
+			return nil, err
+		}
+		return nil, s.didClose(params)
+	case "textDocument/hover":
+		var params textDocumentPositionParams
+		if err := unmarshalParams(msg, &params); err != nil {
+			return nil, err
+		}
+		doc, err := s.document(params.TextDocument.URI)
+		if err != nil {
+			return nil, err
+		}
+		return s.hover(doc, doc.offset(params.Position)), nil
+	case "textDocument/completion":
+		var params textDocumentPositionParams
+		if err := unmarshalParams(msg, &params); err != nil {
+			return nil, err
+		}
+		doc, err := s.document(params.TextDocument.URI)
+		if err != nil {
+			return nil, err
+		}
+		return s.completion(doc, doc.offset(params.Position)), nil
+	case "textDocument/definition":
+		var params textDocumentPositionParams
+		if err := unmarshalParams(msg, &params); err != nil {
+			return nil, err
+		}
+		doc, err := s.document(params.TextDocument.URI)
+		if err != nil {
+			return nil, err
+		}
+		return s.definition(doc, doc.offset(params.Position)), nil
+	case "textDocument/formatting":
+		var params documentFormattingParams
+		if err := unmarshalParams(msg, &params); err != nil {
+			return nil, err
+		}
+		doc, err := s.document(params.TextDocument.URI)
+		if err != nil {
+			return nil, err
+		}
+		return s.format(doc, params.Options), nil
+	default:
+		return nil, errorf(methodNotFound, "method %q not found", msg.Method)
+	}
+}
+
+func unmarshalParams(msg *message, params interface{}) error {
+	if err := json.Unmarshal(msg.Params, params); err != nil {
+		return errorf(invalidParams, "invalid parameters for %v: %v", msg.Method, err)
+	}
+	return nil
+}
+
+// document returns the open document with the given URI.
+func (s *Server) document(uri string) (*document, error) {
+	doc, ok := s.documents[uri]
+	if !ok {
+		return nil, errorf(invalidParams, "document %v is not open", uri)
+	}
+	return doc, nil
+}
+
+func (s *Server) didOpen(params didOpenTextDocumentParams) error {
+	item := params.TextDocument
+	doc, err := newDocument(item.URI, item.Version, item.Text)
+	if err != nil {
+		return err
+	}
+	s.documents[item.URI] = doc
+	return s.analyze(doc)
+}
+
+func (s *Server) didChange(params didChangeTextDocumentParams) error {
+	doc, err := s.document(params.TextDocument.URI)
+	if err != nil {
+		return err
+	}
+	for _, change := range params.ContentChanges {
+		doc.applyChange(change)
+	}
+	doc.version = params.TextDocument.Version
+	return s.analyze(doc)
+}
+
+func (s *Server) didClose(params didCloseTextDocumentParams) error {
+	doc, err := s.document(params.TextDocument.URI)
+	if err != nil {
+		return err
+	}
+	delete(s.documents, doc.uri)
+
+	// Clear the document's diagnostics and re-analyze its program using the contents of the file on disk.
+	if err := s.conn.notify("textDocument/publishDiagnostics", publishDiagnosticsParams{
+		URI:         doc.uri,
+		Diagnostics: []diagnostic{},
+	}); err != nil {
+		return err
+	}
+	for _, other := range s.documents {
+		if sameProgram(doc, other) {
+			return s.analyze(other)
+		}
+	}
+	return nil
+}
+
+// installedPackages returns the schemas of the packages provided by the installed resource plugins. Plugins whose
+// schemas cannot be loaded are skipped.
+func (s *Server) installedPackages() []*schema.Package {
+	if s.installed != nil {
+		return s.installed
+	}
+
+	s.installed = []*schema.Package{}
+	plugins, err := s.plugins()
+	if err != nil {
+		logging.V(5).Infof("lsp: listing plugins: %v", err)
+		return s.installed
+	}
+	seen := map[string]bool{}
+	for _, info := range plugins {
+		if info.Kind != workspace.ResourcePlugin || seen[info.Name] {
+			continue
+		}
+		seen[info.Name] = true
+
+		pkg, err := s.loader.LoadPackage(info.Name, nil)
+		if err != nil {
+			logging.V(5).Infof("lsp: loading schema for %v: %v", info.Name, err)
+			continue
+		}
+		s.installed = append(s.installed, pkg)
+	}
+	return s.installed
+}
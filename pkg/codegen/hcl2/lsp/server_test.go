@@ -0,0 +1,315 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lsp
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/blang/semver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v2/resource/deploy/deploytest"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/workspace"
+)
+
+const testSchema = `{
+	"name": "test",
+	"resources": {
+		"test:index:Bucket": {
+			"description": "A bucket.",
+			"properties": {
+				"arn": {"type": "string", "description": "The bucket's ARN."},
+				"name": {"type": "string"}
+			},
+			"inputProperties": {
+				"name": {"type": "string", "description": "The name of the bucket."},
+				"website": {"$ref": "#/types/test:index:Website"}
+			}
+		}
+	},
+	"types": {
+		"test:index:Website": {
+			"type": "object",
+			"properties": {
+				"indexDocument": {"type": "string", "description": "The index document."}
+			}
+		}
+	},
+	"functions": {
+		"test:index:getRegion": {
+			"description": "Gets the current region.",
+			"outputs": {
+				"properties": {
+					"name": {"type": "string"}
+				}
+			}
+		}
+	}
+}`
+
+const testProgram = `config prefix "string" {
+	default = "my"
+}
+
+// The bucket.
+resource bucket "test:index:Bucket" {
+	name = "${prefix}-bucket"
+}
+
+region = invoke("test:index:getRegion", {})
+
+output arn {
+	value = bucket.arn
+}
+`
+
+// newTestServer creates a server whose plugin host provides the test schema. The server's output is written to the
+// returned buffer.
+func newTestServer(t *testing.T) (*Server, *bytes.Buffer) {
+	host := deploytest.NewPluginHost(nil, nil, nil,
+		deploytest.NewProviderLoader("test", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				GetSchemaF: func(version int) ([]byte, error) {
+					return []byte(testSchema), nil
+				},
+			}, nil
+		}))
+
+	s := NewServer(host)
+	s.plugins = func() ([]workspace.PluginInfo, error) {
+		return []workspace.PluginInfo{{Name: "test", Kind: workspace.ResourcePlugin}}, nil
+	}
+
+	var out bytes.Buffer
+	s.conn = newConn(strings.NewReader(""), &out)
+	return s, &out
+}
+
+// openDocument opens a document with the given text. Each document is placed in its own directory, which does not
+// exist on disk, so each document is its own program.
+func openDocument(t *testing.T, s *Server, text string) *document {
+	uri := pathToURI(filepath.Join(os.TempDir(), "pcl-lsp-test", fmt.Sprintf("%d", len(s.documents)), "main.pp"))
+	require.NoError(t, s.didOpen(didOpenTextDocumentParams{
+		TextDocument: textDocumentItem{URI: uri, LanguageID: "pcl", Version: 1, Text: text},
+	}))
+	return s.documents[uri]
+}
+
+// readMessages reads the messages that the server has written.
+func readMessages(t *testing.T, out *bytes.Buffer) []*message {
+	c := newConn(bytes.NewReader(out.Bytes()), nil)
+	var messages []*message
+	for {
+		msg, err := c.read()
+		if err != nil {
+			break
+		}
+		messages = append(messages, msg)
+	}
+	out.Reset()
+	return messages
+}
+
+// offsetOf returns the offset of the first occurrence of the given text in the document plus delta.
+func offsetOf(t *testing.T, doc *document, text string, delta int) int {
+	i := strings.Index(doc.text, text)
+	require.NotEqual(t, -1, i, "could not find %q", text)
+	return i + delta
+}
+
+func labels(items []completionItem) []string {
+	labels := make([]string, len(items))
+	for i, item := range items {
+		labels[i] = item.Label
+	}
+	return labels
+}
+
+func TestDiagnostics(t *testing.T) {
+	s, out := newTestServer(t)
+
+	doc := openDocument(t, s, testProgram)
+	messages := readMessages(t, out)
+	require.Len(t, messages, 1)
+	assert.Equal(t, "textDocument/publishDiagnostics", messages[0].Method)
+	assert.JSONEq(t, fmt.Sprintf(`{"uri": %q, "diagnostics": []}`, doc.uri), string(messages[0].Params))
+
+	require.NoError(t, s.didChange(didChangeTextDocumentParams{
+		TextDocument: versionedTextDocumentIdentifier{URI: doc.uri, Version: 2},
+		ContentChanges: []textDocumentContentChangeEvent{{
+			Range: &lspRange{Start: position{Line: 12, Character: 16}, End: position{Line: 12, Character: 19}},
+			Text:  "size",
+		}},
+	}))
+	assert.Contains(t, doc.text, "bucket.size")
+
+	messages = readMessages(t, out)
+	require.Len(t, messages, 1)
+	var params publishDiagnosticsParams
+	require.NoError(t, json.Unmarshal(messages[0].Params, &params))
+	require.Len(t, params.Diagnostics, 1)
+	assert.Equal(t, severityError, params.Diagnostics[0].Severity)
+	assert.Contains(t, params.Diagnostics[0].Message, "size")
+	assert.Equal(t, 12, params.Diagnostics[0].Range.Start.Line)
+}
+
+func TestHover(t *testing.T) {
+	s, _ := newTestServer(t)
+	doc := openDocument(t, s, testProgram)
+
+	h := s.hover(doc, offsetOf(t, doc, `"test:index:Bucket"`, 1))
+	require.NotNil(t, h)
+	assert.Equal(t, "```\nresource bucket \"test:index:Bucket\"\n```\n\nA bucket.", h.Contents.Value)
+
+	h = s.hover(doc, offsetOf(t, doc, "name =", 1))
+	require.NotNil(t, h)
+	assert.Equal(t, "```\nname: string\n```\n\nThe name of the bucket.", h.Contents.Value)
+	assert.Equal(t, lspRange{Start: position{Line: 6, Character: 1}, End: position{Line: 6, Character: 5}}, *h.Range)
+
+	h = s.hover(doc, offsetOf(t, doc, "bucket.arn", 8))
+	require.NotNil(t, h)
+	assert.Equal(t, "```\narn: string\n```\n\nThe bucket's ARN.", h.Contents.Value)
+
+	h = s.hover(doc, offsetOf(t, doc, `"test:index:getRegion"`, 1))
+	require.NotNil(t, h)
+	assert.Equal(t, "```\nfunction \"test:index:getRegion\"\n```\n\nGets the current region.", h.Contents.Value)
+
+	assert.Nil(t, s.hover(doc, 0))
+}
+
+func TestDefinition(t *testing.T) {
+	s, _ := newTestServer(t)
+	doc := openDocument(t, s, testProgram)
+
+	locations := s.definition(doc, offsetOf(t, doc, "bucket.arn", 2))
+	assert.Equal(t, []location{{
+		URI:   doc.uri,
+		Range: lspRange{Start: position{Line: 5, Character: 9}, End: position{Line: 5, Character: 15}},
+	}}, locations)
+
+	locations = s.definition(doc, offsetOf(t, doc, "{prefix}", 2))
+	assert.Equal(t, []location{{
+		URI:   doc.uri,
+		Range: lspRange{Start: position{Line: 0, Character: 7}, End: position{Line: 0, Character: 13}},
+	}}, locations)
+
+	assert.Nil(t, s.definition(doc, offsetOf(t, doc, `"my"`, 1)))
+}
+
+func TestCompletion(t *testing.T) {
+	s, _ := newTestServer(t)
+	doc := openDocument(t, s, testProgram)
+
+	// Each edit leaves the document with a syntax error, so completions are computed using the last bound program.
+	complete := func(text string, before string) []string {
+		offset := strings.Index(testProgram, before)
+		require.NotEqual(t, -1, offset)
+		edited := testProgram[:offset] + text + testProgram[offset:]
+		require.NoError(t, s.didChange(didChangeTextDocumentParams{
+			TextDocument:   versionedTextDocumentIdentifier{URI: doc.uri},
+			ContentChanges: []textDocumentContentChangeEvent{{Text: edited}},
+		}))
+		return labels(s.completion(doc, offset+len(text)).Items)
+	}
+
+	assert.Equal(t, []string{"pulumi:providers:test", "test:index:Bucket"},
+		complete("resource other \"test:in", "output arn"))
+	assert.Equal(t, []string{"test:index:getRegion"}, complete("other = invoke(\"", "output arn"))
+	assert.Equal(t, []string{"arn", "id", "name", "urn"}, complete("other = bucket.", "output arn"))
+	assert.Equal(t, []string{"name"}, complete("other = region.", "output arn"))
+	assert.Equal(t, []string{"website", "options"}, complete("\twe", "}\n\nregion"))
+	assert.Equal(t, []string{"config", "output", "resource"}, complete("ou", "output arn"))
+
+	names := complete("other = ", "output arn")
+	assert.Contains(t, names, "bucket")
+	assert.Contains(t, names, "prefix")
+	assert.Contains(t, names, "invoke")
+	assert.NotContains(t, names, "arn")
+}
+
+func TestFormat(t *testing.T) {
+	s, _ := newTestServer(t)
+
+	doc := openDocument(t, s, `// The bucket.
+resource bucket "test:index:Bucket" {
+  name =   "bucket" // The name.
+       website = {
+    indexDocument = "index.html"
+  }
+}
+output   arn { value=bucket.arn }
+`)
+
+	edits := s.format(doc, formattingOptions{TabSize: 4, InsertSpaces: false})
+	require.Len(t, edits, 1)
+	assert.Equal(t, `// The bucket.
+resource bucket "test:index:Bucket" {
+	name = "bucket" // The name.
+	website = {
+		indexDocument = "index.html"
+	}
+}
+output arn { value = bucket.arn }
+`, edits[0].NewText)
+
+	// Documents that contain errors are not formatted.
+	doc = openDocument(t, s, "resource bucket \"test:index:Bucket\" {\n  name = bucket.size\n}\n")
+	assert.Empty(t, s.format(doc, formattingOptions{}))
+}
+
+func TestServe(t *testing.T) {
+	s, _ := newTestServer(t)
+
+	var in, out bytes.Buffer
+	writer := newConn(nil, &in)
+	require.NoError(t, writer.write(&message{ID: rawID(1), Method: "initialize", Params: json.RawMessage(`{}`)}))
+	require.NoError(t, writer.write(&message{Method: "initialized", Params: json.RawMessage(`{}`)}))
+	require.NoError(t, writer.write(&message{ID: rawID(2), Method: "textDocument/unknown"}))
+	require.NoError(t, writer.write(&message{ID: rawID(3), Method: "shutdown"}))
+	require.NoError(t, writer.write(&message{Method: "exit"}))
+
+	require.NoError(t, s.Serve(&in, &out))
+
+	// The response to shutdown must carry a null result.
+	assert.Contains(t, out.String(), `{"jsonrpc":"2.0","id":3,"result":null}`)
+
+	messages := readMessages(t, &out)
+	require.Len(t, messages, 3)
+
+	var result initializeResult
+	require.NotNil(t, messages[0].Result)
+	require.NoError(t, json.Unmarshal(*messages[0].Result, &result))
+	assert.True(t, result.Capabilities.HoverProvider)
+	assert.Equal(t, textDocumentSyncFull, result.Capabilities.TextDocumentSync)
+
+	require.NotNil(t, messages[1].Error)
+	assert.Equal(t, methodNotFound, messages[1].Error.Code)
+
+	assert.Equal(t, "3", string(*messages[2].ID))
+	assert.Nil(t, messages[2].Error)
+}
+
+func rawID(id int) *json.RawMessage {
+	raw := json.RawMessage(fmt.Sprintf("%d", id))
+	return &raw
+}
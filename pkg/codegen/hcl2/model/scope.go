@@ -67,9 +67,13 @@ func (v *Variable) Type() Type {
 	return v.VariableType
 }
 
+// Value returns the value of the variable in the given evaluation context. The variable is looked up in the context
+// and then in each of its ancestors in turn. If no value is defined, the result is unknown.
 func (v *Variable) Value(context *hcl.EvalContext) (cty.Value, hcl.Diagnostics) {
-	if value, hasValue := context.Variables[v.Name]; hasValue {
-		return value, nil
+	for ; context != nil; context = context.Parent() {
+		if value, hasValue := context.Variables[v.Name]; hasValue {
+			return value, nil
+		}
 	}
 	return cty.DynamicVal, nil
 }
@@ -19,6 +19,8 @@ import (
 	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/pulumi/pulumi/pkg/v2/codegen/hcl2/model"
 	"github.com/pulumi/pulumi/pkg/v2/codegen/hcl2/syntax"
+	"github.com/pulumi/pulumi/pkg/v2/codegen/schema"
+	"github.com/zclconf/go-cty/cty"
 )
 
 // ResourceOptions represents a resource instantiation's options.
@@ -49,6 +51,9 @@ type Resource struct {
 
 	// Token is the type token for this resource.
 	Token string
+	// Schema is the schema for this resource, if any. This is nil for provider resources and for resources whose
+	// types could not be resolved.
+	Schema *schema.Resource
 
 	// The type of the resource's inputs. This will always be either Any or an object type.
 	InputType model.Type
@@ -83,6 +88,11 @@ func (r *Resource) Traverse(traverser hcl.Traverser) (model.Traversable, hcl.Dia
 	return r.VariableType.Traverse(traverser)
 }
 
+// Value returns the value of the resource in the given evaluation context, if any.
+func (r *Resource) Value(context *hcl.EvalContext) (cty.Value, hcl.Diagnostics) {
+	return nodeValue(context, r.Name())
+}
+
 // Name returns the name of the resource.
 func (r *Resource) Name() string {
 	return r.Definition.Labels[0]
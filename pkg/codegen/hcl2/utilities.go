@@ -23,8 +23,20 @@ import (
 	"github.com/hashicorp/hcl/v2"
 	"github.com/pulumi/pulumi/pkg/v2/codegen"
 	"github.com/pulumi/pulumi/pkg/v2/codegen/hcl2/model"
+	"github.com/zclconf/go-cty/cty"
 )
 
+// nodeValue returns the value of the named node in the given evaluation context. The node is looked up in the context
+// and then in each of its ancestors in turn. If no value is defined, the result is unknown.
+func nodeValue(context *hcl.EvalContext, name string) (cty.Value, hcl.Diagnostics) {
+	for ; context != nil; context = context.Parent() {
+		if value, hasValue := context.Variables[name]; hasValue {
+			return value, nil
+		}
+	}
+	return cty.DynamicVal, nil
+}
+
 // titleCase replaces the first character in the given string with its upper-case equivalent.
 func titleCase(s string) string {
 	c, sz := utf8.DecodeRuneInString(s)
@@ -7,8 +7,16 @@ import (
 )
 
 func NewHost(schemaDirectoryPath string) plugin.Host {
+	schemaProvider := func(name string) func() (plugin.Provider, error) {
+		return func() (plugin.Provider, error) {
+			return SchemaProvider(schemaDirectoryPath, name)
+		}
+	}
+
 	return deploytest.NewPluginHost(nil, nil, nil,
 		deploytest.NewProviderLoader("aws", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
 			return AWS(schemaDirectoryPath)
-		}))
+		}),
+		deploytest.NewProviderLoader("storage", semver.MustParse("1.0.0"), schemaProvider("storage")),
+		deploytest.NewProviderLoader("backup", semver.MustParse("0.1.0"), schemaProvider("backup")))
 }
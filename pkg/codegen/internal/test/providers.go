@@ -12,8 +12,10 @@ func GetSchema(schemaDirectoryPath, providerName string) ([]byte, error) {
 	return ioutil.ReadFile(filepath.Join(schemaDirectoryPath, providerName+".json"))
 }
 
-func AWS(schemaDirectoryPath string) (plugin.Provider, error) {
-	schema, err := GetSchema(schemaDirectoryPath, "aws")
+// SchemaProvider returns a provider whose GetSchema method returns the named provider's schema from the given
+// directory.
+func SchemaProvider(schemaDirectoryPath, providerName string) (plugin.Provider, error) {
+	schema, err := GetSchema(schemaDirectoryPath, providerName)
 	if err != nil {
 		return nil, err
 	}
@@ -23,3 +25,7 @@ func AWS(schemaDirectoryPath string) (plugin.Provider, error) {
 		},
 	}, nil
 }
+
+func AWS(schemaDirectoryPath string) (plugin.Provider, error) {
+	return SchemaProvider(schemaDirectoryPath, "aws")
+}
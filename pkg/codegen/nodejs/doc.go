@@ -96,6 +96,11 @@ func (d DocLanguageHelper) GetLanguageTypeString(pkg *schema.Package, moduleName
 	return typeName
 }
 
+// GetEnumName returns the property of the enum object that holds the given enum member.
+func (d DocLanguageHelper) GetEnumName(e *schema.Enum, typeName string) (string, error) {
+	return enumMemberName(e), nil
+}
+
 func (d DocLanguageHelper) GetFunctionName(modName string, f *schema.Function) string {
 	return tokenToFunctionName(f.Token)
 }
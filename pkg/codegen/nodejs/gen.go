@@ -82,6 +82,7 @@ type modContext struct {
 	pkg         *schema.Package
 	mod         string
 	types       []*schema.ObjectType
+	enums       []*schema.EnumType
 	resources   []*schema.Resource
 	functions   []*schema.Function
 	typeDetails map[*schema.ObjectType]*typeDetails
@@ -122,6 +123,62 @@ func (mod *modContext) tokenToType(tok string, input bool) string {
 	return root + modName + title(name)
 }
 
+// tokenToEnum returns the name of the enum with the given token, qualified by the namespace of its module within the
+// types module's enums.
+func (mod *modContext) tokenToEnum(tok string) string {
+	modName, name := mod.pkg.TokenToModule(tok), tokenToName(tok)
+	if modName != "" {
+		modName = strings.Replace(modName, "/", ".", -1) + "."
+	}
+	return "enums." + modName + name
+}
+
+// isExternalType returns true if the given type is an object or enum type defined by a package other than the given
+// package.
+func isExternalType(t schema.Type, pkg *schema.Package) bool {
+	switch t := t.(type) {
+	case *schema.ObjectType:
+		return t.Package != nil && t.Package != pkg
+	case *schema.EnumType:
+		return t.Package != nil && t.Package != pkg
+	case *schema.ResourceType:
+		return t.Resource != nil && t.Resource.Package != pkg
+	default:
+		return false
+	}
+}
+
+// externalPackage returns the name of the NPM package for the given package and the name of the namespace as which
+// it is imported by packages that refer to its types or resources.
+func externalPackage(pkg *schema.Package) (string, string) {
+	info, _ := pkg.Language["nodejs"].(NodePackageInfo)
+	packageName := info.PackageName
+	if packageName == "" {
+		packageName = fmt.Sprintf("@pulumi/%s", pkg.Name)
+	}
+
+	namespace := strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return '_'
+	}, pkg.Name)
+	return packageName, namespace
+}
+
+// externalTypeName returns the name of the member of another package with the given token, qualified by the namespace
+// as which the package is imported and by the given namespace within the package.
+func externalTypeName(pkg *schema.Package, namespace, tok string) string {
+	_, name := externalPackage(pkg)
+	if namespace != "" {
+		name += "." + namespace
+	}
+	if modName := pkg.TokenToModule(tok); modName != "" {
+		name += "." + strings.Replace(modName, "/", ".", -1)
+	}
+	return name + "." + tokenToName(tok)
+}
+
 func tokenToName(tok string) string {
 	components := strings.Split(tok, ":")
 	contract.Assertf(len(components) == 3, "malformed token %v", tok)
@@ -147,7 +204,31 @@ func (mod *modContext) typeString(t schema.Type, input, wrapInput, optional bool
 	case *schema.MapType:
 		typ = fmt.Sprintf("{[key: string]: %v}", mod.typeString(t.ElementType, input, wrapInput, false))
 	case *schema.ObjectType:
-		typ = mod.tokenToType(t.Token, input)
+		switch {
+		case !isExternalType(t, mod.pkg):
+			typ = mod.tokenToType(t.Token, input)
+		case input:
+			typ = externalTypeName(t.Package, "types.input", t.Token)
+		default:
+			typ = externalTypeName(t.Package, "types.output", t.Token)
+		}
+	case *schema.EnumType:
+		if isExternalType(t, mod.pkg) {
+			typ = externalTypeName(t.Package, "types.enums", t.Token)
+		} else {
+			typ = mod.tokenToEnum(t.Token)
+		}
+	case *schema.ResourceType:
+		// Resources are referred to by their IDs. Inputs also accept the resources themselves, which are serialized as
+		// their IDs.
+		typ = "string"
+		if input {
+			resourceType := resourceName(t.Resource)
+			if isExternalType(t, mod.pkg) {
+				resourceType = externalTypeName(t.Resource.Package, "", t.Token)
+			}
+			typ += " | " + resourceType
+		}
 	case *schema.TokenType:
 		typ = tokenToName(t.Token)
 	case *schema.UnionType:
@@ -342,16 +423,19 @@ func (mod *modContext) genResource(w io.Writer, r *schema.Resource) error {
 	printComment(w, codegen.StripNonRelevantExamples(r.Comment, "typescript"), "", "")
 
 	baseType := "CustomResource"
-	if r.IsProvider {
+	switch {
+	case r.IsProvider:
 		baseType = "ProviderResource"
+	case r.IsComponent:
+		baseType = "ComponentResource"
 	}
 
 	// Begin defining the class.
 	fmt.Fprintf(w, "export class %s extends pulumi.%s {\n", name, baseType)
 
-	// Emit a static factory to read instances of this resource unless this is a provider resource.
+	// Emit a static factory to read instances of this resource unless this is a provider or component resource.
 	stateType := name + "State"
-	if !r.IsProvider {
+	if !r.IsProvider && !r.IsComponent {
 		fmt.Fprintf(w, "    /**\n")
 		fmt.Fprintf(w, "     * Get an existing %s resource's state with the given name, ID, and optional extra\n", name)
 		fmt.Fprintf(w, "     * properties used to qualify the lookup.\n")
@@ -435,8 +519,11 @@ func (mod *modContext) genResource(w io.Writer, r *schema.Resource) error {
 	}
 	argsType := name + "Args"
 	trailingBrace, optionsType := "", "CustomResourceOptions"
-	if r.IsProvider {
+	switch {
+	case r.IsProvider:
 		trailingBrace, optionsType = " {", "ResourceOptions"
+	case r.IsComponent:
+		trailingBrace, optionsType = " {", "ComponentResourceOptions"
 	}
 
 	if r.DeprecationMessage != "" {
@@ -445,7 +532,7 @@ func (mod *modContext) genResource(w io.Writer, r *schema.Resource) error {
 	fmt.Fprintf(w, "    constructor(name: string, args%s: %s, opts?: pulumi.%s)%s\n", argsFlags, argsType,
 		optionsType, trailingBrace)
 
-	if !r.IsProvider {
+	if !r.IsProvider && !r.IsComponent {
 		if r.DeprecationMessage != "" {
 			fmt.Fprintf(w, "    /** @deprecated %s */\n", r.DeprecationMessage)
 		}
@@ -524,7 +611,13 @@ func (mod *modContext) genResource(w io.Writer, r *schema.Resource) error {
 		fmt.Fprintf(w, "        opts = opts ? pulumi.mergeOptions(opts, aliasOpts) : aliasOpts;\n")
 	}
 
-	fmt.Fprintf(w, "        super(%s.__pulumiType, name, inputs, opts);\n", name)
+	// Component resources are constructed by their provider, and their outputs are resolved from the outputs that
+	// the provider registers.
+	if r.IsComponent {
+		fmt.Fprintf(w, "        super(%s.__pulumiType, name, inputs, opts, true /*remote*/);\n", name)
+	} else {
+		fmt.Fprintf(w, "        super(%s.__pulumiType, name, inputs, opts);\n", name)
+	}
 
 	// Finish the class.
 	fmt.Fprintf(w, "    }\n")
@@ -642,7 +735,46 @@ func (mod *modContext) genType(w io.Writer, obj *schema.ObjectType, input bool,
 	mod.genPlainType(w, tokenToName(obj.Token), obj.Comment, obj.Properties, input, !mod.details(obj).functionType, false, level)
 }
 
+// addExternalImport records an import of the given package, which defines types or resources referred to by the
+// package being generated.
+func addExternalImport(pkg *schema.Package, imports map[string]stringSet) {
+	packageName, namespace := externalPackage(pkg)
+	if imports[packageName] == nil {
+		imports[packageName] = stringSet{}
+	}
+	imports[packageName].add("* as " + namespace)
+}
+
+// addLocalImport imports the given name from the index of the module in this package that defines the given token.
+func (mod *modContext) addLocalImport(tok, name string, imports map[string]stringSet) {
+	modName, modPath := mod.pkg.TokenToModule(tok), "./index"
+	if modName != mod.mod {
+		mp, err := filepath.Rel(mod.mod, modName)
+		contract.Assert(err == nil)
+		if path.Base(mp) == "." {
+			mp = path.Dir(mp)
+		}
+		modPath = filepath.ToSlash(mp)
+	}
+	if imports[modPath] == nil {
+		imports[modPath] = stringSet{}
+	}
+	imports[modPath].add(name)
+}
+
 func (mod *modContext) getTypeImports(t schema.Type, imports map[string]stringSet) bool {
+	if isExternalType(t, mod.pkg) {
+		switch t := t.(type) {
+		case *schema.ObjectType:
+			addExternalImport(t.Package, imports)
+		case *schema.EnumType:
+			addExternalImport(t.Package, imports)
+		case *schema.ResourceType:
+			addExternalImport(t.Resource.Package, imports)
+		}
+		return false
+	}
+
 	switch t := t.(type) {
 	case *schema.ArrayType:
 		return mod.getTypeImports(t.ElementType, imports)
@@ -650,20 +782,18 @@ func (mod *modContext) getTypeImports(t schema.Type, imports map[string]stringSe
 		return mod.getTypeImports(t.ElementType, imports)
 	case *schema.ObjectType:
 		return true
-	case *schema.TokenType:
-		modName, name, modPath := mod.pkg.TokenToModule(t.Token), tokenToName(t.Token), "./index"
-		if modName != mod.mod {
-			mp, err := filepath.Rel(mod.mod, modName)
-			contract.Assert(err == nil)
-			if path.Base(mp) == "." {
-				mp = path.Dir(mp)
-			}
-			modPath = filepath.ToSlash(mp)
-		}
+	case *schema.ResourceType:
+		mod.addLocalImport(t.Token, resourceName(t.Resource), imports)
+		return false
+	case *schema.EnumType:
+		modPath := mod.relRoot() + "/types"
 		if imports[modPath] == nil {
 			imports[modPath] = stringSet{}
 		}
-		imports[modPath].add(name)
+		imports[modPath].add("enums")
+		return false
+	case *schema.TokenType:
+		mod.addLocalImport(t.Token, tokenToName(t.Token), imports)
 		return false
 	case *schema.UnionType:
 		needsTypes := false
@@ -734,8 +864,16 @@ func (mod *modContext) genHeader(w io.Writer, imports []string, importedTypes ma
 		for _, module := range modules {
 			var names []string
 			for name := range importedTypes[module] {
+				// Other packages are imported as namespaces.
+				if strings.HasPrefix(name, "* as ") {
+					fmt.Fprintf(w, "import %s from \"%v\";\n", name, module)
+					continue
+				}
 				names = append(names, name)
 			}
+			if len(names) == 0 {
+				continue
+			}
 			sort.Strings(names)
 
 			fmt.Fprintf(w, "import {")
@@ -787,12 +925,17 @@ func (mod *modContext) genConfig(w io.Writer, variables []*schema.Property) erro
 	return nil
 }
 
+// relRoot returns the relative path from the module's directory to the package's root directory.
+func (mod *modContext) relRoot() string {
+	rel, err := filepath.Rel(mod.mod, "")
+	contract.Assert(err == nil)
+	return filepath.ToSlash(rel)
+}
+
 func (mod *modContext) sdkImports(nested, utilities bool) []string {
 	imports := []string{"import * as pulumi from \"@pulumi/pulumi\";"}
 
-	rel, err := filepath.Rel(mod.mod, "")
-	contract.Assert(err == nil)
-	relRoot := path.Dir(filepath.ToSlash(rel))
+	relRoot := mod.relRoot()
 	if nested {
 		imports = append(imports, fmt.Sprintf("import * as inputs from \"%s/types/input\";", relRoot))
 		imports = append(imports, fmt.Sprintf("import * as outputs from \"%s/types/output\";", relRoot))
@@ -804,6 +947,41 @@ func (mod *modContext) sdkImports(nested, utilities bool) []string {
 	return imports
 }
 
+// namespace is a TypeScript namespace within the types module that corresponds to one of the package's modules.
+type namespace struct {
+	name     string
+	types    []*schema.ObjectType
+	enums    []*schema.EnumType
+	children []*namespace
+}
+
+// namespaceTree maps module names to their namespaces.
+type namespaceTree map[string]*namespace
+
+// get returns the namespace for the given module, creating it and its parents as necessary.
+func (namespaces namespaceTree) get(mod string) *namespace {
+	ns, ok := namespaces[mod]
+	if !ok {
+		name := mod
+		if mod != "" {
+			name = path.Base(mod)
+		}
+
+		ns = &namespace{name: name}
+		if mod != "" {
+			parentMod := path.Dir(mod)
+			if parentMod == "." {
+				parentMod = ""
+			}
+			parent := namespaces.get(parentMod)
+			parent.children = append(parent.children, ns)
+		}
+
+		namespaces[mod] = ns
+	}
+	return ns
+}
+
 func (mod *modContext) genTypes() (string, string) {
 	imports := map[string]stringSet{}
 	for _, t := range mod.types {
@@ -816,40 +994,9 @@ func (mod *modContext) genTypes() (string, string) {
 	mod.genHeader(outputs, mod.sdkImports(true, false), imports)
 
 	// Build a namespace tree out of the types, then emit them.
-
-	type namespace struct {
-		name     string
-		types    []*schema.ObjectType
-		children []*namespace
-	}
-
-	namespaces := map[string]*namespace{}
-	var getNamespace func(string) *namespace
-	getNamespace = func(mod string) *namespace {
-		ns, ok := namespaces[mod]
-		if !ok {
-			name := mod
-			if mod != "" {
-				name = path.Base(mod)
-			}
-
-			ns = &namespace{name: name}
-			if mod != "" {
-				parentMod := path.Dir(mod)
-				if parentMod == "." {
-					parentMod = ""
-				}
-				parent := getNamespace(parentMod)
-				parent.children = append(parent.children, ns)
-			}
-
-			namespaces[mod] = ns
-		}
-		return ns
-	}
-
+	namespaces := namespaceTree{}
 	for _, t := range mod.types {
-		ns := getNamespace(mod.pkg.TokenToModule(t.Token))
+		ns := namespaces.get(mod.pkg.TokenToModule(t.Token))
 		ns.types = append(ns.types, t)
 	}
 
@@ -887,6 +1034,89 @@ func (mod *modContext) genTypes() (string, string) {
 	return inputs.String(), outputs.String()
 }
 
+// genEnums generates the enums module, in which each enum is represented by an object that holds its values and a
+// type that is the union of their types.
+func (mod *modContext) genEnums() (string, error) {
+	w := &bytes.Buffer{}
+	mod.genHeader(w, nil, nil)
+
+	namespaces := namespaceTree{}
+	for _, e := range mod.enums {
+		ns := namespaces.get(mod.pkg.TokenToModule(e.Token))
+		ns.enums = append(ns.enums, e)
+	}
+
+	var genNamespace func(*namespace, int) error
+	genNamespace = func(ns *namespace, level int) error {
+		indent := strings.Repeat("    ", level)
+
+		sort.Slice(ns.enums, func(i, j int) bool {
+			return tokenToName(ns.enums[i].Token) < tokenToName(ns.enums[j].Token)
+		})
+		for i, e := range ns.enums {
+			if err := mod.genEnum(w, e, indent); err != nil {
+				return err
+			}
+			if i != len(ns.enums)-1 || len(ns.children) > 0 {
+				fmt.Fprintf(w, "\n")
+			}
+		}
+
+		sort.Slice(ns.children, func(i, j int) bool {
+			return ns.children[i].name < ns.children[j].name
+		})
+		for i, child := range ns.children {
+			fmt.Fprintf(w, "%sexport namespace %s {\n", indent, child.name)
+			if err := genNamespace(child, level+1); err != nil {
+				return err
+			}
+			fmt.Fprintf(w, "%s}\n", indent)
+			if i != len(ns.children)-1 {
+				fmt.Fprintf(w, "\n")
+			}
+		}
+		return nil
+	}
+	if err := genNamespace(namespaces[""], 0); err != nil {
+		return "", err
+	}
+
+	return w.String(), nil
+}
+
+func (mod *modContext) genEnum(w io.Writer, enum *schema.EnumType, indent string) error {
+	name := tokenToName(enum.Token)
+
+	printComment(w, enum.Comment, "", indent)
+	fmt.Fprintf(w, "%sexport const %s = {\n", indent, name)
+	for _, e := range enum.Elements {
+		v, err := tsPrimitiveValue(e.Value)
+		if err != nil {
+			return err
+		}
+
+		printComment(w, e.Comment, e.DeprecationMessage, indent+"    ")
+		fmt.Fprintf(w, "%s    %s: %s,\n", indent, enumMemberName(e), v)
+	}
+	fmt.Fprintf(w, "%s} as const;\n\n", indent)
+
+	printComment(w, enum.Comment, "", indent)
+	fmt.Fprintf(w, "%sexport type %[2]s = (typeof %[2]s)[keyof typeof %[2]s];\n", indent, name)
+	return nil
+}
+
+// enumMemberName returns the name of the member of an enum's object that holds the given value.
+func enumMemberName(e *schema.Enum) string {
+	var name string
+	for _, word := range codegen.EnumValueWords(e) {
+		name += title(word)
+	}
+	if unicode.IsDigit(rune(name[0])) {
+		name = "_" + name
+	}
+	return name
+}
+
 type fs map[string][]byte
 
 func (fs fs) add(path string, contents []byte) {
@@ -972,6 +1202,15 @@ func (mod *modContext) gen(fs fs) error {
 		fs.add(path.Join(mod.mod, "output.ts"), []byte(output))
 	}
 
+	// Enums
+	if len(mod.enums) > 0 {
+		enums, err := mod.genEnums()
+		if err != nil {
+			return err
+		}
+		fs.add(path.Join(mod.mod, "enums.ts"), []byte(enums))
+	}
+
 	// Index
 	fs.add(path.Join(mod.mod, "index.ts"), []byte(mod.genIndex(files)))
 	return nil
@@ -1003,6 +1242,9 @@ func (mod *modContext) genIndex(exports []string) string {
 	if len(mod.types) > 0 {
 		children = append(children, "input", "output")
 	}
+	if len(mod.enums) > 0 {
+		children = append(children, "enums")
+	}
 
 	// Finally, if there are submodules, export them.
 	if len(children) > 0 {
@@ -1181,6 +1423,11 @@ func GeneratePackage(tool string, pkg *schema.Package, extraFiles map[string][]b
 	if err := pkg.ImportLanguages(map[string]schema.Language{"nodejs": Importer}); err != nil {
 		return nil, err
 	}
+	for _, dep := range pkg.Dependencies {
+		if err := dep.ImportLanguages(map[string]schema.Language{"nodejs": Importer}); err != nil {
+			return nil, errors.Wrapf(err, "importing dependency %v", dep.Name)
+		}
+	}
 	info, _ := pkg.Language["nodejs"].(NodePackageInfo)
 
 	// group resources, types, and functions into Go packages
@@ -1247,6 +1494,11 @@ func GeneratePackage(tool string, pkg *schema.Package, extraFiles map[string][]b
 	}
 
 	for _, f := range pkg.Functions {
+		// Resource methods are served only through Call, and are not yet generated for this language.
+		if f.IsMethod {
+			continue
+		}
+
 		mod := getMod(f.Token)
 		mod.functions = append(mod.functions, f)
 		if f.Inputs != nil {
@@ -1269,11 +1521,14 @@ func GeneratePackage(tool string, pkg *schema.Package, extraFiles map[string][]b
 
 	// Create the types module.
 	for _, t := range pkg.Types {
-		if obj, ok := t.(*schema.ObjectType); ok {
-			types.types = append(types.types, obj)
+		switch t := t.(type) {
+		case *schema.ObjectType:
+			types.types = append(types.types, t)
+		case *schema.EnumType:
+			types.enums = append(types.enums, t)
 		}
 	}
-	if len(types.types) > 0 {
+	if len(types.types) > 0 || len(types.enums) > 0 {
 		root := modules[""]
 		root.children = append(root.children, types)
 		modules["types"] = types
@@ -61,6 +61,15 @@ func (d DocLanguageHelper) GetDocLinkForBuiltInType(typeName string) string {
 
 // GetLanguageTypeString returns the Python-specific type given a Pulumi schema type.
 func (d DocLanguageHelper) GetLanguageTypeString(pkg *schema.Package, moduleName string, t schema.Type, input, optional bool) string {
+	// Enum members are instances of their element types, but the enum class names the values that are accepted.
+	if enum, ok := t.(*schema.EnumType); ok {
+		if enum.Package != nil && enum.Package != pkg {
+			// Enums defined by other packages are qualified by the name of the defining package's module.
+			return fmt.Sprintf("%s.%s", pyPack(enum.Package.Name), tokenToName(enum.Token))
+		}
+		return tokenToName(enum.Token)
+	}
+
 	name := pyType(t)
 
 	// The Python SDK generator will simply return "list" or "dict" for enumerables.
@@ -100,6 +109,11 @@ func (d DocLanguageHelper) GetLanguageTypeString(pkg *schema.Package, moduleName
 	return name
 }
 
+// GetEnumName returns the name of the given enum member.
+func (d DocLanguageHelper) GetEnumName(e *schema.Enum, typeName string) (string, error) {
+	return enumMemberName(e), nil
+}
+
 func (d DocLanguageHelper) GetFunctionName(modName string, f *schema.Function) string {
 	return PyName(tokenToName(f.Token))
 }
@@ -62,6 +62,8 @@ type modContext struct {
 	mod                  string
 	resources            []*schema.Resource
 	functions            []*schema.Function
+	types                []*schema.ObjectType
+	enums                []*schema.EnumType
 	children             []*modContext
 	snakeCaseToCamelCase map[string]string
 	camelCaseToSnakeCase map[string]string
@@ -113,12 +115,34 @@ func (mod *modContext) genHeader(w io.Writer, needsSDK bool) {
 		fmt.Fprintf(w, "import warnings\n")
 		fmt.Fprintf(w, "import pulumi\n")
 		fmt.Fprintf(w, "import pulumi.runtime\n")
-		fmt.Fprintf(w, "from typing import Union\n")
+		fmt.Fprintf(w, "from typing import Any, Awaitable, List, Mapping, Optional, TYPE_CHECKING, Union\n")
+		fmt.Fprintf(w, "from typing_extensions import TypedDict\n")
 		fmt.Fprintf(w, "from %s import utilities, tables\n", relRoot)
 		fmt.Fprintf(w, "\n")
 	}
 }
 
+// genFile returns the contents of a module that consists of the standard header, imports of the modules whose types
+// are referred to by type hints, and the given body.
+func (mod *modContext) genFile(body *bytes.Buffer, imports codegen.StringSet) string {
+	w := &bytes.Buffer{}
+	mod.genHeader(w, true)
+
+	// Type hints that refer to object and enum types are quoted, so the modules that define those types only need to
+	// be imported by type checkers. This avoids circular imports between modules at runtime.
+	if len(imports) > 0 {
+		fmt.Fprintf(w, "if TYPE_CHECKING:\n")
+		for _, imp := range imports.SortedValues() {
+			fmt.Fprintf(w, "    import %s\n", imp)
+		}
+		fmt.Fprintf(w, "\n")
+	}
+
+	_, err := body.WriteTo(w)
+	contract.IgnoreError(err)
+	return w.String()
+}
+
 type fs map[string][]byte
 
 func (fs fs) add(path string, contents []byte) {
@@ -196,6 +220,21 @@ func (mod *modContext) gen(fs fs) error {
 		addFile(PyName(tokenToName(f.Token))+".py", fun)
 	}
 
+	// Types
+	if len(mod.types) > 0 {
+		addFile("_inputs.py", mod.genTypes(true /*input*/))
+		addFile("_outputs.py", mod.genTypes(false /*input*/))
+	}
+
+	// Enums
+	if len(mod.enums) > 0 {
+		enums, err := mod.genEnums()
+		if err != nil {
+			return err
+		}
+		addFile("_enums.py", enums)
+	}
+
 	// Index
 	fs.add(path.Join(dir, "__init__.py"), []byte(mod.genInit(exports)))
 	return nil
@@ -329,12 +368,14 @@ func (mod *modContext) genAwaitableType(w io.Writer, obj *schema.ObjectType) str
 }
 
 func (mod *modContext) genResource(res *schema.Resource) (string, error) {
-	w := &bytes.Buffer{}
-	mod.genHeader(w, true)
+	w, imports := &bytes.Buffer{}, codegen.NewStringSet()
 
 	baseType := "pulumi.CustomResource"
-	if res.IsProvider {
+	switch {
+	case res.IsProvider:
 		baseType = "pulumi.ProviderResource"
+	case res.IsComponent:
+		baseType = "pulumi.ComponentResource"
 	}
 
 	if !res.IsProvider && res.DeprecationMessage != "" {
@@ -348,40 +389,25 @@ func (mod *modContext) genResource(res *schema.Resource) (string, error) {
 
 	// Produce a class definition with optional """ comment.
 	fmt.Fprintf(w, "class %s(%s):\n", name, baseType)
-	for _, prop := range res.Properties {
-		name := PyName(prop.Name)
-		ty := pyType(prop.Type)
-		fmt.Fprintf(w, "    %s: pulumi.Output[%s]\n", name, ty)
-		if prop.Comment != "" {
-			doc := prop.Comment
-
-			nested := nestedStructure(prop.Type)
-			if len(nested) > 0 {
-				doc = fmt.Sprintf("%s\n", doc)
-
-				b := &bytes.Buffer{}
-				mod.genNestedStructureBullets(b, nested, "  ", false /*wrapInput*/)
-				doc = fmt.Sprintf("%s%s", doc, b.String())
-			}
-
-			printComment(w, doc, "    ")
-		}
-	}
 
 	if res.DeprecationMessage != "" {
 		fmt.Fprintf(w, "    warnings.warn(\"%s\", DeprecationWarning)\n", res.DeprecationMessage)
 	}
 	// Now generate an initializer with arguments for all input properties.
-	fmt.Fprintf(w, "    def __init__(__self__, resource_name, opts=None")
+	fmt.Fprintf(w, "    def __init__(__self__,\n")
+	fmt.Fprintf(w, "                 resource_name: str,\n")
+	fmt.Fprintf(w, "                 opts: Optional[pulumi.ResourceOptions] = None,\n")
 
 	// If there's an argument type, emit it.
 	for _, prop := range res.InputProperties {
-		fmt.Fprintf(w, ", %s=None", PyName(prop.Name))
+		fmt.Fprintf(w, "                 %s: %s = None,\n", PyName(prop.Name), mod.argTypeHint(prop.Type, imports))
 	}
 
 	// Old versions of TFGen emitted parameters named __name__ and __opts__. In order to preserve backwards
 	// compatibility, we still emit them, but we don't emit documentation for them.
-	fmt.Fprintf(w, ", __props__=None, __name__=None, __opts__=None):\n")
+	fmt.Fprintf(w, "                 __props__=None,\n")
+	fmt.Fprintf(w, "                 __name__=None,\n")
+	fmt.Fprintf(w, "                 __opts__=None):\n")
 	mod.genInitDocstring(w, res)
 	if res.DeprecationMessage != "" {
 		fmt.Fprintf(w, "        pulumi.log.warn(\"%s is deprecated: %s\")\n", name, res.DeprecationMessage)
@@ -464,7 +490,8 @@ func (mod *modContext) genResource(res *schema.Resource) (string, error) {
 		fmt.Fprintf(w, "        opts = pulumi.ResourceOptions.merge(opts, alias_opts)\n")
 	}
 
-	// Finally, chain to the base constructor, which will actually register the resource.
+	// Finally, chain to the base constructor, which will actually register the resource. Component resources are
+	// constructed by their provider.
 	tok := res.Token
 	if res.IsProvider {
 		tok = mod.pkg.Name
@@ -473,19 +500,27 @@ func (mod *modContext) genResource(res *schema.Resource) (string, error) {
 	fmt.Fprintf(w, "            '%s',\n", tok)
 	fmt.Fprintf(w, "            resource_name,\n")
 	fmt.Fprintf(w, "            __props__,\n")
-	fmt.Fprintf(w, "            opts)\n")
+	if res.IsComponent {
+		fmt.Fprintf(w, "            opts,\n")
+		fmt.Fprintf(w, "            remote=True)\n")
+	} else {
+		fmt.Fprintf(w, "            opts)\n")
+	}
 	fmt.Fprintf(w, "\n")
 
-	if !res.IsProvider {
+	// Existing component resources cannot be read.
+	if !res.IsProvider && !res.IsComponent {
 		fmt.Fprintf(w, "    @staticmethod\n")
-		fmt.Fprintf(w, "    def get(resource_name, id, opts=None")
+		fmt.Fprintf(w, "    def get(resource_name: str,\n")
+		fmt.Fprintf(w, "            id: pulumi.Input[str],\n")
+		fmt.Fprintf(w, "            opts: Optional[pulumi.ResourceOptions] = None")
 
 		if res.StateInputs != nil {
 			for _, prop := range res.StateInputs.Properties {
-				fmt.Fprintf(w, ", %[1]s=None", PyName(prop.Name))
+				fmt.Fprintf(w, ",\n            %s: %s = None", PyName(prop.Name), mod.argTypeHint(prop.Type, imports))
 			}
 		}
-		fmt.Fprintf(w, "):\n")
+		fmt.Fprintf(w, ") -> '%s':\n", name)
 		mod.genGetDocstring(w, res)
 		fmt.Fprintf(w,
 			"        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))\n")
@@ -499,6 +534,44 @@ func (mod *modContext) genResource(res *schema.Resource) (string, error) {
 		}
 
 		fmt.Fprintf(w, "        return %s(resource_name, opts=opts, __props__=__props__)\n", name)
+		fmt.Fprintf(w, "\n")
+	}
+
+	// Emit a property for each output. The runtime assigns each output to the resource with setattr when the resource
+	// is registered, so each property has a setter that stores the output in the resource's __dict__.
+	for _, prop := range res.Properties {
+		pname := PyName(prop.Name)
+		if pname == "id" || pname == "urn" {
+			// These outputs are provided by the base class.
+			continue
+		}
+
+		ty := mod.typeHint(prop.Type, false /*input*/, imports)
+		if !prop.IsRequired {
+			ty = fmt.Sprintf("Optional[%s]", ty)
+		}
+		fmt.Fprintf(w, "    @property\n")
+		fmt.Fprintf(w, "    def %s(self) -> pulumi.Output[%s]:\n", pname, ty)
+		if prop.Comment != "" {
+			doc := prop.Comment
+
+			nested := nestedStructure(prop.Type)
+			if len(nested) > 0 {
+				doc = fmt.Sprintf("%s\n", doc)
+
+				b := &bytes.Buffer{}
+				mod.genNestedStructureBullets(b, nested, "  ", false /*wrapInput*/)
+				doc = fmt.Sprintf("%s%s", doc, b.String())
+			}
+
+			printComment(w, doc, "        ")
+		}
+		fmt.Fprintf(w, "        return self.__dict__['%s']\n", pname)
+		fmt.Fprintf(w, "\n")
+		fmt.Fprintf(w, "    @%s.setter\n", pname)
+		fmt.Fprintf(w, "    def %s(self, value: pulumi.Output[%s]) -> None:\n", pname, ty)
+		fmt.Fprintf(w, "        self.__dict__['%s'] = value\n", pname)
+		fmt.Fprintf(w, "\n")
 	}
 
 	// Override translate_{input|output}_property on each resource to translate between snake case and
@@ -512,7 +585,7 @@ func (mod *modContext) genResource(res *schema.Resource) (string, error) {
 
 `)
 
-	return w.String(), nil
+	return mod.genFile(w, imports), nil
 }
 
 func (mod *modContext) writeAlias(w io.Writer, alias *schema.Alias) {
@@ -538,8 +611,7 @@ func (mod *modContext) writeAlias(w io.Writer, alias *schema.Alias) {
 }
 
 func (mod *modContext) genFunction(fun *schema.Function) (string, error) {
-	w := &bytes.Buffer{}
-	mod.genHeader(w, true)
+	w, imports := &bytes.Buffer{}, codegen.NewStringSet()
 
 	name := PyName(tokenToName(fun.Token))
 
@@ -560,13 +632,18 @@ func (mod *modContext) genFunction(fun *schema.Function) (string, error) {
 		args = fun.Inputs.Properties
 	}
 
-	// Write out the function signature.
+	// Write out the function signature. The arguments to functions are plain values rather than inputs.
+	indent := strings.Repeat(" ", len("def ")+len(name)+len("("))
 	fmt.Fprintf(w, "def %s(", name)
 	for _, arg := range args {
-		fmt.Fprintf(w, "%s=None,", PyName(arg.Name))
+		fmt.Fprintf(w, "%s: Optional[%s] = None,\n%s", PyName(arg.Name), mod.typeHint(arg.Type, false /*input*/, imports),
+			indent)
 	}
-	fmt.Fprintf(w, "opts=None")
-	fmt.Fprintf(w, "):\n")
+	fmt.Fprintf(w, "opts: Optional[pulumi.InvokeOptions] = None)")
+	if retTypeName != "" {
+		fmt.Fprintf(w, " -> %s", retTypeName)
+	}
+	fmt.Fprintf(w, ":\n")
 
 	// If this func has documentation, write it at the top of the docstring, otherwise use a generic comment.
 	docs := &bytes.Buffer{}
@@ -621,7 +698,7 @@ func (mod *modContext) genFunction(fun *schema.Function) (string, error) {
 		}
 	}
 
-	return w.String(), nil
+	return mod.genFile(w, imports), nil
 }
 
 var requirementRegex = regexp.MustCompile(`^>=([^,]+),<[^,]+$`)
@@ -750,6 +827,7 @@ func genPackageMetadata(tool string, pkg *schema.Package, requires map[string]st
 	reqNames := []string{
 		"semver>=2.8.1",
 		"parver>=0.2.1",
+		"typing-extensions>=3.7.4",
 	}
 	for req := range requires {
 		reqNames = append(reqNames, req)
@@ -922,12 +1000,14 @@ func (mod *modContext) genGetDocstring(w io.Writer, res *schema.Resource) {
 	fmt.Fprintln(b, ":param str resource_name: The unique name of the resulting resource.")
 	fmt.Fprintln(b, ":param str id: The unique provider ID of the resource to lookup.")
 	fmt.Fprintln(b, ":param pulumi.ResourceOptions opts: Options for the resource.")
-	for _, prop := range res.StateInputs.Properties {
-		mod.genPropDocstring(b, prop, true /*wrapInput*/)
-	}
+	if res.StateInputs != nil {
+		for _, prop := range res.StateInputs.Properties {
+			mod.genPropDocstring(b, prop, true /*wrapInput*/)
+		}
 
-	// Nested structures are typed as `dict` so we include some extra documentation for these structures.
-	mod.genNestedStructuresDocstring(b, res.StateInputs.Properties, true /*wrapInput*/)
+		// Nested structures are typed as `dict` so we include some extra documentation for these structures.
+		mod.genNestedStructuresDocstring(b, res.StateInputs.Properties, true /*wrapInput*/)
+	}
 
 	// printComment handles the prefix and triple quotes.
 	printComment(w, b.String(), "        ")
@@ -939,10 +1019,7 @@ func (mod *modContext) genPropDocstring(w io.Writer, prop *schema.Property, wrap
 	}
 
 	name := PyName(prop.Name)
-	ty := pyType(prop.Type)
-	if wrapInput {
-		ty = fmt.Sprintf("pulumi.Input[%s]", ty)
-	}
+	ty := mod.docTypeName(prop.Type, wrapInput)
 
 	// If this property has some documentation associated with it, we need to split it so that it is indented
 	// in a way that Sphinx can understand.
@@ -983,15 +1060,9 @@ func (mod *modContext) genNestedStructuresDocstring(w io.Writer, props []*schema
 func (mod *modContext) genNestedStructureBullets(w io.Writer, nested []*nestedVariable, indent string, wrapInput bool) {
 	contract.Assert(len(nested) > 0)
 	for i, nes := range nested {
-		name := nes.prop.Name
-		if snake, ok := mod.camelCaseToSnakeCase[name]; ok {
-			name = snake
-		}
+		name := mod.pyPropertyName(nes.prop)
 
-		typ := pyType(nes.prop.Type)
-		if wrapInput {
-			typ = fmt.Sprintf("pulumi.Input[%s]", typ)
-		}
+		typ := mod.docTypeName(nes.prop.Type, wrapInput)
 
 		docPrefix := " - "
 		if nes.prop.Comment == "" {
@@ -1056,6 +1127,11 @@ func pyType(typ schema.Type) string {
 		return "list"
 	case *schema.MapType, *schema.ObjectType, *schema.UnionType:
 		return "dict"
+	case *schema.EnumType:
+		return pyType(typ.ElementType)
+	case *schema.ResourceType:
+		// Resources are referred to by their IDs.
+		return "str"
 	case *schema.TokenType:
 		if typ.UnderlyingType != nil {
 			return pyType(typ.UnderlyingType)
@@ -1083,10 +1159,282 @@ func isStringType(t schema.Type) bool {
 	for tt, ok := t.(*schema.TokenType); ok; tt, ok = t.(*schema.TokenType) {
 		t = tt.UnderlyingType
 	}
+	if et, ok := t.(*schema.EnumType); ok {
+		t = et.ElementType
+	}
 
 	return t == schema.StringType
 }
 
+// pyModule returns the fully-qualified name of the Python module that defines the member of the given package with the
+// given token.
+func pyModule(pkg *schema.Package, tok string) string {
+	name := pyPack(pkg.Name)
+	if modName := PyName(pkg.TokenToModule(tok)); modName != "" {
+		name += "." + strings.Replace(modName, "/", ".", -1)
+	}
+	return name
+}
+
+// qualifiedTypeName returns a quoted, fully-qualified reference to the class with the given name that describes the
+// type with the given token. The module that defines the class is recorded in imports.
+func (mod *modContext) qualifiedTypeName(pkg *schema.Package, tok, name string, imports codegen.StringSet) string {
+	if pkg == nil {
+		pkg = mod.pkg
+	}
+	module := pyModule(pkg, tok)
+	imports.Add(module)
+	return fmt.Sprintf("'%s.%s'", module, name)
+}
+
+// typeHint returns the type hint for values of the given type. If input is true, the hint describes an input value:
+// object types refer to their Args TypedDicts, enum types accept either the enum or its underlying values, and the
+// elements of collections may be eventual values.
+func (mod *modContext) typeHint(t schema.Type, input bool, imports codegen.StringSet) string {
+	switch t := t.(type) {
+	case *schema.ArrayType:
+		return fmt.Sprintf("List[%s]", mod.elementTypeHint(t.ElementType, input, imports))
+	case *schema.MapType:
+		return fmt.Sprintf("Mapping[str, %s]", mod.elementTypeHint(t.ElementType, input, imports))
+	case *schema.ObjectType:
+		name := pyClassName(tokenToName(t.Token))
+		if input {
+			name += "Args"
+		}
+		return mod.qualifiedTypeName(t.Package, t.Token, name, imports)
+	case *schema.EnumType:
+		elementType := mod.typeHint(t.ElementType, input, imports)
+		if !input {
+			// Enum values are returned as values of their underlying type.
+			return elementType
+		}
+		enum := mod.qualifiedTypeName(t.Package, t.Token, pyClassName(tokenToName(t.Token)), imports)
+		return fmt.Sprintf("Union[%s, %s]", enum, elementType)
+	case *schema.ResourceType:
+		// Resources are referred to by their IDs.
+		return "str"
+	case *schema.TokenType:
+		if t.UnderlyingType != nil {
+			return mod.typeHint(t.UnderlyingType, input, imports)
+		}
+		return "Any"
+	case *schema.UnionType:
+		var elements []string
+		seen := codegen.NewStringSet()
+		for _, e := range t.ElementTypes {
+			element := mod.typeHint(e, input, imports)
+			if !seen.Has(element) {
+				seen.Add(element)
+				elements = append(elements, element)
+			}
+		}
+		if len(elements) == 1 {
+			return elements[0]
+		}
+		return fmt.Sprintf("Union[%s]", strings.Join(elements, ", "))
+	default:
+		switch t {
+		case schema.BoolType:
+			return "bool"
+		case schema.IntType:
+			return "int"
+		case schema.NumberType:
+			return "float"
+		case schema.StringType:
+			return "str"
+		case schema.ArchiveType:
+			return "pulumi.Archive"
+		case schema.AssetType:
+			return "Union[pulumi.Asset, pulumi.Archive]"
+		default:
+			return "Any"
+		}
+	}
+}
+
+// elementTypeHint returns the type hint for the elements or properties of a value of a composite type. Inputs may
+// contain eventual values.
+func (mod *modContext) elementTypeHint(t schema.Type, input bool, imports codegen.StringSet) string {
+	hint := mod.typeHint(t, input, imports)
+	if input {
+		hint = fmt.Sprintf("pulumi.Input[%s]", hint)
+	}
+	return hint
+}
+
+// docTypeName returns the name of the given type as it appears in docstrings. This is the type's hint without the quotes
+// around references to object and enum types. If input is true, the name describes an eventual input value.
+func (mod *modContext) docTypeName(t schema.Type, input bool) string {
+	return strings.Replace(mod.elementTypeHint(t, input, codegen.NewStringSet()), "'", "", -1)
+}
+
+// argTypeHint returns the type hint for an optional resource argument of the given type.
+func (mod *modContext) argTypeHint(t schema.Type, imports codegen.StringSet) string {
+	return fmt.Sprintf("Optional[%s]", mod.elementTypeHint(t, true /*input*/, imports))
+}
+
+// pyPropertyName returns the name of the given property of an object type. Properties whose names are recorded in the
+// case mapping tables are translated to snake case.
+func (mod *modContext) pyPropertyName(prop *schema.Property) string {
+	if snake, ok := mod.camelCaseToSnakeCase[prop.Name]; ok {
+		return snake
+	}
+	return prop.Name
+}
+
+var identifierRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// isIdentifier returns true if the given name is a legal Python identifier.
+func isIdentifier(name string) bool {
+	return identifierRegex.MatchString(name) && !Keywords.Has(name)
+}
+
+// genTypes emits TypedDicts that describe the object types in the given module, returning the resulting file. If
+// input is true, the TypedDicts describe input values and are named after their types with an Args suffix.
+func (mod *modContext) genTypes(input bool) string {
+	w, imports := &bytes.Buffer{}, codegen.NewStringSet()
+	for i, obj := range mod.types {
+		if i > 0 {
+			fmt.Fprintf(w, "\n\n")
+		}
+		mod.genTypedDict(w, obj, input, imports)
+	}
+	return mod.genFile(w, imports)
+}
+
+// genTypedDict emits a TypedDict that describes the given object type. Optional properties may be omitted, so they are
+// declared with total=False by a subclass of a TypedDict that declares the required properties.
+func (mod *modContext) genTypedDict(w io.Writer, obj *schema.ObjectType, input bool, imports codegen.StringSet) {
+	name := pyClassName(tokenToName(obj.Token))
+	if input {
+		name += "Args"
+	}
+
+	var required, optional []*schema.Property
+	identifiers := true
+	for _, prop := range obj.Properties {
+		if prop.IsRequired {
+			required = append(required, prop)
+		} else {
+			optional = append(optional, prop)
+		}
+		identifiers = identifiers && isIdentifier(mod.pyPropertyName(prop))
+	}
+
+	// Properties whose names are not identifiers can only be declared using the functional syntax, which does not
+	// support mixing required and optional properties.
+	if !identifiers {
+		fmt.Fprintf(w, "%s = TypedDict('%s', {\n", name, name)
+		for _, prop := range obj.Properties {
+			fmt.Fprintf(w, "    '%s': %s,\n", mod.pyPropertyName(prop), mod.elementTypeHint(prop.Type, input, imports))
+		}
+		fmt.Fprintf(w, "}, total=False)\n")
+		printComment(w, obj.Comment, "")
+		return
+	}
+
+	base := "TypedDict"
+	if len(required) > 0 && len(optional) > 0 {
+		base = "_" + name + "Required"
+		fmt.Fprintf(w, "class %s(TypedDict):\n", base)
+		mod.genTypedDictFields(w, required, input, imports)
+		fmt.Fprintf(w, "\n\n")
+	}
+
+	fields := required
+	if len(optional) > 0 {
+		base, fields = base+", total=False", optional
+	}
+	fmt.Fprintf(w, "class %s(%s):\n", name, base)
+	printComment(w, obj.Comment, "    ")
+	switch {
+	case len(obj.Properties) > 0:
+		mod.genTypedDictFields(w, fields, input, imports)
+	case obj.Comment == "":
+		fmt.Fprintf(w, "    pass\n")
+	}
+}
+
+// genTypedDictFields emits the declarations of the given properties of a TypedDict, preceded by their comments.
+func (mod *modContext) genTypedDictFields(w io.Writer, props []*schema.Property, input bool,
+	imports codegen.StringSet) {
+	for _, prop := range props {
+		lines := strings.Split(prop.Comment, "\n")
+		for len(lines) > 0 && lines[len(lines)-1] == "" {
+			lines = lines[:len(lines)-1]
+		}
+		for _, l := range lines {
+			fmt.Fprintf(w, "    # %s\n", l)
+		}
+		fmt.Fprintf(w, "    %s: %s\n", mod.pyPropertyName(prop), mod.elementTypeHint(prop.Type, input, imports))
+	}
+}
+
+// genEnums emits the enum types in the given module, returning the resulting file.
+func (mod *modContext) genEnums() (string, error) {
+	w := &bytes.Buffer{}
+	mod.genHeader(w, false)
+
+	fmt.Fprintf(w, "from enum import Enum\n")
+	for _, enum := range mod.enums {
+		fmt.Fprintf(w, "\n\n")
+		if err := mod.genEnum(w, enum); err != nil {
+			return "", err
+		}
+	}
+	return w.String(), nil
+}
+
+// genEnum emits a single enum type. Enums of strings, integers, and numbers derive from the corresponding Python
+// type so that their members may be used wherever the underlying type is expected.
+func (mod *modContext) genEnum(w io.Writer, enum *schema.EnumType) error {
+	base := "Enum"
+	switch enum.ElementType {
+	case schema.StringType:
+		base = "str, Enum"
+	case schema.IntType:
+		base = "int, Enum"
+	case schema.NumberType:
+		base = "float, Enum"
+	}
+
+	fmt.Fprintf(w, "class %s(%s):\n", pyClassName(tokenToName(enum.Token)), base)
+	printComment(w, enum.Comment, "    ")
+	for _, e := range enum.Elements {
+		value, err := getPrimitiveValue(e.Value)
+		if err != nil {
+			return err
+		}
+		if s, ok := e.Value.(string); ok {
+			value = strconv.Quote(s)
+		}
+		fmt.Fprintf(w, "    %s = %s\n", enumMemberName(e), value)
+
+		comment := e.Comment
+		if e.DeprecationMessage != "" {
+			if comment != "" {
+				comment += "\n\n"
+			}
+			comment += "Deprecated: " + e.DeprecationMessage
+		}
+		printComment(w, comment, "    ")
+	}
+	return nil
+}
+
+// enumMemberName returns the upper snake case name of the given enum member.
+func enumMemberName(e *schema.Enum) string {
+	words := codegen.EnumValueWords(e)
+	for i, word := range words {
+		words[i] = PyName(word)
+	}
+	name := strings.ToUpper(strings.Join(words, "_"))
+	if name[0] >= '0' && name[0] <= '9' {
+		name = "_" + name
+	}
+	return name
+}
+
 // pyPack returns the suggested package name for the given string.
 func pyPack(s string) string {
 	return "pulumi_" + s
@@ -1214,10 +1562,26 @@ func GeneratePackage(tool string, pkg *schema.Package, extraFiles map[string][]b
 	}
 
 	for _, f := range pkg.Functions {
+		// Resource methods are served only through Call, and are not yet generated for this language.
+		if f.IsMethod {
+			continue
+		}
+
 		mod := getMod(f.Token)
 		mod.functions = append(mod.functions, f)
 	}
 
+	for _, t := range pkg.Types {
+		switch t := t.(type) {
+		case *schema.ObjectType:
+			mod := getMod(t.Token)
+			mod.types = append(mod.types, t)
+		case *schema.EnumType:
+			mod := getMod(t.Token)
+			mod.enums = append(mod.enums, t)
+		}
+	}
+
 	if _, ok := modules["types"]; ok {
 		return nil, errors.New("this provider has a `types` module which is reserved for input/output types")
 	}
@@ -1236,6 +1600,9 @@ func GeneratePackage(tool string, pkg *schema.Package, extraFiles map[string][]b
 	// Emit casing tables.
 	files.add(filepath.Join(pyPack(pkg.Name), "tables.py"), []byte(modules[""].genPropertyConversionTables()))
 
+	// Mark the package as one that provides type information (PEP 561).
+	files.add(filepath.Join(pyPack(pkg.Name), "py.typed"), []byte{})
+
 	// Finally emit the package metadata (setup.py).
 	setup, err := genPackageMetadata(tool, pkg, info.Requires)
 	if err != nil {
@@ -0,0 +1,102 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package python
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v2/codegen/internal/test"
+	"github.com/pulumi/pulumi/pkg/v2/codegen/schema"
+)
+
+// sdkTestdataPath is the directory that contains the expected output for each of the packages generated by
+// TestGeneratePackage. Setting PULUMI_ACCEPT to a non-empty value replaces the expected output with the actual
+// output.
+var sdkTestdataPath = filepath.Join("testdata", "sdk")
+
+// readFiles returns the contents of the files in the given directory, keyed by their slash-separated paths relative to
+// the directory.
+func readFiles(t *testing.T, dir string) map[string][]byte {
+	files := map[string][]byte{}
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		contents, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(rel)] = contents
+		return nil
+	})
+	require.NoError(t, err)
+	return files
+}
+
+// writeFiles replaces the contents of the given directory with the given files.
+func writeFiles(t *testing.T, dir string, files map[string][]byte) {
+	require.NoError(t, os.RemoveAll(dir))
+	for path, contents := range files {
+		path = filepath.Join(dir, filepath.FromSlash(path))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+		require.NoError(t, ioutil.WriteFile(path, contents, 0600))
+	}
+}
+
+func sortedPaths(files map[string][]byte) []string {
+	paths := make([]string, 0, len(files))
+	for path := range files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+func TestGeneratePackage(t *testing.T) {
+	loader := schema.NewPluginLoader(test.NewHost(testdataPath))
+
+	// The backup package refers to types and resources defined by the storage package.
+	for _, name := range []string{"storage", "backup"} {
+		t.Run(name, func(t *testing.T) {
+			pkg, err := loader.LoadPackage(name, nil)
+			require.NoError(t, err)
+
+			files, err := GeneratePackage("test", pkg, nil)
+			require.NoError(t, err)
+
+			dir := filepath.Join(sdkTestdataPath, name)
+			if os.Getenv("PULUMI_ACCEPT") != "" {
+				writeFiles(t, dir, files)
+			}
+
+			expected := readFiles(t, dir)
+			assert.Equal(t, sortedPaths(expected), sortedPaths(files))
+			for path, contents := range files {
+				assert.Equal(t, string(expected[path]), string(contents), path)
+			}
+		})
+	}
+}
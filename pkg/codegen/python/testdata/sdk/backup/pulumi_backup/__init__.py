@@ -0,0 +1,9 @@
+# coding=utf-8
+# *** WARNING: this file was generated by test. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+# Export this package's modules as members:
+from ._inputs import *
+from ._outputs import *
+from .policy import *
+from .provider import *
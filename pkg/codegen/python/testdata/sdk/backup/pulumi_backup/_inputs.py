@@ -0,0 +1,23 @@
+# coding=utf-8
+# *** WARNING: this file was generated by test. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import json
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Awaitable, List, Mapping, Optional, TYPE_CHECKING, Union
+from typing_extensions import TypedDict
+from . import utilities, tables
+
+if TYPE_CHECKING:
+    import pulumi_storage.blob
+
+class RuleArgs(TypedDict, total=False):
+    """
+    A retention rule.
+    """
+    # The number of days for which backups are retained.
+    retentionDays: pulumi.Input[int]
+    # The tier to which backups are moved.
+    tier: pulumi.Input[Union['pulumi_storage.blob.Tier', str]]
@@ -0,0 +1,20 @@
+# coding=utf-8
+# *** WARNING: this file was generated by test. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import json
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Awaitable, List, Mapping, Optional, TYPE_CHECKING, Union
+from typing_extensions import TypedDict
+from . import utilities, tables
+
+class Rule(TypedDict, total=False):
+    """
+    A retention rule.
+    """
+    # The number of days for which backups are retained.
+    retentionDays: int
+    # The tier to which backups are moved.
+    tier: str
@@ -0,0 +1,121 @@
+# coding=utf-8
+# *** WARNING: this file was generated by test. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import json
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Awaitable, List, Mapping, Optional, TYPE_CHECKING, Union
+from typing_extensions import TypedDict
+from . import utilities, tables
+
+if TYPE_CHECKING:
+    import pulumi_backup
+    import pulumi_storage
+
+class Policy(pulumi.CustomResource):
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 container: Optional[pulumi.Input[str]] = None,
+                 metadata: Optional[pulumi.Input['pulumi_storage.MetadataArgs']] = None,
+                 rules: Optional[pulumi.Input[List[pulumi.Input['pulumi_backup.RuleArgs']]]] = None,
+                 __props__=None,
+                 __name__=None,
+                 __opts__=None):
+        """
+        A backup policy for a blob container.
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[str] container: The container to back up.
+
+        The **metadata** object supports the following:
+
+          * `labels` (`pulumi.Input[Mapping[str, pulumi.Input[str]]]`) - Labels attached to the resource.
+          * `name` (`pulumi.Input[str]`) - The name of the resource.
+
+        The **rules** object supports the following:
+
+          * `retentionDays` (`pulumi.Input[int]`) - The number of days for which backups are retained.
+          * `tier` (`pulumi.Input[Union[pulumi_storage.blob.Tier, str]]`) - The tier to which backups are moved.
+        """
+        if __name__ is not None:
+            warnings.warn("explicit use of __name__ is deprecated", DeprecationWarning)
+            resource_name = __name__
+        if __opts__ is not None:
+            warnings.warn("explicit use of __opts__ is deprecated, use 'opts' instead", DeprecationWarning)
+            opts = __opts__
+        if opts is None:
+            opts = pulumi.ResourceOptions()
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.version is None:
+            opts.version = utilities.get_version()
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = dict()
+
+            if container is None:
+                raise TypeError("Missing required property 'container'")
+            __props__['container'] = container
+            __props__['metadata'] = metadata
+            __props__['rules'] = rules
+        super(Policy, __self__).__init__(
+            'backup:index:Policy',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'Policy':
+        """
+        Get an existing Policy resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param str id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = dict()
+
+        return Policy(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    def container(self) -> pulumi.Output[str]:
+        """
+        The container to back up.
+        """
+        return self.__dict__['container']
+
+    @container.setter
+    def container(self, value: pulumi.Output[str]) -> None:
+        self.__dict__['container'] = value
+
+    @property
+    def metadata(self) -> pulumi.Output[Optional['pulumi_storage.Metadata']]:
+        return self.__dict__['metadata']
+
+    @metadata.setter
+    def metadata(self, value: pulumi.Output[Optional['pulumi_storage.Metadata']]) -> None:
+        self.__dict__['metadata'] = value
+
+    @property
+    def rules(self) -> pulumi.Output[Optional[List['pulumi_backup.Rule']]]:
+        return self.__dict__['rules']
+
+    @rules.setter
+    def rules(self, value: pulumi.Output[Optional[List['pulumi_backup.Rule']]]) -> None:
+        self.__dict__['rules'] = value
+
+    def translate_output_property(self, prop):
+        return tables._CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+    def translate_input_property(self, prop):
+        return tables._SNAKE_TO_CAMEL_CASE_TABLE.get(prop) or prop
+
@@ -0,0 +1,9 @@
+# coding=utf-8
+# *** WARNING: this file was generated by test. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+_SNAKE_TO_CAMEL_CASE_TABLE = {
+}
+
+_CAMEL_TO_SNAKE_CASE_TABLE = {
+}
@@ -0,0 +1,18 @@
+# coding=utf-8
+# *** WARNING: this file was generated by test. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import importlib
+# Make subpackages available:
+__all__ = ['blob']
+for pkg in __all__:
+    if pkg != 'config':
+        importlib.import_module(f'{__name__}.{pkg}')
+
+# Export this package's modules as members:
+from ._inputs import *
+from ._outputs import *
+from .account import *
+from .get_account import *
+from .provider import *
+from .site import *
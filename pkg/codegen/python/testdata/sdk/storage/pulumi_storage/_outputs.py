@@ -0,0 +1,23 @@
+# coding=utf-8
+# *** WARNING: this file was generated by test. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import json
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Awaitable, List, Mapping, Optional, TYPE_CHECKING, Union
+from typing_extensions import TypedDict
+from . import utilities, tables
+
+class _MetadataRequired(TypedDict):
+    # The name of the resource.
+    name: str
+
+
+class Metadata(_MetadataRequired, total=False):
+    """
+    Metadata common to all storage resources.
+    """
+    # Labels attached to the resource.
+    labels: Mapping[str, str]
@@ -0,0 +1,7 @@
+# coding=utf-8
+# *** WARNING: this file was generated by test. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+# Export this package's modules as members:
+from ._enums import *
+from .container import *
@@ -0,0 +1,13 @@
+# coding=utf-8
+# *** WARNING: this file was generated by test. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+from enum import Enum
+
+
+class Tier(str, Enum):
+    """
+    The access tier of a container.
+    """
+    HOT = "Hot"
+    COLD = "Cold"
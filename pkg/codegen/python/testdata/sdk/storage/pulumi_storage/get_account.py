@@ -0,0 +1,49 @@
+# coding=utf-8
+# *** WARNING: this file was generated by test. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import json
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Awaitable, List, Mapping, Optional, TYPE_CHECKING, Union
+from typing_extensions import TypedDict
+from . import utilities, tables
+
+class GetAccountResult:
+    def __init__(__self__, container_count=None, metadata=None):
+        if container_count and not isinstance(container_count, float):
+            raise TypeError("Expected argument 'container_count' to be a float")
+        __self__.container_count = container_count
+        if metadata and not isinstance(metadata, dict):
+            raise TypeError("Expected argument 'metadata' to be a dict")
+        __self__.metadata = metadata
+class AwaitableGetAccountResult(GetAccountResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetAccountResult(
+            container_count=self.container_count,
+            metadata=self.metadata)
+
+def get_account(name: Optional[str] = None,
+                opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetAccountResult:
+    """
+    Gets an existing storage account.
+
+    :param str name: The name of the account.
+    """
+    __args__ = dict()
+
+
+    __args__['name'] = name
+    if opts is None:
+        opts = pulumi.InvokeOptions()
+    if opts.version is None:
+        opts.version = utilities.get_version()
+    __ret__ = pulumi.runtime.invoke('storage:index:getAccount', __args__, opts=opts).value
+
+    return AwaitableGetAccountResult(
+        container_count=__ret__.get('containerCount'),
+        metadata=__ret__.get('metadata'))
@@ -0,0 +1,83 @@
+# coding=utf-8
+# *** WARNING: this file was generated by test. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import json
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Awaitable, List, Mapping, Optional, TYPE_CHECKING, Union
+from typing_extensions import TypedDict
+from . import utilities, tables
+
+class Site(pulumi.ComponentResource):
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 index_document: Optional[pulumi.Input[str]] = None,
+                 __props__=None,
+                 __name__=None,
+                 __opts__=None):
+        """
+        A static website hosted in a storage account.
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[str] index_document: The name of the document to serve for requests to the root of the site.
+        """
+        if __name__ is not None:
+            warnings.warn("explicit use of __name__ is deprecated", DeprecationWarning)
+            resource_name = __name__
+        if __opts__ is not None:
+            warnings.warn("explicit use of __opts__ is deprecated, use 'opts' instead", DeprecationWarning)
+            opts = __opts__
+        if opts is None:
+            opts = pulumi.ResourceOptions()
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.version is None:
+            opts.version = utilities.get_version()
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = dict()
+
+            if index_document is None:
+                raise TypeError("Missing required property 'index_document'")
+            __props__['index_document'] = index_document
+            __props__['account'] = None
+            __props__['url'] = None
+        super(Site, __self__).__init__(
+            'storage:index:Site',
+            resource_name,
+            __props__,
+            opts,
+            remote=True)
+
+    @property
+    def account(self) -> pulumi.Output[str]:
+        """
+        The storage account that hosts the site.
+        """
+        return self.__dict__['account']
+
+    @account.setter
+    def account(self, value: pulumi.Output[str]) -> None:
+        self.__dict__['account'] = value
+
+    @property
+    def url(self) -> pulumi.Output[str]:
+        """
+        The URL of the site.
+        """
+        return self.__dict__['url']
+
+    @url.setter
+    def url(self, value: pulumi.Output[str]) -> None:
+        self.__dict__['url'] = value
+
+    def translate_output_property(self, prop):
+        return tables._CAMEL_TO_SNAKE_CASE_TABLE.get(prop) or prop
+
+    def translate_input_property(self, prop):
+        return tables._SNAKE_TO_CAMEL_CASE_TABLE.get(prop) or prop
+
@@ -0,0 +1,13 @@
+# coding=utf-8
+# *** WARNING: this file was generated by test. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+_SNAKE_TO_CAMEL_CASE_TABLE = {
+    "account_name": "accountName",
+    "index_document": "indexDocument",
+}
+
+_CAMEL_TO_SNAKE_CASE_TABLE = {
+    "accountName": "account_name",
+    "indexDocument": "index_document",
+}
@@ -0,0 +1,463 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Change describes a difference between two versions of a package.
+type Change struct {
+	// Path is the path to the schema element that changed, as a list of JSON object keys and array indices (e.g.
+	// ["resources", "aws:s3/bucket:Bucket", "inputProperties", "acl"]). The path refers to the old schema if the
+	// element was removed, and to the new schema otherwise.
+	Path []string
+	// Breaking is true if the change may break programs that were written against the old version of the package.
+	Breaking bool
+	// Message describes the change.
+	Message string
+}
+
+// DiffPackages compares two versions of a package and returns the changes between them, ordered by path.
+//
+// A change is breaking if a program that uses the old version of the package may fail to compile or may behave
+// differently when it uses the new version: for example, if a resource, function, type, or property is removed or
+// moved to a different module, if the type of a property changes, if a new input property is required, if the default
+// value of a property changes, or if changes to a property newly require its resource to be replaced. Other changes,
+// such as the addition of resources or optional properties, are not breaking.
+func DiffPackages(oldPkg, newPkg *Package) []*Change {
+	d := &differ{oldPkg: oldPkg, newPkg: newPkg, usage: map[string]*typeUsage{}}
+	d.computeUsage(oldPkg)
+	d.computeUsage(newPkg)
+
+	d.diffProperties([]string{"config", "variables"}, oldPkg.Config, newPkg.Config, typeUsage{input: true})
+	d.diffProperties([]string{"provider", "inputProperties"}, oldPkg.Provider.InputProperties,
+		newPkg.Provider.InputProperties, typeUsage{input: true})
+
+	d.diffResources()
+	d.diffFunctions()
+	d.diffTypes()
+
+	sort.SliceStable(d.changes, func(i, j int) bool {
+		return comparePaths(d.changes[i].Path, d.changes[j].Path) < 0
+	})
+	return d.changes
+}
+
+// typeUsage records whether the values of a type are used as inputs, outputs, or both.
+type typeUsage struct {
+	input  bool
+	output bool
+}
+
+type differ struct {
+	oldPkg  *Package
+	newPkg  *Package
+	usage   map[string]*typeUsage
+	changes []*Change
+}
+
+func (d *differ) addChange(breaking bool, path []string, format string, args ...interface{}) {
+	d.changes = append(d.changes, &Change{
+		Path:     append([]string(nil), path...),
+		Breaking: breaking,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// computeUsage records whether each of a package's object types is used as an input, an output, or both.
+func (d *differ) computeUsage(pkg *Package) {
+	var visit func(t Type, input bool)
+	visit = func(t Type, input bool) {
+		switch t := t.(type) {
+		case *ArrayType:
+			visit(t.ElementType, input)
+		case *MapType:
+			visit(t.ElementType, input)
+		case *UnionType:
+			for _, e := range t.ElementTypes {
+				visit(e, input)
+			}
+		case *ObjectType:
+			usage, ok := d.usage[t.Token]
+			if !ok {
+				usage = &typeUsage{}
+				d.usage[t.Token] = usage
+			}
+			if input && usage.input || !input && usage.output {
+				return
+			}
+			if input {
+				usage.input = true
+			} else {
+				usage.output = true
+			}
+			for _, p := range t.Properties {
+				visit(p.Type, input)
+			}
+		}
+	}
+	visitProperties := func(props []*Property, input bool) {
+		for _, p := range props {
+			visit(p.Type, input)
+		}
+	}
+
+	visitProperties(pkg.Config, true)
+	visitProperties(pkg.Provider.InputProperties, true)
+	for _, r := range pkg.Resources {
+		visitProperties(r.InputProperties, true)
+		visitProperties(r.Properties, false)
+	}
+	for _, f := range pkg.Functions {
+		if f.Inputs != nil {
+			visitProperties(f.Inputs.Properties, true)
+		}
+		if f.Outputs != nil {
+			visitProperties(f.Outputs.Properties, false)
+		}
+	}
+}
+
+// diffMembers compares the tokens of the resources, functions, or types of the old and new packages. Members that
+// were removed or added are reported as such, unless a removed member was moved to a different module, in which case
+// the move is reported. The given diff function is called for each member that exists in both packages.
+func (d *differ) diffMembers(key, kind string, oldTokens, newTokens []string,
+	diff func(path []string, oldTok, newTok string)) {
+	oldSet, newSet := map[string]bool{}, map[string]bool{}
+	for _, tok := range oldTokens {
+		oldSet[tok] = true
+	}
+	for _, tok := range newTokens {
+		newSet[tok] = true
+	}
+
+	var removed, added []string
+	for _, tok := range oldTokens {
+		if !newSet[tok] {
+			removed = append(removed, tok)
+		}
+	}
+	for _, tok := range newTokens {
+		if !oldSet[tok] {
+			added = append(added, tok)
+		}
+	}
+
+	moved := map[string]bool{}
+	for _, oldTok := range removed {
+		var candidates []string
+		for _, newTok := range added {
+			if !moved[newTok] && tokenMember(newTok) == tokenMember(oldTok) {
+				candidates = append(candidates, newTok)
+			}
+		}
+		if len(candidates) != 1 {
+			d.addChange(true, []string{key, oldTok}, "%s removed", kind)
+			continue
+		}
+
+		newTok := candidates[0]
+		moved[newTok] = true
+		path := []string{key, newTok}
+		d.addChange(true, path, "%s moved from module %q to module %q", kind, tokenModule(oldTok), tokenModule(newTok))
+		diff(path, oldTok, newTok)
+	}
+	for _, tok := range added {
+		if !moved[tok] {
+			d.addChange(false, []string{key, tok}, "%s added", kind)
+		}
+	}
+
+	for _, tok := range newTokens {
+		if oldSet[tok] {
+			diff([]string{key, tok}, tok, tok)
+		}
+	}
+}
+
+// tokenModule returns the module component of a token.
+func tokenModule(tok string) string {
+	components := strings.Split(tok, ":")
+	if len(components) != 3 {
+		return ""
+	}
+	return components[1]
+}
+
+// tokenMember returns the member name component of a token.
+func tokenMember(tok string) string {
+	components := strings.Split(tok, ":")
+	return components[len(components)-1]
+}
+
+func (d *differ) diffResources() {
+	oldResources, newResources := map[string]*Resource{}, map[string]*Resource{}
+	var oldTokens, newTokens []string
+	for _, r := range d.oldPkg.Resources {
+		oldResources[r.Token], oldTokens = r, append(oldTokens, r.Token)
+	}
+	for _, r := range d.newPkg.Resources {
+		newResources[r.Token], newTokens = r, append(newTokens, r.Token)
+	}
+
+	d.diffMembers("resources", "resource", oldTokens, newTokens, func(path []string, oldTok, newTok string) {
+		oldResource, newResource := oldResources[oldTok], newResources[newTok]
+		d.diffProperties(append(path, "inputProperties"), oldResource.InputProperties, newResource.InputProperties,
+			typeUsage{input: true})
+		d.diffProperties(append(path, "properties"), oldResource.Properties, newResource.Properties,
+			typeUsage{output: true})
+		if oldResource.IsComponent != newResource.IsComponent {
+			d.addChange(true, append(path, "isComponent"), "isComponent changed from %v to %v",
+				oldResource.IsComponent, newResource.IsComponent)
+		}
+		d.diffDeprecation(path, "resource", oldResource.DeprecationMessage, newResource.DeprecationMessage)
+	})
+}
+
+func (d *differ) diffFunctions() {
+	oldFunctions, newFunctions := map[string]*Function{}, map[string]*Function{}
+	var oldTokens, newTokens []string
+	for _, f := range d.oldPkg.Functions {
+		oldFunctions[f.Token], oldTokens = f, append(oldTokens, f.Token)
+	}
+	for _, f := range d.newPkg.Functions {
+		newFunctions[f.Token], newTokens = f, append(newTokens, f.Token)
+	}
+
+	objectProperties := func(obj *ObjectType) []*Property {
+		if obj == nil {
+			return nil
+		}
+		return obj.Properties
+	}
+
+	d.diffMembers("functions", "function", oldTokens, newTokens, func(path []string, oldTok, newTok string) {
+		oldFunction, newFunction := oldFunctions[oldTok], newFunctions[newTok]
+		d.diffProperties(append(path, "inputs", "properties"), objectProperties(oldFunction.Inputs),
+			objectProperties(newFunction.Inputs), typeUsage{input: true})
+		d.diffProperties(append(path, "outputs", "properties"), objectProperties(oldFunction.Outputs),
+			objectProperties(newFunction.Outputs), typeUsage{output: true})
+		d.diffDeprecation(path, "function", oldFunction.DeprecationMessage, newFunction.DeprecationMessage)
+	})
+}
+
+func (d *differ) diffTypes() {
+	typeTokens := func(pkg *Package) (map[string]Type, []string) {
+		types, tokens := map[string]Type{}, []string(nil)
+		for _, t := range pkg.Types {
+			var tok string
+			switch t := t.(type) {
+			case *ObjectType:
+				tok = t.Token
+			case *EnumType:
+				tok = t.Token
+			default:
+				continue
+			}
+			types[tok], tokens = t, append(tokens, tok)
+		}
+		return types, tokens
+	}
+	oldTypes, oldTokens := typeTokens(d.oldPkg)
+	newTypes, newTokens := typeTokens(d.newPkg)
+
+	d.diffMembers("types", "type", oldTokens, newTokens, func(path []string, oldTok, newTok string) {
+		switch oldType := oldTypes[oldTok].(type) {
+		case *ObjectType:
+			newType, ok := newTypes[newTok].(*ObjectType)
+			if !ok {
+				d.addChange(true, path, "type changed from an object type to an enum type")
+				return
+			}
+
+			usage, ok := d.usage[oldTok]
+			if !ok || !usage.input && !usage.output {
+				// Types that are not used by any resource or function may be used as either inputs or outputs.
+				usage = &typeUsage{input: true, output: true}
+			}
+			d.diffProperties(append(path, "properties"), oldType.Properties, newType.Properties, *usage)
+		case *EnumType:
+			newType, ok := newTypes[newTok].(*EnumType)
+			if !ok {
+				d.addChange(true, path, "type changed from an enum type to an object type")
+				return
+			}
+			d.diffEnum(path, oldType, newType)
+		}
+	})
+}
+
+// diffEnum compares the values of two versions of an enum type. Values are matched by value rather than by name.
+func (d *differ) diffEnum(path []string, oldType, newType *EnumType) {
+	if oldType.ElementType != newType.ElementType {
+		d.addChange(true, append(path, "type"), "element type changed from %v to %v", oldType.ElementType,
+			newType.ElementType)
+		return
+	}
+
+	for i, oldValue := range oldType.Elements {
+		found := false
+		for j, newValue := range newType.Elements {
+			if !reflect.DeepEqual(oldValue.Value, newValue.Value) {
+				continue
+			}
+			found = true
+			if oldValue.Name != newValue.Name {
+				d.addChange(true, append(path, "enum", strconv.Itoa(j), "name"), "name of value %v changed from %q to %q",
+					newValue.Value, oldValue.Name, newValue.Name)
+			}
+			d.diffDeprecation(append(path, "enum", strconv.Itoa(j)), "value", oldValue.DeprecationMessage,
+				newValue.DeprecationMessage)
+			break
+		}
+		if !found {
+			d.addChange(true, append(path, "enum", strconv.Itoa(i)), "value %v removed", oldValue.Value)
+		}
+	}
+	for j, newValue := range newType.Elements {
+		found := false
+		for _, oldValue := range oldType.Elements {
+			if reflect.DeepEqual(oldValue.Value, newValue.Value) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			d.addChange(false, append(path, "enum", strconv.Itoa(j)), "value %v added", newValue.Value)
+		}
+	}
+}
+
+// diffProperties compares two versions of a set of properties. Whether a change is breaking depends on whether the
+// properties are used as inputs, outputs, or both: for example, a new required property breaks programs that must
+// now supply it as an input, but not programs that read it as an output.
+func (d *differ) diffProperties(path []string, oldProps, newProps []*Property, usage typeUsage) {
+	oldMap := map[string]*Property{}
+	for _, p := range oldProps {
+		oldMap[p.Name] = p
+	}
+	newMap := map[string]*Property{}
+	for _, p := range newProps {
+		newMap[p.Name] = p
+	}
+
+	for _, p := range oldProps {
+		if _, ok := newMap[p.Name]; !ok {
+			d.addChange(true, append(path, p.Name), "property removed")
+		}
+	}
+
+	for _, newProp := range newProps {
+		propPath := append(append([]string(nil), path...), newProp.Name)
+
+		oldProp, ok := oldMap[newProp.Name]
+		if !ok {
+			if newProp.IsRequired {
+				d.addChange(usage.input, propPath, "required property added")
+			} else {
+				d.addChange(false, propPath, "optional property added")
+			}
+			continue
+		}
+
+		if oldTyp, newTyp := oldProp.Type.String(), newProp.Type.String(); oldTyp != newTyp {
+			// Widening the type of an input or narrowing the type of an output is compatible with existing programs.
+			compatible := usage.input && !usage.output && unionContains(newProp.Type, oldProp.Type) ||
+				usage.output && !usage.input && unionContains(oldProp.Type, newProp.Type)
+			d.addChange(!compatible, append(propPath, "type"), "type changed from %v to %v", oldTyp, newTyp)
+		}
+
+		switch {
+		case newProp.IsRequired && !oldProp.IsRequired:
+			d.addChange(usage.input, propPath, "property is now required")
+		case !newProp.IsRequired && oldProp.IsRequired:
+			d.addChange(usage.output, propPath, "property is now optional")
+		}
+
+		oldDefault, newDefault := defaultValueString(oldProp.DefaultValue), defaultValueString(newProp.DefaultValue)
+		switch {
+		case oldDefault == newDefault:
+			// OK
+		case oldDefault == "":
+			d.addChange(false, append(propPath, "default"), "default value %s added", newDefault)
+		case newDefault == "":
+			d.addChange(true, append(propPath, "default"), "default value %s removed", oldDefault)
+		default:
+			d.addChange(true, append(propPath, "default"), "default value changed from %s to %s", oldDefault, newDefault)
+		}
+
+		switch {
+		case newProp.ReplaceOnChanges && !oldProp.ReplaceOnChanges:
+			d.addChange(true, append(propPath, "replaceOnChanges"), "changes to the property now require replacement")
+		case !newProp.ReplaceOnChanges && oldProp.ReplaceOnChanges:
+			d.addChange(false, append(propPath, "replaceOnChanges"),
+				"changes to the property no longer require replacement")
+		}
+
+		d.diffDeprecation(propPath, "property", oldProp.DeprecationMessage, newProp.DeprecationMessage)
+	}
+}
+
+// diffDeprecation reports the deprecation of a schema element, which is not a breaking change.
+func (d *differ) diffDeprecation(path []string, kind, oldMessage, newMessage string) {
+	if newMessage != "" && oldMessage == "" {
+		d.addChange(false, append(path, "deprecationMessage"), "%s deprecated: %s", kind, newMessage)
+	}
+}
+
+// unionContains returns true if the given type is a union type that includes the given element type.
+func unionContains(t, element Type) bool {
+	union, ok := t.(*UnionType)
+	if !ok {
+		return false
+	}
+	for _, e := range union.ElementTypes {
+		if e.String() == element.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// defaultValueString returns a string that describes the given default value, or the empty string if there is none.
+func defaultValueString(dv *DefaultValue) string {
+	if dv == nil {
+		return ""
+	}
+
+	var parts []string
+	if dv.Value != nil {
+		parts = append(parts, fmt.Sprintf("%#v", dv.Value))
+	}
+	for _, env := range dv.Environment {
+		parts = append(parts, "$"+env)
+	}
+	return strings.Join(parts, " or ")
+}
+
+// comparePaths orders paths lexicographically by their elements.
+func comparePaths(a, b []string) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if c := strings.Compare(a[i], b[i]); c != 0 {
+			return c
+		}
+	}
+	return len(a) - len(b)
+}
@@ -0,0 +1,160 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// nolint: lll
+func TestDiffPackages(t *testing.T) {
+	stringType := TypeSpec{Type: "string"}
+	intType := TypeSpec{Type: "integer"}
+
+	oldSpec := PackageSpec{
+		Name: "test",
+		Types: map[string]ComplexTypeSpec{
+			"test:index:Rule": {
+				ObjectTypeSpec: ObjectTypeSpec{
+					Type: "object",
+					Properties: map[string]PropertySpec{
+						"days": {TypeSpec: intType},
+					},
+				},
+			},
+			"test:index:Tier": {
+				ObjectTypeSpec: ObjectTypeSpec{Type: "string"},
+				Enum:           []EnumValueSpec{{Name: "Hot", Value: "hot"}, {Name: "Cold", Value: "cold"}},
+			},
+		},
+		Resources: map[string]ResourceSpec{
+			"test:index:Bucket": {
+				ObjectTypeSpec: ObjectTypeSpec{
+					Properties: map[string]PropertySpec{
+						"arn":  {TypeSpec: stringType},
+						"size": {TypeSpec: intType},
+					},
+					Required: []string{"arn", "size"},
+				},
+				InputProperties: map[string]PropertySpec{
+					"acl":   {TypeSpec: stringType, Default: "private"},
+					"name":  {TypeSpec: stringType},
+					"rules": {TypeSpec: TypeSpec{Type: "array", Items: &TypeSpec{Ref: "#/types/test:index:Rule"}}},
+					"size":  {TypeSpec: intType},
+					"tier":  {TypeSpec: TypeSpec{Ref: "#/types/test:index:Tier"}},
+				},
+			},
+			"test:index:Queue":   {},
+			"test:index:Service": {},
+			"test:index:Widget":  {},
+		},
+		Functions: map[string]FunctionSpec{
+			"test:index:getBucket": {
+				Outputs: &ObjectTypeSpec{
+					Properties: map[string]PropertySpec{
+						"arn": {TypeSpec: stringType},
+					},
+				},
+			},
+		},
+	}
+
+	newSpec := PackageSpec{
+		Name: "test",
+		Types: map[string]ComplexTypeSpec{
+			"test:index:Rule": {
+				ObjectTypeSpec: ObjectTypeSpec{
+					Type: "object",
+					Properties: map[string]PropertySpec{
+						"days":   {TypeSpec: intType},
+						"prefix": {TypeSpec: stringType},
+					},
+					Required: []string{"prefix"},
+				},
+			},
+			"test:index:Tier": {
+				ObjectTypeSpec: ObjectTypeSpec{Type: "string"},
+				Enum:           []EnumValueSpec{{Name: "Hot", Value: "hot"}, {Name: "Archive", Value: "archive"}},
+			},
+		},
+		Resources: map[string]ResourceSpec{
+			"test:index:Bucket": {
+				ObjectTypeSpec: ObjectTypeSpec{
+					Properties: map[string]PropertySpec{
+						"arn":  {TypeSpec: stringType},
+						"size": {TypeSpec: intType},
+						"tags": {TypeSpec: TypeSpec{Type: "object", AdditionalProperties: &stringType}},
+					},
+					Required: []string{"size"},
+				},
+				InputProperties: map[string]PropertySpec{
+					"acl":  {TypeSpec: stringType, Default: "public-read"},
+					"name": {TypeSpec: stringType, ReplaceOnChanges: true},
+					"rules": {
+						TypeSpec:           TypeSpec{Type: "array", Items: &TypeSpec{Ref: "#/types/test:index:Rule"}},
+						DeprecationMessage: "Use lifecycle rules instead.",
+					},
+					"size":  {TypeSpec: TypeSpec{OneOf: []TypeSpec{intType, stringType}}},
+					"tier":  {TypeSpec: TypeSpec{Ref: "#/types/test:index:Tier"}},
+					"owner": {TypeSpec: stringType},
+				},
+				RequiredInputs: []string{"owner"},
+			},
+			"test:messaging:Queue": {},
+			"test:index:Service":   {IsComponent: true},
+			"test:index:Topic":     {},
+		},
+	}
+
+	oldPkg, err := ImportSpec(oldSpec, nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	newPkg, err := ImportSpec(newSpec, nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	var actual []string
+	for _, change := range DiffPackages(oldPkg, newPkg) {
+		kind := "non-breaking"
+		if change.Breaking {
+			kind = "breaking"
+		}
+		actual = append(actual, strings.Join(change.Path, "/")+": "+kind+": "+change.Message)
+	}
+
+	expected := []string{
+		`functions/test:index:getBucket: breaking: function removed`,
+		`resources/test:index:Bucket/inputProperties/acl/default: breaking: default value changed from "private" to "public-read"`,
+		`resources/test:index:Bucket/inputProperties/name/replaceOnChanges: breaking: changes to the property now require replacement`,
+		`resources/test:index:Bucket/inputProperties/owner: breaking: required property added`,
+		`resources/test:index:Bucket/inputProperties/rules/deprecationMessage: non-breaking: property deprecated: Use lifecycle rules instead.`,
+		`resources/test:index:Bucket/inputProperties/size/type: non-breaking: type changed from integer to Union<integer, string>`,
+		`resources/test:index:Bucket/properties/arn: breaking: property is now optional`,
+		`resources/test:index:Bucket/properties/tags: non-breaking: optional property added`,
+		`resources/test:index:Service/isComponent: breaking: isComponent changed from false to true`,
+		`resources/test:index:Topic: non-breaking: resource added`,
+		`resources/test:index:Widget: breaking: resource removed`,
+		`resources/test:messaging:Queue: breaking: resource moved from module "index" to module "messaging"`,
+		`types/test:index:Rule/properties/prefix: breaking: required property added`,
+		`types/test:index:Tier/enum/1: breaking: value cold removed`,
+		`types/test:index:Tier/enum/1: non-breaking: value archive added`,
+	}
+	assert.Equal(t, expected, actual)
+}
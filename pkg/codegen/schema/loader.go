@@ -0,0 +1,91 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"encoding/json"
+
+	"github.com/blang/semver"
+	"github.com/pkg/errors"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/tokens"
+)
+
+// Loader loads the packages that define the types and resources referred to by a package schema.
+type Loader interface {
+	// LoadPackage loads the given version of the named package. If the version is nil, the newest available version of
+	// the package is loaded.
+	LoadPackage(pkg string, version *semver.Version) (*Package, error)
+}
+
+type pluginLoader struct {
+	host    plugin.Host
+	entries map[string]*Package
+	loading map[string]bool
+}
+
+// NewPluginLoader returns a Loader that loads package schemas from the resource provider plugins that are available to
+// the given host. Loaded packages are cached, and references to other packages within loaded schemas are resolved
+// using the same loader. The returned loader is not safe for concurrent use.
+func NewPluginLoader(host plugin.Host) Loader {
+	return &pluginLoader{
+		host:    host,
+		entries: map[string]*Package{},
+		loading: map[string]bool{},
+	}
+}
+
+func (l *pluginLoader) LoadPackage(pkg string, version *semver.Version) (*Package, error) {
+	key := pkg
+	if version != nil {
+		key += "@" + version.String()
+	}
+
+	if p, ok := l.entries[key]; ok {
+		return p, nil
+	}
+	if l.loading[key] {
+		return nil, errors.Errorf("cyclic reference to package %s", key)
+	}
+	l.loading[key] = true
+	defer delete(l.loading, key)
+
+	provider, err := l.host.Provider(tokens.Package(pkg), version)
+	if err != nil {
+		return nil, err
+	}
+	if provider == nil {
+		return nil, errors.Errorf("no resource plugin for package %s is available", key)
+	}
+
+	schemaBytes, err := provider.GetSchema(0)
+	if err != nil {
+		return nil, err
+	}
+
+	var spec PackageSpec
+	if err := json.Unmarshal(schemaBytes, &spec); err != nil {
+		return nil, errors.Wrapf(err, "decoding schema for package %s", key)
+	}
+
+	p, err := ImportSpecWithLoader(spec, nil, l)
+	if err != nil {
+		return nil, err
+	}
+
+	l.entries[key] = p
+	return p, nil
+}
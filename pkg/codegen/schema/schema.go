@@ -18,9 +18,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+	"net/url"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
+	"sync"
+	"unicode"
 
 	"github.com/blang/semver"
 	"github.com/pkg/errors"
@@ -140,6 +144,8 @@ func (*UnionType) isType() {}
 
 // ObjectType represents schematized maps from strings to particular types.
 type ObjectType struct {
+	// Package is the package that defines the type.
+	Package *Package
 	// Token is the type's Pulumi type token.
 	Token string
 	// Comment is the description of the type, if any.
@@ -169,6 +175,54 @@ func (t *ObjectType) String() string {
 
 func (*ObjectType) isType() {}
 
+// EnumType represents an enumeration: a named type whose values are restricted to a fixed set of values of a primitive
+// element type.
+type EnumType struct {
+	// Package is the package that defines the type.
+	Package *Package
+	// Token is the type's Pulumi type token.
+	Token string
+	// Comment is the description of the type, if any.
+	Comment string
+	// Elements is the list of the enum's values.
+	Elements []*Enum
+	// ElementType is the primitive type of the enum's values.
+	ElementType Type
+}
+
+func (t *EnumType) String() string {
+	return t.Token
+}
+
+func (*EnumType) isType() {}
+
+// Enum describes a single value of an enum type.
+type Enum struct {
+	// Name is the name of the value, if any. Generators derive a name from the value itself if this is empty.
+	Name string
+	// Comment is the description of the value, if any.
+	Comment string
+	// Value is the value. Its type corresponds to the enum's element type.
+	Value interface{}
+	// DeprecationMessage indicates whether or not the value is deprecated.
+	DeprecationMessage string
+}
+
+// ResourceType represents a reference to a resource. Resources are referenced by their IDs: a value of a resource type
+// is the ID of a resource of that type.
+type ResourceType struct {
+	// Token is the type's Pulumi type token.
+	Token string
+	// Resource is the resource to which the type refers.
+	Resource *Resource
+}
+
+func (t *ResourceType) String() string {
+	return t.Token
+}
+
+func (*ResourceType) isType() {}
+
 // TokenType represents an opaque type that is referred to only by its token. A TokenType may have an underlying type
 // that can be used in place of the token.
 type TokenType struct {
@@ -209,6 +263,8 @@ type Property struct {
 	DefaultValue *DefaultValue
 	// IsRequired is true if the property must always be populated.
 	IsRequired bool
+	// ReplaceOnChanges is true if changes to the property require its resource to be replaced.
+	ReplaceOnChanges bool
 	// DeprecationMessage indicates whether or not the property is deprecated.
 	DeprecationMessage string
 	// Language specifies additional language-specific data about the property.
@@ -227,12 +283,17 @@ type Alias struct {
 
 // Resource describes a Pulumi resource.
 type Resource struct {
+	// Package is the package that defines the resource.
+	Package *Package
 	// Token is the resource's Pulumi type token.
 	Token string
 	// Comment is the description of the resource, if any.
 	Comment string
 	// IsProvider is true if the resource is a provider resource.
 	IsProvider bool
+	// IsComponent is true if the resource is a component resource that is constructed by its provider. The outputs of a
+	// component resource are the outputs that its provider registers for it.
+	IsComponent bool
 	// InputProperties is the list of the resource's input properties.
 	InputProperties []*Property
 	// Properties is the list of the resource's output properties. This should be a superset of the input properties.
@@ -245,6 +306,17 @@ type Resource struct {
 	DeprecationMessage string
 	// Language specifies additional language-specific data about the resource.
 	Language map[string]interface{}
+	// Methods is the list of the resource's methods.
+	Methods []*Method
+}
+
+// Method describes a method of a Pulumi resource.
+type Method struct {
+	// Name is the method's name.
+	Name string
+	// Function is the function that implements the method. The function's token identifies the method in calls to the
+	// resource's provider.
+	Function *Function
 }
 
 // Function describes a Pulumi function.
@@ -261,6 +333,8 @@ type Function struct {
 	DeprecationMessage string
 	// Language specifies additional language-specific data about the function.
 	Language map[string]interface{}
+	// IsMethod is true if the function implements a method of a resource.
+	IsMethod bool
 }
 
 // Package describes a Pulumi package.
@@ -298,6 +372,15 @@ type Package struct {
 	Functions []*Function
 	// Language specifies additional language-specific data about the package.
 	Language map[string]interface{}
+	// Dependencies is the list of other packages that define types or resources referred to by the package, sorted by
+	// name.
+	Dependencies []*Package
+
+	// Packages are shared by the loader's cache, so the lookup tables are built at most once.
+	typeTableOnce     sync.Once
+	typeTable         map[string]Type
+	resourceTableOnce sync.Once
+	resourceTable     map[string]*Resource
 }
 
 // Language provides hooks for importing language-specific metadata in a package.
@@ -523,6 +606,38 @@ func (pkg *Package) TokenToModule(tok string) string {
 	}
 }
 
+// GetType returns the object or enum type with the given token, if the package defines one.
+func (pkg *Package) GetType(token string) (Type, bool) {
+	pkg.typeTableOnce.Do(func() {
+		pkg.typeTable = make(map[string]Type)
+		for _, t := range pkg.Types {
+			switch t := t.(type) {
+			case *ObjectType:
+				pkg.typeTable[t.Token] = t
+			case *EnumType:
+				pkg.typeTable[t.Token] = t
+			}
+		}
+	})
+	t, ok := pkg.typeTable[token]
+	return t, ok
+}
+
+// GetResource returns the resource with the given token, if the package defines one.
+func (pkg *Package) GetResource(token string) (*Resource, bool) {
+	pkg.resourceTableOnce.Do(func() {
+		pkg.resourceTable = make(map[string]*Resource)
+		if pkg.Provider != nil {
+			pkg.resourceTable[pkg.Provider.Token] = pkg.Provider
+		}
+		for _, r := range pkg.Resources {
+			pkg.resourceTable[r.Token] = r
+		}
+	})
+	r, ok := pkg.resourceTable[token]
+	return r, ok
+}
+
 // TypeSpec is the serializable form of a reference to a type.
 type TypeSpec struct {
 	// Type is the primitive or composite type, if any. May be "bool", "integer", "number", "string", "array", or
@@ -530,7 +645,9 @@ type TypeSpec struct {
 	Type string `json:"type,omitempty"`
 	// Ref is a reference to a type in this or another document. For example, the built-in Archive, Asset, and Any
 	// types are referenced as "pulumi.json#/Archive", "pulumi.json#/Asset", and "pulumi.json#/Any", respectively.
-	// A type from this document is referenced as "#/types/pulumi:type:token".
+	// A type from this document is referenced as "#/types/pulumi:type:token". A type or resource from another package
+	// is referenced as "/pkg/vX.Y.Z/schema.json#/types/pulumi:type:token" or
+	// "/pkg/vX.Y.Z/schema.json#/resources/pulumi:type:token", respectively.
 	Ref string `json:"$ref,omitempty"`
 	// AdditionalProperties, if set, describes the element type of an "object" (i.e. a string -> value map).
 	AdditionalProperties *TypeSpec `json:"additionalProperties,omitempty"`
@@ -562,6 +679,8 @@ type PropertySpec struct {
 	Default interface{} `json:"default,omitempty"`
 	// DefaultInfo contains additional information about the property's default value, if any.
 	DefaultInfo *DefaultSpec `json:"defaultInfo,omitempty"`
+	// ReplaceOnChanges specifies whether changes to the property require its resource to be replaced.
+	ReplaceOnChanges bool `json:"replaceOnChanges,omitempty"`
 	// DeprecationMessage indicates whether or not the property is deprecated.
 	DeprecationMessage string `json:"deprecationMessage,omitempty"`
 	// Language specifies additional language-specific data about the property.
@@ -574,7 +693,7 @@ type ObjectTypeSpec struct {
 	Description string `json:"description,omitempty"`
 	// Properties is a map from property name to PropertySpec that describes the type's properties.
 	Properties map[string]PropertySpec `json:"properties,omitempty"`
-	// Type must be "object".
+	// Type must be "object" for an object type, or the primitive type of the values of an enum type.
 	Type string `json:"type,omitempty"`
 	// Requires is a list of the names of the type's required properties. These properties must be set for inputs and
 	// will always be set for outputs.
@@ -583,6 +702,27 @@ type ObjectTypeSpec struct {
 	Language map[string]json.RawMessage `json:"language,omitempty"`
 }
 
+// ComplexTypeSpec is the serializable form of an object or enum type.
+type ComplexTypeSpec struct {
+	ObjectTypeSpec
+
+	// Enum, if present, is the list of the values of an enum type. The type's Type must be the primitive type of the
+	// values ("boolean", "integer", "number", or "string").
+	Enum []EnumValueSpec `json:"enum,omitempty"`
+}
+
+// EnumValueSpec is the serializable form of a value of an enum type.
+type EnumValueSpec struct {
+	// Name is the name of the value, if any. If the name is omitted, a name is derived from the value.
+	Name string `json:"name,omitempty"`
+	// Description is the description of the value, if any.
+	Description string `json:"description,omitempty"`
+	// Value is the value. Its type must be the enum's type.
+	Value interface{} `json:"value"`
+	// DeprecationMessage indicates whether or not the value is deprecated.
+	DeprecationMessage string `json:"deprecationMessage,omitempty"`
+}
+
 // AliasSpec is the serializable form of an alias description.
 type AliasSpec struct {
 	// Name is the name portion of the alias, if any.
@@ -604,12 +744,17 @@ type ResourceSpec struct {
 	// StateInputs is an optional ObjectTypeSpec that describes additional inputs that mau be necessary to get an
 	// existing resource. If this is unset, only an ID is necessary.
 	StateInputs *ObjectTypeSpec `json:"stateInputs,omitempty"`
+	// IsComponent indicates whether the resource is a component resource that is constructed by its provider. Existing
+	// component resources cannot be read, so component resources may not specify StateInputs.
+	IsComponent bool `json:"isComponent,omitempty"`
 	// Aliases is the list of aliases for the resource.
 	Aliases []AliasSpec `json:"aliases,omitempty"`
 	// DeprecationMessage indicates whether or not the resource is deprecated.
 	DeprecationMessage string `json:"deprecationMessage,omitempty"`
 	// Language specifies additional language-specific data about the resource.
 	Language map[string]json.RawMessage `json:"language,omitempty"`
+	// Methods maps the names of the resource's methods to the tokens of the functions that implement them.
+	Methods map[string]string `json:"methods,omitempty"`
 }
 
 // FunctionSpec is the serializable form of a function description.
@@ -669,8 +814,9 @@ type PackageSpec struct {
 
 	// Config describes the set of configuration variables defined by this package.
 	Config ConfigSpec `json:"config"`
-	// Types is a map from type token to ObjectTypeSpec that describes the set of object types defined by this package.
-	Types map[string]ObjectTypeSpec `json:"types,omitempty"`
+	// Types is a map from type token to ComplexTypeSpec that describes the set of object and enum types defined by this
+	// package.
+	Types map[string]ComplexTypeSpec `json:"types,omitempty"`
 	// Provider describes the provider type for this package.
 	Provider ResourceSpec `json:"provider"`
 	// Resources is a map from type token to ResourceSpec that describes the set of resources defined by this package.
@@ -681,14 +827,21 @@ type PackageSpec struct {
 	Language map[string]json.RawMessage `json:"language,omitempty"`
 }
 
-// ImportSpec converts a serializable PackageSpec into a Package.
+// ImportSpec converts a serializable PackageSpec into a Package. The spec may not refer to types or resources defined
+// by other packages.
 func ImportSpec(spec PackageSpec, languages map[string]Language) (*Package, error) {
+	return ImportSpecWithLoader(spec, languages, nil)
+}
+
+// ImportSpecWithLoader converts a serializable PackageSpec into a Package, using the given loader to load the
+// packages that define any types or resources the spec refers to.
+func ImportSpecWithLoader(spec PackageSpec, languages map[string]Language, loader Loader) (*Package, error) {
 	// Parse the version, if any.
 	var version *semver.Version
 	if spec.Version != "" {
 		v, err := semver.ParseTolerant(spec.Version)
 		if err != nil {
-			return nil, errors.Wrap(err, "parsing package version")
+			return nil, errors.Wrap(withPath(err, "version"), "parsing package version")
 		}
 		version = &v
 	}
@@ -700,39 +853,53 @@ func ImportSpec(spec PackageSpec, languages map[string]Language) (*Package, erro
 	}
 	moduleFormatRegexp, err := regexp.Compile(moduleFormat)
 	if err != nil {
-		return nil, errors.Wrap(err, "compiling module format regexp")
+		return nil, errors.Wrap(withPath(err, "meta", "moduleFormat"), "compiling module format regexp")
 	}
 
-	types, err := bindTypes(spec.Types)
+	// Types and resources refer to the package that defines them, so the package is allocated up front and filled in
+	// once binding is complete.
+	pkg := &Package{Name: spec.Name}
+
+	types, err := bindTypes(pkg, spec.Types, spec.Resources, loader)
 	if err != nil {
 		return nil, errors.Wrap(err, "binding types")
 	}
 
 	config, err := bindConfig(spec.Config, types)
 	if err != nil {
-		return nil, errors.Wrap(err, "binding config")
+		return nil, errors.Wrap(withPath(err, "config"), "binding config")
 	}
 
 	provider, err := bindProvider(spec.Name, spec.Provider, types)
 	if err != nil {
-		return nil, errors.Wrap(err, "binding provider")
+		return nil, errors.Wrap(withPath(err, "provider"), "binding provider")
 	}
 
 	resources, err := bindResources(spec.Resources, types)
 	if err != nil {
 		return nil, errors.Wrap(err, "binding resources")
 	}
+	for _, r := range resources {
+		types.resources[r.Token].Resource = r
+	}
 
 	functions, err := bindFunctions(spec.Functions, types)
 	if err != nil {
 		return nil, errors.Wrap(err, "binding functions")
 	}
 
+	if err := bindMethods(spec, provider, resources, functions); err != nil {
+		return nil, errors.Wrap(err, "binding methods")
+	}
+
 	// Build the type list.
 	var typeList []Type
 	for _, t := range types.objects {
 		typeList = append(typeList, t)
 	}
+	for _, t := range types.enums {
+		typeList = append(typeList, t)
+	}
 	for _, t := range types.arrays {
 		typeList = append(typeList, t)
 	}
@@ -750,12 +917,20 @@ func ImportSpec(spec PackageSpec, languages map[string]Language) (*Package, erro
 		return typeList[i].String() < typeList[j].String()
 	})
 
+	var dependencies []*Package
+	for dep := range types.dependencies {
+		dependencies = append(dependencies, dep)
+	}
+	sort.Slice(dependencies, func(i, j int) bool {
+		return dependencies[i].Name < dependencies[j].Name
+	})
+
 	language := make(map[string]interface{})
 	for name, raw := range spec.Language {
 		language[name] = raw
 	}
 
-	pkg := &Package{
+	*pkg = Package{
 		moduleFormat: moduleFormatRegexp,
 		Name:         spec.Name,
 		Version:      version,
@@ -771,6 +946,7 @@ func ImportSpec(spec PackageSpec, languages map[string]Language) (*Package, erro
 		Resources:    resources,
 		Functions:    functions,
 		Language:     language,
+		Dependencies: dependencies,
 	}
 	if err := pkg.ImportLanguages(languages); err != nil {
 		return nil, err
@@ -778,12 +954,143 @@ func ImportSpec(spec PackageSpec, languages map[string]Language) (*Package, erro
 	return pkg, nil
 }
 
+// bindError annotates an error that occurred while binding a package schema with the path to the schema element that
+// caused it.
+type bindError struct {
+	path []string
+	err  error
+}
+
+func (e *bindError) Error() string {
+	return e.err.Error()
+}
+
+func (e *bindError) Cause() error {
+	return e.err
+}
+
+// withPath annotates an error with the path of the schema element that caused it, relative to the element that is
+// being bound by the caller.
+func withPath(err error, path ...string) error {
+	return &bindError{path: path, err: err}
+}
+
+// ErrorPath returns the path to the element of a PackageSpec that caused an error returned by ImportSpec, as a list of
+// JSON object keys and array indices (e.g. ["resources", "aws:s3/bucket:Bucket", "properties", "acl", "type"]). It
+// returns nil if the error does not identify an element.
+func ErrorPath(err error) []string {
+	var path []string
+	for err != nil {
+		if be, ok := err.(*bindError); ok {
+			path = append(path, be.path...)
+		}
+		cause, ok := err.(interface{ Cause() error })
+		if !ok {
+			break
+		}
+		err = cause.Cause()
+	}
+	return path
+}
+
 type types struct {
-	objects map[string]*ObjectType
-	arrays  map[Type]*ArrayType
-	maps    map[Type]*MapType
-	unions  map[string]*UnionType
-	tokens  map[string]*TokenType
+	pkg    *Package
+	loader Loader
+
+	dependencies map[*Package]bool
+
+	objects   map[string]*ObjectType
+	enums     map[string]*EnumType
+	arrays    map[Type]*ArrayType
+	maps      map[Type]*MapType
+	unions    map[string]*UnionType
+	tokens    map[string]*TokenType
+	resources map[string]*ResourceType
+}
+
+// typeRef is a parsed reference to a type or resource.
+type typeRef struct {
+	// Package is the name of the package that defines the referent, or the empty string if the referent is defined by
+	// the referring document.
+	Package string
+	// Version is the version of the package that defines the referent, if any.
+	Version *semver.Version
+	// Kind is the kind of the referent, either "types" or "resources".
+	Kind string
+	// Token is the referent's token.
+	Token string
+}
+
+// parseTypeRef parses a reference of the form "[/pkg/vX.Y.Z/schema.json]#/types|resources/token". Tokens may be
+// percent-encoded.
+func parseTypeRef(ref string) (typeRef, error) {
+	hash := strings.IndexByte(ref, '#')
+	if hash == -1 {
+		return typeRef{}, errors.Errorf("failed to parse ref %s", ref)
+	}
+	document, fragment := ref[:hash], ref[hash+1:]
+
+	var result typeRef
+	if document != "" {
+		components := strings.Split(document, "/")
+		if len(components) != 4 || components[0] != "" || components[1] == "" ||
+			!strings.HasPrefix(components[2], "v") || components[3] != "schema.json" {
+			return typeRef{}, errors.Errorf("failed to parse ref %s: expected a document of the form "+
+				"/pkg/vX.Y.Z/schema.json", ref)
+		}
+		version, err := semver.ParseTolerant(components[2][1:])
+		if err != nil {
+			return typeRef{}, errors.Wrapf(err, "failed to parse ref %s: invalid package version", ref)
+		}
+		result.Package, result.Version = components[1], &version
+	}
+
+	switch {
+	case strings.HasPrefix(fragment, "/types/"):
+		result.Kind = "types"
+	case strings.HasPrefix(fragment, "/resources/"):
+		result.Kind = "resources"
+	default:
+		return typeRef{}, errors.Errorf("failed to parse ref %s", ref)
+	}
+
+	token, err := url.PathUnescape(fragment[len(result.Kind)+2:])
+	if err != nil {
+		return typeRef{}, errors.Wrapf(err, "failed to parse ref %s", ref)
+	}
+	result.Token = token
+	return result, nil
+}
+
+// bindExternalRef resolves a reference to a type or resource defined by another package.
+func (t *types) bindExternalRef(ref typeRef) (Type, error) {
+	if t.loader == nil {
+		return nil, errors.Errorf("cannot resolve reference to package %s: no schema loader is available", ref.Package)
+	}
+	pkg, err := t.loader.LoadPackage(ref.Package, ref.Version)
+	if err != nil {
+		return nil, errors.Wrapf(err, "loading schema for package %s", ref.Package)
+	}
+	t.dependencies[pkg] = true
+
+	if ref.Kind == "types" {
+		typ, ok := pkg.GetType(ref.Token)
+		if !ok {
+			return nil, errors.Errorf("package %s does not define type %s", ref.Package, ref.Token)
+		}
+		return typ, nil
+	}
+
+	typ, ok := t.resources[ref.Token]
+	if !ok {
+		res, ok := pkg.GetResource(ref.Token)
+		if !ok {
+			return nil, errors.Errorf("package %s does not define resource %s", ref.Package, ref.Token)
+		}
+		typ = &ResourceType{Token: ref.Token, Resource: res}
+		t.resources[ref.Token] = typ
+	}
+	return typ, nil
 }
 
 func (t *types) bindType(spec TypeSpec) (Type, error) {
@@ -797,15 +1104,35 @@ func (t *types) bindType(spec TypeSpec) (Type, error) {
 			return AnyType, nil
 		}
 
-		// Parse the ref and look up the type in the type map.
-		if !strings.HasPrefix(spec.Ref, "#/types/") {
-			return nil, errors.Errorf("failed to parse ref %s", spec.Ref)
+		// Parse the ref. References to other packages are resolved using the loader.
+		ref, err := parseTypeRef(spec.Ref)
+		if err != nil {
+			return nil, withPath(err, "$ref")
+		}
+		if ref.Package != "" && ref.Package != t.pkg.Name {
+			typ, err := t.bindExternalRef(ref)
+			if err != nil {
+				return nil, withPath(err, "$ref")
+			}
+			return typ, nil
+		}
+		if ref.Kind == "resources" {
+			typ, ok := t.resources[ref.Token]
+			if !ok {
+				return nil, withPath(errors.Errorf("package %s does not define resource %s", t.pkg.Name, ref.Token),
+					"$ref")
+			}
+			return typ, nil
 		}
 
-		token := spec.Ref[len("#/types/"):]
+		// Look up the type in the type map.
+		token := ref.Token
 		if typ, ok := t.objects[token]; ok {
 			return typ, nil
 		}
+		if typ, ok := t.enums[token]; ok {
+			return typ, nil
+		}
 		typ, ok := t.tokens[token]
 		if !ok {
 			typ = &TokenType{Token: token}
@@ -823,14 +1150,14 @@ func (t *types) bindType(spec TypeSpec) (Type, error) {
 
 	if spec.OneOf != nil {
 		if len(spec.OneOf) < 2 {
-			return nil, errors.New("oneOf should list at least two types")
+			return nil, withPath(errors.New("oneOf should list at least two types"), "oneOf")
 		}
 
 		elements := make([]Type, len(spec.OneOf))
 		for i, spec := range spec.OneOf {
 			e, err := t.bindType(spec)
 			if err != nil {
-				return nil, err
+				return nil, withPath(err, "oneOf", strconv.Itoa(i))
 			}
 			elements[i] = e
 		}
@@ -859,7 +1186,7 @@ func (t *types) bindType(spec TypeSpec) (Type, error) {
 
 		elementType, err := t.bindType(*spec.Items)
 		if err != nil {
-			return nil, err
+			return nil, withPath(err, "items")
 		}
 
 		typ, ok := t.arrays[elementType]
@@ -873,7 +1200,7 @@ func (t *types) bindType(spec TypeSpec) (Type, error) {
 		if spec.AdditionalProperties != nil {
 			et, err := t.bindType(*spec.AdditionalProperties)
 			if err != nil {
-				return nil, err
+				return nil, withPath(err, "additionalProperties")
 			}
 			elementType = et
 		}
@@ -885,7 +1212,7 @@ func (t *types) bindType(spec TypeSpec) (Type, error) {
 		}
 		return typ, nil
 	default:
-		return nil, errors.Errorf("unknown type kind %v", spec.Type)
+		return nil, withPath(errors.Errorf("unknown type kind %v", spec.Type), "type")
 	}
 }
 
@@ -969,9 +1296,10 @@ func bindDefaultValue(value interface{}, spec *DefaultSpec, typ Type) (*DefaultV
 }
 
 // bindProperties binds the map of property specs and list of required properties into a sorted list of peroperties and
-// a lookup table.
-func (t *types) bindProperties(properties map[string]PropertySpec,
-	required []string) ([]*Property, map[string]*Property, error) {
+// a lookup table. The keys of the properties and required properties within their parent element are used to annotate
+// errors with the path of the element that caused them.
+func (t *types) bindProperties(properties map[string]PropertySpec, required []string,
+	propertiesKey, requiredKey string) ([]*Property, map[string]*Property, error) {
 
 	// Bind property types and constant or default values.
 	propertyMap := map[string]*Property{}
@@ -979,17 +1307,19 @@ func (t *types) bindProperties(properties map[string]PropertySpec,
 	for name, spec := range properties {
 		typ, err := t.bindType(spec.TypeSpec)
 		if err != nil {
-			return nil, nil, errors.Wrapf(err, "error binding type for property %s", name)
+			return nil, nil, errors.Wrapf(withPath(err, propertiesKey, name), "error binding type for property %s", name)
 		}
 
 		cv, err := bindConstValue(spec.Const, typ)
 		if err != nil {
-			return nil, nil, errors.Wrapf(err, "error binding constant value for property %s", name)
+			return nil, nil, errors.Wrapf(withPath(err, propertiesKey, name, "const"),
+				"error binding constant value for property %s", name)
 		}
 
 		dv, err := bindDefaultValue(spec.Default, spec.DefaultInfo, typ)
 		if err != nil {
-			return nil, nil, errors.Wrapf(err, "error binding default value for property %s", name)
+			return nil, nil, errors.Wrapf(withPath(err, propertiesKey, name, "default"),
+				"error binding default value for property %s", name)
 		}
 
 		language := make(map[string]interface{})
@@ -1003,6 +1333,7 @@ func (t *types) bindProperties(properties map[string]PropertySpec,
 			Type:               typ,
 			ConstValue:         cv,
 			DefaultValue:       dv,
+			ReplaceOnChanges:   spec.ReplaceOnChanges,
 			DeprecationMessage: spec.DeprecationMessage,
 			Language:           language,
 		}
@@ -1011,10 +1342,10 @@ func (t *types) bindProperties(properties map[string]PropertySpec,
 	}
 
 	// Compute required properties.
-	for _, name := range required {
+	for i, name := range required {
 		p, ok := propertyMap[name]
 		if !ok {
-			return nil, nil, errors.Errorf("unknown required property %s", name)
+			return nil, nil, withPath(errors.Errorf("unknown required property %s", name), requiredKey, strconv.Itoa(i))
 		}
 		p.IsRequired = true
 	}
@@ -1027,7 +1358,7 @@ func (t *types) bindProperties(properties map[string]PropertySpec,
 }
 
 func (t *types) bindObjectType(token string, spec ObjectTypeSpec) (*ObjectType, error) {
-	properties, propertyMap, err := t.bindProperties(spec.Properties, spec.Required)
+	properties, propertyMap, err := t.bindProperties(spec.Properties, spec.Required, "properties", "required")
 	if err != nil {
 		return nil, err
 	}
@@ -1038,6 +1369,7 @@ func (t *types) bindObjectType(token string, spec ObjectTypeSpec) (*ObjectType,
 	}
 
 	return &ObjectType{
+		Package:    t.pkg,
 		Token:      token,
 		Comment:    spec.Description,
 		Language:   language,
@@ -1046,22 +1378,105 @@ func (t *types) bindObjectType(token string, spec ObjectTypeSpec) (*ObjectType,
 	}, nil
 }
 
-func bindTypes(objects map[string]ObjectTypeSpec) (*types, error) {
+func bindEnumType(pkg *Package, token string, spec ComplexTypeSpec) (*EnumType, error) {
+	var elementType Type
+	switch spec.Type {
+	case "boolean":
+		elementType = BoolType
+	case "integer":
+		elementType = IntType
+	case "number":
+		elementType = NumberType
+	case "string":
+		elementType = StringType
+	default:
+		return nil, withPath(errors.Errorf("enum %s must be a boolean, integer, number, or string, not a %s", token,
+			spec.Type), "type")
+	}
+
+	seen := map[interface{}]bool{}
+	elements := make([]*Enum, len(spec.Enum))
+	for i, e := range spec.Enum {
+		value, err := bindConstValue(e.Value, elementType)
+		if err != nil {
+			return nil, withPath(errors.Wrapf(err, "invalid value for enum %s", token), "enum", strconv.Itoa(i), "value")
+		}
+		if value == nil {
+			return nil, withPath(errors.Errorf("missing value for enum %s", token), "enum", strconv.Itoa(i))
+		}
+		if seen[value] {
+			return nil, withPath(errors.Errorf("duplicate value %v for enum %s", value, token),
+				"enum", strconv.Itoa(i), "value")
+		}
+		seen[value] = true
+
+		// Generators derive the names of unnamed values from the values themselves, so the name or value must contain
+		// at least one letter or digit.
+		name := e.Name
+		if name == "" {
+			name = fmt.Sprint(value)
+		}
+		if strings.IndexFunc(name, func(r rune) bool { return unicode.IsLetter(r) || unicode.IsDigit(r) }) == -1 {
+			return nil, withPath(errors.Errorf("value %v of enum %s must have a name", value, token),
+				"enum", strconv.Itoa(i))
+		}
+
+		elements[i] = &Enum{
+			Name:               e.Name,
+			Comment:            e.Description,
+			Value:              value,
+			DeprecationMessage: e.DeprecationMessage,
+		}
+	}
+
+	return &EnumType{
+		Package:     pkg,
+		Token:       token,
+		Comment:     spec.Description,
+		Elements:    elements,
+		ElementType: elementType,
+	}, nil
+}
+
+func bindTypes(pkg *Package, objects map[string]ComplexTypeSpec, resources map[string]ResourceSpec,
+	loader Loader) (*types, error) {
+
 	typs := &types{
-		objects: map[string]*ObjectType{},
-		arrays:  map[Type]*ArrayType{},
-		maps:    map[Type]*MapType{},
-		unions:  map[string]*UnionType{},
-		tokens:  map[string]*TokenType{},
+		pkg:          pkg,
+		loader:       loader,
+		dependencies: map[*Package]bool{},
+		objects:      map[string]*ObjectType{},
+		enums:        map[string]*EnumType{},
+		arrays:       map[Type]*ArrayType{},
+		maps:         map[Type]*MapType{},
+		unions:       map[string]*UnionType{},
+		tokens:       map[string]*TokenType{},
+		resources:    map[string]*ResourceType{},
 	}
 
-	// Declare object types before processing properties.
+	// Declare the package's resources so that they may be referenced by properties. The resources themselves are filled
+	// in once they are bound.
+	for token := range resources {
+		typs.resources[token] = &ResourceType{Token: token}
+	}
+
+	// Bind enum types and declare object types before processing properties.
 	for token, spec := range objects {
+		if len(spec.Enum) > 0 {
+			enum, err := bindEnumType(pkg, token, spec)
+			if err != nil {
+				return nil, errors.Wrapf(withPath(err, "types", token), "failed to bind type %s", token)
+			}
+			typs.enums[token] = enum
+			continue
+		}
 		if spec.Type != "object" {
-			return nil, errors.Errorf("type %s must be an object, not a %s", token, spec.Type)
+			return nil, withPath(errors.Errorf("type %s must be an object, not a %s", token, spec.Type),
+				"types", token, "type")
 		}
 
 		typs.objects[token] = &ObjectType{
+			Package: pkg,
 			Token:   token,
 			Comment: spec.Description,
 		}
@@ -1069,9 +1484,12 @@ func bindTypes(objects map[string]ObjectTypeSpec) (*types, error) {
 
 	// Process properties.
 	for token, spec := range objects {
-		properties, propertyMap, err := typs.bindProperties(spec.Properties, spec.Required)
+		if len(spec.Enum) > 0 {
+			continue
+		}
+		properties, propertyMap, err := typs.bindProperties(spec.Properties, spec.Required, "properties", "required")
 		if err != nil {
-			return nil, errors.Wrapf(err, "failed to bind type %s", token)
+			return nil, errors.Wrapf(withPath(err, "types", token), "failed to bind type %s", token)
 		}
 		obj := typs.objects[token]
 		obj.Properties, obj.properties = properties, propertyMap
@@ -1081,26 +1499,30 @@ func bindTypes(objects map[string]ObjectTypeSpec) (*types, error) {
 }
 
 func bindConfig(spec ConfigSpec, types *types) ([]*Property, error) {
-	properties, _, err := types.bindProperties(spec.Variables, spec.Required)
+	properties, _, err := types.bindProperties(spec.Variables, spec.Required, "variables", "defaults")
 	return properties, err
 }
 
 func bindResource(token string, spec ResourceSpec, types *types) (*Resource, error) {
-	properties, _, err := types.bindProperties(spec.Properties, spec.Required)
+	properties, _, err := types.bindProperties(spec.Properties, spec.Required, "properties", "required")
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to bind properties")
 	}
 
-	inputProperties, _, err := types.bindProperties(spec.InputProperties, spec.RequiredInputs)
+	inputProperties, _, err := types.bindProperties(spec.InputProperties, spec.RequiredInputs,
+		"inputProperties", "requiredInputs")
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to bind properties")
 	}
 
 	var stateInputs *ObjectType
 	if spec.StateInputs != nil {
+		if spec.IsComponent {
+			return nil, withPath(errors.New("component resources may not specify stateInputs"), "stateInputs")
+		}
 		si, err := types.bindObjectType(token+"Args", *spec.StateInputs)
 		if err != nil {
-			return nil, errors.Wrap(err, "error binding inputs")
+			return nil, errors.Wrap(withPath(err, "stateInputs"), "error binding inputs")
 		}
 		stateInputs = si
 	}
@@ -1116,11 +1538,13 @@ func bindResource(token string, spec ResourceSpec, types *types) (*Resource, err
 	}
 
 	return &Resource{
+		Package:            types.pkg,
 		Token:              token,
 		Comment:            spec.Description,
 		InputProperties:    inputProperties,
 		Properties:         properties,
 		StateInputs:        stateInputs,
+		IsComponent:        spec.IsComponent,
 		Aliases:            aliases,
 		DeprecationMessage: spec.DeprecationMessage,
 		Language:           language,
@@ -1128,6 +1552,10 @@ func bindResource(token string, spec ResourceSpec, types *types) (*Resource, err
 }
 
 func bindProvider(pkgName string, spec ResourceSpec, types *types) (*Resource, error) {
+	if spec.IsComponent {
+		return nil, errors.Wrap(withPath(errors.New("providers may not be component resources"), "isComponent"),
+			"error binding provider")
+	}
 	res, err := bindResource("pulumi:providers:"+pkgName, spec, types)
 	if err != nil {
 		return nil, errors.Wrap(err, "error binding provider")
@@ -1141,7 +1569,7 @@ func bindResources(specs map[string]ResourceSpec, types *types) ([]*Resource, er
 	for token, spec := range specs {
 		res, err := bindResource(token, spec, types)
 		if err != nil {
-			return nil, errors.Wrapf(err, "error binding resource %v", token)
+			return nil, errors.Wrapf(withPath(err, "resources", token), "error binding resource %v", token)
 		}
 		resources = append(resources, res)
 	}
@@ -1158,7 +1586,7 @@ func bindFunction(token string, spec FunctionSpec, types *types) (*Function, err
 	if spec.Inputs != nil {
 		ins, err := types.bindObjectType(token+"Args", *spec.Inputs)
 		if err != nil {
-			return nil, errors.Wrap(err, "error binding inputs")
+			return nil, errors.Wrap(withPath(err, "inputs"), "error binding inputs")
 		}
 		inputs = ins
 	}
@@ -1167,7 +1595,7 @@ func bindFunction(token string, spec FunctionSpec, types *types) (*Function, err
 	if spec.Outputs != nil {
 		outs, err := types.bindObjectType(token+"Result", *spec.Outputs)
 		if err != nil {
-			return nil, errors.Wrap(err, "error binding inputs")
+			return nil, errors.Wrap(withPath(err, "outputs"), "error binding inputs")
 		}
 		outputs = outs
 	}
@@ -1192,7 +1620,7 @@ func bindFunctions(specs map[string]FunctionSpec, types *types) ([]*Function, er
 	for token, spec := range specs {
 		f, err := bindFunction(token, spec, types)
 		if err != nil {
-			return nil, errors.Wrapf(err, "error binding function %v", token)
+			return nil, errors.Wrapf(withPath(err, "functions", token), "error binding function %v", token)
 		}
 		functions = append(functions, f)
 	}
@@ -1203,3 +1631,46 @@ func bindFunctions(specs map[string]FunctionSpec, types *types) ([]*Function, er
 
 	return functions, nil
 }
+
+// bindMethods binds the methods of the provider and resources in the given package spec to the functions that
+// implement them.
+func bindMethods(spec PackageSpec, provider *Resource, resources []*Resource, functions []*Function) error {
+	functionTable := make(map[string]*Function)
+	for _, f := range functions {
+		functionTable[f.Token] = f
+	}
+
+	bind := func(res *Resource, methods map[string]string, path ...string) error {
+		names := make([]string, 0, len(methods))
+		for name := range methods {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			token := methods[name]
+			f, ok := functionTable[token]
+			if !ok {
+				return withPath(errors.Errorf("method %v of resource %v refers to unknown function %v",
+					name, res.Token, token), append(path, "methods", name)...)
+			}
+			if f.IsMethod {
+				return withPath(errors.Errorf("function %v implements more than one method", token),
+					append(path, "methods", name)...)
+			}
+			f.IsMethod = true
+			res.Methods = append(res.Methods, &Method{Name: name, Function: f})
+		}
+		return nil
+	}
+
+	if err := bind(provider, spec.Provider.Methods, "provider"); err != nil {
+		return err
+	}
+	for _, res := range resources {
+		if err := bind(res, spec.Resources[res.Token].Methods, "resources", res.Token); err != nil {
+			return err
+		}
+	}
+	return nil
+}
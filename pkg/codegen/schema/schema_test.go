@@ -0,0 +1,336 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/blang/semver"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/pkg/v2/codegen/internal/test"
+)
+
+var testdataPath = filepath.Join("..", "internal", "test", "testdata")
+
+func importEnumSpec(t *testing.T, enumSpec string) (*Package, error) {
+	var spec PackageSpec
+	err := json.Unmarshal([]byte(`{
+		"name": "plant",
+		"types": {
+			"plant:tree:Variety": `+enumSpec+`
+		},
+		"resources": {
+			"plant:tree:Tree": {
+				"inputProperties": {
+					"variety": {"$ref": "#/types/plant:tree:Variety"},
+					"varieties": {"type": "array", "items": {"$ref": "#/types/plant:tree:Variety"}}
+				}
+			}
+		}
+	}`), &spec)
+	assert.NoError(t, err)
+	return ImportSpec(spec, nil)
+}
+
+func TestImportEnumType(t *testing.T) {
+	pkg, err := importEnumSpec(t, `{
+		"type": "string",
+		"description": "Types of trees.",
+		"enum": [
+			{"value": "Burgundy", "description": "A burgundy tree."},
+			{"name": "Ruby", "value": "ruby-red", "deprecationMessage": "Ruby is no longer grown."}
+		]
+	}`)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	if !assert.Len(t, pkg.Types, 2) {
+		return
+	}
+	var enum *EnumType
+	for _, typ := range pkg.Types {
+		if e, ok := typ.(*EnumType); ok {
+			enum = e
+		}
+	}
+	if !assert.NotNil(t, enum) {
+		return
+	}
+	assert.Equal(t, "plant:tree:Variety", enum.Token)
+	assert.Equal(t, "Types of trees.", enum.Comment)
+	assert.Equal(t, StringType, enum.ElementType)
+	assert.Equal(t, []*Enum{
+		{Value: "Burgundy", Comment: "A burgundy tree."},
+		{Name: "Ruby", Value: "ruby-red", DeprecationMessage: "Ruby is no longer grown."},
+	}, enum.Elements)
+
+	// Properties are sorted by name.
+	props := pkg.Resources[0].InputProperties
+	assert.Equal(t, &ArrayType{ElementType: enum}, props[0].Type)
+	assert.Equal(t, enum, props[1].Type)
+}
+
+func TestImportEnumTypeErrors(t *testing.T) {
+	cases := []struct {
+		spec    string
+		path    []string
+		message string
+	}{
+		{
+			spec:    `{"type": "object", "enum": [{"value": 1}]}`,
+			path:    []string{"types", "plant:tree:Variety", "type"},
+			message: "must be a boolean, integer, number, or string",
+		},
+		{
+			spec:    `{"type": "integer", "enum": [{"value": 1}, {"value": "two"}]}`,
+			path:    []string{"types", "plant:tree:Variety", "enum", "1", "value"},
+			message: "invalid value for enum plant:tree:Variety",
+		},
+		{
+			spec:    `{"type": "integer", "enum": [{"name": "One"}]}`,
+			path:    []string{"types", "plant:tree:Variety", "enum", "0"},
+			message: "missing value for enum plant:tree:Variety",
+		},
+		{
+			spec:    `{"type": "string", "enum": [{"value": "a"}, {"value": "a"}]}`,
+			path:    []string{"types", "plant:tree:Variety", "enum", "1", "value"},
+			message: "duplicate value a",
+		},
+		{
+			spec:    `{"type": "string", "enum": [{"value": "-"}]}`,
+			path:    []string{"types", "plant:tree:Variety", "enum", "0"},
+			message: "must have a name",
+		},
+	}
+	for _, c := range cases {
+		_, err := importEnumSpec(t, c.spec)
+		if assert.Error(t, err, c.spec) {
+			assert.Contains(t, err.Error(), c.message)
+			assert.Equal(t, c.path, ErrorPath(err))
+		}
+	}
+}
+
+func TestImportComponentResource(t *testing.T) {
+	var spec PackageSpec
+	err := json.Unmarshal([]byte(`{
+		"name": "web",
+		"resources": {
+			"web:index:StaticSite": {
+				"isComponent": true,
+				"inputProperties": {
+					"indexDocument": {"type": "string"}
+				},
+				"properties": {
+					"url": {"type": "string"}
+				}
+			}
+		}
+	}`), &spec)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	pkg, err := ImportSpec(spec, nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.True(t, pkg.Resources[0].IsComponent)
+	assert.False(t, pkg.Provider.IsComponent)
+}
+
+func TestImportComponentResourceErrors(t *testing.T) {
+	cases := []struct {
+		spec    string
+		path    []string
+		message string
+	}{
+		{
+			spec: `{
+				"name": "web",
+				"resources": {
+					"web:index:StaticSite": {
+						"isComponent": true,
+						"stateInputs": {"properties": {"url": {"type": "string"}}}
+					}
+				}
+			}`,
+			path:    []string{"resources", "web:index:StaticSite", "stateInputs"},
+			message: "component resources may not specify stateInputs",
+		},
+		{
+			spec:    `{"name": "web", "provider": {"isComponent": true}}`,
+			path:    []string{"provider", "isComponent"},
+			message: "providers may not be component resources",
+		},
+	}
+	for _, c := range cases {
+		var spec PackageSpec
+		err := json.Unmarshal([]byte(c.spec), &spec)
+		if !assert.NoError(t, err) {
+			continue
+		}
+
+		_, err = ImportSpec(spec, nil)
+		if assert.Error(t, err, c.spec) {
+			assert.Contains(t, err.Error(), c.message)
+			assert.Equal(t, c.path, ErrorPath(err))
+		}
+	}
+}
+
+func TestParseTypeRef(t *testing.T) {
+	version := semver.MustParse("1.2.3")
+	cases := []struct {
+		ref      string
+		expected typeRef
+	}{
+		{ref: "#/types/plant:tree:Tree", expected: typeRef{Kind: "types", Token: "plant:tree:Tree"}},
+		{ref: "#/types/aws:s3%2Fbucket:Bucket", expected: typeRef{Kind: "types", Token: "aws:s3/bucket:Bucket"}},
+		{
+			ref:      "/aws/v1.2.3/schema.json#/types/aws:s3/bucket:Bucket",
+			expected: typeRef{Package: "aws", Version: &version, Kind: "types", Token: "aws:s3/bucket:Bucket"},
+		},
+		{
+			ref:      "/aws/v1.2.3/schema.json#/resources/aws:s3%2Fbucket:Bucket",
+			expected: typeRef{Package: "aws", Version: &version, Kind: "resources", Token: "aws:s3/bucket:Bucket"},
+		},
+	}
+	for _, c := range cases {
+		ref, err := parseTypeRef(c.ref)
+		if assert.NoError(t, err, c.ref) {
+			assert.Equal(t, c.expected, ref, c.ref)
+		}
+	}
+
+	for _, ref := range []string{
+		"plant:tree:Tree",
+		"#/functions/plant:tree:getTree",
+		"/aws/schema.json#/types/aws:s3/bucket:Bucket",
+		"/aws/vlatest/schema.json#/types/aws:s3/bucket:Bucket",
+		"aws/v1.2.3/schema.json#/types/aws:s3/bucket:Bucket",
+	} {
+		_, err := parseTypeRef(ref)
+		assert.Error(t, err, ref)
+	}
+}
+
+func TestImportExternalRefs(t *testing.T) {
+	loader := NewPluginLoader(test.NewHost(testdataPath))
+	pkg, err := loader.LoadPackage("backup", nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	storage, err := loader.LoadPackage("storage", &semver.Version{Major: 1})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, []*Package{storage}, pkg.Dependencies)
+
+	policy, ok := pkg.GetResource("backup:index:Policy")
+	if !assert.True(t, ok) {
+		return
+	}
+	assert.Equal(t, pkg, policy.Package)
+
+	// Properties are sorted by name.
+	props := policy.InputProperties
+	if assert.IsType(t, &ResourceType{}, props[0].Type) {
+		container, _ := storage.GetResource("storage:blob:Container")
+		assert.Equal(t, container, props[0].Type.(*ResourceType).Resource)
+		assert.Equal(t, storage, container.Package)
+	}
+	metadata, _ := storage.GetType("storage:index:Metadata")
+	assert.Equal(t, metadata, props[1].Type)
+	assert.Equal(t, storage, metadata.(*ObjectType).Package)
+	assert.Equal(t, props[0].Type, policy.Properties[0].Type)
+
+	// Types defined by other packages are not listed as types of the referring package.
+	_, ok = pkg.GetType("storage:index:Metadata")
+	assert.False(t, ok)
+
+	rule, ok := pkg.GetType("backup:index:Rule")
+	if assert.True(t, ok) {
+		assert.Equal(t, pkg, rule.(*ObjectType).Package)
+		tier, _ := storage.GetType("storage:blob:Tier")
+		p, _ := rule.(*ObjectType).Property("tier")
+		assert.Equal(t, tier, p.Type)
+	}
+}
+
+func TestImportExternalRefErrors(t *testing.T) {
+	importSpec := func(ref string, loader Loader) error {
+		var spec PackageSpec
+		err := json.Unmarshal([]byte(`{
+			"name": "backup",
+			"resources": {
+				"backup:index:Policy": {
+					"inputProperties": {
+						"container": {"$ref": "`+ref+`"}
+					}
+				}
+			}
+		}`), &spec)
+		assert.NoError(t, err)
+		_, err = ImportSpecWithLoader(spec, nil, loader)
+		return err
+	}
+
+	loader := NewPluginLoader(test.NewHost(testdataPath))
+	cases := []struct {
+		ref     string
+		loader  Loader
+		message string
+	}{
+		{
+			ref:     "/storage/v1.0.0/schema.json#/resources/storage:blob:Container",
+			message: "no schema loader is available",
+		},
+		{
+			ref:     "/storage/v1.0.0/schema.json#/types/storage:blob:Bucket",
+			loader:  loader,
+			message: "package storage does not define type storage:blob:Bucket",
+		},
+		{
+			ref:     "/storage/v1.0.0/schema.json#/resources/storage:blob:Tier",
+			loader:  loader,
+			message: "package storage does not define resource storage:blob:Tier",
+		},
+		{
+			ref:     "/compute/v1.0.0/schema.json#/resources/compute:index:Instance",
+			loader:  loader,
+			message: "loading schema for package compute",
+		},
+		{
+			ref:     "#/resources/backup:index:Schedule",
+			loader:  loader,
+			message: "package backup does not define resource backup:index:Schedule",
+		},
+	}
+	for _, c := range cases {
+		err := importSpec(c.ref, c.loader)
+		if assert.Error(t, err, c.ref) {
+			assert.Contains(t, err.Error(), c.message)
+			assert.Equal(t, []string{"resources", "backup:index:Policy", "inputProperties", "container", "$ref"},
+				ErrorPath(err))
+		}
+	}
+}
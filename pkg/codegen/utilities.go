@@ -15,9 +15,13 @@
 package codegen
 
 import (
+	"fmt"
 	"reflect"
 	"sort"
+	"strings"
+	"unicode"
 
+	"github.com/pulumi/pulumi/pkg/v2/codegen/schema"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/contract"
 )
 
@@ -79,3 +83,16 @@ func SortedKeys(m interface{}) []string {
 
 	return keys
 }
+
+// EnumValueWords splits the name of an enum value into words so that each language can form an identifier for the
+// value in its own casing convention. If the value has no name, the words are taken from the value itself. Any
+// character other than a letter or digit separates words.
+func EnumValueWords(e *schema.Enum) []string {
+	name := e.Name
+	if name == "" {
+		name = fmt.Sprint(e.Value)
+	}
+	return strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
@@ -50,6 +50,7 @@ const (
 	ResourcePreEvent        EventType = "resource-pre"
 	ResourceOutputsEvent    EventType = "resource-outputs"
 	ResourceOperationFailed EventType = "resource-operationfailed"
+	ResourceRetryEvent      EventType = "resource-retry"
 	PolicyViolationEvent    EventType = "policy-violation"
 )
 
@@ -104,6 +105,16 @@ type ResourceOperationFailedPayload struct {
 	Steps    int
 }
 
+// ResourceRetryEventPayload is the payload for an event with type `resource-retry`, which is emitted each time a
+// failed step is about to be retried.
+type ResourceRetryEventPayload struct {
+	Metadata    StepEventMetadata
+	Attempt     int           // the attempt that failed, counting from one.
+	MaxAttempts int           // the total number of attempts allowed by the retry policy.
+	Delay       time.Duration // the time to wait before the next attempt.
+	Error       string        // the error that caused the attempt to fail.
+}
+
 type ResourceOutputsEventPayload struct {
 	Metadata StepEventMetadata
 	Planning bool
@@ -129,6 +140,8 @@ type StepEventMetadata struct {
 	DetailedDiff map[string]plugin.PropertyDiff // the rich, structured diff
 	Logical      bool                           // true if this step represents a logical operation in the program.
 	Provider     string                         // the provider that performed this step.
+
+	DeleteSkipReason string // why the provider will not delete the resource, if it will not (only for DeleteStep).
 }
 
 // StepEventStateMetadata contains detailed metadata about a resource's state pertaining to a given step.
@@ -265,6 +278,11 @@ func makeStepEventMetadata(op deploy.StepOp, step deploy.Step, debug bool) StepE
 		diffs = differ.Diffs()
 	}
 
+	var deleteSkipReason string
+	if skipper, hasSkipReason := step.(interface{ DeleteSkipReason() string }); hasSkipReason {
+		deleteSkipReason = skipper.DeleteSkipReason()
+	}
+
 	var detailedDiff map[string]plugin.PropertyDiff
 	if detailedDiffer, hasDetailedDiff := step.(interface {
 		DetailedDiff() map[string]plugin.PropertyDiff
@@ -284,6 +302,8 @@ func makeStepEventMetadata(op deploy.StepOp, step deploy.Step, debug bool) StepE
 		Res:          makeStepEventStateMetadata(step.Res(), debug),
 		Logical:      step.Logical(),
 		Provider:     step.Provider(),
+
+		DeleteSkipReason: deleteSkipReason,
 	}
 }
 
@@ -439,6 +459,23 @@ func (e *eventEmitter) resourceOperationFailedEvent(
 	}
 }
 
+func (e *eventEmitter) resourceRetryEvent(
+	step deploy.Step, attempt, maxAttempts int, delay time.Duration, err error, debug bool) {
+
+	contract.Requiref(e != nil, "e", "!= nil")
+
+	e.ch <- Event{
+		Type: ResourceRetryEvent,
+		Payload: ResourceRetryEventPayload{
+			Metadata:    makeStepEventMetadata(step.Op(), step, debug),
+			Attempt:     attempt,
+			MaxAttempts: maxAttempts,
+			Delay:       delay,
+			Error:       err.Error(),
+		},
+	}
+}
+
 func (e *eventEmitter) resourceOutputsEvent(op deploy.StepOp, step deploy.Step, planning bool, debug bool) {
 	contract.Requiref(e != nil, "e", "!= nil")
 
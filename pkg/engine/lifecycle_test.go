@@ -33,6 +33,7 @@ import (
 	"github.com/pulumi/pulumi/pkg/v2/resource/deploy"
 	"github.com/pulumi/pulumi/pkg/v2/resource/deploy/deploytest"
 	"github.com/pulumi/pulumi/pkg/v2/resource/deploy/providers"
+	"github.com/pulumi/pulumi/pkg/v2/resource/edit"
 	"github.com/pulumi/pulumi/pkg/v2/secrets"
 	"github.com/pulumi/pulumi/pkg/v2/util/cancel"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/diag"
@@ -67,6 +68,7 @@ type JournalEntry struct {
 
 type Journal struct {
 	Entries []JournalEntry
+	base    *deploy.Snapshot
 	events  chan JournalEntry
 	cancel  chan bool
 	done    chan bool
@@ -110,6 +112,11 @@ func (j *Journal) RegisterResourceOutputs(step deploy.Step) error {
 	}
 }
 
+func (j *Journal) SetBaseSnapshot(snap *deploy.Snapshot) error {
+	j.base = snap
+	return nil
+}
+
 func (j *Journal) RecordPlugin(plugin workspace.PluginInfo) error {
 	return nil
 }
@@ -181,6 +188,9 @@ func (j *Journal) Snap(base *deploy.Snapshot) *deploy.Snapshot {
 
 	// Append any resources from the base snapshot that were not produced by the current snapshot.
 	// See backend.SnapshotManager.snap for why this works.
+	if j.base != nil {
+		base = j.base
+	}
 	if base != nil {
 		for _, res := range base.Resources {
 			if !dones[res] {
@@ -2328,6 +2338,88 @@ func TestPreviewWithPendingOperations(t *testing.T) {
 	assert.EqualError(t, res.Error(), deploy.PlanPendingOperationsError{}.Error())
 }
 
+// Tests that an update proceeds once its pending operations are resolved, and that resolving an operation by reading
+// its resource refreshes that resource from its provider.
+func TestUpdateWithPendingOperationResolutions(t *testing.T) {
+	p := &TestPlan{}
+
+	const resType = "pkgA:m:typA"
+	urnA := p.NewURN(resType, "resA", "")
+	urnB := p.NewURN(resType, "resB", "")
+
+	old := &deploy.Snapshot{
+		PendingOperations: []resource.Operation{
+			{
+				Resource: &resource.State{Type: resType, URN: urnA, Custom: true, ID: "a-id",
+					Inputs: resource.PropertyMap{}, Outputs: resource.PropertyMap{}},
+				Type: resource.OperationTypeUpdating,
+			},
+			{
+				Resource: &resource.State{Type: resType, URN: urnB, Custom: true,
+					Inputs: resource.PropertyMap{}},
+				Type: resource.OperationTypeCreating,
+			},
+		},
+		Resources: []*resource.State{
+			{Type: resType, URN: urnA, Custom: true, ID: "a-id",
+				Inputs: resource.PropertyMap{}, Outputs: resource.PropertyMap{}},
+		},
+	}
+
+	readOutputs := resource.PropertyMap{"foo": resource.NewStringProperty("bar")}
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				ReadF: func(urn resource.URN, id resource.ID,
+					inputs, state resource.PropertyMap) (plugin.ReadResult, resource.Status, error) {
+
+					assert.Equal(t, urnB, urn)
+					assert.Equal(t, resource.ID("b-id"), id)
+					return plugin.ReadResult{Inputs: inputs, Outputs: readOutputs}, resource.StatusOK, nil
+				},
+			}, nil
+		}),
+	}
+
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		_, _, _, err := monitor.RegisterResource(resType, "resA", true)
+		assert.NoError(t, err)
+		_, _, _, err = monitor.RegisterResource(resType, "resB", true)
+		assert.NoError(t, err)
+		return nil
+	})
+
+	op := TestOp(Update)
+	options := UpdateOptions{
+		host: deploytest.NewPluginHost(nil, nil, program, loaders...),
+		PendingOperationResolutions: []edit.PendingOperationResolution{
+			{URN: urnA, Type: resource.OperationTypeUpdating, Action: edit.PendingOperationKeep},
+			{URN: urnB, Type: resource.OperationTypeCreating, Action: edit.PendingOperationRead, ID: "b-id"},
+		},
+	}
+	project, target := p.GetProject(), p.GetTarget(old)
+
+	// Resolving the pending operations does not modify the target's snapshot, either in a preview or in an update.
+	_, res := op.Run(project, target, options, true, nil, nil)
+	assert.Nil(t, res)
+	assert.Len(t, old.PendingOperations, 2)
+
+	snap, res := op.Run(project, target, options, false, nil, nil)
+	assert.Nil(t, res)
+	assert.Empty(t, snap.PendingOperations)
+	assert.Len(t, old.PendingOperations, 2)
+
+	found := false
+	for _, r := range snap.Resources {
+		if r.URN == urnB {
+			found = true
+			assert.Equal(t, resource.ID("b-id"), r.ID)
+			assert.Equal(t, readOutputs, r.Outputs)
+		}
+	}
+	assert.True(t, found)
+}
+
 // Tests that a failed partial update causes the engine to persist the resource's old inputs and new outputs.
 func TestUpdatePartialFailure(t *testing.T) {
 	loaders := []*deploytest.ProviderLoader{
@@ -5936,3 +6028,709 @@ func TestProviderInheritanceGolangLifecycle(t *testing.T) {
 	}
 	p.Run(t, nil)
 }
+
+func TestRemoteComponent(t *testing.T) {
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				ConstructF: func(monitor *deploytest.ResourceMonitor, typ, name string, parent resource.URN,
+					inputs resource.PropertyMap, options plugin.ConstructOptions) (plugin.ConstructResult, error) {
+
+					assert.Equal(t, "pkgA:m:typComponent", typ)
+					assert.Equal(t, "resA", name)
+					assert.True(t, options.Protect)
+
+					urn, _, _, err := monitor.RegisterResource(tokens.Type(typ), name, false,
+						deploytest.ResourceOptions{
+							Parent:  parent,
+							Protect: options.Protect,
+						})
+					assert.NoError(t, err)
+
+					childURN, _, _, err := monitor.RegisterResource("pkgA:m:typA", name+"-child", true,
+						deploytest.ResourceOptions{
+							Parent: urn,
+							Inputs: inputs,
+						})
+					assert.NoError(t, err)
+
+					return plugin.ConstructResult{
+						URN:                urn,
+						Outputs:            resource.PropertyMap{"foo": inputs["foo"]},
+						OutputDependencies: map[resource.PropertyKey][]resource.URN{"foo": {childURN}},
+					}, nil
+				},
+			}, nil
+		}),
+	}
+
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		urn, _, outs, err := monitor.RegisterResource("pkgA:m:typComponent", "resA", false, deploytest.ResourceOptions{
+			Remote:  true,
+			Protect: true,
+			Inputs:  resource.PropertyMap{"foo": resource.NewStringProperty("bar")},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, resource.URN("urn:pulumi:test::test::pkgA:m:typComponent::resA"), urn)
+		assert.Equal(t, resource.PropertyMap{"foo": resource.NewStringProperty("bar")}, outs)
+		return nil
+	})
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+
+	p := &TestPlan{
+		Options: UpdateOptions{host: host},
+	}
+
+	project := p.GetProject()
+	snap, res := TestOp(Update).Run(project, p.GetTarget(nil), p.Options, false, p.BackendClient, nil)
+	assert.Nil(t, res)
+
+	// The snapshot should contain the default provider, the component, and the component's child.
+	assert.Len(t, snap.Resources, 3)
+	component := snap.Resources[1]
+	assert.Equal(t, p.NewURN("pkgA:m:typComponent", "resA", ""), component.URN)
+	assert.False(t, component.Custom)
+	assert.True(t, component.Protect)
+	child := snap.Resources[2]
+	assert.Equal(t, component.URN, child.Parent)
+	assert.Equal(t, resource.NewStringProperty("bar"), child.Inputs["foo"])
+}
+
+func TestRemoteComponentRequiresComponent(t *testing.T) {
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{}, nil
+		}),
+	}
+
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true, deploytest.ResourceOptions{
+			Remote: true,
+		})
+		assert.Error(t, err)
+		return nil
+	})
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+
+	p := &TestPlan{
+		Options: UpdateOptions{host: host},
+	}
+
+	project := p.GetProject()
+	_, res := TestOp(Update).Run(project, p.GetTarget(nil), p.Options, false, p.BackendClient, nil)
+	assert.Nil(t, res)
+}
+
+func TestResourceMethodCall(t *testing.T) {
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				CreateF: func(urn resource.URN, news resource.PropertyMap,
+					timeout float64) (resource.ID, resource.PropertyMap, resource.Status, error) {
+
+					return "created-id", news, resource.StatusOK, nil
+				},
+				CallF: func(monitor *deploytest.ResourceMonitor, tok tokens.ModuleMember, urn resource.URN,
+					state, args resource.PropertyMap, options plugin.CallOptions) (plugin.CallResult, error) {
+
+					assert.Equal(t, tokens.ModuleMember("pkgA:m:typA/method"), tok)
+					assert.Equal(t, resource.NewStringProperty("bar"), state["foo"])
+					assert.Equal(t, resource.NewStringProperty("baz"), args["arg"])
+
+					return plugin.CallResult{
+						Return: resource.PropertyMap{
+							"result": resource.NewStringProperty(state["foo"].StringValue() + args["arg"].StringValue()),
+						},
+						ReturnDependencies: map[resource.PropertyKey][]resource.URN{"result": {urn}},
+					}, nil
+				},
+			}, nil
+		}),
+	}
+
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		urn, _, state, err := monitor.RegisterResource("pkgA:m:typA", "resA", true, deploytest.ResourceOptions{
+			Inputs: resource.PropertyMap{"foo": resource.NewStringProperty("bar")},
+		})
+		assert.NoError(t, err)
+
+		ret, deps, failures, err := monitor.Call("pkgA:m:typA/method", urn, state,
+			resource.PropertyMap{"arg": resource.NewStringProperty("baz")}, nil, "", "")
+		assert.NoError(t, err)
+		assert.Empty(t, failures)
+		assert.Equal(t, resource.PropertyMap{"result": resource.NewStringProperty("barbaz")}, ret)
+		assert.Equal(t, map[resource.PropertyKey][]resource.URN{"result": {urn}}, deps)
+		return nil
+	})
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+
+	p := &TestPlan{
+		Options: UpdateOptions{host: host},
+		Steps:   []TestStep{{Op: Update}},
+	}
+	p.Run(t, nil)
+}
+
+// Tests that an update that continues on error still updates resources that do not depend on a failed resource, skips
+// those that do, and retains both in the snapshot.
+func TestContinueOnErrorUpdate(t *testing.T) {
+	p := &TestPlan{}
+	resA := p.NewURN("pkgA:m:typA", "resA", "")
+	resB := p.NewURN("pkgA:m:typA", "resB", "")
+	resC := p.NewURN("pkgA:m:typA", "resC", "")
+
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				DiffF: func(urn resource.URN, id resource.ID, olds, news resource.PropertyMap,
+					ignoreChanges []string) (plugin.DiffResult, error) {
+
+					if olds.DeepEquals(news) {
+						return plugin.DiffResult{Changes: plugin.DiffNone}, nil
+					}
+					return plugin.DiffResult{Changes: plugin.DiffSome}, nil
+				},
+				UpdateF: func(urn resource.URN, id resource.ID, olds, news resource.PropertyMap,
+					timeout float64, ignoreChanges []string) (resource.PropertyMap, resource.Status, error) {
+
+					if urn == resA {
+						return nil, resource.StatusOK, errors.New("update failed")
+					}
+					return news, resource.StatusOK, nil
+				},
+			}, nil
+		}),
+	}
+
+	version := "1"
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		inputs := resource.PropertyMap{"version": resource.NewStringProperty(version)}
+
+		_, _, _, errA := monitor.RegisterResource("pkgA:m:typA", "resA", true, deploytest.ResourceOptions{
+			Inputs: inputs,
+		})
+		_, _, _, errB := monitor.RegisterResource("pkgA:m:typA", "resB", true, deploytest.ResourceOptions{
+			Inputs:       inputs,
+			Dependencies: []resource.URN{resA},
+		})
+		_, _, _, errC := monitor.RegisterResource("pkgA:m:typA", "resC", true, deploytest.ResourceOptions{
+			Inputs: inputs,
+		})
+
+		if version == "2" {
+			assert.Error(t, errA)
+			assert.Error(t, errB)
+		} else {
+			assert.NoError(t, errA)
+			assert.NoError(t, errB)
+		}
+		assert.NoError(t, errC)
+		return nil
+	})
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+
+	p.Options = UpdateOptions{host: host, ContinueOnError: true}
+	p.Steps = MakeBasicLifecycleSteps(t, 4)[:1]
+	snap := p.Run(t, nil)
+
+	version = "2"
+	p.Steps = []TestStep{{
+		Op:            Update,
+		ExpectFailure: true,
+		SkipPreview:   true,
+		Validate: func(project workspace.Project, target deploy.Target, j *Journal,
+			evts []Event, res result.Result) result.Result {
+
+			assertIsErrorOrBailResult(t, res)
+
+			updated := make(map[resource.URN]bool)
+			for _, entry := range j.Entries {
+				if entry.Kind == JournalEntrySuccess && entry.Step.Op() == deploy.OpUpdate {
+					updated[entry.Step.URN()] = true
+				}
+			}
+			assert.Equal(t, map[resource.URN]bool{resC: true}, updated)
+
+			sawSummary := false
+			for _, evt := range evts {
+				if evt.Type == DiagEvent {
+					e := evt.Payload.(DiagEventPayload)
+					if strings.Contains(e.Message, "1 resource failed:") {
+						sawSummary = true
+						assert.Contains(t, e.Message, string(resA))
+						assert.Contains(t, e.Message, "skipped "+string(resB))
+						assert.NotContains(t, e.Message, string(resC))
+					}
+				}
+			}
+			assert.True(t, sawSummary)
+			return res
+		},
+	}}
+	snap = p.Run(t, snap)
+
+	versions := make(map[resource.URN]string)
+	for _, r := range snap.Resources {
+		if !providers.IsProviderType(r.Type) {
+			versions[r.URN] = r.Inputs["version"].StringValue()
+		}
+	}
+	assert.Equal(t, map[resource.URN]string{resA: "1", resB: "1", resC: "2"}, versions)
+}
+
+// Tests that a destroy that continues on error keeps the dependencies of a resource that failed to be deleted and
+// deletes everything else.
+func TestContinueOnErrorDestroy(t *testing.T) {
+	p := &TestPlan{}
+	resA := p.NewURN("pkgA:m:typA", "resA", "")
+	resB := p.NewURN("pkgA:m:typA", "resB", "")
+	resC := p.NewURN("pkgA:m:typA", "resC", "")
+
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				DeleteF: func(urn resource.URN, id resource.ID, olds resource.PropertyMap,
+					timeout float64) (resource.Status, error) {
+
+					if urn == resB {
+						return resource.StatusOK, errors.New("delete failed")
+					}
+					return resource.StatusOK, nil
+				},
+			}, nil
+		}),
+	}
+
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true)
+		assert.NoError(t, err)
+		_, _, _, err = monitor.RegisterResource("pkgA:m:typA", "resB", true, deploytest.ResourceOptions{
+			Dependencies: []resource.URN{resA},
+		})
+		assert.NoError(t, err)
+		_, _, _, err = monitor.RegisterResource("pkgA:m:typA", "resC", true)
+		assert.NoError(t, err)
+		return nil
+	})
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+
+	p.Options = UpdateOptions{host: host, ContinueOnError: true}
+	p.Steps = []TestStep{
+		{Op: Update},
+		{
+			Op:            Destroy,
+			ExpectFailure: true,
+			SkipPreview:   true,
+			Validate: func(project workspace.Project, target deploy.Target, j *Journal,
+				evts []Event, res result.Result) result.Result {
+
+				assertIsErrorOrBailResult(t, res)
+
+				deleted := make(map[resource.URN]bool)
+				for _, entry := range j.Entries {
+					if entry.Kind == JournalEntrySuccess {
+						deleted[entry.Step.URN()] = true
+					}
+				}
+				assert.Equal(t, map[resource.URN]bool{resC: true}, deleted)
+
+				sawSummary := false
+				for _, evt := range evts {
+					if evt.Type == DiagEvent {
+						e := evt.Payload.(DiagEventPayload)
+						if strings.Contains(e.Message, "1 resource failed:") {
+							sawSummary = true
+							assert.Contains(t, e.Message, string(resB))
+							assert.Contains(t, e.Message, "skipped "+string(resA))
+						}
+					}
+				}
+				assert.True(t, sawSummary)
+				return res
+			},
+		},
+	}
+	snap := p.Run(t, nil)
+
+	var remaining []resource.URN
+	for _, r := range snap.Resources {
+		if !providers.IsProviderType(r.Type) {
+			remaining = append(remaining, r.URN)
+		}
+	}
+	assert.ElementsMatch(t, []resource.URN{resA, resB}, remaining)
+}
+
+// Tests that failed creates are retried according to the resource's retry policy, or the default policy if the
+// resource has none, and that each retry is reported.
+func TestRetryPolicy(t *testing.T) {
+	p := &TestPlan{}
+	resA := p.NewURN("pkgA:m:typA", "resA", "")
+	resB := p.NewURN("pkgA:m:typA", "resB", "")
+
+	attempts := make(map[resource.URN]int)
+	var lock sync.Mutex
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				CreateF: func(urn resource.URN, news resource.PropertyMap,
+					timeout float64) (resource.ID, resource.PropertyMap, resource.Status, error) {
+
+					lock.Lock()
+					defer lock.Unlock()
+					attempts[urn]++
+					if attempts[urn] < 3 {
+						return "", nil, resource.StatusOK, errors.New("throttled: rate exceeded")
+					}
+					return resource.ID(urn.Name()), news, resource.StatusOK, nil
+				},
+			}, nil
+		}),
+	}
+
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true, deploytest.ResourceOptions{
+			RetryPolicy: &resource.RetryPolicy{MaxAttempts: 3, ErrorMatchers: []string{"throttled"}},
+		})
+		assert.NoError(t, err)
+		_, _, _, err = monitor.RegisterResource("pkgA:m:typA", "resB", true)
+		assert.NoError(t, err)
+		return nil
+	})
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+
+	p.Options = UpdateOptions{host: host, DefaultRetryPolicy: &resource.RetryPolicy{MaxAttempts: 5}}
+	p.Steps = []TestStep{{
+		Op:          Update,
+		SkipPreview: true,
+		Validate: func(project workspace.Project, target deploy.Target, j *Journal,
+			evts []Event, res result.Result) result.Result {
+
+			assert.Nil(t, res)
+
+			retries, retryEvents := make(map[resource.URN]int), make(map[resource.URN][]int)
+			for _, evt := range evts {
+				switch evt.Type {
+				case DiagEvent:
+					e := evt.Payload.(DiagEventPayload)
+					if e.Severity == diag.Warning && strings.Contains(e.Message, "retrying") {
+						assert.Contains(t, e.Message, "throttled: rate exceeded")
+						retries[e.URN]++
+					}
+				case ResourceRetryEvent:
+					e := evt.Payload.(ResourceRetryEventPayload)
+					assert.Equal(t, deploy.OpCreate, e.Metadata.Op)
+					assert.Equal(t, "throttled: rate exceeded", e.Error)
+					assert.True(t, e.Delay >= resource.MinRetryDelay)
+					retryEvents[e.Metadata.URN] = append(retryEvents[e.Metadata.URN], e.Attempt)
+				}
+			}
+			assert.Equal(t, map[resource.URN]int{resA: 2, resB: 2}, retries)
+			assert.Equal(t, map[resource.URN][]int{resA: {1, 2}, resB: {1, 2}}, retryEvents)
+			return res
+		},
+	}}
+	p.Run(t, nil)
+
+	assert.Equal(t, map[resource.URN]int{resA: 3, resB: 3}, attempts)
+}
+
+// Tests that errors detected by the engine rather than reported by the provider, such as an attempt to delete a
+// protected resource, are not retried.
+func TestRetryPolicyEngineError(t *testing.T) {
+	p := &TestPlan{}
+
+	deletes := 0
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				DeleteF: func(urn resource.URN, id resource.ID, olds resource.PropertyMap,
+					timeout float64) (resource.Status, error) {
+
+					deletes++
+					return resource.StatusOK, nil
+				},
+			}, nil
+		}),
+	}
+
+	createResource := true
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		if createResource {
+			_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true, deploytest.ResourceOptions{
+				Protect: true,
+			})
+			assert.NoError(t, err)
+		}
+		return nil
+	})
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+
+	p.Options = UpdateOptions{host: host, DefaultRetryPolicy: &resource.RetryPolicy{MaxAttempts: 3}}
+	p.Steps = []TestStep{{Op: Update, SkipPreview: true}}
+	snap := p.Run(t, nil)
+
+	createResource = false
+	p.Steps = []TestStep{{
+		Op:            Update,
+		ExpectFailure: true,
+		SkipPreview:   true,
+		Validate: func(project workspace.Project, target deploy.Target, j *Journal,
+			evts []Event, res result.Result) result.Result {
+
+			for _, evt := range evts {
+				assert.NotEqual(t, ResourceRetryEvent, evt.Type)
+			}
+			return res
+		},
+	}}
+	p.Run(t, snap)
+
+	assert.Equal(t, 0, deletes)
+}
+
+// Tests that a create that returned an ID is not retried, even if the resource has a retry policy.
+func TestRetryPolicyPartialFailure(t *testing.T) {
+	p := &TestPlan{}
+
+	attempts := 0
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				CreateF: func(urn resource.URN, news resource.PropertyMap,
+					timeout float64) (resource.ID, resource.PropertyMap, resource.Status, error) {
+
+					attempts++
+					return "created-id", news, resource.StatusPartialFailure, errors.New("initialization failed")
+				},
+			}, nil
+		}),
+	}
+
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		_, _, _, _ = monitor.RegisterResource("pkgA:m:typA", "resA", true, deploytest.ResourceOptions{
+			RetryPolicy: &resource.RetryPolicy{MaxAttempts: 3},
+		})
+		return nil
+	})
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+
+	p.Options = UpdateOptions{host: host}
+	p.Steps = []TestStep{{Op: Update, ExpectFailure: true, SkipPreview: true}}
+	snap := p.Run(t, nil)
+
+	assert.Equal(t, 1, attempts)
+	found := false
+	for _, r := range snap.Resources {
+		if r.URN.Name() == "resA" {
+			found = true
+			assert.Equal(t, resource.ID("created-id"), r.ID)
+		}
+	}
+	assert.True(t, found)
+}
+
+// Tests that the provider is not asked to delete a resource that is retained on delete, and that the resource is
+// still removed from the stack.
+func TestRetainOnDelete(t *testing.T) {
+	p := &TestPlan{}
+
+	deletes := 0
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				DeleteF: func(urn resource.URN, id resource.ID, olds resource.PropertyMap,
+					timeout float64) (resource.Status, error) {
+
+					deletes++
+					return resource.StatusOK, nil
+				},
+			}, nil
+		}),
+	}
+
+	createResource := true
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		if createResource {
+			_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true, deploytest.ResourceOptions{
+				RetainOnDelete: true,
+			})
+			assert.NoError(t, err)
+		}
+		return nil
+	})
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+
+	p.Options = UpdateOptions{host: host}
+	p.Steps = []TestStep{{Op: Update}}
+	snap := p.Run(t, nil)
+	assert.Len(t, snap.Resources, 2)
+	assert.True(t, snap.Resources[1].RetainOnDelete)
+
+	createResource = false
+	p.Steps = []TestStep{{
+		Op: Update,
+		Validate: func(project workspace.Project, target deploy.Target, j *Journal,
+			evts []Event, res result.Result) result.Result {
+
+			sawRetain := false
+			for _, evt := range evts {
+				if evt.Type == ResourcePreEvent {
+					md := evt.Payload.(ResourcePreEventPayload).Metadata
+					if md.Op == deploy.OpDelete && md.URN.Name() == "resA" {
+						sawRetain = true
+						assert.Equal(t, "retained: retainOnDelete is set", md.DeleteSkipReason)
+					}
+				}
+			}
+			assert.True(t, sawRetain)
+			return res
+		},
+	}}
+	snap = p.Run(t, snap)
+	assert.Len(t, snap.Resources, 0)
+	assert.Equal(t, 0, deletes)
+}
+
+// Tests that the provider is not asked to delete a resource that is deleted with another resource when both are
+// deleted, but is asked to delete it when it is deleted on its own.
+func TestDeletedWith(t *testing.T) {
+	p := &TestPlan{}
+	resA := p.NewURN("pkgA:m:typA", "resA", "")
+	resC := p.NewURN("pkgA:m:typA", "resC", "")
+
+	var deleted []resource.URN
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				DeleteF: func(urn resource.URN, id resource.ID, olds resource.PropertyMap,
+					timeout float64) (resource.Status, error) {
+
+					deleted = append(deleted, urn)
+					return resource.StatusOK, nil
+				},
+			}, nil
+		}),
+	}
+
+	createC := true
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true)
+		assert.NoError(t, err)
+		_, _, _, err = monitor.RegisterResource("pkgA:m:typA", "resB", true, deploytest.ResourceOptions{
+			DeletedWith: resA,
+		})
+		assert.NoError(t, err)
+		if createC {
+			_, _, _, err = monitor.RegisterResource("pkgA:m:typA", "resC", true, deploytest.ResourceOptions{
+				DeletedWith: resA,
+			})
+			assert.NoError(t, err)
+		}
+		return nil
+	})
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+
+	p.Options = UpdateOptions{host: host}
+	p.Steps = []TestStep{{Op: Update}}
+	snap := p.Run(t, nil)
+
+	// Removing resC on its own must delete it, since resA is not being deleted.
+	createC = false
+	p.Steps = []TestStep{{Op: Update, SkipPreview: true}}
+	snap = p.Run(t, snap)
+	assert.Equal(t, []resource.URN{resC}, deleted)
+
+	// Destroying the stack deletes resB along with resA.
+	deleted = nil
+	p.Steps = []TestStep{{Op: Destroy, SkipPreview: true}}
+	snap = p.Run(t, snap)
+	assert.Len(t, snap.Resources, 0)
+	assert.Equal(t, []resource.URN{resA}, deleted)
+}
+
+// Tests that resource inputs are validated against the provider's schema before they are checked by the provider,
+// and that validation can be skipped for individual packages.
+func TestSchemaValidation(t *testing.T) {
+	const schema = `{
+		"name": "pkgA",
+		"resources": {
+			"pkgA:m:typA": {
+				"inputProperties": {
+					"name": {"type": "string"},
+					"old": {"type": "string", "deprecationMessage": "use name instead"}
+				},
+				"requiredInputs": ["name"]
+			}
+		}
+	}`
+
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				GetSchemaF: func(version int) ([]byte, error) {
+					return []byte(schema), nil
+				},
+			}, nil
+		}),
+	}
+
+	var inputs resource.PropertyMap
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true, deploytest.ResourceOptions{
+			Inputs: inputs,
+		})
+		return err
+	})
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+
+	diagMessages := func(evts []Event, severity diag.Severity) []string {
+		var messages []string
+		for _, evt := range evts {
+			if evt.Type == DiagEvent {
+				if e := evt.Payload.(DiagEventPayload); e.Severity == severity {
+					messages = append(messages, e.Message)
+				}
+			}
+		}
+		return messages
+	}
+
+	// A missing required input fails the update with an error that names the property.
+	inputs = resource.PropertyMap{"old": resource.NewStringProperty("foo")}
+	p := &TestPlan{
+		Options: UpdateOptions{host: host},
+		Steps: []TestStep{{
+			Op:            Update,
+			ExpectFailure: true,
+			SkipPreview:   true,
+			Validate: func(project workspace.Project, target deploy.Target, j *Journal,
+				evts []Event, res result.Result) result.Result {
+
+				assertIsErrorOrBailResult(t, res)
+
+				errs := diagMessages(evts, diag.Error)
+				if assert.Len(t, errs, 1) {
+					assert.Contains(t, errs[0], "property 'name' value {<nil>} has a problem: "+
+						"missing required property 'name'")
+				}
+				warnings := diagMessages(evts, diag.Warning)
+				if assert.Len(t, warnings, 1) {
+					assert.Contains(t, warnings[0], "old: property 'old' is deprecated: use name instead")
+				}
+				return res
+			},
+		}},
+	}
+	p.Run(t, nil)
+
+	// Skipping validation for the package leaves checking the inputs to the provider.
+	p.Options.SkipSchemaValidation = []tokens.Package{"pkgA"}
+	p.Steps = []TestStep{{Op: Update, SkipPreview: true}}
+	p.Run(t, nil)
+
+	// Valid inputs pass validation.
+	inputs = resource.PropertyMap{"name": resource.NewStringProperty("foo")}
+	p.Options.SkipSchemaValidation = nil
+	p.Run(t, nil)
+}
@@ -17,10 +17,12 @@ package engine
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/opentracing/opentracing-go"
 	"github.com/pulumi/pulumi/pkg/v2/resource/deploy"
 	"github.com/pulumi/pulumi/pkg/v2/resource/deploy/providers"
+	"github.com/pulumi/pulumi/pkg/v2/resource/edit"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/diag"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
@@ -118,6 +120,34 @@ func plan(ctx *Context, info *planContext, opts planOptions, dryRun bool) (*plan
 	proj, target := info.Update.GetProject(), info.Update.GetTarget()
 	contract.Assert(proj != nil)
 	contract.Assert(target != nil)
+
+	// Resolve any pending operations before planning, refreshing the resources whose resolution requires it.
+	if len(opts.PendingOperationResolutions) != 0 && target.Snapshot != nil {
+		snap, reads, err := edit.ResolvePendingOperations(target.Snapshot, opts.PendingOperationResolutions)
+		if err != nil {
+			return nil, err
+		}
+
+		// Plan against the resolved snapshot, leaving the target's snapshot as it is. If this is not a preview, the
+		// snapshots persisted by the update are based on the resolved snapshot, too.
+		resolvedTarget := *target
+		resolvedTarget.Snapshot = snap
+		target = &resolvedTarget
+		if !dryRun && ctx.SnapshotManager != nil {
+			if err := ctx.SnapshotManager.SetBaseSnapshot(snap); err != nil {
+				return nil, err
+			}
+		}
+		if len(reads) != 0 {
+			switch {
+			case !opts.Refresh:
+				opts.Refresh, opts.RefreshTargets = true, reads
+			case len(opts.RefreshTargets) != 0:
+				opts.RefreshTargets = append(opts.RefreshTargets, reads...)
+			}
+		}
+	}
+
 	projinfo := &Projinfo{Proj: proj, Root: info.Update.GetRoot()}
 	pwd, main, plugctx, err := ProjectInfoContext(projinfo, opts.host, target,
 		opts.Diag, opts.StatusDiag, info.TracingSpan)
@@ -189,6 +219,10 @@ func (planResult *planResult) Walk(cancelCtx *Context, events deploy.Events, pre
 			TargetDependents:  planResult.Options.TargetDependents,
 			TrustDependencies: planResult.Options.trustDependencies,
 			UseLegacyDiff:     planResult.Options.UseLegacyDiff,
+			ContinueOnError:   planResult.Options.ContinueOnError,
+
+			SkipSchemaValidation: planResult.Options.SkipSchemaValidation,
+			DefaultRetryPolicy:   planResult.Options.DefaultRetryPolicy,
 		}
 		walkResult = planResult.Plan.Execute(ctx, opts, preview)
 		close(done)
@@ -333,6 +367,11 @@ func ShouldRecordReadStep(step deploy.Step) bool {
 		step.Old().Outputs.Diff(step.New().Outputs) != nil
 }
 
+// OnResourceStepRetry is never called during a preview, as steps are not applied and so cannot be retried.
+func (acts *planActions) OnResourceStepRetry(step deploy.Step, attempt, maxAttempts int, delay time.Duration,
+	err error) {
+}
+
 func (acts *planActions) OnResourceOutputs(step deploy.Step) error {
 	acts.MapLock.Lock()
 	assertSeen(acts.Seen, step)
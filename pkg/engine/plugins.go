@@ -127,6 +127,15 @@ func ensurePluginsAreInstalled(plugins pluginSet) error {
 	logging.V(preparePluginLog).Infof("ensurePluginsAreInstalled(): beginning")
 	var installTasks errgroup.Group
 	for _, plug := range plugins.Values() {
+		// Providers that are already running will be attached to rather than launched, so need not be installed.
+		if plug.Kind == workspace.ResourcePlugin {
+			if port, err := plugin.GetProviderAttachPort(tokens.Package(plug.Name)); err == nil && port != nil {
+				logging.V(preparePluginLog).Infof(
+					"ensurePluginsAreInstalled(): plugin %s will be attached on port %d", plug.Name, *port)
+				continue
+			}
+		}
+
 		_, path, err := workspace.GetPluginPath(plug.Kind, plug.Name, plug.Version)
 		if err == nil && path != "" {
 			logging.V(preparePluginLog).Infof(
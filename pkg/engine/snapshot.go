@@ -34,6 +34,10 @@ type SnapshotManager interface {
 	// RegisterResourceOutputs registers the set of resource outputs generated by performing the
 	// given step. These outputs are persisted in the snapshot.
 	RegisterResourceOutputs(step deploy.Step) error
+
+	// SetBaseSnapshot replaces the snapshot that the plan is based on. The engine calls it before planning if it
+	// edits the stack's snapshot, e.g. in order to resolve pending operations, and before any mutation begins.
+	SetBaseSnapshot(snap *deploy.Snapshot) error
 }
 
 // SnapshotMutation represents an outstanding mutation that is yet to be completed. When the engine completes
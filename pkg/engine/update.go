@@ -28,6 +28,7 @@ import (
 	"github.com/pkg/errors"
 	resourceanalyzer "github.com/pulumi/pulumi/pkg/v2/resource/analyzer"
 	"github.com/pulumi/pulumi/pkg/v2/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/v2/resource/edit"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/diag"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
@@ -129,9 +130,22 @@ type UpdateOptions struct {
 	// XXXTargets lists.
 	TargetDependents bool
 
+	// true if the engine should continue executing independent steps after a step fails.
+	ContinueOnError bool
+
+	// the packages whose resources' inputs should not be validated against their providers' schemas.
+	SkipSchemaValidation []tokens.Package
+
+	// the policy for retrying failed resource operations, for resources that do not specify their own.
+	DefaultRetryPolicy *resource.RetryPolicy
+
 	// true if the engine should use legacy diffing behavior during an update.
 	UseLegacyDiff bool
 
+	// Resolutions for any operations that were left pending by an interrupted update. If these are provided, they
+	// are applied to the stack's state before the update proceeds.
+	PendingOperationResolutions []edit.PendingOperationResolution
+
 	// true if we should report events for steps that involve default providers.
 	reportDefaultProviderSteps bool
 
@@ -621,6 +635,14 @@ func (acts *updateActions) OnResourceOutputs(step deploy.Step) error {
 	return acts.Context.SnapshotManager.RegisterResourceOutputs(step)
 }
 
+func (acts *updateActions) OnResourceStepRetry(step deploy.Step, attempt, maxAttempts int, delay time.Duration,
+	err error) {
+
+	if shouldReportStep(step, acts.Opts) {
+		acts.Opts.Events.resourceRetryEvent(step, attempt, maxAttempts, delay, err, acts.Opts.Debug)
+	}
+}
+
 func (acts *updateActions) OnPolicyViolation(urn resource.URN, d plugin.AnalyzeDiagnostic) {
 	acts.Opts.Events.policyViolationEvent(urn, d)
 }
@@ -0,0 +1,80 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operations
+
+import (
+	"sort"
+
+	"github.com/pulumi/pulumi/pkg/v2/resource/deploy/providers"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/logging"
+)
+
+// ProviderSource allows access to the resource providers that manage a stack's resources.
+type ProviderSource interface {
+	// GetProvider returns the provider plugin that is registered under the given reference, if any.
+	GetProvider(ref providers.Reference) (plugin.Provider, bool)
+}
+
+// PluginOperationsProvider creates an OperationsProvider that answers operational queries about a custom resource by
+// calling the resource's own provider plugin. If the plugin does not support a query, the query is answered by the
+// fallback provider instead, if one is given.
+func PluginOperationsProvider(provider plugin.Provider, component *Resource,
+	fallback func() (Provider, error)) Provider {
+
+	return &pluginOpsProvider{
+		provider:  provider,
+		component: component,
+		fallback:  fallback,
+	}
+}
+
+type pluginOpsProvider struct {
+	provider  plugin.Provider
+	component *Resource
+	fallback  func() (Provider, error)
+}
+
+var _ Provider = (*pluginOpsProvider)(nil)
+
+func (ops *pluginOpsProvider) GetLogs(query LogQuery) (*[]LogEntry, error) {
+	state := ops.component.State
+
+	var logs []LogEntry
+	err := ops.provider.GetLogs(state.URN, state.ID, state.Outputs, query.StartTime, query.EndTime,
+		func(entry plugin.LogEntry) error {
+			logs = append(logs, LogEntry{ID: entry.ID, Timestamp: entry.Timestamp, Message: entry.Message})
+			return nil
+		})
+	if err == plugin.ErrLogsNotSupported {
+		logging.V(6).Infof("GetLogs: provider for %v does not support logs", state.URN)
+		if ops.fallback == nil {
+			return nil, nil
+		}
+		fallback, err := ops.fallback()
+		if err != nil || fallback == nil {
+			return nil, err
+		}
+		return fallback.GetLogs(query)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Providers may interleave entries from multiple sources, so ensure that the logs are in order.
+	sort.SliceStable(logs, func(i, j int) bool { return logs[i].Timestamp < logs[j].Timestamp })
+	logging.V(5).Infof("GetLogs: provider for %v returned %d entries", state.URN, len(logs))
+	return &logs, nil
+}
@@ -0,0 +1,134 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operations
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/pkg/v2/resource/deploy/deploytest"
+	"github.com/pulumi/pulumi/pkg/v2/resource/deploy/providers"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/tokens"
+)
+
+type testProviderSource map[providers.Reference]plugin.Provider
+
+func (s testProviderSource) GetProvider(ref providers.Reference) (plugin.Provider, bool) {
+	p, ok := s[ref]
+	return p, ok
+}
+
+func newLogsProvider(entries map[resource.ID][]plugin.LogEntry) *deploytest.Provider {
+	return &deploytest.Provider{
+		GetLogsF: func(urn resource.URN, id resource.ID, state resource.PropertyMap, startTime, endTime *time.Time,
+			onNext func(plugin.LogEntry) error) error {
+
+			logs, ok := entries[id]
+			if !ok {
+				return plugin.ErrLogsNotSupported
+			}
+			for _, entry := range logs {
+				if startTime != nil && entry.Timestamp < startTime.UnixNano()/int64(time.Millisecond) {
+					continue
+				}
+				if err := onNext(entry); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
+
+func newProviderState(t *testing.T, pkg string) (*resource.State, providers.Reference) {
+	urn := resource.NewURN("stack", "project", "", providers.MakeProviderType(tokens.Package(pkg)), "prov")
+	ref, err := providers.NewReference(urn, "provider-id")
+	assert.NoError(t, err)
+	return &resource.State{URN: urn, Type: urn.Type(), Custom: true, ID: "provider-id"}, ref
+}
+
+func newCustomState(typ tokens.Type, name string, id resource.ID, provider providers.Reference) *resource.State {
+	urn := resource.NewURN("stack", "project", "", typ, tokens.QName(name))
+	return &resource.State{URN: urn, Type: typ, Custom: true, ID: id, Provider: provider.String()}
+}
+
+func TestPluginLogs(t *testing.T) {
+	provA, refA := newProviderState(t, "pkgA")
+	provB, refB := newProviderState(t, "pkgB")
+	source := testProviderSource{
+		refA: newLogsProvider(map[resource.ID][]plugin.LogEntry{
+			"a1": {{ID: "a1", Timestamp: 3, Message: "a1-3"}, {ID: "a1", Timestamp: 1, Message: "a1-1"}},
+		}),
+		refB: newLogsProvider(map[resource.ID][]plugin.LogEntry{
+			"b1": {{ID: "b1", Timestamp: 2, Message: "b1-2"}, {ID: "b1", Timestamp: 4, Message: "b1-4"}},
+		}),
+	}
+
+	tree := NewResourceTree([]*resource.State{
+		provA,
+		provB,
+		newCustomState("pkgA:index:Function", "a1", "a1", refA),
+		newCustomState("pkgB:index:Container", "b1", "b1", refB),
+		newCustomState("pkgB:index:Bucket", "b2", "b2", refB),
+	})
+	ops := tree.OperationsProviderWithPlugins(nil, source)
+
+	// Logs from all providers are merged and sorted.
+	logs, err := ops.GetLogs(LogQuery{})
+	assert.NoError(t, err)
+	if assert.NotNil(t, logs) {
+		assert.Equal(t, []LogEntry{
+			{ID: "a1", Timestamp: 1, Message: "a1-1"},
+			{ID: "b1", Timestamp: 2, Message: "b1-2"},
+			{ID: "a1", Timestamp: 3, Message: "a1-3"},
+			{ID: "b1", Timestamp: 4, Message: "b1-4"},
+		}, *logs)
+	}
+
+	// The resource filter and time bounds are respected.
+	filter := ResourceFilter("b1")
+	startTime := time.Unix(0, 3*int64(time.Millisecond))
+	logs, err = ops.GetLogs(LogQuery{ResourceFilter: &filter, StartTime: &startTime})
+	assert.NoError(t, err)
+	if assert.NotNil(t, logs) {
+		assert.Equal(t, []LogEntry{{ID: "b1", Timestamp: 4, Message: "b1-4"}}, *logs)
+	}
+
+	// Resources whose provider does not support logs have none.
+	filter = ResourceFilter("b2")
+	logs, err = ops.GetLogs(LogQuery{ResourceFilter: &filter})
+	assert.NoError(t, err)
+	if logs != nil {
+		assert.Empty(t, *logs)
+	}
+}
+
+func TestPluginLogsWithoutProviders(t *testing.T) {
+	provA, refA := newProviderState(t, "pkgA")
+	tree := NewResourceTree([]*resource.State{
+		provA,
+		newCustomState("pkgA:index:Function", "a1", "a1", refA),
+	})
+
+	logs, err := tree.OperationsProvider(nil).GetLogs(LogQuery{})
+	assert.NoError(t, err)
+	if logs != nil {
+		assert.Empty(t, *logs)
+	}
+}
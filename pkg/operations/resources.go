@@ -18,10 +18,14 @@ import (
 	"sort"
 
 	"github.com/hashicorp/go-multierror"
+
+	"github.com/pulumi/pulumi/pkg/v2/resource/deploy/providers"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/config"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/tokens"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/contract"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/logging"
 )
 
 // Resource is a tree representation of a resource/component hierarchy
@@ -114,16 +118,24 @@ func (r *Resource) GetChild(typ string, name string) (*Resource, bool) {
 
 // OperationsProvider gets an OperationsProvider for this resource.
 func (r *Resource) OperationsProvider(config map[config.Key]string) Provider {
+	return r.OperationsProviderWithPlugins(config, nil)
+}
+
+// OperationsProviderWithPlugins gets an OperationsProvider for this resource that also asks the provider plugins in
+// the given source about the custom resources they manage. The source may be nil.
+func (r *Resource) OperationsProviderWithPlugins(config map[config.Key]string, providers ProviderSource) Provider {
 	return &resourceOperations{
-		resource: r,
-		config:   config,
+		resource:  r,
+		config:    config,
+		providers: providers,
 	}
 }
 
 // ResourceOperations is an OperationsProvider for Resources
 type resourceOperations struct {
-	resource *Resource
-	config   map[config.Key]string
+	resource  *Resource
+	config    map[config.Key]string
+	providers ProviderSource
 }
 
 var _ Provider = (*resourceOperations)(nil)
@@ -168,8 +180,9 @@ func (ops *resourceOperations) GetLogs(query LogQuery) (*[]LogEntry, error) {
 	errch := make(chan error)
 	for _, child := range ops.resource.Children {
 		childOps := &resourceOperations{
-			resource: child,
-			config:   ops.config,
+			resource:  child,
+			config:    ops.config,
+			providers: ops.providers,
 		}
 		go func() {
 			childLogs, err := childOps.GetLogs(query)
@@ -249,6 +262,30 @@ func (ops *resourceOperations) getOperationsProvider() (Provider, error) {
 	if ops.resource == nil || ops.resource.State == nil {
 		return nil, nil
 	}
+
+	// Prefer the resource's own provider plugin, falling back to the built-in providers below if the plugin does not
+	// support the query.
+	if provider, ok := ops.getProviderPlugin(); ok {
+		return PluginOperationsProvider(provider, ops.resource, ops.getBuiltinOperationsProvider), nil
+	}
+	return ops.getBuiltinOperationsProvider()
+}
+
+// getProviderPlugin returns the provider plugin that manages this resource, if any.
+func (ops *resourceOperations) getProviderPlugin() (plugin.Provider, bool) {
+	state := ops.resource.State
+	if ops.providers == nil || !state.Custom || state.Provider == "" {
+		return nil, false
+	}
+	ref, err := providers.ParseReference(state.Provider)
+	if err != nil {
+		logging.V(6).Infof("GetLogs: bad provider reference for %v: %v", state.URN, err)
+		return nil, false
+	}
+	return ops.providers.GetProvider(ref)
+}
+
+func (ops *resourceOperations) getBuiltinOperationsProvider() (Provider, error) {
 	switch ops.resource.State.Type.Package() {
 	case "cloud":
 		return CloudOperationsProvider(ops.config, ops.resource)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"time"
 
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
@@ -179,6 +180,21 @@ func (p *builtinProvider) StreamInvoke(
 	return nil, fmt.Errorf("the builtin provider does not implement streaming invokes")
 }
 
+func (p *builtinProvider) Construct(info plugin.ConstructInfo, typ tokens.Type, name tokens.QName, parent resource.URN,
+	inputs resource.PropertyMap, options plugin.ConstructOptions) (plugin.ConstructResult, error) {
+	return plugin.ConstructResult{}, errors.New("the builtin provider does not construct components")
+}
+
+func (p *builtinProvider) Call(tok tokens.ModuleMember, urn resource.URN, state resource.PropertyMap,
+	args resource.PropertyMap, info plugin.CallInfo, options plugin.CallOptions) (plugin.CallResult, error) {
+	return plugin.CallResult{}, errors.Errorf("unrecognized method name: '%v'", tok)
+}
+
+func (p *builtinProvider) GetLogs(urn resource.URN, id resource.ID, state resource.PropertyMap, startTime, endTime *time.Time,
+	onNext func(plugin.LogEntry) error) error {
+	return plugin.ErrLogsNotSupported
+}
+
 func (p *builtinProvider) GetPluginInfo() (workspace.PluginInfo, error) {
 	// return an error: this should not be called for the builtin provider
 	return workspace.PluginInfo{}, errors.New("the builtin provider does not report plugin info")
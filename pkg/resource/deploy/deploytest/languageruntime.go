@@ -15,14 +15,11 @@
 package deploytest
 
 import (
-	"github.com/pkg/errors"
-	"github.com/pulumi/pulumi/sdk/v2/go/common/util/rpcutil"
-	"google.golang.org/grpc"
+	"io"
 
 	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/contract"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/workspace"
-	pulumirpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
 )
 
 type ProgramFunc func(runInfo plugin.RunInfo, monitor *ResourceMonitor) error
@@ -48,24 +45,16 @@ func (p *languageRuntime) GetRequiredPlugins(info plugin.ProgInfo) ([]workspace.
 }
 
 func (p *languageRuntime) Run(info plugin.RunInfo) (string, bool, error) {
-	// Connect to the resource monitor and create an appropriate client.
-	conn, err := grpc.Dial(
-		info.MonitorAddress,
-		grpc.WithInsecure(),
-		rpcutil.GrpcChannelOptions(),
-	)
+	monitor, err := dialMonitor(info.MonitorAddress)
 	if err != nil {
-		return "", false, errors.Wrapf(err, "could not connect to resource monitor")
+		return "", false, err
 	}
-	defer contract.IgnoreClose(conn)
-
-	// Fire up a resource monitor client
-	resmon := pulumirpc.NewResourceMonitorClient(conn)
+	defer contract.IgnoreClose(monitor)
 
 	// Run the program.
 	done := make(chan error)
 	go func() {
-		done <- p.program(info, &ResourceMonitor{resmon: resmon})
+		done <- p.program(info, monitor)
 	}()
 	if progerr := <-done; progerr != nil {
 		return progerr.Error(), false, nil
@@ -76,3 +65,7 @@ func (p *languageRuntime) Run(info plugin.RunInfo) (string, bool, error) {
 func (p *languageRuntime) GetPluginInfo() (workspace.PluginInfo, error) {
 	return workspace.PluginInfo{Name: "TestLanguage"}, nil
 }
+
+func (p *languageRuntime) InstallDependencies(directory string, isTerminal bool, stdout, stderr io.Writer) error {
+	return nil
+}
@@ -16,6 +16,7 @@ package deploytest
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/blang/semver"
 	uuid "github.com/satori/go.uuid"
@@ -58,6 +59,15 @@ type Provider struct {
 	InvokeF func(tok tokens.ModuleMember,
 		inputs resource.PropertyMap) (resource.PropertyMap, []plugin.CheckFailure, error)
 
+	ConstructF func(monitor *ResourceMonitor, typ, name string, parent resource.URN, inputs resource.PropertyMap,
+		options plugin.ConstructOptions) (plugin.ConstructResult, error)
+
+	CallF func(monitor *ResourceMonitor, tok tokens.ModuleMember, urn resource.URN, state resource.PropertyMap,
+		args resource.PropertyMap, options plugin.CallOptions) (plugin.CallResult, error)
+
+	GetLogsF func(urn resource.URN, id resource.ID, state resource.PropertyMap, startTime, endTime *time.Time,
+		onNext func(plugin.LogEntry) error) error
+
 	CancelF func() error
 }
 
@@ -175,3 +185,37 @@ func (prov *Provider) StreamInvoke(
 
 	return nil, fmt.Errorf("not implemented")
 }
+
+func (prov *Provider) Construct(info plugin.ConstructInfo, typ tokens.Type, name tokens.QName, parent resource.URN,
+	inputs resource.PropertyMap, options plugin.ConstructOptions) (plugin.ConstructResult, error) {
+	if prov.ConstructF == nil {
+		return plugin.ConstructResult{}, nil
+	}
+	monitor, err := dialMonitor(info.MonitorAddress)
+	if err != nil {
+		return plugin.ConstructResult{}, err
+	}
+	defer contract.IgnoreClose(monitor)
+	return prov.ConstructF(monitor, string(typ), string(name), parent, inputs, options)
+}
+
+func (prov *Provider) Call(tok tokens.ModuleMember, urn resource.URN, state resource.PropertyMap,
+	args resource.PropertyMap, info plugin.CallInfo, options plugin.CallOptions) (plugin.CallResult, error) {
+	if prov.CallF == nil {
+		return plugin.CallResult{}, nil
+	}
+	monitor, err := dialMonitor(info.MonitorAddress)
+	if err != nil {
+		return plugin.CallResult{}, err
+	}
+	defer contract.IgnoreClose(monitor)
+	return prov.CallF(monitor, tok, urn, state, args, options)
+}
+
+func (prov *Provider) GetLogs(urn resource.URN, id resource.ID, state resource.PropertyMap, startTime, endTime *time.Time,
+	onNext func(plugin.LogEntry) error) error {
+	if prov.GetLogsF == nil {
+		return plugin.ErrLogsNotSupported
+	}
+	return prov.GetLogsF(urn, id, state, startTime, endTime, onNext)
+}
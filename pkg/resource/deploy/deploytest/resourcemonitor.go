@@ -18,16 +18,43 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+
 	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/rpcutil"
 	pulumirpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
 )
 
 type ResourceMonitor struct {
+	conn   *grpc.ClientConn
 	resmon pulumirpc.ResourceMonitorClient
 }
 
+func dialMonitor(endpoint string) (*ResourceMonitor, error) {
+	// Connect to the resource monitor and create an appropriate client.
+	conn, err := grpc.Dial(
+		endpoint,
+		grpc.WithInsecure(),
+		rpcutil.GrpcChannelOptions(),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not connect to resource monitor")
+	}
+
+	// Fire up a resource monitor client
+	return &ResourceMonitor{
+		conn:   conn,
+		resmon: pulumirpc.NewResourceMonitorClient(conn),
+	}, nil
+}
+
+func (rm *ResourceMonitor) Close() error {
+	return rm.conn.Close()
+}
+
 type ResourceOptions struct {
 	Parent                resource.URN
 	Protect               bool
@@ -41,7 +68,12 @@ type ResourceOptions struct {
 	Aliases               []resource.URN
 	ImportID              resource.ID
 	CustomTimeouts        *resource.CustomTimeouts
+	RetryPolicy           *resource.RetryPolicy
+	RetainOnDelete        bool
+	DeletedWith           resource.URN
 	SupportsPartialValues *bool
+	Remote                bool
+	Providers             map[string]string
 }
 
 func (rm *ResourceMonitor) RegisterResource(t tokens.Type, name string, custom bool,
@@ -91,6 +123,15 @@ func (rm *ResourceMonitor) RegisterResource(t tokens.Type, name string, custom b
 		timeouts.Delete = prepareTestTimeout(opts.CustomTimeouts.Delete)
 	}
 
+	var retryPolicy *pulumirpc.RegisterResourceRequest_RetryPolicy
+	if opts.RetryPolicy != nil {
+		retryPolicy = &pulumirpc.RegisterResourceRequest_RetryPolicy{
+			MaxAttempts:   int32(opts.RetryPolicy.MaxAttempts),
+			Backoff:       fmt.Sprintf("%vs", opts.RetryPolicy.Backoff),
+			ErrorMatchers: opts.RetryPolicy.ErrorMatchers,
+		}
+	}
+
 	deleteBeforeReplace := false
 	if opts.DeleteBeforeReplace != nil {
 		deleteBeforeReplace = *opts.DeleteBeforeReplace
@@ -116,7 +157,12 @@ func (rm *ResourceMonitor) RegisterResource(t tokens.Type, name string, custom b
 		Aliases:                    aliasStrings,
 		ImportId:                   string(opts.ImportID),
 		CustomTimeouts:             &timeouts,
+		RetryPolicy:                retryPolicy,
+		RetainOnDelete:             opts.RetainOnDelete,
+		DeletedWith:                string(opts.DeletedWith),
 		SupportsPartialValues:      supportsPartialValues,
+		Remote:                     opts.Remote,
+		Providers:                  opts.Providers,
 	}
 
 	// submit request
@@ -199,6 +245,64 @@ func (rm *ResourceMonitor) Invoke(tok tokens.ModuleMember, inputs resource.Prope
 	return outs, nil, nil
 }
 
+func (rm *ResourceMonitor) Call(tok tokens.ModuleMember, urn resource.URN, state, args resource.PropertyMap,
+	argDependencies map[resource.PropertyKey][]resource.URN, provider string,
+	version string) (resource.PropertyMap, map[resource.PropertyKey][]resource.URN, []*pulumirpc.CheckFailure, error) {
+
+	// marshal state and arguments
+	rpcState, err := plugin.MarshalProperties(state, plugin.MarshalOptions{KeepUnknowns: true})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	rpcArgs, err := plugin.MarshalProperties(args, plugin.MarshalOptions{KeepUnknowns: true})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	rpcArgDeps := make(map[string]*pulumirpc.CallRequest_ArgumentDependencies)
+	for k, deps := range argDependencies {
+		urns := make([]string, len(deps))
+		for i, urn := range deps {
+			urns[i] = string(urn)
+		}
+		rpcArgDeps[string(k)] = &pulumirpc.CallRequest_ArgumentDependencies{Urns: urns}
+	}
+
+	// submit request
+	resp, err := rm.resmon.Call(context.Background(), &pulumirpc.CallRequest{
+		Tok:             string(tok),
+		Urn:             string(urn),
+		State:           rpcState,
+		Args:            rpcArgs,
+		ArgDependencies: rpcArgDeps,
+		Provider:        provider,
+		Version:         version,
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// handle failures
+	if len(resp.Failures) != 0 {
+		return nil, nil, resp.Failures, nil
+	}
+
+	// unmarshal outputs
+	outs, err := plugin.UnmarshalProperties(resp.Return, plugin.MarshalOptions{KeepUnknowns: true})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	returnDeps := make(map[resource.PropertyKey][]resource.URN)
+	for k, deps := range resp.ReturnDependencies {
+		urns := make([]resource.URN, len(deps.Urns))
+		for i, urn := range deps.Urns {
+			urns[i] = resource.URN(urn)
+		}
+		returnDeps[resource.PropertyKey(k)] = urns
+	}
+
+	return outs, returnDeps, nil, nil
+}
+
 func prepareTestTimeout(timeout float64) string {
 	mins := int(timeout) / 60
 
@@ -17,6 +17,7 @@ package deploy
 import (
 	"context"
 	"math"
+	"time"
 
 	"github.com/blang/semver"
 	"github.com/pkg/errors"
@@ -58,6 +59,13 @@ type Options struct {
 	TargetDependents  bool           // true if we're allowing things to proceed, even with unspecified targets
 	TrustDependencies bool           // whether or not to trust the resource dependency graph.
 	UseLegacyDiff     bool           // whether or not to use legacy diffing behavior.
+	ContinueOnError   bool           // whether or not to keep executing independent steps after a step fails.
+
+	// SkipSchemaValidation lists the packages whose resources' inputs are not validated against their providers'
+	// schemas.
+	SkipSchemaValidation []tokens.Package
+
+	DefaultRetryPolicy *resource.RetryPolicy // the retry policy for resources that do not specify their own.
 }
 
 // DegreeOfParallelism returns the degree of parallelism that should be used during the
@@ -79,6 +87,7 @@ type StepExecutorEvents interface {
 	OnResourceStepPre(step Step) (interface{}, error)
 	OnResourceStepPost(ctx interface{}, step Step, status resource.Status, err error) error
 	OnResourceOutputs(step Step) error
+	OnResourceStepRetry(step Step, attempt, maxAttempts int, delay time.Duration, err error)
 }
 
 // PolicyEvents is an interface that can be used to hook policy violation events.
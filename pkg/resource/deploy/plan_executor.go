@@ -18,6 +18,7 @@ import (
 	"context"
 	"strings"
 
+	opentracing "github.com/opentracing/opentracing-go"
 	"github.com/pkg/errors"
 	"github.com/pulumi/pulumi/pkg/v2/resource/deploy/providers"
 	"github.com/pulumi/pulumi/pkg/v2/resource/graph"
@@ -134,7 +135,10 @@ func (pe *planExecutor) Execute(callerCtx context.Context, opts Options, preview
 
 	// Before doing anything else, optionally refresh each resource in the base checkpoint.
 	if opts.Refresh {
-		if res := pe.refresh(callerCtx, opts, preview); res != nil {
+		span, refreshCtx := opentracing.StartSpanFromContext(callerCtx, "pulumi-refresh")
+		res := pe.refresh(refreshCtx, opts, preview)
+		span.Finish()
+		if res != nil {
 			return res
 		}
 		if opts.RefreshOnly {
@@ -170,16 +174,20 @@ func (pe *planExecutor) Execute(callerCtx context.Context, opts Options, preview
 	pe.stepGen = newStepGenerator(pe.plan, opts, updateTargetsOpt, replaceTargetsOpt)
 
 	// Retire any pending deletes that are currently present in this plan.
-	if res := pe.retirePendingDeletes(callerCtx, opts, preview); res != nil {
+	pendingDeletesSpan, pendingDeletesCtx := opentracing.StartSpanFromContext(callerCtx, "pulumi-pending-deletes")
+	res = pe.retirePendingDeletes(pendingDeletesCtx, opts, preview)
+	pendingDeletesSpan.Finish()
+	if res != nil {
 		return res
 	}
 
 	// Derive a cancellable context for this plan. We will only cancel this context if some piece of the plan's
-	// execution fails.
-	ctx, cancel := context.WithCancel(callerCtx)
+	// execution fails. Steps are traced within a span that covers the whole of the plan's execution.
+	stepsSpan, stepsCtx := opentracing.StartSpanFromContext(callerCtx, "pulumi-steps")
+	ctx, cancel := context.WithCancel(stepsCtx)
 
 	// Set up a step generator and executor for this plan.
-	pe.stepExec = newStepExecutor(ctx, cancel, pe.plan, opts, preview, false)
+	pe.stepExec = newStepExecutor(ctx, cancel, pe.plan, opts, preview, opts.ContinueOnError)
 
 	// We iterate the source in its own goroutine because iteration is blocking and we want the main loop to be able to
 	// respond to cancellation requests promptly.
@@ -251,8 +259,14 @@ func (pe *planExecutor) Execute(callerCtx context.Context, opts Options, preview
 	}()
 
 	pe.stepExec.WaitForCompletion()
+	stepsSpan.Finish()
 	logging.V(4).Infof("planExecutor.Execute(...): step executor has completed")
 
+	// If we continued past any step errors, list the resources that failed and those skipped because of them.
+	if summary := pe.stepExec.Failures().Summary(); summary != "" {
+		pe.reportError("", errors.New(summary))
+	}
+
 	// Now that we've performed all steps in the plan, ensure that the list of targets to update was
 	// valid.  We have to do this *after* performing the steps as the target list may have referred
 	// to a resource that was created in one of hte steps.
@@ -267,7 +281,9 @@ func (pe *planExecutor) Execute(callerCtx context.Context, opts Options, preview
 	// If the step generator and step executor were both successful, then we send all the resources
 	// observed to be analyzed. Otherwise, this step is skipped.
 	if res == nil && !pe.stepExec.Errored() {
+		span, _ := opentracing.StartSpanFromContext(callerCtx, "pulumi-analyze")
 		res := pe.stepGen.AnalyzeResources()
+		span.Finish()
 		if res != nil {
 			if resErr := res.Error(); resErr != nil {
 				logging.V(4).Infof("planExecutor.Execute(...): error analyzing resources: %v", resErr)
@@ -306,6 +322,9 @@ func (pe *planExecutor) performDeletes(
 
 	logging.V(7).Infof("performDeletes(...): beginning")
 
+	span, ctx := opentracing.StartSpanFromContext(ctx, "pulumi-deletes")
+	defer span.Finish()
+
 	// At this point we have generated the set of resources above that we would normally want to
 	// delete.  However, if the user provided -target's we will only actually delete the specific
 	// resources that are in the set explicitly asked for.
@@ -331,11 +350,27 @@ func (pe *planExecutor) performDeletes(
 	// This is not "true" delete parallelism, since there may be resources that could safely begin
 	// deleting but we won't until the previous set of deletes fully completes. This approximation
 	// is conservative, but correct.
+	//
+	// If we're continuing after step errors, a resource that failed to be deleted must keep everything that it
+	// depends on, so we skip the deletion of those resources as we come to them.
+	retained := make(map[*resource.State]resource.URN)
 	for _, antichain := range deletes {
+		if pe.stepExec.continueOnError {
+			antichain = pe.skipRetainedDeletes(antichain, retained)
+		}
+
 		logging.V(4).Infof("planExecutor.Execute(...): beginning delete antichain")
 		tok := pe.stepExec.ExecuteParallel(antichain)
 		tok.Wait(ctx)
 		logging.V(4).Infof("planExecutor.Execute(...): antichain complete")
+
+		if pe.stepExec.continueOnError {
+			for _, step := range antichain {
+				if cause, failed := pe.stepExec.Failures().causeOf(step.URN()); failed {
+					pe.retainDependencies(step.Res(), cause, retained)
+				}
+			}
+		}
 	}
 
 	// After executing targeted deletes, we may now have resources that depend on the resource that
@@ -343,6 +378,10 @@ func (pe *planExecutor) performDeletes(
 	if targetsOpt != nil {
 		resourceToStep := make(map[*resource.State]Step)
 		for _, step := range deleteSteps {
+			// Resources that failed to be deleted or whose deletion was skipped are still around.
+			if _, failed := pe.stepExec.Failures().causeOf(step.URN()); failed {
+				continue
+			}
 			resourceToStep[pe.plan.olds[step.URN()]] = step
 		}
 
@@ -352,11 +391,96 @@ func (pe *planExecutor) performDeletes(
 	return nil
 }
 
+// skipRetainedDeletes removes the deletions of retained resources from an antichain, recording them as skipped and
+// retaining their own dependencies in turn. Deletions of resources that failed or were skipped during the update are
+// removed as well.
+func (pe *planExecutor) skipRetainedDeletes(steps antichain, retained map[*resource.State]resource.URN) antichain {
+	var remaining antichain
+	for _, step := range steps {
+		if cause, failed := pe.stepExec.Failures().causeOf(step.URN()); failed {
+			logging.V(4).Infof("planExecutor.Execute(...): not deleting %v due to failure of %v", step.URN(), cause)
+			pe.retainDependencies(step.Res(), cause, retained)
+			continue
+		}
+
+		cause, has := retained[step.Res()]
+		if !has {
+			remaining = append(remaining, step)
+			continue
+		}
+
+		logging.V(4).Infof("planExecutor.Execute(...): skipping delete of %v due to failure of %v", step.URN(), cause)
+		pe.stepExec.Failures().skip(step.URN(), cause)
+		pe.retainDependencies(step.Res(), cause, retained)
+	}
+	return remaining
+}
+
+// retainDependencies marks the resources that the given resource depends on as retained because of the given failure.
+func (pe *planExecutor) retainDependencies(res *resource.State, cause resource.URN,
+	retained map[*resource.State]resource.URN) {
+
+	for dep := range pe.plan.depGraph.DependenciesOf(res) {
+		if _, has := retained[dep]; !has {
+			retained[dep] = cause
+		}
+	}
+}
+
+// skipDependentOfFailure answers a registration or read whose resource depends on a resource that failed or was
+// skipped, rather than generating steps for it. It returns true if the event was answered.
+func (pe *planExecutor) skipDependentOfFailure(event SourceEvent) bool {
+	var deps []resource.URN
+	var provider string
+	switch e := event.(type) {
+	case RegisterResourceEvent:
+		goal := e.Goal()
+		deps = append([]resource.URN{goal.Parent}, goal.Dependencies...)
+		for _, propDeps := range goal.PropertyDependencies {
+			deps = append(deps, propDeps...)
+		}
+		provider = goal.Provider
+	case ReadResourceEvent:
+		deps = append([]resource.URN{e.Parent()}, e.Dependencies()...)
+		provider = e.Provider()
+	default:
+		return false
+	}
+	if provider != "" {
+		if ref, err := providers.ParseReference(provider); err == nil {
+			deps = append(deps, ref.URN())
+		}
+	}
+
+	cause, failed := pe.stepExec.Failures().causeOf(deps...)
+	if !failed {
+		return false
+	}
+
+	urn := pe.plan.generateEventURN(event)
+	logging.V(4).Infof("planExecutor.handleSingleEvent(...): skipping %v due to failure of %v", urn, cause)
+	pe.stepExec.Failures().skip(urn, cause)
+
+	state := &resource.State{URN: urn}
+	switch e := event.(type) {
+	case RegisterResourceEvent:
+		e.Done(&RegisterResult{State: state, Failed: true})
+	case ReadResourceEvent:
+		e.Done(&ReadResult{State: state, Failed: true})
+	}
+	return true
+}
+
 // handleSingleEvent handles a single source event. For all incoming events, it produces a chain that needs
 // to be executed and schedules the chain for execution.
 func (pe *planExecutor) handleSingleEvent(event SourceEvent) result.Result {
 	contract.Require(event != nil, "event != nil")
 
+	// If we're continuing after step errors, resources that depend on a failed resource are skipped.
+	if pe.stepExec.continueOnError && pe.skipDependentOfFailure(event) {
+		return nil
+	}
+
 	var steps []Step
 	var res result.Result
 	switch e := event.(type) {
@@ -17,6 +17,7 @@ package providers
 import (
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/blang/semver"
 	"github.com/pkg/errors"
@@ -405,6 +406,21 @@ func (r *Registry) StreamInvoke(
 	return nil, fmt.Errorf("the provider registry does not implement streaming invokes")
 }
 
+func (r *Registry) Construct(info plugin.ConstructInfo, typ tokens.Type, name tokens.QName, parent resource.URN,
+	inputs resource.PropertyMap, options plugin.ConstructOptions) (plugin.ConstructResult, error) {
+	return plugin.ConstructResult{}, errors.New("the provider registry does not construct components")
+}
+
+func (r *Registry) Call(tok tokens.ModuleMember, urn resource.URN, state resource.PropertyMap,
+	args resource.PropertyMap, info plugin.CallInfo, options plugin.CallOptions) (plugin.CallResult, error) {
+	return plugin.CallResult{}, errors.New("the provider registry does not implement methods")
+}
+
+func (r *Registry) GetLogs(urn resource.URN, id resource.ID, state resource.PropertyMap, startTime, endTime *time.Time,
+	onNext func(plugin.LogEntry) error) error {
+	return plugin.ErrLogsNotSupported
+}
+
 func (r *Registry) GetPluginInfo() (workspace.PluginInfo, error) {
 	// return an error: this should not be called for the provider registry
 	return workspace.PluginInfo{}, errors.New("the provider registry does not report plugin info")
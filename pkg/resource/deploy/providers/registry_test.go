@@ -17,6 +17,7 @@ package providers
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/blang/semver"
 	"github.com/pkg/errors"
@@ -153,6 +154,21 @@ func (prov *testProvider) StreamInvoke(
 
 	return nil, fmt.Errorf("not implemented")
 }
+func (prov *testProvider) Construct(info plugin.ConstructInfo, typ tokens.Type, name tokens.QName, parent resource.URN,
+	inputs resource.PropertyMap, options plugin.ConstructOptions) (plugin.ConstructResult, error) {
+	return plugin.ConstructResult{}, errors.New("unsupported")
+}
+
+func (prov *testProvider) Call(tok tokens.ModuleMember, urn resource.URN, state resource.PropertyMap,
+	args resource.PropertyMap, info plugin.CallInfo, options plugin.CallOptions) (plugin.CallResult, error) {
+	return plugin.CallResult{}, errors.New("unsupported")
+}
+
+func (prov *testProvider) GetLogs(urn resource.URN, id resource.ID, state resource.PropertyMap,
+	startTime, endTime *time.Time, onNext func(plugin.LogEntry) error) error {
+	return plugin.ErrLogsNotSupported
+}
+
 func (prov *testProvider) GetPluginInfo() (workspace.PluginInfo, error) {
 	return workspace.PluginInfo{
 		Name:    "testProvider",
@@ -0,0 +1,370 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/pulumi/pulumi/pkg/v2/codegen/schema"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/logging"
+)
+
+// propertyNameRegexp matches the property names that may appear unquoted in a property path.
+var propertyNameRegexp = regexp.MustCompile(`^[a-zA-Z_$][a-zA-Z0-9_$]*$`)
+
+// schemaValidator validates the inputs of resources against the schemas of their providers before the inputs are
+// passed to the providers' Check methods. Many providers check their inputs only loosely, so this catches missing
+// required inputs and type mismatches before they surface as errors from the providers' APIs.
+type schemaValidator struct {
+	sink    diag.Sink                  // the sink for warnings about schemas that cannot be bound, if any.
+	skip    map[tokens.Package]bool    // the packages whose schemas are not used for validation.
+	loader  schema.Loader              // the loader for packages referenced by providers' schemas, if any.
+	keys    map[plugin.Provider]string // the package and version of each provider.
+	schemas map[string]*schema.Package // the bound schema of each package version, or nil if it has none.
+}
+
+func newSchemaValidator(host plugin.Host, sink diag.Sink, skip []tokens.Package) *schemaValidator {
+	skipSet := make(map[tokens.Package]bool)
+	for _, pkg := range skip {
+		skipSet[pkg] = true
+	}
+
+	var loader schema.Loader
+	if host != nil {
+		loader = schema.NewPluginLoader(host)
+	}
+
+	return &schemaValidator{
+		sink:    sink,
+		skip:    skipSet,
+		loader:  loader,
+		keys:    make(map[plugin.Provider]string),
+		schemas: make(map[string]*schema.Package),
+	}
+}
+
+// schemaKey returns the key of the given provider's schema, which is the provider's package and version. Providers for
+// the same version of a package share a schema.
+func (v *schemaValidator) schemaKey(prov plugin.Provider) string {
+	if key, ok := v.keys[prov]; ok {
+		return key
+	}
+
+	key := string(prov.Pkg())
+	if info, err := prov.GetPluginInfo(); err == nil && info.Version != nil {
+		key += "@" + info.Version.String()
+	}
+	v.keys[prov] = key
+	return key
+}
+
+// getSchema fetches and binds the schema of the given provider. The schema of each version of a package is only bound
+// once. Providers that do not have a schema, or whose schemas cannot be bound, are not validated against; the latter
+// are reported to the user, once for each version of a package, so that they know that validation is off.
+func (v *schemaValidator) getSchema(prov plugin.Provider) *schema.Package {
+	key := v.schemaKey(prov)
+	if pkg, ok := v.schemas[key]; ok {
+		return pkg
+	}
+
+	bytes, err := prov.GetSchema(0)
+	if err != nil {
+		// Providers that predate schemas do not implement GetSchema, so this is not worth a warning.
+		logging.V(5).Infof("not validating inputs for resources of package %v: fetching schema: %v", key, err)
+		v.schemas[key] = nil
+		return nil
+	}
+
+	pkg, err := v.bindSchema(bytes)
+	if err != nil {
+		msg := fmt.Sprintf("not validating inputs for resources of package %v against its schema: %v", key, err)
+		if v.sink != nil {
+			v.sink.Warningf(diag.RawMessage("", msg))
+		} else {
+			logging.Warningf("%s", msg)
+		}
+	}
+	v.schemas[key] = pkg
+	return pkg
+}
+
+// bindSchema binds a provider's schema. Packages referenced by the schema are loaded using the validator's loader.
+func (v *schemaValidator) bindSchema(bytes []byte) (*schema.Package, error) {
+	var spec schema.PackageSpec
+	if err := json.Unmarshal(bytes, &spec); err != nil {
+		return nil, fmt.Errorf("unmarshaling schema: %v", err)
+	}
+	pkg, err := schema.ImportSpecWithLoader(spec, nil, v.loader)
+	if err != nil {
+		return nil, fmt.Errorf("binding schema: %v", err)
+	}
+	return pkg, nil
+}
+
+// Validate validates the inputs of a resource of the given type against the schema of its provider. It returns the
+// problems that make the inputs invalid, and the problems that only merit warnings: properties that are not defined by
+// the schema and deprecated properties that are set. Problems with the resource as a whole have no property path.
+func (v *schemaValidator) Validate(prov plugin.Provider, typ tokens.Type,
+	inputs resource.PropertyMap) (failures []plugin.CheckFailure, warnings []plugin.CheckFailure) {
+
+	if v.skip[typ.Package()] {
+		return nil, nil
+	}
+	pkg := v.getSchema(prov)
+	if pkg == nil {
+		return nil, nil
+	}
+	res, ok := pkg.GetResource(string(typ))
+	if !ok {
+		return nil, nil
+	}
+
+	iv := &inputValidator{}
+	if res.DeprecationMessage != "" {
+		iv.warn("", "resource type %v is deprecated: %v", typ, res.DeprecationMessage)
+	}
+	iv.validateObject("", res.InputProperties, func(name string) (*schema.Property, bool) {
+		for _, p := range res.InputProperties {
+			if p.Name == name {
+				return p, true
+			}
+		}
+		return nil, false
+	}, inputs)
+	return iv.failures, iv.warnings
+}
+
+// inputValidator accumulates the problems with a set of resource inputs.
+type inputValidator struct {
+	failures []plugin.CheckFailure
+	warnings []plugin.CheckFailure
+}
+
+func (iv *inputValidator) fail(path, format string, args ...interface{}) {
+	iv.failures = append(iv.failures, plugin.CheckFailure{
+		Property: resource.PropertyKey(path),
+		Reason:   fmt.Sprintf(format, args...),
+	})
+}
+
+func (iv *inputValidator) warn(path, format string, args ...interface{}) {
+	iv.warnings = append(iv.warnings, plugin.CheckFailure{
+		Property: resource.PropertyKey(path),
+		Reason:   fmt.Sprintf(format, args...),
+	})
+}
+
+// validateObject validates the properties of an object against the given property definitions.
+func (iv *inputValidator) validateObject(path string, properties []*schema.Property,
+	lookup func(name string) (*schema.Property, bool), object resource.PropertyMap) {
+
+	for _, p := range properties {
+		value, has := object[resource.PropertyKey(p.Name)]
+		if (!has || value.IsNull()) && p.IsRequired && p.DefaultValue == nil && p.ConstValue == nil {
+			iv.fail(joinPropertyPath(path, p.Name), "missing required property '%v'", p.Name)
+		}
+	}
+
+	for _, key := range object.StableKeys() {
+		propertyPath := joinPropertyPath(path, string(key))
+		p, ok := lookup(string(key))
+		if !ok {
+			iv.warn(propertyPath, "unknown property '%v'", key)
+			continue
+		}
+
+		value := object[key]
+		if value.IsNull() {
+			continue
+		}
+		if p.DeprecationMessage != "" {
+			iv.warn(propertyPath, "property '%v' is deprecated: %v", key, p.DeprecationMessage)
+		}
+		iv.validateValue(propertyPath, p.Type, value)
+	}
+}
+
+// validateValue validates a value against the given type.
+func (iv *inputValidator) validateValue(path string, typ schema.Type, value resource.PropertyValue) {
+	// Unknown values may turn out to be anything, so they are always valid. Secret values are validated by their
+	// contents.
+	switch {
+	case value.IsComputed() || value.IsOutput():
+		return
+	case value.IsSecret():
+		iv.validateValue(path, typ, value.SecretValue().Element)
+		return
+	case value.IsNull():
+		return
+	}
+
+	switch typ := typ.(type) {
+	case *schema.ArrayType:
+		if !value.IsArray() {
+			iv.fail(path, "expected an array, got %v", describeValue(value))
+			return
+		}
+		for i, element := range value.ArrayValue() {
+			iv.validateValue(path+"["+strconv.Itoa(i)+"]", typ.ElementType, element)
+		}
+	case *schema.MapType:
+		if !value.IsObject() {
+			iv.fail(path, "expected a map, got %v", describeValue(value))
+			return
+		}
+		object := value.ObjectValue()
+		for _, key := range object.StableKeys() {
+			iv.validateValue(joinPropertyPath(path, string(key)), typ.ElementType, object[key])
+		}
+	case *schema.ObjectType:
+		if !value.IsObject() {
+			iv.fail(path, "expected an object, got %v", describeValue(value))
+			return
+		}
+		iv.validateObject(path, typ.Properties, typ.Property, value.ObjectValue())
+	case *schema.EnumType:
+		iv.validateEnum(path, typ, value)
+	case *schema.UnionType:
+		// A value is valid if it is valid for any of the union's element types.
+		var expected []string
+		for _, element := range typ.ElementTypes {
+			elementValidator := &inputValidator{}
+			elementValidator.validateValue(path, element, value)
+			if len(elementValidator.failures) == 0 {
+				iv.warnings = append(iv.warnings, elementValidator.warnings...)
+				return
+			}
+			expected = append(expected, element.String())
+		}
+		sort.Strings(expected)
+		iv.fail(path, "expected one of %v, got %v", expected, describeValue(value))
+	case *schema.TokenType:
+		if typ.UnderlyingType != nil {
+			iv.validateValue(path, typ.UnderlyingType, value)
+		}
+	case *schema.ResourceType:
+		// Resources are referred to by their IDs, which the program may produce in any form.
+		return
+	default:
+		iv.validatePrimitive(path, typ, value)
+	}
+}
+
+// validatePrimitive validates a value against one of the schema's primitive types.
+func (iv *inputValidator) validatePrimitive(path string, typ schema.Type, value resource.PropertyValue) {
+	var ok bool
+	var expected string
+	switch typ {
+	case schema.BoolType:
+		ok, expected = value.IsBool(), "a boolean"
+	case schema.IntType:
+		ok, expected = value.IsNumber() && math.Trunc(value.NumberValue()) == value.NumberValue(), "an integer"
+	case schema.NumberType:
+		ok, expected = value.IsNumber(), "a number"
+	case schema.StringType:
+		ok, expected = value.IsString(), "a string"
+	case schema.ArchiveType:
+		ok, expected = value.IsArchive(), "an archive"
+	case schema.AssetType:
+		ok, expected = value.IsAsset() || value.IsArchive(), "an asset or archive"
+	default:
+		// Any other type, including schema.AnyType, accepts any value.
+		ok = true
+	}
+	if !ok {
+		iv.fail(path, "expected %v, got %v", expected, describeValue(value))
+	}
+}
+
+// validateEnum validates that a value is one of the values of an enum type.
+func (iv *inputValidator) validateEnum(path string, typ *schema.EnumType, value resource.PropertyValue) {
+	failures := len(iv.failures)
+	iv.validatePrimitive(path, typ.ElementType, value)
+	if len(iv.failures) != failures {
+		return
+	}
+
+	var allowed []string
+	for _, e := range typ.Elements {
+		if enumValueEquals(e.Value, value) {
+			if e.DeprecationMessage != "" {
+				iv.warn(path, "value %v is deprecated: %v", value.V, e.DeprecationMessage)
+			}
+			return
+		}
+		allowed = append(allowed, fmt.Sprintf("%v", e.Value))
+	}
+	iv.fail(path, "expected one of %v, got %v", allowed, value.V)
+}
+
+// enumValueEquals returns true if the given enum value, which is a bool, int32, float64, or string, is equal to the
+// given property value.
+func enumValueEquals(enumValue interface{}, value resource.PropertyValue) bool {
+	switch enumValue := enumValue.(type) {
+	case bool:
+		return value.IsBool() && value.BoolValue() == enumValue
+	case int32:
+		return value.IsNumber() && value.NumberValue() == float64(enumValue)
+	case float64:
+		return value.IsNumber() && value.NumberValue() == enumValue
+	case string:
+		return value.IsString() && value.StringValue() == enumValue
+	default:
+		return false
+	}
+}
+
+// describeValue returns a short description of the kind of a value for use in error messages.
+func describeValue(value resource.PropertyValue) string {
+	switch {
+	case value.IsBool():
+		return "a boolean"
+	case value.IsNumber():
+		return "a number"
+	case value.IsString():
+		return "a string"
+	case value.IsArray():
+		return "an array"
+	case value.IsObject():
+		return "an object"
+	case value.IsAsset():
+		return "an asset"
+	case value.IsArchive():
+		return "an archive"
+	default:
+		return value.TypeString()
+	}
+}
+
+// joinPropertyPath appends a property name to a property path, quoting the name if necessary. The result can be parsed
+// by resource.ParsePropertyPath.
+func joinPropertyPath(path, name string) string {
+	if !propertyNameRegexp.MatchString(name) {
+		return path + "[" + strconv.Quote(name) + "]"
+	}
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
@@ -0,0 +1,311 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/blang/semver"
+	"github.com/pulumi/pulumi/pkg/v2/resource/deploy/deploytest"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/diag/colors"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/tokens"
+	"github.com/stretchr/testify/assert"
+)
+
+const testSchema = `{
+	"name": "pkgA",
+	"types": {
+		"pkgA:index:Rule": {
+			"properties": {
+				"port": {"type": "integer"},
+				"protocol": {"$ref": "#/types/pkgA:index:Protocol"}
+			},
+			"type": "object",
+			"required": ["port"]
+		},
+		"pkgA:index:Protocol": {
+			"type": "string",
+			"enum": [{"value": "tcp"}, {"value": "udp"}]
+		}
+	},
+	"resources": {
+		"pkgA:index:Resource": {
+			"inputProperties": {
+				"name": {"type": "string"},
+				"size": {"type": "number", "default": 1},
+				"enabled": {"type": "boolean", "deprecationMessage": "use state instead"},
+				"rules": {"type": "array", "items": {"$ref": "#/types/pkgA:index:Rule"}},
+				"tags": {"type": "object", "additionalProperties": {"type": "string"}},
+				"code": {"$ref": "pulumi.json#/Asset"},
+				"value": {"oneOf": [{"type": "string"}, {"type": "integer"}]},
+				"extra": {"$ref": "pulumi.json#/Any"}
+			},
+			"requiredInputs": ["name", "size"]
+		},
+		"pkgA:index:Legacy": {
+			"deprecationMessage": "use Resource instead"
+		}
+	}
+}`
+
+func newTestSchemaProvider(schema string, err error) plugin.Provider {
+	return &deploytest.Provider{
+		Package: "pkgA",
+		GetSchemaF: func(version int) ([]byte, error) {
+			return []byte(schema), err
+		},
+	}
+}
+
+func validateInputs(v *schemaValidator, prov plugin.Provider, typ tokens.Type,
+	inputs map[string]interface{}) ([]plugin.CheckFailure, []plugin.CheckFailure) {
+
+	return v.Validate(prov, typ, resource.NewPropertyMapFromMap(inputs))
+}
+
+func TestSchemaValidation(t *testing.T) {
+	prov := newTestSchemaProvider(testSchema, nil)
+	typ := tokens.Type("pkgA:index:Resource")
+
+	cases := []struct {
+		name     string
+		inputs   resource.PropertyMap
+		failures []plugin.CheckFailure
+		warnings []plugin.CheckFailure
+	}{
+		{
+			name: "Valid inputs",
+			inputs: resource.NewPropertyMapFromMap(map[string]interface{}{
+				"name":  "foo",
+				"rules": []interface{}{map[string]interface{}{"port": 80, "protocol": "tcp"}},
+				"tags":  map[string]interface{}{"env": "prod"},
+				"value": 42,
+				"extra": []interface{}{true},
+			}),
+		},
+		{
+			name: "Missing required property",
+			inputs: resource.NewPropertyMapFromMap(map[string]interface{}{
+				"tags": map[string]interface{}{},
+			}),
+			failures: []plugin.CheckFailure{{Property: "name", Reason: "missing required property 'name'"}},
+		},
+		{
+			name: "Unknown values",
+			inputs: resource.PropertyMap{
+				"name":  resource.MakeComputed(resource.NewStringProperty("")),
+				"rules": resource.NewArrayProperty([]resource.PropertyValue{resource.MakeOutput(resource.NewNullProperty())}),
+			},
+		},
+		{
+			name: "Secret values",
+			inputs: resource.PropertyMap{
+				"name": resource.MakeSecret(resource.NewNumberProperty(1)),
+			},
+			failures: []plugin.CheckFailure{{Property: "name", Reason: "expected a string, got a number"}},
+		},
+		{
+			name: "Nested type mismatches",
+			inputs: resource.NewPropertyMapFromMap(map[string]interface{}{
+				"name": "foo",
+				"rules": []interface{}{
+					map[string]interface{}{"port": 80.5, "protocol": "icmp"},
+					map[string]interface{}{"protocol": "udp"},
+				},
+				"tags": map[string]interface{}{"cost center": true},
+			}),
+			failures: []plugin.CheckFailure{
+				{Property: "rules[0].port", Reason: "expected an integer, got a number"},
+				{Property: "rules[0].protocol", Reason: "expected one of [tcp udp], got icmp"},
+				{Property: "rules[1].port", Reason: "missing required property 'port'"},
+				{Property: `tags["cost center"]`, Reason: "expected a string, got a boolean"},
+			},
+		},
+		{
+			name: "Assets and unions",
+			inputs: resource.NewPropertyMapFromMap(map[string]interface{}{
+				"name":  "foo",
+				"code":  "index.js",
+				"value": true,
+			}),
+			failures: []plugin.CheckFailure{
+				{Property: "code", Reason: "expected an asset or archive, got a string"},
+				{Property: "value", Reason: "expected one of [integer string], got a boolean"},
+			},
+		},
+		{
+			name: "Unknown and deprecated properties",
+			inputs: resource.NewPropertyMapFromMap(map[string]interface{}{
+				"name":    "foo",
+				"enabled": true,
+				"rules":   []interface{}{map[string]interface{}{"port": 80, "host": "localhost"}},
+				"color":   "blue",
+			}),
+			warnings: []plugin.CheckFailure{
+				{Property: "color", Reason: "unknown property 'color'"},
+				{Property: "enabled", Reason: "property 'enabled' is deprecated: use state instead"},
+				{Property: "rules[0].host", Reason: "unknown property 'host'"},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			failures, warnings := newSchemaValidator(nil, nil, nil).Validate(prov, typ, c.inputs)
+			assert.Equal(t, c.failures, failures)
+			assert.Equal(t, c.warnings, warnings)
+		})
+	}
+}
+
+func TestSchemaValidationDeprecatedResource(t *testing.T) {
+	prov := newTestSchemaProvider(testSchema, nil)
+
+	failures, warnings := validateInputs(newSchemaValidator(nil, nil, nil), prov, "pkgA:index:Legacy", nil)
+	assert.Empty(t, failures)
+	assert.Equal(t, []plugin.CheckFailure{
+		{Property: "", Reason: "resource type pkgA:index:Legacy is deprecated: use Resource instead"},
+	}, warnings)
+}
+
+func TestSchemaValidationSkipped(t *testing.T) {
+	inputs := map[string]interface{}{"name": 42}
+
+	// Packages on the skip list are not validated.
+	prov := newTestSchemaProvider(testSchema, nil)
+	failures, warnings := validateInputs(newSchemaValidator(nil, nil, []tokens.Package{"pkgA"}), prov,
+		"pkgA:index:Resource", inputs)
+	assert.Empty(t, failures)
+	assert.Empty(t, warnings)
+
+	// Resources that are not in the schema are not validated.
+	failures, warnings = validateInputs(newSchemaValidator(nil, nil, nil), prov, "pkgA:index:Other", inputs)
+	assert.Empty(t, failures)
+	assert.Empty(t, warnings)
+
+	// Providers without usable schemas are not validated against.
+	for _, prov := range []plugin.Provider{
+		newTestSchemaProvider("{}", nil),
+		newTestSchemaProvider("", errors.New("schema not supported")),
+		newTestSchemaProvider(`{"name": "pkgA", "resources": {"pkgA:index:Resource": {"inputProperties": 42}}}`, nil),
+	} {
+		failures, warnings = validateInputs(newSchemaValidator(nil, nil, nil), prov, "pkgA:index:Resource", inputs)
+		assert.Empty(t, failures)
+		assert.Empty(t, warnings)
+	}
+}
+
+func TestSchemaValidationBindsOnce(t *testing.T) {
+	calls := 0
+	newProvider := func(version string) plugin.Provider {
+		return &deploytest.Provider{
+			Package: "pkgA",
+			Version: semver.MustParse(version),
+			GetSchemaF: func(version int) ([]byte, error) {
+				calls++
+				return []byte(testSchema), nil
+			},
+		}
+	}
+
+	// Every provider for the same version of a package shares a schema.
+	v := newSchemaValidator(nil, nil, nil)
+	for _, prov := range []plugin.Provider{newProvider("1.0.0"), newProvider("1.0.0")} {
+		for i := 0; i < 3; i++ {
+			failures, _ := validateInputs(v, prov, "pkgA:index:Resource", map[string]interface{}{})
+			assert.Len(t, failures, 1)
+		}
+	}
+	assert.Equal(t, 1, calls)
+
+	// Providers for other versions of the package have their own schemas.
+	failures, _ := validateInputs(v, newProvider("2.0.0"), "pkgA:index:Resource", map[string]interface{}{})
+	assert.Len(t, failures, 1)
+	assert.Equal(t, 2, calls)
+}
+
+func TestSchemaValidationBindFailureWarning(t *testing.T) {
+	var stderr bytes.Buffer
+	sink := diag.DefaultSink(ioutil.Discard, &stderr, diag.FormatOptions{Color: colors.Never})
+	v := newSchemaValidator(nil, sink, nil)
+
+	// A schema that cannot be bound turns validation off for its package, which is reported once.
+	prov := newTestSchemaProvider(`{"name": "pkgA", "resources": {"pkgA:index:Resource": {"inputProperties": 42}}}`,
+		nil)
+	for i := 0; i < 3; i++ {
+		failures, _ := validateInputs(v, prov, "pkgA:index:Resource", map[string]interface{}{"name": 42})
+		assert.Empty(t, failures)
+	}
+	assert.Equal(t, 1, strings.Count(stderr.String(), "not validating inputs for resources of package pkgA"))
+	assert.Contains(t, stderr.String(), "warning: ")
+
+	// Providers that do not have a schema are not reported.
+	stderr.Reset()
+	validateInputs(v, &deploytest.Provider{
+		Package: "pkgB",
+		GetSchemaF: func(version int) ([]byte, error) {
+			return nil, errors.New("schema not supported")
+		},
+	}, "pkgB:index:Resource", nil)
+	assert.Empty(t, stderr.String())
+}
+
+func TestSchemaValidationExternalReferences(t *testing.T) {
+	prov := newTestSchemaProvider(`{
+	"name": "pkgA",
+	"resources": {
+		"pkgA:index:Resource": {
+			"inputProperties": {
+				"rule": {"$ref": "/pkgB/v1.0.0/schema.json#/types/pkgB:index:Rule"}
+			}
+		}
+	}
+}`, nil)
+
+	host := deploytest.NewPluginHost(nil, nil, nil,
+		deploytest.NewProviderLoader("pkgB", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				Package: "pkgB",
+				GetSchemaF: func(version int) ([]byte, error) {
+					return []byte(strings.Replace(testSchema, "pkgA", "pkgB", -1)), nil
+				},
+			}, nil
+		}))
+
+	failures, _ := validateInputs(newSchemaValidator(host, nil, nil), prov, "pkgA:index:Resource", map[string]interface{}{
+		"rule": map[string]interface{}{"port": "80"},
+	})
+	assert.Equal(t, []plugin.CheckFailure{
+		{Property: "rule.port", Reason: "expected an integer, got a string"},
+	}, failures)
+}
+
+func TestCheckFailureValue(t *testing.T) {
+	inputs := resource.NewPropertyMapFromMap(map[string]interface{}{
+		"a.b":   "dotted",
+		"rules": []interface{}{map[string]interface{}{"port": 80}},
+	})
+
+	assert.Equal(t, resource.NewStringProperty("dotted"), checkFailureValue(inputs, "a.b"))
+	assert.Equal(t, resource.NewNumberProperty(80), checkFailureValue(inputs, "rules[0].port"))
+	assert.True(t, checkFailureValue(inputs, "rules[1].port").IsNull())
+}
@@ -17,6 +17,7 @@ package deploy
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/blang/semver"
@@ -28,6 +29,7 @@ import (
 
 	"github.com/pulumi/pulumi/pkg/v2/resource/deploy/providers"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/config"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/tokens"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/contract"
@@ -95,7 +97,7 @@ func (src *evalSource) Iterate(
 	regChan := make(chan *registerResourceEvent)
 	regOutChan := make(chan *registerResourceOutputsEvent)
 	regReadChan := make(chan *readResourceEvent)
-	mon, err := newResourceMonitor(src, providers, regChan, regOutChan, regReadChan, tracingSpan)
+	mon, err := newResourceMonitor(src, opts, providers, regChan, regOutChan, regReadChan, tracingSpan)
 	if err != nil {
 		return nil, result.FromError(errors.Wrap(err, "failed to start resource monitor"))
 	}
@@ -300,7 +302,7 @@ func (d *defaultProviders) newRegisterDefaultProviderEvent(
 	event := &registerResourceEvent{
 		goal: resource.NewGoal(
 			providers.MakeProviderType(req.Package()),
-			req.Name(), true, inputs, "", false, nil, "", nil, nil, nil, nil, nil, nil, "", nil),
+			req.Name(), true, inputs, "", false, nil, "", nil, nil, nil, nil, nil, nil, "", nil, nil, false, ""),
 		done: done,
 	}
 	return event, done, nil
@@ -347,6 +349,10 @@ func (d *defaultProviders) handleRequest(req providers.ProviderRequest) (provide
 		return providers.Reference{}, context.Canceled
 	}
 
+	if result.Failed {
+		return providers.Reference{}, errors.Errorf("registering the default provider for package %s failed", req)
+	}
+
 	logging.V(5).Infof("registered default provider for package %s: %s", req, result.State.URN)
 
 	id := result.State.ID
@@ -396,6 +402,11 @@ type resmon struct {
 	regChan          chan *registerResourceEvent        // the channel to send resource registrations to.
 	regOutChan       chan *registerResourceOutputsEvent // the channel to send resource output registrations to.
 	regReadChan      chan *readResourceEvent            // the channel to send resource reads to.
+	constructInfo    plugin.ConstructInfo               // information for construct calls, without configuration.
+	target           *Target                            // the target whose configuration is passed to providers.
+	configOnce       sync.Once                          // ensures the configuration is decrypted at most once.
+	config           map[config.Key]string              // the decrypted configuration, once it has been needed.
+	configErr        error                              // the error, if any, from decrypting the configuration.
 	addr             string                             // the address the host is listening on.
 	cancel           chan bool                          // a channel that can cancel the server.
 	done             chan error                         // a channel that resolves when the server completes.
@@ -404,7 +415,7 @@ type resmon struct {
 var _ SourceResourceMonitor = (*resmon)(nil)
 
 // newResourceMonitor creates a new resource monitor RPC server.
-func newResourceMonitor(src *evalSource, provs ProviderSource, regChan chan *registerResourceEvent,
+func newResourceMonitor(src *evalSource, opts Options, provs ProviderSource, regChan chan *registerResourceEvent,
 	regOutChan chan *registerResourceOutputsEvent, regReadChan chan *readResourceEvent,
 	tracingSpan opentracing.Span) (*resmon, error) {
 
@@ -428,7 +439,14 @@ func newResourceMonitor(src *evalSource, provs ProviderSource, regChan chan *reg
 		regChan:          regChan,
 		regOutChan:       regOutChan,
 		regReadChan:      regReadChan,
-		cancel:           cancel,
+		constructInfo: plugin.ConstructInfo{
+			Project:  string(src.runinfo.Proj.Name),
+			Stack:    string(src.runinfo.Target.Name),
+			DryRun:   src.dryRun,
+			Parallel: opts.Parallel,
+		},
+		target: src.runinfo.Target,
+		cancel: cancel,
 	}
 
 	// Fire up a gRPC server and start listening for incomings.
@@ -443,6 +461,7 @@ func newResourceMonitor(src *evalSource, provs ProviderSource, regChan chan *reg
 	}
 
 	resmon.addr = fmt.Sprintf("127.0.0.1:%d", port)
+	resmon.constructInfo.MonitorAddress = resmon.addr
 	resmon.done = done
 
 	go d.serve()
@@ -455,6 +474,16 @@ func (rm *resmon) Address() string {
 	return rm.addr
 }
 
+// getConfig returns the decrypted configuration of the target, which is passed to providers that construct components
+// or call methods on our behalf. The configuration is only decrypted the first time that it is needed, so programs
+// that do not use such providers never decrypt it here.
+func (rm *resmon) getConfig() (map[config.Key]string, error) {
+	rm.configOnce.Do(func() {
+		rm.config, rm.configErr = rm.target.Config.Decrypt(rm.target.Decrypter)
+	})
+	return rm.config, rm.configErr
+}
+
 // Cancel signals that the engine should be terminated, awaits its termination, and returns any errors that result.
 func (rm *resmon) Cancel() error {
 	close(rm.cancel)
@@ -579,6 +608,98 @@ func (rm *resmon) Invoke(ctx context.Context, req *pulumirpc.InvokeRequest) (*pu
 	return &pulumirpc.InvokeResponse{Return: mret, Failures: chkfails}, nil
 }
 
+// Call calls a method of a resource. The method is implemented by the provider for the method's package, which
+// receives the receiver's URN and state along with the method's arguments.
+func (rm *resmon) Call(ctx context.Context, req *pulumirpc.CallRequest) (*pulumirpc.CallResponse, error) {
+	// Fetch the token and load up the resource provider if necessary.
+	tok := tokens.ModuleMember(req.GetTok())
+	providerReq, err := parseProviderRequest(tok.Package(), req.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+	prov, err := getProviderFromSource(rm.providers, rm.defaultProviders, providerReq, req.GetProvider())
+	if err != nil {
+		return nil, err
+	}
+
+	label := fmt.Sprintf("ResourceMonitor.Call(%s)", tok)
+
+	state, err := plugin.UnmarshalProperties(
+		req.GetState(), plugin.MarshalOptions{
+			Label:        label,
+			KeepUnknowns: true,
+			KeepSecrets:  true,
+		})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal %v state", tok)
+	}
+	args, err := plugin.UnmarshalProperties(
+		req.GetArgs(), plugin.MarshalOptions{
+			Label:        label,
+			KeepUnknowns: true,
+			KeepSecrets:  true,
+		})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal %v args", tok)
+	}
+
+	argDependencies := map[resource.PropertyKey][]resource.URN{}
+	for name, deps := range req.GetArgDependencies() {
+		urns := make([]resource.URN, len(deps.Urns))
+		for i, urn := range deps.Urns {
+			urns[i] = resource.URN(urn)
+		}
+		argDependencies[resource.PropertyKey(name)] = urns
+	}
+
+	// Do the call and then return the result.
+	logging.V(5).Infof("ResourceMonitor.Call received: tok=%v urn=%v #args=%v", tok, req.GetUrn(), len(args))
+	cfg, err := rm.getConfig()
+	if err != nil {
+		return nil, err
+	}
+	info := plugin.CallInfo{
+		Project:        rm.constructInfo.Project,
+		Stack:          rm.constructInfo.Stack,
+		Config:         cfg,
+		DryRun:         rm.constructInfo.DryRun,
+		Parallel:       rm.constructInfo.Parallel,
+		MonitorAddress: rm.constructInfo.MonitorAddress,
+	}
+	result, err := prov.Call(tok, resource.URN(req.GetUrn()), state, args, info, plugin.CallOptions{
+		ArgDependencies: argDependencies,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "call of %v returned an error", tok)
+	}
+	mret, err := plugin.MarshalProperties(result.Return, plugin.MarshalOptions{
+		Label:        label,
+		KeepUnknowns: true,
+		KeepSecrets:  true,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal %v return", tok)
+	}
+
+	returnDependencies := map[string]*pulumirpc.CallResponse_ReturnDependencies{}
+	for name, deps := range result.ReturnDependencies {
+		urns := make([]string, len(deps))
+		for i, urn := range deps {
+			urns[i] = string(urn)
+		}
+		returnDependencies[string(name)] = &pulumirpc.CallResponse_ReturnDependencies{Urns: urns}
+	}
+
+	var chkfails []*pulumirpc.CheckFailure
+	for _, failure := range result.Failures {
+		chkfails = append(chkfails, &pulumirpc.CheckFailure{
+			Property: string(failure.Property),
+			Reason:   failure.Reason,
+		})
+	}
+	return &pulumirpc.CallResponse{Return: mret, ReturnDependencies: returnDependencies, Failures: chkfails}, nil
+}
+
 func (rm *resmon) StreamInvoke(
 	req *pulumirpc.InvokeRequest, stream pulumirpc.ResourceMonitor_StreamInvokeServer) error {
 
@@ -659,6 +780,9 @@ func (rm *resmon) ReadResource(ctx context.Context,
 	}
 
 	contract.Assert(result != nil)
+	if result.Failed {
+		return nil, rpcerror.Newf(codes.Aborted, "reading resource '%s' failed", result.State.URN)
+	}
 	marshaled, err := plugin.MarshalProperties(result.State.Outputs, plugin.MarshalOptions{
 		Label:        label,
 		KeepUnknowns: true,
@@ -681,17 +805,22 @@ func (rm *resmon) RegisterResource(ctx context.Context,
 	// Communicate the type, name, and object information to the iterator that is awaiting us.
 	name := tokens.QName(req.GetName())
 	custom := req.GetCustom()
+	remote := req.GetRemote()
 	parent := resource.URN(req.GetParent())
 	protect := req.GetProtect()
 	deleteBeforeReplaceValue := req.GetDeleteBeforeReplace()
 	ignoreChanges := req.GetIgnoreChanges()
 	id := resource.ID(req.GetImportId())
 	customTimeouts := req.GetCustomTimeouts()
+	requestRetryPolicy := req.GetRetryPolicy()
+	retainOnDelete := req.GetRetainOnDelete()
+	deletedWith := resource.URN(req.GetDeletedWith())
 	var t tokens.Type
 
 	// Custom resources must have a three-part type so that we can 1) identify if they are providers and 2) retrieve the
-	// provider responsible for managing a particular resource (based on the type's Package).
-	if custom {
+	// provider responsible for managing a particular resource (based on the type's Package). The same is true of
+	// remote components, which are constructed by the provider for their package.
+	if custom || remote {
 		var err error
 		t, err = tokens.ParseTypeToken(req.GetType())
 		if err != nil {
@@ -703,6 +832,9 @@ func (rm *resmon) RegisterResource(ctx context.Context,
 	}
 
 	label := fmt.Sprintf("ResourceMonitor.RegisterResource(%s,%s)", t, name)
+	if custom && remote {
+		return nil, rpcerror.New(codes.InvalidArgument, "only component resources may be constructed remotely")
+	}
 	provider := req.GetProvider()
 	if custom && !providers.IsProviderType(t) && provider == "" {
 		providerReq, err := parseProviderRequest(t.Package(), req.GetVersion())
@@ -785,38 +917,106 @@ func (rm *resmon) RegisterResource(ctx context.Context,
 		}
 	}
 
+	var retryPolicy resource.RetryPolicy
+	if requestRetryPolicy != nil {
+		retryPolicy.MaxAttempts = int(requestRetryPolicy.MaxAttempts)
+		retryPolicy.ErrorMatchers = requestRetryPolicy.ErrorMatchers
+		if requestRetryPolicy.Backoff != "" {
+			duration, err := time.ParseDuration(requestRetryPolicy.Backoff)
+			if err != nil {
+				return nil, rpcerror.Newf(codes.InvalidArgument, "unable to parse retry policy backoff %s",
+					requestRetryPolicy.Backoff)
+			}
+			retryPolicy.Backoff = duration.Seconds()
+		}
+		if err := retryPolicy.Validate(); err != nil {
+			return nil, rpcerror.New(codes.InvalidArgument, err.Error())
+		}
+	}
+
 	var deleteBeforeReplace *bool
 	if deleteBeforeReplaceValue || req.GetDeleteBeforeReplaceDefined() {
 		deleteBeforeReplace = &deleteBeforeReplaceValue
 	}
 
 	logging.V(5).Infof(
-		"ResourceMonitor.RegisterResource received: t=%v, name=%v, custom=%v, #props=%v, parent=%v, protect=%v, "+
-			"provider=%v, deps=%v, deleteBeforeReplace=%v, ignoreChanges=%v, aliases=%v, customTimeouts=%v",
-		t, name, custom, len(props), parent, protect, provider, dependencies, deleteBeforeReplace, ignoreChanges,
-		aliases, timeouts)
+		"ResourceMonitor.RegisterResource received: t=%v, name=%v, custom=%v, remote=%v, #props=%v, parent=%v, "+
+			"protect=%v, provider=%v, deps=%v, deleteBeforeReplace=%v, ignoreChanges=%v, aliases=%v, customTimeouts=%v, "+
+			"retryPolicy=%v, retainOnDelete=%v, deletedWith=%v",
+		t, name, custom, remote, len(props), parent, protect, provider, dependencies, deleteBeforeReplace,
+		ignoreChanges, aliases, timeouts, retryPolicy, retainOnDelete, deletedWith)
 
-	// Send the goal state to the engine.
-	step := &registerResourceEvent{
-		goal: resource.NewGoal(t, name, custom, props, parent, protect, dependencies, provider, nil,
-			propertyDependencies, deleteBeforeReplace, ignoreChanges, additionalSecretOutputs, aliases, id, &timeouts),
-		done: make(chan *RegisterResult),
-	}
+	var result *RegisterResult
+	var outputDeps map[string]*pulumirpc.RegisterResourceResponse_PropertyDependencies
+	if remote {
+		// Remote components are not registered by the language host: the provider for the component's package
+		// constructs the component and its children by calling back into this monitor, and we simply relay the result.
+		providerReq, err := parseProviderRequest(t.Package(), req.GetVersion())
+		if err != nil {
+			return nil, err
+		}
+		prov, err := getProviderFromSource(rm.providers, rm.defaultProviders, providerReq, provider)
+		if err != nil {
+			return nil, err
+		}
 
-	select {
-	case rm.regChan <- step:
-	case <-rm.cancel:
-		logging.V(5).Infof("ResourceMonitor.RegisterResource operation canceled, name=%s", name)
-		return nil, rpcerror.New(codes.Unavailable, "resource monitor shut down while sending resource registration")
-	}
+		cfg, err := rm.getConfig()
+		if err != nil {
+			return nil, err
+		}
+		info := rm.constructInfo
+		info.Config = cfg
+
+		constructResult, err := prov.Construct(info, t, name, parent, props, plugin.ConstructOptions{
+			Aliases:              aliases,
+			Dependencies:         dependencies,
+			Protect:              protect,
+			Providers:            req.GetProviders(),
+			PropertyDependencies: propertyDependencies,
+		})
+		if err != nil {
+			return nil, err
+		}
+		result = &RegisterResult{State: &resource.State{
+			Type:    t,
+			URN:     constructResult.URN,
+			Outputs: constructResult.Outputs,
+		}}
+
+		outputDeps = map[string]*pulumirpc.RegisterResourceResponse_PropertyDependencies{}
+		for k, urns := range constructResult.OutputDependencies {
+			deps := make([]string, len(urns))
+			for i, urn := range urns {
+				deps[i] = string(urn)
+			}
+			outputDeps[string(k)] = &pulumirpc.RegisterResourceResponse_PropertyDependencies{Urns: deps}
+		}
+	} else {
+		// Send the goal state to the engine.
+		step := &registerResourceEvent{
+			goal: resource.NewGoal(t, name, custom, props, parent, protect, dependencies, provider, nil,
+				propertyDependencies, deleteBeforeReplace, ignoreChanges, additionalSecretOutputs, aliases, id,
+				&timeouts, &retryPolicy, retainOnDelete, deletedWith),
+			done: make(chan *RegisterResult),
+		}
 
-	// Now block waiting for the operation to finish.
-	var result *RegisterResult
-	select {
-	case result = <-step.done:
-	case <-rm.cancel:
-		logging.V(5).Infof("ResourceMonitor.RegisterResource operation canceled, name=%s", name)
-		return nil, rpcerror.New(codes.Unavailable, "resource monitor shut down while waiting on step's done channel")
+		select {
+		case rm.regChan <- step:
+		case <-rm.cancel:
+			logging.V(5).Infof("ResourceMonitor.RegisterResource operation canceled, name=%s", name)
+			return nil, rpcerror.New(codes.Unavailable, "resource monitor shut down while sending resource registration")
+		}
+
+		// Now block waiting for the operation to finish.
+		select {
+		case result = <-step.done:
+		case <-rm.cancel:
+			logging.V(5).Infof("ResourceMonitor.RegisterResource operation canceled, name=%s", name)
+			return nil, rpcerror.New(codes.Unavailable, "resource monitor shut down while waiting on step's done channel")
+		}
+		if result.Failed {
+			return nil, rpcerror.Newf(codes.Aborted, "registering resource '%s' failed", result.State.URN)
+		}
 	}
 
 	// Filter out partially-known values if the requestor does not support them.
@@ -847,9 +1047,10 @@ func (rm *resmon) RegisterResource(ctx context.Context,
 		return nil, err
 	}
 	return &pulumirpc.RegisterResourceResponse{
-		Urn:    string(state.URN),
-		Id:     string(state.ID),
-		Object: obj,
+		Urn:                  string(state.URN),
+		Id:                   string(state.ID),
+		Object:               obj,
+		PropertyDependencies: outputDeps,
 	}, nil
 }
 
@@ -397,6 +397,11 @@ func (rm *queryResmon) ReadResource(ctx context.Context,
 	return nil, fmt.Errorf("Query mode does not support reading resources")
 }
 
+// Call is not supported in query mode, as query programs do not register resources.
+func (rm *queryResmon) Call(ctx context.Context, req *pulumirpc.CallRequest) (*pulumirpc.CallResponse, error) {
+	return nil, errors.New("Query mode does not support calling resource methods")
+}
+
 // RegisterResource is invoked by a language process when a new resource has been allocated.
 func (rm *queryResmon) RegisterResource(ctx context.Context,
 	req *pulumirpc.RegisterResourceRequest) (*pulumirpc.RegisterResourceResponse, error) {
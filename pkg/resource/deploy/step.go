@@ -221,7 +221,7 @@ func (s *CreateStep) Apply(preview bool) (resource.Status, StepCompleteFunc, err
 			id, outs, rst, err := prov.Create(s.URN(), s.new.Inputs, s.new.CustomTimeouts.Create)
 			if err != nil {
 				if rst != resource.StatusPartialFailure {
-					return rst, nil, err
+					return rst, nil, &providerError{err: err}
 				}
 
 				resourceError = err
@@ -257,25 +257,34 @@ func (s *CreateStep) Apply(preview bool) (resource.Status, StepCompleteFunc, err
 // DeleteStep is a mutating step that deletes an existing resource. If `old` is marked "External",
 // DeleteStep is a no-op.
 type DeleteStep struct {
-	plan      *Plan           // the current plan.
-	old       *resource.State // the state of the existing resource.
-	replacing bool            // true if part of a replacement.
+	plan           *Plan                 // the current plan.
+	old            *resource.State       // the state of the existing resource.
+	replacing      bool                  // true if part of a replacement.
+	otherDeletions map[resource.URN]bool // the other resources deleted by the plan, if known.
+	retryPolicy    resource.RetryPolicy  // the retry policy of the resource's replacement, if any.
 }
 
 var _ Step = (*DeleteStep)(nil)
 
-func NewDeleteStep(plan *Plan, old *resource.State) Step {
+// NewDeleteStep creates a step that deletes a resource. otherDeletions, if non-nil, is the set of resources deleted by
+// the plan; it must not change once the step begins executing, and is used to honor the resource's DeletedWith.
+func NewDeleteStep(plan *Plan, otherDeletions map[resource.URN]bool, old *resource.State) Step {
 	contract.Assert(old != nil)
 	contract.Assert(old.URN != "")
 	contract.Assert(old.ID != "" || !old.Custom)
 	contract.Assert(!old.Custom || old.Provider != "" || providers.IsProviderType(old.Type))
 	return &DeleteStep{
-		plan: plan,
-		old:  old,
+		plan:           plan,
+		old:            old,
+		otherDeletions: otherDeletions,
 	}
 }
 
-func NewDeleteReplacementStep(plan *Plan, old *resource.State, pendingReplace bool) Step {
+// NewDeleteReplacementStep creates a step that deletes a resource that is being replaced. Retry policies are not
+// recorded in checkpoints, so retryPolicy is the policy of the resource's replacement, if it is known.
+func NewDeleteReplacementStep(plan *Plan, otherDeletions map[resource.URN]bool, old *resource.State,
+	pendingReplace bool, retryPolicy resource.RetryPolicy) Step {
+
 	contract.Assert(old != nil)
 	contract.Assert(old.URN != "")
 	contract.Assert(old.ID != "" || !old.Custom)
@@ -296,9 +305,11 @@ func NewDeleteReplacementStep(plan *Plan, old *resource.State, pendingReplace bo
 	contract.Assert(pendingReplace != old.Delete)
 	old.PendingReplacement = pendingReplace
 	return &DeleteStep{
-		plan:      plan,
-		old:       old,
-		replacing: true,
+		plan:           plan,
+		old:            old,
+		replacing:      true,
+		otherDeletions: otherDeletions,
+		retryPolicy:    retryPolicy,
 	}
 }
 
@@ -331,8 +342,9 @@ func (s *DeleteStep) Apply(preview bool) (resource.Status, StepCompleteFunc, err
 			errors.Errorf("refusing to delete protected resource '%s'", s.old.URN)
 	}
 
-	// Deleting an External resource is a no-op, since Pulumi does not own the lifecycle.
-	if !preview && !s.old.External {
+	// Deleting an External resource is a no-op, since Pulumi does not own the lifecycle. Likewise, a resource that is
+	// retained or deleted along with another resource is only removed from the stack.
+	if !preview && !s.old.External && s.DeleteSkipReason() == "" {
 		if s.old.Custom {
 			// Invoke the Delete RPC function for this provider:
 			prov, err := getProvider(s)
@@ -341,7 +353,7 @@ func (s *DeleteStep) Apply(preview bool) (resource.Status, StepCompleteFunc, err
 			}
 
 			if rst, err := prov.Delete(s.URN(), s.old.ID, s.old.Outputs, s.old.CustomTimeouts.Delete); err != nil {
-				return rst, nil, err
+				return rst, nil, &providerError{err: err}
 			}
 		}
 	}
@@ -349,6 +361,21 @@ func (s *DeleteStep) Apply(preview bool) (resource.Status, StepCompleteFunc, err
 	return resource.StatusOK, func() {}, nil
 }
 
+// DeleteSkipReason returns a description of why the provider will not be asked to delete this resource, or the empty
+// string if it will be.
+func (s *DeleteStep) DeleteSkipReason() string {
+	if s.old.External {
+		return ""
+	}
+	if s.old.RetainOnDelete {
+		return "retained: retainOnDelete is set"
+	}
+	if with := s.old.DeletedWith; with != "" && s.otherDeletions[with] {
+		return fmt.Sprintf("deleted with %s", with.Name())
+	}
+	return ""
+}
+
 type RemovePendingReplaceStep struct {
 	plan *Plan           // the current plan.
 	old  *resource.State // the state of the existing resource.
@@ -451,7 +478,7 @@ func (s *UpdateStep) Apply(preview bool) (resource.Status, StepCompleteFunc, err
 				s.new.CustomTimeouts.Update, s.ignoreChanges)
 			if upderr != nil {
 				if rst != resource.StatusPartialFailure {
-					return rst, nil, upderr
+					return rst, nil, &providerError{err: upderr}
 				}
 
 				resourceError = upderr
@@ -761,7 +788,7 @@ func (s *RefreshStep) Apply(preview bool) (resource.Status, StepCompleteFunc, er
 		s.new = resource.NewState(s.old.Type, s.old.URN, s.old.Custom, s.old.Delete, resourceID, inputs, outputs,
 			s.old.Parent, s.old.Protect, s.old.External, s.old.Dependencies, initErrors, s.old.Provider,
 			s.old.PropertyDependencies, s.old.PendingReplacement, s.old.AdditionalSecretOutputs, s.old.Aliases,
-			&s.old.CustomTimeouts, s.old.ImportID)
+			&s.old.CustomTimeouts, s.old.ImportID, &s.old.RetryPolicy, s.old.RetainOnDelete, s.old.DeletedWith)
 	} else {
 		s.new = nil
 	}
@@ -871,7 +898,8 @@ func (s *ImportStep) Apply(preview bool) (resource.Status, StepCompleteFunc, err
 	// differences between the old and new states are between the inputs and outputs.
 	s.old = resource.NewState(s.new.Type, s.new.URN, s.new.Custom, false, s.new.ID, read.Inputs, read.Outputs,
 		s.new.Parent, s.new.Protect, false, s.new.Dependencies, s.new.InitErrors, s.new.Provider,
-		s.new.PropertyDependencies, false, nil, nil, &s.new.CustomTimeouts, s.new.ImportID)
+		s.new.PropertyDependencies, false, nil, nil, &s.new.CustomTimeouts, s.new.ImportID, &s.new.RetryPolicy,
+		s.new.RetainOnDelete, s.new.DeletedWith)
 
 	// Check the user inputs using the provider inputs for defaults.
 	inputs, failures, err := prov.Check(s.new.URN, s.old.Inputs, s.new.Inputs, preview)
@@ -1064,3 +1092,33 @@ func getProvider(s Step) (plugin.Provider, error) {
 	}
 	return provider, nil
 }
+
+// providerError is an error returned by a resource provider from a create, update, or delete. Only provider errors
+// are retried by a resource's retry policy: failures detected by the engine itself, such as an attempt to delete a
+// protected resource, will not succeed on a later attempt.
+type providerError struct {
+	err error
+}
+
+func (e *providerError) Error() string { return e.err.Error() }
+func (e *providerError) Cause() error  { return e.err }
+
+// failStep tells the program whose registration or read produced the given step that the step will not complete. It
+// returns false if the step does not answer a request from the program.
+func failStep(s Step) bool {
+	switch s := s.(type) {
+	case *SameStep:
+		s.reg.Done(&RegisterResult{State: s.new, Failed: true})
+	case *CreateStep:
+		s.reg.Done(&RegisterResult{State: s.new, Failed: true})
+	case *UpdateStep:
+		s.reg.Done(&RegisterResult{State: s.new, Failed: true})
+	case *ImportStep:
+		s.reg.Done(&RegisterResult{State: s.new, Failed: true})
+	case *ReadStep:
+		s.event.Done(&ReadResult{State: s.new, Failed: true})
+	default:
+		return false
+	}
+	return true
+}
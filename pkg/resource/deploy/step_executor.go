@@ -17,9 +17,15 @@ package deploy
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 
+	opentracing "github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+	"github.com/opentracing/opentracing-go/log"
 	"github.com/pkg/errors"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/diag"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
@@ -77,6 +83,83 @@ type incomingChain struct {
 	CompletionChan chan bool // A completion channel to be closed when the chain has completed execution
 }
 
+// stepFailures records the resources whose steps failed and the resources whose steps were skipped because they
+// depend on a failed resource. It is only populated when the step executor continues after step errors.
+type stepFailures struct {
+	m       sync.Mutex
+	causes  map[resource.URN]resource.URN   // maps each failed or skipped resource to the failed resource responsible.
+	failed  []resource.URN                  // the resources whose steps failed, in the order that they failed.
+	skipped map[resource.URN][]resource.URN // maps each failed resource to the resources skipped because of it.
+}
+
+// fail records that a step for the given resource failed.
+func (f *stepFailures) fail(urn resource.URN) {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	if _, has := f.causes[urn]; has {
+		return
+	}
+	f.causes[urn] = urn
+	f.failed = append(f.failed, urn)
+}
+
+// skip records that a step for the given resource was skipped because of the failure of the given cause.
+func (f *stepFailures) skip(urn, cause resource.URN) {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	if _, has := f.causes[urn]; has {
+		return
+	}
+	f.causes[urn] = cause
+	f.skipped[cause] = append(f.skipped[cause], urn)
+}
+
+// causeOf returns the failed resource responsible for the failure or skipping of any of the given resources.
+func (f *stepFailures) causeOf(urns ...resource.URN) (resource.URN, bool) {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	for _, urn := range urns {
+		if cause, has := f.causes[urn]; has {
+			return cause, true
+		}
+	}
+	return "", false
+}
+
+// Summary returns a description of each failed resource and the resources skipped because of it, or the empty
+// string if no steps failed.
+func (f *stepFailures) Summary() string {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	if len(f.failed) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	if len(f.failed) == 1 {
+		b.WriteString("1 resource failed:")
+	} else {
+		fmt.Fprintf(&b, "%d resources failed:", len(f.failed))
+	}
+	for _, urn := range f.failed {
+		fmt.Fprintf(&b, "\n    %s", urn)
+
+		skipped := make([]string, len(f.skipped[urn]))
+		for i, s := range f.skipped[urn] {
+			skipped[i] = string(s)
+		}
+		sort.Strings(skipped)
+		for _, s := range skipped {
+			fmt.Fprintf(&b, "\n        skipped %s", s)
+		}
+	}
+	return b.String()
+}
+
 // stepExecutor is the component of the engine responsible for taking steps and executing
 // them, possibly in parallel if requested. The step generator operates on the granularity
 // of "chains", which are sequences of steps that must be executed exactly in the given order.
@@ -85,11 +168,12 @@ type incomingChain struct {
 // resolved, we (the engine) can assume that any chain given to us by the step generator is already
 // ready to execute.
 type stepExecutor struct {
-	plan            *Plan    // The plan currently being executed.
-	opts            Options  // The options for this current plan.
-	preview         bool     // Whether or not we are doing a preview.
-	pendingNews     sync.Map // Resources that have been created but are pending a RegisterResourceOutputs.
-	continueOnError bool     // True if we want to continue the plan after a step error.
+	plan            *Plan        // The plan currently being executed.
+	opts            Options      // The options for this current plan.
+	preview         bool         // Whether or not we are doing a preview.
+	pendingNews     sync.Map     // Resources that have been created but are pending a RegisterResourceOutputs.
+	continueOnError bool         // True if we want to continue the plan after a step error.
+	failures        stepFailures // The failed and skipped resources, if continuing after step errors.
 
 	workers        sync.WaitGroup     // WaitGroup tracking the worker goroutines that are owned by this step executor.
 	incomingChains chan incomingChain // Incoming chains that we are to execute
@@ -162,8 +246,8 @@ func (se *stepExecutor) ExecuteRegisterResourceOutputs(e RegisterResourceOutputs
 		"registered resource outputs %s: old=#%d, new=#%d", urn, len(reg.New().Outputs), len(outs))
 	reg.New().Outputs = e.Outputs()
 	// If there is an event subscription for finishing the resource, execute them.
-	if e := se.opts.Events; e != nil {
-		if eventerr := e.OnResourceOutputs(reg); eventerr != nil {
+	if events := se.opts.Events; events != nil {
+		if eventerr := events.OnResourceOutputs(reg); eventerr != nil {
 			se.log(synchronousWorkerID, "register resource outputs failed: %s", eventerr.Error())
 
 			// This is a bit of a kludge, but ExecuteRegisterResourceOutputs is an odd duck
@@ -176,6 +260,11 @@ func (se *stepExecutor) ExecuteRegisterResourceOutputs(e RegisterResourceOutputs
 			diagMsg := diag.RawMessage(reg.URN(), outErr.Error())
 			se.plan.Diag().Errorf(diagMsg)
 			se.cancelDueToError()
+			if se.continueOnError {
+				// The program must not wait on this event forever, as nothing is going to cancel it.
+				se.failures.fail(urn)
+				e.Done()
+			}
 			return
 		}
 	}
@@ -210,7 +299,7 @@ func (se *stepExecutor) WaitForCompletion() {
 // executeChain executes a chain, one step at a time. If any step in the chain fails to execute, or if the
 // context is canceled, the chain stops execution.
 func (se *stepExecutor) executeChain(workerID int, chain chain) {
-	for _, step := range chain {
+	for i, step := range chain {
 		select {
 		case <-se.ctx.Done():
 			se.log(workerID, "step %v on %v canceled", step.Op(), step.URN())
@@ -218,7 +307,7 @@ func (se *stepExecutor) executeChain(workerID int, chain chain) {
 		default:
 		}
 
-		if err := se.executeStep(workerID, step); err != nil {
+		if completed, err := se.executeStep(workerID, step); err != nil {
 			se.log(workerID, "step %v on %v failed, signalling cancellation", step.Op(), step.URN())
 			se.cancelDueToError()
 			if err != errStepApplyFailed {
@@ -230,11 +319,43 @@ func (se *stepExecutor) executeChain(workerID int, chain chain) {
 				diagMsg := diag.RawMessage(step.URN(), err.Error())
 				se.plan.Diag().Errorf(diagMsg)
 			}
+			if se.continueOnError {
+				se.failChain(workerID, chain[i:], completed)
+			}
+			return
+		}
+	}
+}
+
+// failChain records the failure of the first step in the given remainder of a chain and skips the rest of its steps.
+// If the chain was produced by a registration or read that has not yet been answered, the program is told that it
+// failed so that it does not wait on the chain forever. completed is true if the failed step has already answered
+// the program, as a step that partially fails does.
+func (se *stepExecutor) failChain(workerID int, rest chain, completed bool) {
+	failed := rest[0].URN()
+	se.failures.fail(failed)
+	for _, step := range rest[1:] {
+		if step.URN() != failed {
+			se.log(workerID, "step %v on %v skipped due to failure of %v", step.Op(), step.URN(), failed)
+			se.failures.skip(step.URN(), failed)
+		}
+	}
+
+	if completed {
+		rest = rest[1:]
+	}
+	for _, step := range rest {
+		if failStep(step) {
 			return
 		}
 	}
 }
 
+// Failures returns the resources that failed or were skipped when continuing after step errors.
+func (se *stepExecutor) Failures() *stepFailures {
+	return &se.failures
+}
+
 func (se *stepExecutor) cancelDueToError() {
 	se.sawError.Store(true)
 	if !se.continueOnError {
@@ -253,9 +374,15 @@ func (se *stepExecutor) cancelDueToError() {
 // verbatim to the post-step event.
 //
 
-// executeStep executes a single step, returning true if the step execution was successful and
-// false if it was not.
-func (se *stepExecutor) executeStep(workerID int, step Step) error {
+// executeStep executes a single step, returning an error if the step execution was not successful. It also returns
+// true if the step was retired, which allows steps that depend on it to proceed even if it failed.
+func (se *stepExecutor) executeStep(workerID int, step Step) (bool, error) {
+	span, _ := opentracing.StartSpanFromContext(se.ctx, "pulumi-step",
+		opentracing.Tag{Key: "op", Value: string(step.Op())},
+		opentracing.Tag{Key: "urn", Value: string(step.URN())},
+		opentracing.Tag{Key: "preview", Value: se.preview})
+	defer span.Finish()
+
 	var payload interface{}
 	events := se.opts.Events
 	if events != nil {
@@ -263,18 +390,18 @@ func (se *stepExecutor) executeStep(workerID int, step Step) error {
 		payload, err = events.OnResourceStepPre(step)
 		if err != nil {
 			se.log(workerID, "step %v on %v failed pre-resource step: %v", step.Op(), step.URN(), err)
-			return errors.Wrap(err, "pre-step event returned an error")
+			return false, errors.Wrap(err, "pre-step event returned an error")
 		}
 	}
 
 	se.log(workerID, "applying step %v on %v (preview %v)", step.Op(), step.URN(), se.preview)
-	status, stepComplete, err := step.Apply(se.preview)
+	status, stepComplete, err := se.applyStep(workerID, step)
 
 	if err == nil {
 		// If we have a state object, and this is a create or update, remember it, as we may need to update it later.
 		if step.Logical() && step.New() != nil {
 			if prior, has := se.pendingNews.Load(step.URN()); has {
-				return errors.Errorf(
+				return false, errors.Errorf(
 					"resource '%s' registered twice (%s and %s)", step.URN(), prior.(Step).Op(), step.Op())
 			}
 
@@ -295,7 +422,7 @@ func (se *stepExecutor) executeStep(workerID int, step Step) error {
 	if events != nil {
 		if postErr := events.OnResourceStepPost(payload, step, status, err); postErr != nil {
 			se.log(workerID, "step %v on %v failed post-resource step: %v", step.Op(), step.URN(), postErr)
-			return errors.Wrap(postErr, "post-step event returned an error")
+			return false, errors.Wrap(postErr, "post-step event returned an error")
 		}
 	}
 
@@ -308,10 +435,82 @@ func (se *stepExecutor) executeStep(workerID int, step Step) error {
 
 	if err != nil {
 		se.log(workerID, "step %v on %v failed with an error: %v", step.Op(), step.URN(), err)
-		return errStepApplyFailed
+		ext.Error.Set(span, true)
+		span.LogFields(log.String("event", "error"), log.String("message", err.Error()))
+		return stepComplete != nil, errStepApplyFailed
+	}
+
+	return true, nil
+}
+
+// applyStep applies a single step. If the step fails, it is retried according to the retry policy for the step's
+// resource, or the plan's default retry policy if the resource has none. Each retry is reported as a warning and as a
+// retry event.
+func (se *stepExecutor) applyStep(workerID int, step Step) (resource.Status, StepCompleteFunc, error) {
+	status, stepComplete, err := step.Apply(se.preview)
+	if err == nil || se.preview {
+		return status, stepComplete, err
+	}
+
+	policy := se.retryPolicy(step)
+	for attempt := 1; err != nil && policy != nil && isRetryable(step, status, err) && policy.ShouldRetry(attempt, err); {
+		delay := policy.Delay(attempt)
+		se.log(workerID, "step %v on %v failed on attempt %d, retrying in %v", step.Op(), step.URN(), attempt, delay)
+		se.plan.Diag().Warningf(diag.RawMessage(step.URN(), fmt.Sprintf("%s failed on attempt %d of %d; retrying in %v: %v",
+			step.Op(), attempt, policy.MaxAttempts, delay, err)))
+		if events := se.opts.Events; events != nil {
+			events.OnResourceStepRetry(step, attempt, policy.MaxAttempts, delay, err)
+		}
+
+		select {
+		case <-time.After(delay):
+		case <-se.ctx.Done():
+			se.log(workerID, "step %v on %v canceled while waiting to retry", step.Op(), step.URN())
+			return status, stepComplete, err
+		}
+
+		attempt++
+		status, stepComplete, err = step.Apply(se.preview)
+	}
+
+	return status, stepComplete, err
+}
+
+// retryPolicy returns the retry policy that applies to the given step, or nil if the step should not be retried.
+func (se *stepExecutor) retryPolicy(step Step) *resource.RetryPolicy {
+	switch step.Op() {
+	case OpCreate, OpCreateReplacement, OpUpdate, OpDelete, OpDeleteReplaced:
+	default:
+		return nil
+	}
+
+	// The state of a resource that is being deleted comes from the checkpoint, which does not record retry policies. A
+	// replaced resource is deleted using the policy of its replacement, and a resource that is deleted outright uses
+	// the stack's default policy.
+	policy := step.Res().RetryPolicy
+	if del, ok := step.(*DeleteStep); ok {
+		policy = del.retryPolicy
+	}
+	if policy.IsNotEmpty() {
+		return &policy
 	}
+	return se.opts.DefaultRetryPolicy
+}
 
-	return nil
+// isRetryable returns true if a step that failed with the given status and error may be applied again. Only errors
+// reported by the provider are retried. A partially failed operation has changed the resource, and a create that
+// returned an ID has created one that must not be leaked by creating another.
+func isRetryable(step Step, status resource.Status, err error) bool {
+	if _, ok := err.(*providerError); !ok {
+		return false
+	}
+	if status == resource.StatusPartialFailure {
+		return false
+	}
+	if op := step.Op(); (op == OpCreate || op == OpCreateReplacement) && step.New().ID != "" {
+		return false
+	}
+	return true
 }
 
 // log is a simple logging helper for the step executor.
@@ -387,9 +586,13 @@ func newStepExecutor(ctx context.Context, cancel context.CancelFunc, plan *Plan,
 		opts:            opts,
 		preview:         preview,
 		continueOnError: continueOnError,
-		incomingChains:  make(chan incomingChain),
-		ctx:             ctx,
-		cancel:          cancel,
+		failures: stepFailures{
+			causes:  make(map[resource.URN]resource.URN),
+			skipped: make(map[resource.URN][]resource.URN),
+		},
+		incomingChains: make(chan incomingChain),
+		ctx:            ctx,
+		cancel:         cancel,
 	}
 
 	exec.sawError.Store(false)
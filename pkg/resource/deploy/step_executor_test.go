@@ -0,0 +1,130 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// These tests are in their own package so that they may read snapshots back from checkpoints using the stack package,
+// which depends upon this one.
+package deploy_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/blang/semver"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v2/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/v2/resource/deploy/deploytest"
+	"github.com/pulumi/pulumi/pkg/v2/resource/deploy/providers"
+	"github.com/pulumi/pulumi/pkg/v2/resource/stack"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/cmdutil"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/workspace"
+)
+
+// Tests that retry policies apply to deletes of resources whose state has been read back from a checkpoint, which does
+// not record policies: a replaced resource is deleted using its replacement's policy, and a resource that is removed
+// from the program is deleted using the stack's default policy.
+func TestRetryPolicyDeleteFromCheckpoint(t *testing.T) {
+	policy := &resource.RetryPolicy{MaxAttempts: 2, ErrorMatchers: []string{"throttled"}}
+
+	provURN := resource.NewURN("test", "test", "", "pulumi:providers:pkgA", "default")
+	provRef, err := providers.NewReference(provURN, "provider-id")
+	require.NoError(t, err)
+	urnA := resource.NewURN("test", "test", "", "pkgA:m:typA", "resA")
+	urnB := resource.NewURN("test", "test", "", "pkgA:m:typA", "resB")
+
+	// Write a checkpoint of the resources as they were created by a program that gave each of them a retry policy, and
+	// read it back.
+	newState := func(urn resource.URN, id resource.ID, props resource.PropertyMap) *resource.State {
+		return resource.NewState(urn.Type(), urn, true, false, id, props, props, "", false, false, nil, nil,
+			provRef.String(), nil, false, nil, nil, nil, "", policy, false, "")
+	}
+	prov := resource.NewState(provURN.Type(), provURN, true, false, provRef.ID(), resource.PropertyMap{},
+		resource.PropertyMap{}, "", false, false, nil, nil, "", nil, false, nil, nil, nil, "", nil, false, "")
+	snap := deploy.NewSnapshot(deploy.Manifest{}, nil, []*resource.State{
+		prov,
+		newState(urnA, "a", resource.PropertyMap{"foo": resource.NewStringProperty("bar")}),
+		newState(urnB, "b", resource.PropertyMap{}),
+	}, nil)
+
+	deployment, err := stack.SerializeDeployment(snap, nil)
+	require.NoError(t, err)
+	snap, err = stack.DeserializeDeploymentV3(*deployment, stack.DefaultSecretsProvider)
+	require.NoError(t, err)
+	for _, r := range snap.Resources {
+		assert.False(t, r.RetryPolicy.IsNotEmpty())
+	}
+
+	// Replace resA and delete resB. Each resource's first delete fails with an error that only the policy that should
+	// apply to it retries, and its second delete succeeds.
+	var lock sync.Mutex
+	deletes := make(map[resource.URN]int)
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				DiffF: func(urn resource.URN, id resource.ID,
+					olds, news resource.PropertyMap, ignoreChanges []string) (plugin.DiffResult, error) {
+
+					if !olds["foo"].DeepEquals(news["foo"]) {
+						return plugin.DiffResult{Changes: plugin.DiffSome, ReplaceKeys: []resource.PropertyKey{"foo"}}, nil
+					}
+					return plugin.DiffResult{}, nil
+				},
+				DeleteF: func(urn resource.URN, id resource.ID, olds resource.PropertyMap,
+					timeout float64) (resource.Status, error) {
+
+					lock.Lock()
+					defer lock.Unlock()
+					deletes[urn]++
+					switch {
+					case deletes[urn] > 1:
+						return resource.StatusOK, nil
+					case urn == urnA:
+						return resource.StatusOK, errors.New("throttled")
+					default:
+						return resource.StatusOK, errors.New("busy")
+					}
+				},
+			}, nil
+		}),
+	}
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true, deploytest.ResourceOptions{
+			Inputs:      resource.PropertyMap{"foo": resource.NewStringProperty("baz")},
+			RetryPolicy: policy,
+		})
+		return err
+	})
+
+	sink := cmdutil.Diag()
+	host := deploytest.NewPluginHost(sink, sink, program, loaders...)
+	ctx, err := plugin.NewContext(sink, sink, host, nil, "", nil, nil)
+	require.NoError(t, err)
+	defer ctx.Close()
+
+	target := &deploy.Target{Name: "test"}
+	runInfo := &deploy.EvalRunInfo{Proj: &workspace.Project{Name: "test"}, Target: target}
+	plan, err := deploy.NewPlan(ctx, target, snap, deploy.NewEvalSource(ctx, runInfo, nil, false), nil, false, nil)
+	require.NoError(t, err)
+	res := plan.Execute(context.Background(), deploy.Options{
+		DefaultRetryPolicy: &resource.RetryPolicy{MaxAttempts: 2, ErrorMatchers: []string{"busy"}},
+	}, false)
+	assert.Nil(t, res)
+
+	assert.Equal(t, map[resource.URN]int{urnA: 2, urnB: 2}, deletes)
+}
@@ -15,6 +15,7 @@
 package deploy
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -69,6 +70,16 @@ type stepGenerator struct {
 
 	// a map from old names (aliased URNs) to the new URN that aliased to them.
 	aliased map[resource.URN]resource.URN
+
+	validator *schemaValidator // validates resource inputs against the schemas of their providers.
+}
+
+// goalRetryPolicy returns the retry policy of the goal registered for the resource with the given URN, if any.
+func (sg *stepGenerator) goalRetryPolicy(urn resource.URN) resource.RetryPolicy {
+	if goal, ok := sg.resourceGoals[urn]; ok {
+		return goal.RetryPolicy
+	}
+	return resource.RetryPolicy{}
 }
 
 func (sg *stepGenerator) isTargetedUpdate() bool {
@@ -107,9 +118,12 @@ func (sg *stepGenerator) GenerateReadSteps(event ReadResourceEvent) ([]Step, res
 		nil,   /* propertyDependencies */
 		false, /* deleteBeforeCreate */
 		event.AdditionalSecretOutputs(),
-		nil, /* aliases */
-		nil, /* customTimeouts */
-		"",  /* importID */
+		nil,   /* aliases */
+		nil,   /* customTimeouts */
+		"",    /* importID */
+		nil,   /* retryPolicy */
+		false, /* retainOnDelete */
+		"",    /* deletedWith */
 	)
 	old, hasOld := sg.plan.Olds()[urn]
 
@@ -251,7 +265,8 @@ func (sg *stepGenerator) generateSteps(event RegisterResourceEvent) ([]Step, res
 	// get serialized into the checkpoint file.
 	new := resource.NewState(goal.Type, urn, goal.Custom, false, "", inputs, nil, goal.Parent, goal.Protect, false,
 		goal.Dependencies, goal.InitErrors, goal.Provider, goal.PropertyDependencies, false,
-		goal.AdditionalSecretOutputs, goal.Aliases, &goal.CustomTimeouts, "")
+		goal.AdditionalSecretOutputs, goal.Aliases, &goal.CustomTimeouts, "", &goal.RetryPolicy, goal.RetainOnDelete,
+		goal.DeletedWith)
 
 	// Mark the URN/resource as having been seen. So we can run analyzers on all resources seen, as well as
 	// lookup providers for calculating replacement of resources that use the provider.
@@ -302,6 +317,22 @@ func (sg *stepGenerator) generateSteps(event RegisterResourceEvent) ([]Step, res
 		return []Step{NewImportStep(sg.plan, event, new, goal.IgnoreChanges)}, nil
 	}
 
+	// Validate the inputs against the provider's schema. Schemas describe the inputs the provider accepts more
+	// precisely than many providers' Check methods do, so this catches problems before they reach the provider.
+	if prov != nil && !providers.IsProviderType(goal.Type) {
+		failures, warnings := sg.validator.Validate(prov, goal.Type, goal.Properties)
+		for _, warning := range warnings {
+			msg := warning.Reason
+			if warning.Property != "" {
+				msg = fmt.Sprintf("%v: %v", warning.Property, warning.Reason)
+			}
+			sg.plan.Diag().Warningf(diag.RawMessage(urn, msg))
+		}
+		if issueCheckErrors(sg.plan, new, urn, failures) {
+			invalid = true
+		}
+	}
+
 	// Ensure the provider is okay with this resource and fetch the inputs to pass to subsequent methods.
 	var err error
 	if prov != nil {
@@ -594,7 +625,11 @@ func (sg *stepGenerator) generateStepsFromDiff(
 				//
 				// To do this, we'll utilize the dependency information contained in the snapshot if it is
 				// trustworthy, which is interpreted by the DependencyGraph type.
+				//
+				// These deletes execute while the plan is still generating steps, so they are given their own set
+				// of the resources deleted alongside them rather than the step generator's.
 				var steps []Step
+				deleted := map[resource.URN]bool{urn: true}
 				if sg.opts.TrustDependencies {
 					toReplace, res := sg.calculateDependentReplacements(old)
 					if res != nil {
@@ -616,7 +651,9 @@ func (sg *stepGenerator) generateStepsFromDiff(
 						logging.V(7).Infof("Planner decided to delete '%v' due to dependence on condemned resource '%v'",
 							dependentResource.URN, urn)
 
-						steps = append(steps, NewDeleteReplacementStep(sg.plan, dependentResource, true))
+						steps = append(steps, NewDeleteReplacementStep(sg.plan, deleted, dependentResource, true,
+							sg.goalRetryPolicy(dependentResource.URN)))
+						deleted[dependentResource.URN] = true
 						// Mark the condemned resource as deleted. We won't know until later in the plan whether
 						// or not we're going to be replacing this resource.
 						sg.deletes[dependentResource.URN] = true
@@ -624,7 +661,7 @@ func (sg *stepGenerator) generateStepsFromDiff(
 				}
 
 				return append(steps,
-					NewDeleteReplacementStep(sg.plan, old, true),
+					NewDeleteReplacementStep(sg.plan, deleted, old, true, new.RetryPolicy),
 					NewReplaceStep(sg.plan, old, new, diff.ReplaceKeys, diff.ChangedKeys, diff.DetailedDiff, false),
 					NewCreateReplacementStep(
 						sg.plan, event, old, new, diff.ReplaceKeys, diff.ChangedKeys, diff.DetailedDiff, false),
@@ -664,7 +701,11 @@ func (sg *stepGenerator) GenerateDeletes(targetsOpt map[resource.URN]bool) ([]St
 	// To compute the deletion list, we must walk the list of old resources *backwards*.  This is because the list is
 	// stored in dependency order, and earlier elements are possibly leaf nodes for later elements.  We must not delete
 	// dependencies prior to their dependent nodes.
+	//
+	// The set of deleted resources is shared by all of the delete steps and filled in once the final list of deletes
+	// is known, so that resources deleted along with another resource can tell whether it is actually being deleted.
 	var dels []Step
+	deleted := make(map[resource.URN]bool)
 	if prev := sg.plan.prev; prev != nil {
 		for i := len(prev.Resources) - 1; i >= 0; i-- {
 			// If this resource is explicitly marked for deletion or wasn't seen at all, delete it.
@@ -699,7 +740,7 @@ func (sg *stepGenerator) GenerateDeletes(targetsOpt map[resource.URN]bool) ([]St
 
 				logging.V(7).Infof("Planner decided to delete '%v' due to replacement", res.URN)
 				sg.deletes[res.URN] = true
-				dels = append(dels, NewDeleteReplacementStep(sg.plan, res, false))
+				dels = append(dels, NewDeleteReplacementStep(sg.plan, deleted, res, false, sg.goalRetryPolicy(res.URN)))
 			} else if _, aliased := sg.aliased[res.URN]; !sg.sames[res.URN] && !sg.updates[res.URN] && !sg.replaces[res.URN] &&
 				!sg.reads[res.URN] && !aliased {
 				// NOTE: we deliberately do not check sg.deletes here, as it is possible for us to issue multiple
@@ -707,7 +748,7 @@ func (sg *stepGenerator) GenerateDeletes(targetsOpt map[resource.URN]bool) ([]St
 				logging.V(7).Infof("Planner decided to delete '%v'", res.URN)
 				sg.deletes[res.URN] = true
 				if !res.PendingReplacement {
-					dels = append(dels, NewDeleteStep(sg.plan, res))
+					dels = append(dels, NewDeleteStep(sg.plan, deleted, res))
 				} else {
 					dels = append(dels, NewRemovePendingReplaceStep(sg.plan, res))
 				}
@@ -761,6 +802,12 @@ func (sg *stepGenerator) GenerateDeletes(targetsOpt map[resource.URN]bool) ([]St
 		return nil, result.Bail()
 	}
 
+	for _, step := range dels {
+		if _, isDelete := step.(*DeleteStep); isDelete {
+			deleted[step.URN()] = true
+		}
+	}
+
 	return dels, nil
 }
 
@@ -845,7 +892,7 @@ func (sg *stepGenerator) GeneratePendingDeletes() []Step {
 				logging.V(7).Infof(
 					"stepGenerator.GeneratePendingDeletes(): resource (%v, %v) is pending deletion", res.URN, res.ID)
 				sg.pendingDeletes[res] = true
-				dels = append(dels, NewDeleteStep(sg.plan, res))
+				dels = append(dels, NewDeleteStep(sg.plan, nil, res))
 			}
 		}
 	}
@@ -1085,7 +1132,7 @@ func issueCheckErrors(plan *Plan, new *resource.State, urn resource.URN, failure
 	for _, failure := range failures {
 		if failure.Property != "" {
 			plan.Diag().Errorf(diag.GetResourcePropertyInvalidValueError(urn),
-				new.Type, urn.Name(), failure.Property, inputs[failure.Property], failure.Reason)
+				new.Type, urn.Name(), failure.Property, checkFailureValue(inputs, failure.Property), failure.Reason)
 		} else {
 			plan.Diag().Errorf(
 				diag.GetResourceInvalidError(urn), new.Type, urn.Name(), failure.Reason)
@@ -1094,6 +1141,20 @@ func issueCheckErrors(plan *Plan, new *resource.State, urn resource.URN, failure
 	return true
 }
 
+// checkFailureValue returns the value of the input property named by a check failure. The property may be a top-level
+// property key or a property path. If the property does not exist, checkFailureValue returns a null value.
+func checkFailureValue(inputs resource.PropertyMap, property resource.PropertyKey) resource.PropertyValue {
+	if value, ok := inputs[property]; ok {
+		return value
+	}
+	if path, err := resource.ParsePropertyPath(string(property)); err == nil {
+		if value, ok := path.Get(resource.NewObjectProperty(inputs)); ok {
+			return value
+		}
+	}
+	return resource.NewNullProperty()
+}
+
 // processIgnoreChanges sets the value for each ignoreChanges property in inputs to the value from oldInputs.  This has
 // the effect of ensuring that no changes will be made for the corresponding property.
 func processIgnoreChanges(inputs, oldInputs resource.PropertyMap,
@@ -1372,5 +1433,6 @@ func newStepGenerator(
 		resourceStates:       make(map[resource.URN]*resource.State),
 		dependentReplaceKeys: make(map[resource.URN][]resource.PropertyKey),
 		aliased:              make(map[resource.URN]resource.URN),
+		validator:            newSchemaValidator(plan.ctx.Host, plan.Diag(), opts.SkipSchemaValidation),
 	}
 }
@@ -0,0 +1,166 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package edit
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/pulumi/pulumi/pkg/v2/resource/deploy"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/contract"
+)
+
+// PendingOperationAction is a way of resolving an operation that was left pending by an interrupted update.
+type PendingOperationAction string
+
+const (
+	// PendingOperationKeep records the operation's resource as existing with a given ID.
+	PendingOperationKeep PendingOperationAction = "keep"
+	// PendingOperationDiscard records the operation's resource as not existing, and removes it from the snapshot.
+	PendingOperationDiscard PendingOperationAction = "discard"
+	// PendingOperationRead records the operation's resource as existing and asks for it to be refreshed from its
+	// provider. If the provider reports that the resource does not exist, the refresh removes it from the snapshot.
+	PendingOperationRead PendingOperationAction = "read"
+)
+
+// PendingOperationResolution describes how to resolve a single pending operation.
+type PendingOperationResolution struct {
+	URN    resource.URN           // the URN of the pending operation's resource.
+	Type   resource.OperationType // the type of the pending operation.
+	Action PendingOperationAction // the action to take to resolve the operation.
+	// ID is the ID of the resource. It is required in order to keep or read a resource whose creation was pending,
+	// and otherwise overrides the resource's recorded ID.
+	ID resource.ID
+}
+
+// ResolvePendingOperations resolves each of a snapshot's pending operations using the given resolutions. It returns a
+// new snapshot with the operations resolved and no pending operations, along with the URNs of any resources that must
+// be refreshed in order to complete their resolution. The given snapshot is not modified. Every pending operation must
+// have a resolution.
+func ResolvePendingOperations(snap *deploy.Snapshot,
+	resolutions []PendingOperationResolution) (*deploy.Snapshot, []resource.URN, error) {
+
+	contract.Require(snap != nil, "snap")
+
+	resources := make([]*resource.State, len(snap.Resources))
+	copy(resources, snap.Resources)
+	resolved := deploy.NewSnapshot(snap.Manifest, snap.SecretsManager, resources, nil)
+
+	var reads []resource.URN
+	for _, op := range snap.PendingOperations {
+		resolution, ok := findPendingOperationResolution(op, resolutions)
+		if !ok {
+			return nil, nil, errors.Errorf("no resolution given for resource %s, interrupted while %s",
+				op.Resource.URN, op.Type)
+		}
+
+		switch resolution.Action {
+		case PendingOperationKeep, PendingOperationRead:
+			if err := keepPendingOperation(resolved, op, resolution.ID); err != nil {
+				return nil, nil, err
+			}
+			if resolution.Action == PendingOperationRead {
+				reads = append(reads, op.Resource.URN)
+			}
+		case PendingOperationDiscard:
+			if err := discardPendingOperation(resolved, op); err != nil {
+				return nil, nil, err
+			}
+		default:
+			return nil, nil, errors.Errorf("unknown resolution %q for resource %s", resolution.Action,
+				op.Resource.URN)
+		}
+	}
+
+	return resolved, reads, nil
+}
+
+// findPendingOperationResolution returns the resolution for the given pending operation, if any.
+func findPendingOperationResolution(op resource.Operation,
+	resolutions []PendingOperationResolution) (PendingOperationResolution, bool) {
+
+	for _, resolution := range resolutions {
+		if resolution.URN == op.Resource.URN && resolution.Type == op.Type {
+			return resolution, true
+		}
+	}
+	return PendingOperationResolution{}, false
+}
+
+// findPendingOperationResource returns the index of the resource in the snapshot that the given pending operation was
+// operating upon, or -1 if the resource is not present in the snapshot.
+func findPendingOperationResource(snap *deploy.Snapshot, op resource.Operation) int {
+	switch op.Type {
+	case resource.OperationTypeCreating, resource.OperationTypeImporting, resource.OperationTypeReading:
+		// These operations add a new resource to the snapshot, so there is no existing resource to find.
+		return -1
+	}
+	for i, res := range snap.Resources {
+		if res.URN == op.Resource.URN && res.ID == op.Resource.ID {
+			return i
+		}
+	}
+	return -1
+}
+
+// keepPendingOperation records the resource for the given pending operation as existing with the given ID.
+func keepPendingOperation(snap *deploy.Snapshot, op resource.Operation, id resource.ID) error {
+	idx := findPendingOperationResource(snap, op)
+
+	// Determine the resource's ID. Resources that were being created do not yet have one.
+	if id == "" {
+		id = op.Resource.ID
+	}
+	if id == "" {
+		return errors.Errorf("an ID is required for resource %s, interrupted while %s", op.Resource.URN, op.Type)
+	}
+
+	// The resource being deleted is still present in the snapshot, so there is nothing more to do than to update its
+	// ID if it has changed.
+	if op.Type == resource.OperationTypeDeleting && idx != -1 {
+		state := *snap.Resources[idx]
+		state.ID = id
+		snap.Resources[idx] = &state
+		return nil
+	}
+
+	// Otherwise, record the state that the operation would have produced. If the operation did not get as far as
+	// producing outputs, use the resource's previous outputs, if any, or its inputs.
+	state := *op.Resource
+	state.ID = id
+	if len(state.Outputs) == 0 {
+		if idx != -1 && len(snap.Resources[idx].Outputs) != 0 {
+			state.Outputs = snap.Resources[idx].Outputs
+		} else {
+			state.Outputs = state.Inputs.Copy()
+		}
+	}
+
+	if idx != -1 {
+		snap.Resources[idx] = &state
+	} else {
+		snap.Resources = append(snap.Resources, &state)
+	}
+	return nil
+}
+
+// discardPendingOperation records the resource for the given pending operation as not existing.
+func discardPendingOperation(snap *deploy.Snapshot, op resource.Operation) error {
+	idx := findPendingOperationResource(snap, op)
+	if idx == -1 {
+		return nil
+	}
+	return DeleteResource(snap, snap.Resources[idx])
+}
@@ -0,0 +1,162 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package edit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+)
+
+func pendingOperation(res *resource.State, typ resource.OperationType) resource.Operation {
+	state := *res
+	return resource.Operation{Resource: &state, Type: typ}
+}
+
+func TestResolvePendingCreate(t *testing.T) {
+	pA := NewProviderResource("a", "p1", "0")
+	a := NewResource("a", pA)
+	a.Inputs = resource.PropertyMap{"foo": resource.NewStringProperty("bar")}
+
+	// Keeping a resource that was being created requires an ID.
+	snap := NewSnapshot([]*resource.State{pA})
+	snap.PendingOperations = []resource.Operation{pendingOperation(a, resource.OperationTypeCreating)}
+	_, _, err := ResolvePendingOperations(snap, []PendingOperationResolution{
+		{URN: a.URN, Type: resource.OperationTypeCreating, Action: PendingOperationKeep},
+	})
+	assert.Error(t, err)
+
+	// Given an ID, the resource is added to the snapshot with its inputs as its outputs.
+	resolved, reads, err := ResolvePendingOperations(snap, []PendingOperationResolution{
+		{URN: a.URN, Type: resource.OperationTypeCreating, Action: PendingOperationKeep, ID: "a-id"},
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, reads)
+	assert.Empty(t, resolved.PendingOperations)
+	if assert.Len(t, resolved.Resources, 2) {
+		assert.Equal(t, a.URN, resolved.Resources[1].URN)
+		assert.Equal(t, resource.ID("a-id"), resolved.Resources[1].ID)
+		assert.Equal(t, a.Inputs, resolved.Resources[1].Outputs)
+	}
+	assert.NoError(t, resolved.VerifyIntegrity())
+
+	// The original snapshot is left untouched.
+	assert.Len(t, snap.PendingOperations, 1)
+	assert.Equal(t, []*resource.State{pA}, snap.Resources)
+
+	// Reading the resource adds it to the snapshot and requests that it be refreshed.
+	resolved, reads, err = ResolvePendingOperations(snap, []PendingOperationResolution{
+		{URN: a.URN, Type: resource.OperationTypeCreating, Action: PendingOperationRead, ID: "a-id"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []resource.URN{a.URN}, reads)
+	assert.Len(t, resolved.Resources, 2)
+
+	// Discarding the resource leaves the snapshot as it was.
+	resolved, reads, err = ResolvePendingOperations(snap, []PendingOperationResolution{
+		{URN: a.URN, Type: resource.OperationTypeCreating, Action: PendingOperationDiscard},
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, reads)
+	assert.Empty(t, resolved.PendingOperations)
+	assert.Equal(t, []*resource.State{pA}, resolved.Resources)
+}
+
+func TestResolvePendingUpdate(t *testing.T) {
+	pA := NewProviderResource("a", "p1", "0")
+	a := NewResource("a", pA)
+	a.ID = "a-id"
+	a.Outputs = resource.PropertyMap{"foo": resource.NewStringProperty("old")}
+
+	pending := pendingOperation(a, resource.OperationTypeUpdating)
+	pending.Resource.Inputs = resource.PropertyMap{"foo": resource.NewStringProperty("new")}
+	pending.Resource.Outputs = nil
+
+	// Keeping the update records the new inputs, but retains the old outputs.
+	snap := NewSnapshot([]*resource.State{pA, a})
+	snap.PendingOperations = []resource.Operation{pending}
+	resolved, _, err := ResolvePendingOperations(snap, []PendingOperationResolution{
+		{URN: a.URN, Type: resource.OperationTypeUpdating, Action: PendingOperationKeep},
+	})
+	assert.NoError(t, err)
+	if assert.Len(t, resolved.Resources, 2) {
+		assert.Equal(t, resource.ID("a-id"), resolved.Resources[1].ID)
+		assert.Equal(t, pending.Resource.Inputs, resolved.Resources[1].Inputs)
+		assert.Equal(t, a.Outputs, resolved.Resources[1].Outputs)
+	}
+	assert.True(t, snap.Resources[1] == a)
+
+	// Discarding the resource removes it from the snapshot.
+	resolved, _, err = ResolvePendingOperations(snap, []PendingOperationResolution{
+		{URN: a.URN, Type: resource.OperationTypeUpdating, Action: PendingOperationDiscard},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []*resource.State{pA}, resolved.Resources)
+	assert.Equal(t, []*resource.State{pA, a}, snap.Resources)
+}
+
+func TestResolvePendingDelete(t *testing.T) {
+	pA := NewProviderResource("a", "p1", "0")
+	a := NewResource("a", pA)
+	a.ID = "a-id"
+
+	// Keeping the resource leaves it in the snapshot.
+	snap := NewSnapshot([]*resource.State{pA, a})
+	snap.PendingOperations = []resource.Operation{pendingOperation(a, resource.OperationTypeDeleting)}
+	resolved, _, err := ResolvePendingOperations(snap, []PendingOperationResolution{
+		{URN: a.URN, Type: resource.OperationTypeDeleting, Action: PendingOperationKeep},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []*resource.State{pA, a}, resolved.Resources)
+
+	// Keeping the resource with a new ID does not modify the original state.
+	resolved, _, err = ResolvePendingOperations(snap, []PendingOperationResolution{
+		{URN: a.URN, Type: resource.OperationTypeDeleting, Action: PendingOperationKeep, ID: "new-id"},
+	})
+	assert.NoError(t, err)
+	if assert.Len(t, resolved.Resources, 2) {
+		assert.Equal(t, resource.ID("new-id"), resolved.Resources[1].ID)
+	}
+	assert.Equal(t, resource.ID("a-id"), a.ID)
+
+	// Discarding the resource completes its deletion.
+	resolved, _, err = ResolvePendingOperations(snap, []PendingOperationResolution{
+		{URN: a.URN, Type: resource.OperationTypeDeleting, Action: PendingOperationDiscard},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []*resource.State{pA}, resolved.Resources)
+}
+
+func TestResolvePendingMissingResolution(t *testing.T) {
+	pA := NewProviderResource("a", "p1", "0")
+	a := NewResource("a", pA)
+	a.ID = "a-id"
+	b := NewResource("b", pA)
+	b.ID = "b-id"
+
+	snap := NewSnapshot([]*resource.State{pA, a, b})
+	snap.PendingOperations = []resource.Operation{
+		pendingOperation(a, resource.OperationTypeUpdating),
+		pendingOperation(b, resource.OperationTypeDeleting),
+	}
+	_, _, err := ResolvePendingOperations(snap, []PendingOperationResolution{
+		{URN: a.URN, Type: resource.OperationTypeUpdating, Action: PendingOperationKeep},
+		{URN: b.URN, Type: resource.OperationTypeUpdating, Action: PendingOperationKeep},
+	})
+	assert.Error(t, err)
+	assert.Len(t, snap.PendingOperations, 2)
+}
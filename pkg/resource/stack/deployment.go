@@ -287,6 +287,8 @@ func SerializeResource(res *resource.State, enc config.Encrypter) (apitype.Resou
 		AdditionalSecretOutputs: res.AdditionalSecretOutputs,
 		Aliases:                 res.Aliases,
 		ImportID:                res.ImportID,
+		RetainOnDelete:          res.RetainOnDelete,
+		DeletedWith:             res.DeletedWith,
 	}
 
 	if res.CustomTimeouts.IsNotEmpty() {
@@ -412,7 +414,7 @@ func DeserializeResource(res apitype.ResourceV3, dec config.Decrypter) (*resourc
 		res.Type, res.URN, res.Custom, res.Delete, res.ID,
 		inputs, outputs, res.Parent, res.Protect, res.External, res.Dependencies, res.InitErrors, res.Provider,
 		res.PropertyDependencies, res.PendingReplacement, res.AdditionalSecretOutputs, res.Aliases, res.CustomTimeouts,
-		res.ImportID), nil
+		res.ImportID, nil, res.RetainOnDelete, res.DeletedWith), nil
 }
 
 func DeserializeOperation(op apitype.OperationV2, dec config.Decrypter) (resource.Operation, error) {
@@ -85,6 +85,9 @@ func TestDeploymentSerialization(t *testing.T) {
 		nil,
 		nil,
 		"",
+		nil,
+		false,
+		"",
 	)
 
 	dep, err := SerializeResource(res, config.NopEncrypter)
@@ -0,0 +1,101 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package integration
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/contract"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/rpcutil"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+// FakeProviders serves a set of in-process providers over gRPC so that a `pulumi` process may attach to them in place
+// of the real provider plugins for their packages.
+type FakeProviders struct {
+	ports  map[tokens.Package]int // the port on which each package's provider is listening.
+	cancel chan bool              // closed to shut down the providers' servers.
+	done   []chan error           // the channels on which each server reports its completion.
+}
+
+// StartFakeProviders starts serving the given providers, keyed by package name. The returned FakeProviders must be
+// closed once the providers are no longer needed.
+func StartFakeProviders(providers map[tokens.Package]plugin.Provider) (*FakeProviders, error) {
+	fp := &FakeProviders{
+		ports:  make(map[tokens.Package]int),
+		cancel: make(chan bool),
+	}
+	for pkg, provider := range providers {
+		server := plugin.NewProviderServer(provider)
+		port, done, err := rpcutil.Serve(0, fp.cancel, []func(*grpc.Server) error{
+			func(srv *grpc.Server) error {
+				pulumirpc.RegisterResourceProviderServer(srv, server)
+				return nil
+			},
+		}, nil)
+		if err != nil {
+			contract.IgnoreError(fp.Close())
+			return nil, errors.Wrapf(err, "serving fake provider for package %v", pkg)
+		}
+		fp.ports[pkg] = port
+		fp.done = append(fp.done, done)
+	}
+	return fp, nil
+}
+
+// Port returns the port on which the fake provider for the given package is listening, or 0 if there is no fake
+// provider for the package.
+func (fp *FakeProviders) Port(pkg tokens.Package) int {
+	return fp.ports[pkg]
+}
+
+// Env returns the environment variables that direct a `pulumi` process to attach to the fake providers.
+func (fp *FakeProviders) Env() []string {
+	if len(fp.ports) == 0 {
+		return nil
+	}
+
+	attach := make([]string, 0, len(fp.ports))
+	for pkg, port := range fp.ports {
+		attach = append(attach, fmt.Sprintf("%s:%d", pkg, port))
+	}
+	sort.Strings(attach)
+	return []string{fmt.Sprintf("%s=%s", plugin.AttachProvidersEnvVar, strings.Join(attach, ","))}
+}
+
+// Close shuts down the fake providers' servers and waits for them to exit.
+func (fp *FakeProviders) Close() error {
+	if fp.cancel == nil {
+		return nil
+	}
+	close(fp.cancel)
+	fp.cancel = nil
+
+	var result error
+	for _, done := range fp.done {
+		if err := <-done; err != nil && result == nil {
+			result = err
+		}
+	}
+	fp.done = nil
+	return result
+}
@@ -0,0 +1,122 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package integration
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/pkg/v2/resource/deploy/deploytest"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/diag/colors"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/contract"
+)
+
+func TestFakeProvidersEnv(t *testing.T) {
+	fakes, err := StartFakeProviders(map[tokens.Package]plugin.Provider{
+		"pkgA": &deploytest.Provider{},
+		"pkgB": &deploytest.Provider{},
+	})
+	assert.NoError(t, err)
+	defer contract.IgnoreClose(fakes)
+
+	expected := fmt.Sprintf("%s=pkgA:%d,pkgB:%d", plugin.AttachProvidersEnvVar, fakes.Port("pkgA"), fakes.Port("pkgB"))
+	assert.Equal(t, []string{expected}, fakes.Env())
+	assert.Equal(t, 0, fakes.Port("pkgC"))
+}
+
+// Test that a plugin host attaches to a fake provider rather than launching a provider plugin.
+func TestFakeProvidersAttach(t *testing.T) {
+	var created resource.PropertyMap
+	fakes, err := StartFakeProviders(map[tokens.Package]plugin.Provider{
+		"fake": &deploytest.Provider{
+			CreateF: func(urn resource.URN, news resource.PropertyMap,
+				timeout float64) (resource.ID, resource.PropertyMap, resource.Status, error) {
+
+				created = news
+				return "created-id", resource.PropertyMap{"out": resource.NewStringProperty("baz")}, resource.StatusOK, nil
+			},
+			GetLogsF: func(urn resource.URN, id resource.ID, state resource.PropertyMap, startTime, endTime *time.Time,
+				onNext func(plugin.LogEntry) error) error {
+
+				if id != "created-id" {
+					return plugin.ErrLogsNotSupported
+				}
+				for _, message := range []string{"first", "second"} {
+					entry := plugin.LogEntry{ID: string(id), Timestamp: startTime.Unix() * 1000, Message: message}
+					if err := onNext(entry); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+		},
+	})
+	assert.NoError(t, err)
+	defer contract.IgnoreClose(fakes)
+
+	old, hadOld := os.LookupEnv(plugin.AttachProvidersEnvVar)
+	contract.AssertNoError(os.Setenv(plugin.AttachProvidersEnvVar, fmt.Sprintf("fake:%d", fakes.Port("fake"))))
+	defer func() {
+		if hadOld {
+			contract.IgnoreError(os.Setenv(plugin.AttachProvidersEnvVar, old))
+		} else {
+			contract.IgnoreError(os.Unsetenv(plugin.AttachProvidersEnvVar))
+		}
+	}()
+
+	sink := diag.DefaultSink(ioutil.Discard, ioutil.Discard, diag.FormatOptions{Color: colors.Never})
+	ctx, err := plugin.NewContext(sink, sink, nil, nil, "", nil, nil)
+	assert.NoError(t, err)
+	defer contract.IgnoreClose(ctx)
+
+	prov, err := ctx.Host.Provider("fake", nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.NoError(t, prov.Configure(resource.PropertyMap{}))
+
+	urn := resource.NewURN("stack", "project", "", "fake:index:Resource", "res")
+	id, outs, status, err := prov.Create(urn, resource.PropertyMap{"foo": resource.NewStringProperty("bar")}, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, resource.StatusOK, status)
+	assert.Equal(t, resource.ID("created-id"), id)
+	assert.Equal(t, resource.PropertyMap{"out": resource.NewStringProperty("baz")}, outs)
+	assert.Equal(t, resource.PropertyMap{"foo": resource.NewStringProperty("bar")}, created)
+
+	// Logs are streamed back from the fake provider, and unsupported queries are reported as such.
+	var logs []plugin.LogEntry
+	startTime := time.Unix(42, 0)
+	err = prov.GetLogs(urn, id, outs, &startTime, nil, func(entry plugin.LogEntry) error {
+		logs = append(logs, entry)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []plugin.LogEntry{
+		{ID: "created-id", Timestamp: 42000, Message: "first"},
+		{ID: "created-id", Timestamp: 42000, Message: "second"},
+	}, logs)
+
+	err = prov.GetLogs(urn, "other-id", outs, nil, nil, func(plugin.LogEntry) error { return nil })
+	assert.Equal(t, plugin.ErrLogsNotSupported, err)
+}
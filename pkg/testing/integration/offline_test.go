@@ -0,0 +1,191 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package integration
+
+import (
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/pkg/v2/resource/deploy/deploytest"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/fsutil"
+)
+
+// opLog records the mutating operations that a fake provider performs.
+type opLog struct {
+	m     sync.Mutex
+	ops   []string
+	diffs int
+}
+
+func (l *opLog) record(op string, urn resource.URN) {
+	l.m.Lock()
+	defer l.m.Unlock()
+	l.ops = append(l.ops, op+":"+string(urn.Name()))
+}
+
+func (l *opLog) diffed() {
+	l.m.Lock()
+	defer l.m.Unlock()
+	l.diffs++
+}
+
+// take returns the operations and the number of diffs recorded so far and resets the log.
+func (l *opLog) take() ([]string, int) {
+	l.m.Lock()
+	defer l.m.Unlock()
+	ops, diffs := l.ops, l.diffs
+	l.ops, l.diffs = nil, 0
+	return ops, diffs
+}
+
+// newRecordingProvider returns a fake provider that echoes its inputs as outputs, replaces resources whose "key"
+// property changes, and records each operation in the given log.
+func newRecordingProvider(log *opLog) plugin.Provider {
+	return &deploytest.Provider{
+		DiffF: func(urn resource.URN, id resource.ID, olds, news resource.PropertyMap,
+			ignoreChanges []string) (plugin.DiffResult, error) {
+
+			log.diffed()
+			diff := olds.Diff(news)
+			if diff == nil {
+				return plugin.DiffResult{Changes: plugin.DiffNone}, nil
+			}
+			result := plugin.DiffResult{Changes: plugin.DiffSome}
+			if diff.Changed("key") {
+				result.ReplaceKeys = []resource.PropertyKey{"key"}
+			}
+			return result, nil
+		},
+		CreateF: func(urn resource.URN, inputs resource.PropertyMap,
+			timeout float64) (resource.ID, resource.PropertyMap, resource.Status, error) {
+
+			log.record("create", urn)
+			return resource.ID(urn.Name()), inputs, resource.StatusOK, nil
+		},
+		UpdateF: func(urn resource.URN, id resource.ID, olds, news resource.PropertyMap, timeout float64,
+			ignoreChanges []string) (resource.PropertyMap, resource.Status, error) {
+
+			log.record("update", urn)
+			return news, resource.StatusOK, nil
+		},
+		DeleteF: func(urn resource.URN, id resource.ID, olds resource.PropertyMap,
+			timeout float64) (resource.Status, error) {
+
+			log.record("delete", urn)
+			return resource.StatusOK, nil
+		},
+		ReadF: func(urn resource.URN, id resource.ID,
+			inputs, state resource.PropertyMap) (plugin.ReadResult, resource.Status, error) {
+
+			return plugin.ReadResult{Inputs: inputs, Outputs: state}, resource.StatusOK, nil
+		},
+	}
+}
+
+// TestOfflineLifeCycle drives a program through preview, update, diff, replace, targeted update, and delete against
+// a local backend and fake providers.
+func TestOfflineLifeCycle(t *testing.T) {
+	if _, err := exec.LookPath("pulumi"); err != nil {
+		t.Skip("the pulumi CLI is required to run offline program tests")
+	}
+
+	var log opLog
+	var initialOps, editOps []string
+	var initialDiffs int
+	pt := ProgramTestManualLifeCycle(t, &ProgramTestOptions{
+		Dir:          filepath.Join("testdata", "offline", "step1"),
+		Dependencies: []string{"@pulumi/pulumi"},
+		Offline:      true,
+		FakeProviders: map[tokens.Package]plugin.Provider{
+			"fake": newRecordingProvider(&log),
+		},
+		StackName: "offline",
+		ExtraRuntimeValidation: func(t *testing.T, stack RuntimeValidationStackInfo) {
+			initialOps, initialDiffs = log.take()
+		},
+		EditDirs: []EditDir{{
+			Dir:      filepath.Join("testdata", "offline", "step2"),
+			Additive: true,
+			ExtraRuntimeValidation: func(t *testing.T, stack RuntimeValidationStackInfo) {
+				editOps, _ = log.take()
+			},
+		}},
+	})
+
+	err := pt.TestLifeCyclePrepare()
+	if !assert.NoError(t, err) {
+		return
+	}
+	pt.TestFinished = false
+	defer pt.TestCleanUp()
+
+	err = pt.TestLifeCycleInitialize()
+	if !assert.NoError(t, err) {
+		return
+	}
+	destroyed := false
+	defer func() {
+		if !destroyed {
+			assert.NoError(t, pt.TestLifeCycleDestroy())
+		}
+	}()
+
+	err = pt.TestPreviewUpdateAndEdits()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	// The initial update creates every resource, and the empty preview and update diff them without changes.
+	assert.ElementsMatch(t, []string{"create:a", "create:b", "create:c"}, initialOps)
+	assert.NotZero(t, initialDiffs)
+
+	// The edit updates a, replaces b (creating the new resource before deleting the old), and deletes c.
+	assert.ElementsMatch(t, []string{"update:a", "create:b", "delete:b", "delete:c"}, editOps)
+
+	// A targeted update only touches the targeted resource.
+	err = fsutil.CopyFile(pt.projdir, filepath.Join("testdata", "offline", "step3"), nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	urn := resource.NewURN("offline", "offline", "", "fake:index:Resource", "a")
+	err = pt.runPulumiCommand("pulumi-update-targeted",
+		[]string{"up", "--non-interactive", "--yes", "--skip-preview", "--target", string(urn)}, pt.projdir, false)
+	if !assert.NoError(t, err) {
+		return
+	}
+	ops, _ := log.take()
+	assert.Equal(t, []string{"update:a"}, ops)
+
+	// Destroying the stack deletes the remaining resources.
+	destroyed = true
+	assert.NoError(t, pt.TestLifeCycleDestroy())
+	ops, _ = log.take()
+	assert.ElementsMatch(t, []string{"delete:a", "delete:b"}, ops)
+
+	pt.TestFinished = true
+}
+
+func TestOfflineRejectsCloudURL(t *testing.T) {
+	pt := newProgramTester(t, &ProgramTestOptions{Offline: true, CloudURL: "https://api.pulumi.com"})
+	err := pt.TestLifeCycleInitialize()
+	assert.EqualError(t, err, "offline tests may not specify a CloudURL")
+}
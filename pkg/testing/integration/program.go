@@ -45,6 +45,7 @@ import (
 	"github.com/pulumi/pulumi/sdk/v2/go/common/apitype"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/config"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
 	pulumi_testing "github.com/pulumi/pulumi/sdk/v2/go/common/testing"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/tokens"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/tools"
@@ -60,6 +61,9 @@ const NodeJSRuntime = "nodejs"
 const GoRuntime = "go"
 const DotNetRuntime = "dotnet"
 
+// offlinePassphrase is the passphrase used for the secrets of offline test stacks if none is otherwise configured.
+const offlinePassphrase = "pulumi-offline-test"
+
 // RuntimeValidationStackInfo contains details related to the stack that runtime validation logic may want to use.
 type RuntimeValidationStackInfo struct {
 	StackName    tokens.QName
@@ -208,6 +212,16 @@ type ProgramTestOptions struct {
 	// PULUMI_ACCESS_TOKEN environment variable must also be set to a valid access token for the target cloud.
 	CloudURL string
 
+	// Offline runs the test against a local filestate backend in a temporary directory rather than the Pulumi
+	// Service, so that the test needs neither cloud credentials nor network access. If PULUMI_CONFIG_PASSPHRASE is not
+	// set, a fixed passphrase is used for the test stack's secrets. Offline may not be combined with CloudURL.
+	Offline bool
+	// FakeProviders maps package names to in-process providers to use in place of the real provider plugins for those
+	// packages. The providers are served for the duration of the test, and the `pulumi` CLI attaches to them instead
+	// of launching (or installing) the real plugins. This is typically combined with Offline in order to exercise a
+	// program's full lifecycle without cloud access.
+	FakeProviders map[tokens.Package]plugin.Provider
+
 	// StackName allows the stack name to be explicitly provided instead of computed from the
 	// environment during tests.
 	StackName string
@@ -409,6 +423,12 @@ func (opts ProgramTestOptions) With(overrides ProgramTestOptions) ProgramTestOpt
 	if overrides.CloudURL != "" {
 		opts.CloudURL = overrides.CloudURL
 	}
+	if overrides.Offline {
+		opts.Offline = overrides.Offline
+	}
+	if overrides.FakeProviders != nil {
+		opts.FakeProviders = overrides.FakeProviders
+	}
 	if overrides.StackName != "" {
 		opts.StackName = overrides.StackName
 	}
@@ -576,7 +596,7 @@ func prepareProgram(t *testing.T, opts *ProgramTestOptions) {
 // ProgramTest runs a lifecycle of Pulumi commands in a program working directory, using the `pulumi` and `yarn`
 // binaries available on PATH.  It essentially executes the following workflow:
 //
-//   yarn install
+//   pulumi install
 //   yarn link <each opts.Depencies>
 //   (+) yarn run build
 //   pulumi init
@@ -634,6 +654,8 @@ type ProgramTester struct {
 	maxStepTries int                 // The maximum number of times to retry a failed pulumi step.
 	tmpdir       string              // the temporary directory we use for our test environment
 	projdir      string              // the project directory we use for this run
+	backendDir   string              // the local backend directory we use for offline runs
+	fakes        *FakeProviders      // the fake providers we are serving, if any
 	TestFinished bool                // whether or not the test if finished
 }
 
@@ -656,6 +678,16 @@ func newProgramTester(t *testing.T, opts *ProgramTestOptions) *ProgramTester {
 	}
 }
 
+// hasEnv returns true if the test's additional environment variables include the given variable.
+func (pt *ProgramTester) hasEnv(name string) bool {
+	for _, env := range pt.opts.Env {
+		if strings.HasPrefix(env, name+"=") {
+			return true
+		}
+	}
+	return false
+}
+
 func (pt *ProgramTester) getBin() (string, error) {
 	return getCmdBin(&pt.bin, "pulumi", pt.opts.Bin)
 }
@@ -736,8 +768,9 @@ func (pt *ProgramTester) runPulumiCommand(name string, args []string, wd string,
 	// If we're doing a preview or an update and this project is a Python project, we need to run
 	// the command in the context of the virtual environment that Pipenv created in order to pick up
 	// the correct version of Python.  We also need to do this for destroy and refresh so that
-	// dynamic providers are run in the right virtual environment.
-	if isUpdate {
+	// dynamic providers are run in the right virtual environment, and for install so that the
+	// program's dependencies are installed into that virtual environment.
+	if isUpdate || args[0] == "install" {
 		projinfo, err := pt.getProjinfo(wd)
 		if err != nil {
 			return nil
@@ -870,6 +903,14 @@ func (pt *ProgramTester) TestLifeCyclePrepare() error {
 // TestCleanUp cleans up the temporary directory that a test used
 func (pt *ProgramTester) TestCleanUp() {
 	testFinished := pt.TestFinished
+	if pt.fakes != nil {
+		contract.IgnoreError(pt.fakes.Close())
+		pt.fakes = nil
+	}
+	if pt.backendDir != "" {
+		contract.IgnoreError(os.RemoveAll(pt.backendDir))
+		pt.backendDir = ""
+	}
 	if pt.tmpdir != "" {
 		if !testFinished || pt.t.Failed() {
 			// Test aborted or failed. Maybe copy to "failed tests" directory.
@@ -963,6 +1004,32 @@ func (pt *ProgramTester) TestLifeCycleInitialize() error {
 		dir = filepath.Join(dir, pt.opts.RelativeWorkDir)
 	}
 
+	// Offline tests use a fresh local backend rather than the Pulumi Service.
+	if pt.opts.Offline {
+		if pt.opts.CloudURL != "" {
+			return errors.New("offline tests may not specify a CloudURL")
+		}
+		backendDir, err := ioutil.TempDir("", "pulumi-backend-")
+		if err != nil {
+			return errors.Wrap(err, "creating local backend directory")
+		}
+		pt.backendDir = backendDir
+		pt.opts.CloudURL = "file://" + filepath.ToSlash(backendDir)
+		if os.Getenv("PULUMI_CONFIG_PASSPHRASE") == "" && !pt.hasEnv("PULUMI_CONFIG_PASSPHRASE") {
+			pt.opts.Env = append(pt.opts.Env, "PULUMI_CONFIG_PASSPHRASE="+offlinePassphrase)
+		}
+	}
+
+	// Serve any fake providers so that the CLI attaches to them.
+	if len(pt.opts.FakeProviders) > 0 {
+		fakes, err := StartFakeProviders(pt.opts.FakeProviders)
+		if err != nil {
+			return errors.Wrap(err, "starting fake providers")
+		}
+		pt.fakes = fakes
+		pt.opts.Env = append(pt.opts.Env, fakes.Env()...)
+	}
+
 	// Set the default target Pulumi API if not overridden in options.
 	if pt.opts.CloudURL == "" {
 		pulumiAPI := os.Getenv("PULUMI_API")
@@ -1567,8 +1634,18 @@ func (pt *ProgramTester) prepareNodeJSProject(projinfo *engine.Projinfo) error {
 		}
 	}
 
-	// Now ensure dependencies are present.
-	if err = pt.runYarnCommand("yarn-install", []string{"install"}, cwd); err != nil {
+	// Now ensure dependencies are present. `pulumi install` runs `npm install` unless yarn is preferred, so ask it to
+	// use the same `yarn` binary that we use to link and build the project.
+	if !pt.hasEnv("PULUMI_PREFER_YARN") {
+		yarnBin, err := pt.getYarnBin()
+		if err != nil {
+			return errors.Wrap(err, "locating `yarn` binary")
+		}
+		pt.opts.Env = append(pt.opts.Env,
+			"PULUMI_PREFER_YARN=true",
+			"PATH="+filepath.Dir(yarnBin)+string(os.PathListSeparator)+os.Getenv("PATH"))
+	}
+	if err = pt.runPulumiCommand("pulumi-install", []string{"install"}, cwd, false); err != nil {
 		return err
 	}
 
@@ -1640,11 +1717,10 @@ func (pt *ProgramTester) preparePythonProject(projinfo *engine.Projinfo) error {
 		return err
 	}
 
-	// Install the package's dependencies. We do this by running `pip` inside the virtualenv that `pipenv` has created.
-	// We don't use `pipenv install` because we don't want a lock file and prefer the similar model of `pip install`
-	// which matches what our customers do
-	err = pt.runPipenvCommand("pipenv-install", []string{"run", "pip", "install", "-r", "requirements.txt"}, cwd)
-	if err != nil {
+	// Install the package's dependencies. We do this by running `pulumi install` inside the virtualenv that `pipenv`
+	// has created, which in turn runs `pip install`. We don't use `pipenv install` because we don't want a lock file
+	// and prefer the similar model of `pip install` which matches what our customers do.
+	if err = pt.runPulumiCommand("pulumi-install", []string{"install"}, cwd, false); err != nil {
 		return err
 	}
 
@@ -1753,8 +1829,7 @@ func (pt *ProgramTester) prepareGoProject(projinfo *engine.Projinfo) error {
 	}
 
 	// resolve dependencies
-	err = pt.runCommand("go-mod-download", []string{goBin, "mod", "download"}, cwd)
-	if err != nil {
+	if err = pt.runPulumiCommand("pulumi-install", []string{"install"}, cwd, false); err != nil {
 		return err
 	}
 
@@ -1817,5 +1892,6 @@ func (pt *ProgramTester) prepareDotNetProject(projinfo *engine.Projinfo) error {
 		}
 	}
 
-	return nil
+	// Restore the project's dependencies and build it.
+	return pt.runPulumiCommand("pulumi-install", []string{"install"}, cwd, false)
 }
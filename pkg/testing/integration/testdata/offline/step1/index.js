@@ -0,0 +1,7 @@
+// Copyright 2016-2020, Pulumi Corporation.  All rights reserved.
+
+const { Resource } = require("./resource");
+
+new Resource("a", { key: "a", value: "1" });
+new Resource("b", { key: "b", value: "1" });
+new Resource("c", { key: "c", value: "1" });
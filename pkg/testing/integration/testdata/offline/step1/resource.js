@@ -0,0 +1,12 @@
+// Copyright 2016-2020, Pulumi Corporation.  All rights reserved.
+
+const pulumi = require("@pulumi/pulumi");
+
+// Resource is a custom resource served by the test's fake "fake" provider.
+class Resource extends pulumi.CustomResource {
+    constructor(name, args, opts) {
+        super("fake:index:Resource", name, args, opts);
+    }
+}
+
+exports.Resource = Resource;
@@ -0,0 +1,7 @@
+// Copyright 2016-2020, Pulumi Corporation.  All rights reserved.
+
+const { Resource } = require("./resource");
+
+// Update a, replace b by changing its key, and delete c.
+new Resource("a", { key: "a", value: "2" });
+new Resource("b", { key: "b2", value: "1" });
@@ -0,0 +1,7 @@
+// Copyright 2016-2020, Pulumi Corporation.  All rights reserved.
+
+const { Resource } = require("./resource");
+
+// Change both resources; the test only targets a.
+new Resource("a", { key: "a", value: "3" });
+new Resource("b", { key: "b2", value: "3" });
@@ -49,10 +49,12 @@ readonly PULUMI_ROOT=$PWD
 
 # Build binaries
 run_go_build "${PULUMI_ROOT}/pkg/cmd/pulumi" "pkg"
+run_go_build "${PULUMI_ROOT}/pkg/cmd/pulumi-language-pcl" "pkg"
 run_go_build "${PULUMI_ROOT}/sdk/nodejs/cmd/pulumi-language-nodejs" "sdk"
 run_go_build "${PULUMI_ROOT}/sdk/python/cmd/pulumi-language-python" "sdk"
 run_go_build "${PULUMI_ROOT}/sdk/dotnet/cmd/pulumi-language-dotnet" "sdk"
 run_go_build "${PULUMI_ROOT}/sdk/go/pulumi-language-go" "sdk"
+run_go_build "${PULUMI_ROOT}/sdk/go/pulumi-resource-pulumi-go" "sdk"
 
 # Copy over the language and dynamic resource providers.
 cp "${ROOT}/sdk/nodejs/dist/pulumi-resource-pulumi-nodejs" "${PUBDIR}/bin/"
@@ -0,0 +1,58 @@
+// Copyright 2016-2020, Pulumi Corporation
+
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Testing;
+using Xunit;
+
+namespace Pulumi.Tests.Mocks
+{
+    class ComponentMocks : IMocks
+    {
+        public readonly Dictionary<string, ImmutableDictionary<string, object>> Inputs =
+            new Dictionary<string, ImmutableDictionary<string, object>>();
+
+        public Task<object> CallAsync(string token, ImmutableDictionary<string, object> args, string? provider)
+        {
+            return Task.FromResult<object>(args);
+        }
+
+        public Task<(string id, object state)> NewResourceAsync(string type, string name, ImmutableDictionary<string, object> inputs, string? provider, string? id)
+        {
+            lock (Inputs)
+            {
+                Inputs[name] = inputs;
+            }
+            return Task.FromResult<(string, object)>(("", new Dictionary<string, object>()));
+        }
+    }
+
+    public sealed class WidgetArgs : ResourceArgs
+    {
+        [Input("size")]
+        public Input<int>? Size { get; set; }
+    }
+
+    public class ComponentStack : Stack
+    {
+        public ComponentStack()
+        {
+            new ComponentResource("test:index:Widget", "local", new WidgetArgs { Size = 3 });
+            new ComponentResource("test:index:Widget", "remote", new WidgetArgs { Size = 3 }, remote: true);
+        }
+    }
+
+    public class ComponentResourceTests
+    {
+        [Fact]
+        public async Task TestArgsAreSentOnlyForRemoteComponents()
+        {
+            var mocks = new ComponentMocks();
+            await Deployment.TestAsync<ComponentStack>(mocks, new TestOptions { IsPreview = false });
+
+            Assert.Empty(mocks.Inputs["local"]);
+            Assert.Equal(3.0, mocks.Inputs["remote"]["size"]);
+        }
+    }
+}
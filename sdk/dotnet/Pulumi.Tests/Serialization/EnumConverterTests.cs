@@ -0,0 +1,116 @@
+﻿// Copyright 2016-2020, Pulumi Corporation
+
+using System;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Google.Protobuf.WellKnownTypes;
+using Pulumi.Serialization;
+using Xunit;
+
+namespace Pulumi.Tests.Serialization
+{
+    public class EnumConverterTests : ConverterTests
+    {
+        [EnumType]
+        public readonly struct ContainerColor : IEquatable<ContainerColor>
+        {
+            private readonly string _value;
+
+            private ContainerColor(string value)
+            {
+                _value = value ?? throw new ArgumentNullException(nameof(value));
+            }
+
+            public static ContainerColor Red { get; } = new ContainerColor("red");
+            public static ContainerColor Blue { get; } = new ContainerColor("blue");
+
+            public static bool operator ==(ContainerColor left, ContainerColor right) => left.Equals(right);
+            public static bool operator !=(ContainerColor left, ContainerColor right) => !left.Equals(right);
+
+            public static explicit operator string(ContainerColor value) => value._value;
+
+            public override bool Equals(object? obj) => obj is ContainerColor other && Equals(other);
+            public bool Equals(ContainerColor other) => string.Equals(_value, other._value, StringComparison.Ordinal);
+
+            public override int GetHashCode() => _value?.GetHashCode() ?? 0;
+
+            public override string ToString() => _value;
+        }
+
+        [EnumType]
+        public readonly struct ContainerSize : IEquatable<ContainerSize>
+        {
+            private readonly int _value;
+
+            private ContainerSize(int value)
+            {
+                _value = value;
+            }
+
+            public static ContainerSize FourInch { get; } = new ContainerSize(4);
+            public static ContainerSize SixInch { get; } = new ContainerSize(6);
+
+            public static bool operator ==(ContainerSize left, ContainerSize right) => left.Equals(right);
+            public static bool operator !=(ContainerSize left, ContainerSize right) => !left.Equals(right);
+
+            public static explicit operator int(ContainerSize value) => value._value;
+
+            public override bool Equals(object? obj) => obj is ContainerSize other && Equals(other);
+            public bool Equals(ContainerSize other) => _value == other._value;
+
+            public override int GetHashCode() => _value.GetHashCode();
+
+            public override string ToString() => _value.ToString();
+        }
+
+        [Fact]
+        public async Task SerializeStringEnum()
+        {
+            var value = await SerializeToValueAsync(ContainerColor.Blue);
+            Assert.Equal(Value.KindOneofCase.StringValue, value.KindCase);
+            Assert.Equal("blue", value.StringValue);
+        }
+
+        [Fact]
+        public async Task SerializeIntegerEnumInput()
+        {
+            var value = await SerializeToValueAsync((Input<ContainerSize>)ContainerSize.SixInch);
+            Assert.Equal(Value.KindOneofCase.NumberValue, value.KindCase);
+            Assert.Equal(6, value.NumberValue);
+        }
+
+        [Fact]
+        public void ConvertStringEnum()
+        {
+            var data = Converter.ConvertValue<ContainerColor>("", new Value { StringValue = "red" });
+            Assert.Equal(ContainerColor.Red, data.Value);
+            Assert.True(data.IsKnown);
+        }
+
+        [Fact]
+        public void ConvertIntegerEnumArray()
+        {
+            var data = Converter.ConvertValue<ImmutableArray<ContainerSize>>("", new Value
+            {
+                ListValue = new ListValue { Values = { new Value { NumberValue = 6 }, new Value { NumberValue = 4 } } },
+            });
+            Assert.Equal(new[] { ContainerSize.SixInch, ContainerSize.FourInch }, data.Value);
+        }
+
+        [Fact]
+        public void ConvertNullableEnum()
+        {
+            var data = Converter.ConvertValue<ContainerSize?>("", new Value { NullValue = NullValue.NullValue });
+            Assert.Null(data.Value);
+        }
+
+        [Fact]
+        public void MismatchedEnumValueThrows()
+        {
+            Assert.Throws<InvalidOperationException>(() =>
+            {
+                var data = Converter.ConvertValue<ContainerColor>("", new Value { NumberValue = 1 });
+            });
+        }
+    }
+}
@@ -15,11 +15,12 @@ namespace Pulumi
             var name = resource.GetResourceName();
             var type = resource.GetResourceType();
             var custom = resource is CustomResource;
+            var remote = resource._remote;
 
             var label = $"resource:{name}[{type}]";
-            Log.Debug($"Registering resource start: t={type}, name={name}, custom={custom}");
+            Log.Debug($"Registering resource start: t={type}, name={name}, custom={custom}, remote={remote}");
 
-            var request = CreateRegisterResourceRequest(type, name, custom, options);
+            var request = CreateRegisterResourceRequest(type, name, custom, remote, options);
 
             Log.Debug($"Preparing resource: t={type}, name={name}, custom={custom}");
             var prepareResult = await PrepareResourceAsync(label, resource, custom, args, options).ConfigureAwait(false);
@@ -49,7 +50,8 @@ namespace Pulumi
             }
         }
 
-        private static RegisterResourceRequest CreateRegisterResourceRequest(string type, string name, bool custom, ResourceOptions options)
+        private static RegisterResourceRequest CreateRegisterResourceRequest(
+            string type, string name, bool custom, bool remote, ResourceOptions options)
         {
             var customOpts = options as CustomResourceOptions;
             var deleteBeforeReplace = customOpts?.DeleteBeforeReplace;
@@ -59,6 +61,7 @@ namespace Pulumi
                 Type = type,
                 Name = name,
                 Custom = custom,
+                Remote = remote,
                 Protect = options.Protect ?? false,
                 Version = options.Version ?? "",
                 ImportId = customOpts?.ImportId ?? "",
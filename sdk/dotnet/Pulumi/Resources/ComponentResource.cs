@@ -30,6 +30,27 @@ namespace Pulumi
         {
         }
 
+        /// <summary>
+        /// Creates and registers a new component resource with the given <paramref name="args"/>.
+        /// If <paramref name="remote"/> is true, the component is constructed by its provider
+        /// rather than by this program, and its <see cref="OutputAttribute"/> properties are
+        /// resolved from the outputs that the provider registers for it. The <paramref name="args"/>
+        /// are sent to the provider only for remote components.
+        /// </summary>
+#pragma warning disable RS0022 // Constructor make noninheritable base class inheritable
+#pragma warning disable RS0026 // Do not add multiple public overloads with optional parameters
+        public ComponentResource(
+            string type, string name, ResourceArgs? args,
+            ComponentResourceOptions? options = null, bool remote = false)
+            : base(type, name, custom: false,
+                   remote ? args ?? ResourceArgs.Empty : ResourceArgs.Empty,
+                   options ?? new ComponentResourceOptions(),
+                   remote)
+#pragma warning restore RS0026 // Do not add multiple public overloads with optional parameters
+#pragma warning restore RS0022 // Constructor make noninheritable base class inheritable
+        {
+        }
+
         /// <summary>
         /// RegisterOutputs registers synthetic outputs that a component has initialized, usually by
         /// allocating other child sub-resources and propagating their resulting property values.
@@ -71,6 +71,12 @@ namespace Pulumi
         /// </summary>
         private readonly bool _protect;
 
+        /// <summary>
+        /// True if this is a component resource that is constructed by its provider rather than by
+        /// this program.
+        /// </summary>
+        internal readonly bool _remote;
+
         /// <summary>
         /// A collection of transformations to apply as part of resource registration.
         /// </summary>
@@ -110,9 +116,11 @@ namespace Pulumi
         /// <param name="custom">True to indicate that this is a custom resource, managed by a plugin.</param>
         /// <param name="args">The arguments to use to populate the new resource.</param>
         /// <param name="options">A bag of options that control this resource's behavior.</param>
+        /// <param name="remote">True if this is a component resource that is constructed by its
+        /// provider rather than by this program.</param>
         private protected Resource(
             string type, string name, bool custom,
-            ResourceArgs args, ResourceOptions options)
+            ResourceArgs args, ResourceOptions options, bool remote = false)
         {
             if (string.IsNullOrEmpty(type))
                 throw new ArgumentException("'type' cannot be null or empty.", nameof(type));
@@ -128,6 +136,7 @@ namespace Pulumi
 
             _type = type;
             _name = name;
+            _remote = remote;
 
             var transformations = ImmutableArray.CreateBuilder<ResourceTransformation>();
             transformations.AddRange(options.ResourceTransformations);
@@ -76,4 +76,15 @@ namespace Pulumi
     public sealed class OutputConstructorAttribute : Attribute
     {
     }
+
+    /// <summary>
+    /// Attribute used by a Pulumi Cloud Provider Package to mark enum types. An enum type must be a
+    /// struct with a single private constructor that accepts the underlying value of the enum and an
+    /// explicit conversion operator to the type of that value. Enum values are serialized as their
+    /// underlying values.
+    /// </summary>
+    [AttributeUsage(AttributeTargets.Struct)]
+    public sealed class EnumTypeAttribute : Attribute
+    {
+    }
 }
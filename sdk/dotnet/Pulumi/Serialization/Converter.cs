@@ -113,6 +113,9 @@ namespace Pulumi.Serialization
                     $"Unexpected generic target type {targetType.FullName} when deserializing {context}");
             }
 
+            if (targetType.GetCustomAttribute<Pulumi.EnumTypeAttribute>() != null)
+                return TryConvertEnum(context, val, targetType);
+
             if (targetType.GetCustomAttribute<Pulumi.OutputTypeAttribute>() == null)
                 return (null, new InvalidOperationException(
                     $"Unexpected target type {targetType.FullName} when deserializing {context}"));
@@ -147,6 +150,21 @@ namespace Pulumi.Serialization
             return (constructor.Invoke(arguments), null);
         }
 
+        private static (object?, InvalidOperationException?) TryConvertEnum(string context, object val, System.Type targetType)
+        {
+            var constructor = GetEnumConstructor(targetType);
+            if (constructor == null)
+                return (null, new InvalidOperationException(
+                    $"Expected target type {targetType.FullName} to have a constructor with a single parameter when deserializing {context}"));
+
+            var valueType = constructor.GetParameters()[0].ParameterType;
+            var (value, exception) = TryConvertObject(context, val, valueType);
+            if (exception != null)
+                return (null, exception);
+
+            return (constructor.Invoke(new[] { value }), null);
+        }
+
         private static (object?, InvalidOperationException?) TryConvertJsonElement(
             string context, object val)
         {
@@ -374,6 +392,19 @@ $@"{context} contains invalid type {targetType.FullName}:
                 }
             }
 
+            if (targetType.GetCustomAttribute<EnumTypeAttribute>() != null)
+            {
+                var enumConstructor = GetEnumConstructor(targetType);
+                if (enumConstructor == null)
+                {
+                    throw new InvalidOperationException(
+$@"{targetType.FullName} had [{nameof(EnumTypeAttribute)}], but did not contain a constructor with a single parameter.");
+                }
+
+                CheckTargetType($@"{targetType.FullName}(value)", enumConstructor.GetParameters()[0].ParameterType, seenTypes);
+                return;
+            }
+
             var propertyTypeAttribute = (Attribute?)targetType.GetCustomAttribute<OutputTypeAttribute>();
             if (propertyTypeAttribute == null)
             {
@@ -400,5 +431,9 @@ $@"{targetType.FullName} had [{nameof(OutputTypeAttribute)}], but did not contai
         private static ConstructorInfo GetPropertyConstructor(System.Type outputTypeArg)
             => outputTypeArg.GetConstructors(BindingFlags.NonPublic | BindingFlags.Public | BindingFlags.Instance).FirstOrDefault(
                 c => c.GetCustomAttributes<OutputConstructorAttribute>() != null);
+
+        private static ConstructorInfo? GetEnumConstructor(System.Type enumType)
+            => enumType.GetConstructors(BindingFlags.NonPublic | BindingFlags.Public | BindingFlags.Instance).SingleOrDefault(
+                c => c.GetParameters().Length == 1);
     }
 }
@@ -5,6 +5,7 @@ using System.Collections;
 using System.Collections.Generic;
 using System.Collections.Immutable;
 using System.Linq;
+using System.Reflection;
 using System.Text.Json;
 using System.Threading.Tasks;
 using Google.Protobuf.WellKnownTypes;
@@ -196,6 +197,16 @@ $"Tasks are not allowed inside ResourceArgs. Please wrap your Task in an Output:
                 return await SerializeAsync($"{ctx}.urn", componentResource.Urn).ConfigureAwait(false);
             }
 
+            if (prop.GetType().GetCustomAttribute<EnumTypeAttribute>() != null)
+            {
+                if (_excessiveDebugOutput)
+                {
+                    Log.Debug($"Serialize property[{ctx}]: Encountered enum");
+                }
+
+                return await SerializeAsync(ctx, GetEnumValue(ctx, prop)).ConfigureAwait(false);
+            }
+
             if (prop is IDictionary dictionary)
                 return await SerializeDictionaryAsync(ctx, dictionary).ConfigureAwait(false);
 
@@ -246,6 +257,20 @@ $"Tasks are not allowed inside ResourceArgs. Please wrap your Task in an Output:
             }
         }
 
+        private static object? GetEnumValue(string ctx, object prop)
+        {
+            var type = prop.GetType();
+            var conversion = type.GetMethods(BindingFlags.Public | BindingFlags.Static).SingleOrDefault(
+                m => m.Name == "op_Explicit" && m.GetParameters().Length == 1 && m.GetParameters()[0].ParameterType == type);
+            if (conversion == null)
+            {
+                throw new InvalidOperationException(
+                    $"{type.FullName} had [{nameof(EnumTypeAttribute)}], but did not contain an explicit conversion operator.\n\t{ctx}");
+            }
+
+            return conversion.Invoke(null, new[] { prop });
+        }
+
         private async Task<ImmutableDictionary<string, object>> SerializeAssetOrArchiveAsync(string ctx, AssetOrArchive assetOrArchive)
         {
             if (_excessiveDebugOutput)
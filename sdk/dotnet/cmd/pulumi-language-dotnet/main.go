@@ -27,6 +27,7 @@ import (
 
 	pbempty "github.com/golang/protobuf/ptypes/empty"
 	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/cmdutil"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/logging"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/rpcutil"
@@ -433,6 +434,21 @@ func (host *dotnetLanguageHost) RunDotnetCommand(
 	return infoBuffer.String(), err
 }
 
+// InstallDependencies restores the program's NuGet packages and builds it by running `dotnet build`, so that the
+// program is ready for a faster initial deployment.
+func (host *dotnetLanguageHost) InstallDependencies(
+	req *pulumirpc.InstallDependenciesRequest, server pulumirpc.LanguageRuntime_InstallDependenciesServer) error {
+
+	stdout, stderr := plugin.InstallDependenciesWriters(server)
+	cmd := exec.Command(host.exec, "build", "-nologo") // nolint: gas // intentionally running dynamic program name.
+	cmd.Dir = req.GetDirectory()
+	cmd.Stdout, cmd.Stderr = stdout, stderr
+	if err := cmd.Run(); err != nil {
+		return errors.Wrap(err, "'dotnet build' failed to install dependencies")
+	}
+	return nil
+}
+
 type logWriter struct {
 	ctx          context.Context
 	logToUser    bool
@@ -20,6 +20,18 @@ package apitype
 //
 // The types aren't versioned in the same manner as Resource, Deployment, and Checkpoint (see
 // apitype/migrate). So care must be taken if these are ever returned from the service to the CLI.
+//
+// The CLI can also write these events to an event stream (`pulumi up --event-stream`) so that external tools can
+// follow an operation as it happens. An event stream is newline-delimited JSON: each line is a single EngineEvent,
+// written as soon as the engine emits it. An operation's events begin with a PreludeEvent, followed by resource,
+// diagnostic, and policy events, and end with a SummaryEvent (unless the operation failed before completing) and a
+// CancelEvent. When a preview precedes an update, both operations' events are written to the same stream.
+//
+// The stream's schema is versioned by EngineEventStreamVersion. Within a version, fields may be added but are
+// never removed or repurposed.
+
+// EngineEventStreamVersion is the version of the event stream schema, recorded in each event written to a stream.
+const EngineEventStreamVersion = 1
 
 // CancelEvent is emitted when the user initiates a cancellation of the update in progress, or
 // the update successfully completes.
@@ -133,6 +145,8 @@ type StepEventMetadata struct {
 	Logical bool `json:"logical,omitempty"`
 	// Provider actually performing the step.
 	Provider string `json:"provider"`
+	// DeleteSkipReason explains why the provider will not delete the resource, if it will not.
+	DeleteSkipReason string `json:"deleteSkipReason,omitempty"`
 }
 
 // StepEventStateMetadata is the more detailed state information for a resource as it relates to
@@ -182,6 +196,16 @@ type ResOpFailedEvent struct {
 	Steps    int               `json:"steps"`
 }
 
+// ResRetryEvent is emitted when a failed resource operation is about to be retried. A DiagnosticEvent with a warning
+// is also emitted for each retry.
+type ResRetryEvent struct {
+	Metadata     StepEventMetadata `json:"metadata"`
+	Attempt      int               `json:"attempt"`
+	MaxAttempts  int               `json:"maxAttempts"`
+	DelaySeconds float64           `json:"delaySeconds"`
+	Error        string            `json:"error"`
+}
+
 // EngineEvent describes a Pulumi engine event, such as a change to a resource or diagnostic
 // message. EngineEvent is a discriminated union of all possible event types, and exactly one
 // field will be non-nil.
@@ -198,6 +222,10 @@ type EngineEvent struct {
 	// Timestamp is a Unix timestamp (seconds) of when the event was emitted.
 	Timestamp int `json:"timestamp"`
 
+	// Version is the EngineEventStreamVersion of an event written to an event stream. It is not set on events sent
+	// to the Pulumi Service.
+	Version int `json:"version,omitempty"`
+
 	CancelEvent      *CancelEvent       `json:"cancelEvent,omitempty"`
 	StdoutEvent      *StdoutEngineEvent `json:"stdoutEvent,omitempty"`
 	DiagnosticEvent  *DiagnosticEvent   `json:"diagnosticEvent,omitempty"`
@@ -207,6 +235,7 @@ type EngineEvent struct {
 	ResOutputsEvent  *ResOutputsEvent   `json:"resOutputsEvent,omitempty"`
 	ResOpFailedEvent *ResOpFailedEvent  `json:"resOpFailedEvent,omitempty"`
 	PolicyEvent      *PolicyEvent       `json:"policyEvent,omitempty"`
+	ResRetryEvent    *ResRetryEvent     `json:"resRetryEvent,omitempty"`
 }
 
 // EngineEventBatch is a group of engine events.
@@ -44,8 +44,20 @@ const (
 	// BookkeepingDir is the name of our bookkeeping folder, we store state here (like .git for git).
 	// Copied from workspace.BookkeepingDir to break import cycle.
 	BookkeepingDir = ".pulumi"
+
+	// DeterministicArchivesEnvVar is the environment variable that, when set to "1" or "true", causes archives to be
+	// produced in a reproducible form: entries are sorted by name, timestamps are fixed, and modes and owners are
+	// normalized, so that identical contents always produce identical bytes. Because it is read from the environment,
+	// the setting is inherited by any plugins launched by the engine.
+	DeterministicArchivesEnvVar = "PULUMI_DETERMINISTIC_ARCHIVES"
 )
 
+// deterministicArchives returns true if archives should be produced in their reproducible form.
+func deterministicArchives() bool {
+	v := os.Getenv(DeterministicArchivesEnvVar)
+	return v == "1" || strings.EqualFold(v, "true")
+}
+
 // Asset is a serialized asset reference.  It is a union: thus, only one of its fields will be non-nil.  Several helper
 // routines exist as members in order to easily interact with the assets referenced by an instance of this type.
 type Asset struct {
@@ -381,25 +393,40 @@ func (a *Asset) readURI() (*Blob, error) {
 	}
 }
 
-// EnsureHash computes the SHA256 hash of the asset's contents and stores it on the object.
+// EnsureHash computes the SHA256 hash of the asset's contents and stores it on the object. The hashes of path assets
+// are cached persistently, keyed by the file's metadata, so that unchanged files need not be re-read.
 func (a *Asset) EnsureHash() error {
 	if a.Hash == "" {
-		blob, err := a.Read()
-		if err != nil {
-			return err
+		var hash string
+		var err error
+		if path, ispath := a.GetPath(); ispath {
+			hash, err = cachedHash(assetHashCacheKind, path, a.computeHash)
+		} else {
+			hash, err = a.computeHash()
 		}
-		defer contract.IgnoreClose(blob)
-
-		hash := sha256.New()
-		_, err = io.Copy(hash, blob)
 		if err != nil {
 			return err
 		}
-		a.Hash = hex.EncodeToString(hash.Sum(nil))
+		a.Hash = hash
 	}
 	return nil
 }
 
+// computeHash reads the asset's contents and returns their SHA256 hash.
+func (a *Asset) computeHash() (string, error) {
+	blob, err := a.Read()
+	if err != nil {
+		return "", err
+	}
+	defer contract.IgnoreClose(blob)
+
+	hash := sha256.New()
+	if _, err = io.Copy(hash, blob); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
 // Blob is a blob that implements ReadCloser and offers Len functionality.
 type Blob struct {
 	rd io.ReadCloser // an underlying reader.
@@ -866,6 +893,14 @@ func (a *Archive) readPath() (ArchiveReader, error) {
 			return nil, walkerr
 		}
 
+		// filepath.Walk orders paths component by component, which differs from a plain sort of the archive member
+		// names (e.g. "a/b" is walked before "a.txt"). Reproducible archives are sorted by member name.
+		if deterministicArchives() {
+			sort.SliceStable(assetPaths, func(i, j int) bool {
+				return filepath.ToSlash(assetPaths[i]) < filepath.ToSlash(assetPaths[j])
+			})
+		}
+
 		r := &directoryArchiveReader{
 			directoryPath: path,
 			assetPaths:    assetPaths,
@@ -957,9 +992,24 @@ func (a *Archive) Archive(format ArchiveFormat, w io.Writer) error {
 	}
 }
 
+// openForArchiving opens the archive for translation into another format. If reproducible archives have been
+// requested, the returned reader yields members sorted by name.
+func (a *Archive) openForArchiving() (ArchiveReader, error) {
+	reader, err := a.Open()
+	if err != nil || !deterministicArchives() {
+		return reader, err
+	}
+
+	// Directory archives are already sorted by readPath, so there is no need to spool their contents.
+	if _, ok := reader.(*directoryArchiveReader); ok {
+		return reader, nil
+	}
+	return newSortedArchiveReader(reader)
+}
+
 // addNextFileToTar adds the next file in the given archive to the given tar file. Returns io.EOF if the archive
 // contains no more files.
-func addNextFileToTar(r ArchiveReader, tw *tar.Writer, seenFiles map[string]bool) error {
+func addNextFileToTar(r ArchiveReader, tw *tar.Writer, seenFiles map[string]bool, deterministic bool) error {
 	file, data, err := r.Next()
 	if err != nil {
 		return err
@@ -975,11 +1025,18 @@ func addNextFileToTar(r ArchiveReader, tw *tar.Writer, seenFiles map[string]bool
 	seenFiles[file] = true
 
 	sz := data.Size()
-	if err = tw.WriteHeader(&tar.Header{
+	hdr := &tar.Header{
 		Name: file,
 		Mode: 0600,
 		Size: sz,
-	}); err != nil {
+	}
+	if deterministic {
+		// Pin down every field that might otherwise vary with the host or the version of the tar writer.
+		hdr.Typeflag = tar.TypeReg
+		hdr.Mode = 0644
+		hdr.ModTime = time.Unix(0, 0)
+	}
+	if err = tw.WriteHeader(hdr); err != nil {
 		return err
 	}
 	n, err := io.Copy(tw, data)
@@ -991,7 +1048,7 @@ func addNextFileToTar(r ArchiveReader, tw *tar.Writer, seenFiles map[string]bool
 
 func (a *Archive) archiveTar(w io.Writer) error {
 	// Open the archive.
-	reader, err := a.Open()
+	reader, err := a.openForArchiving()
 	if err != nil {
 		return err
 	}
@@ -1000,8 +1057,9 @@ func (a *Archive) archiveTar(w io.Writer) error {
 	// Now actually emit the contents, file by file.
 	tw := tar.NewWriter(w)
 	seenFiles := make(map[string]bool)
+	deterministic := deterministicArchives()
 	for err == nil {
-		err = addNextFileToTar(reader, tw, seenFiles)
+		err = addNextFileToTar(reader, tw, seenFiles, deterministic)
 	}
 	if err != io.EOF {
 		return err
@@ -1012,12 +1070,22 @@ func (a *Archive) archiveTar(w io.Writer) error {
 
 func (a *Archive) archiveTarGZIP(w io.Writer) error {
 	z := gzip.NewWriter(w)
-	return a.archiveTar(z)
+	if deterministicArchives() {
+		// The zero values for these fields are already stable, but set them explicitly so that the header never
+		// depends on the host.
+		z.Header.Name = ""
+		z.Header.ModTime = time.Time{}
+		z.Header.OS = 255 // unknown
+	}
+	if err := a.archiveTar(z); err != nil {
+		return err
+	}
+	return z.Close()
 }
 
 // addNextFileToZIP adds the next file in the given archive to the given ZIP file. Returns io.EOF if the archive
 // contains no more files.
-func addNextFileToZIP(r ArchiveReader, zw *zip.Writer, seenFiles map[string]bool) error {
+func addNextFileToZIP(r ArchiveReader, zw *zip.Writer, seenFiles map[string]bool, deterministic bool) error {
 	file, data, err := r.Next()
 	if err != nil {
 		return err
@@ -1045,6 +1113,11 @@ func addNextFileToZIP(r ArchiveReader, zw *zip.Writer, seenFiles map[string]bool
 	// nolint: megacheck
 	fh.SetModTime(time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC))
 
+	// Reproducible archives also record a fixed, Unix-style file mode, regardless of the host.
+	if deterministic {
+		fh.SetMode(0644)
+	}
+
 	fw, err := zw.CreateHeader(fh)
 	if err != nil {
 		return err
@@ -1055,7 +1128,7 @@ func addNextFileToZIP(r ArchiveReader, zw *zip.Writer, seenFiles map[string]bool
 
 func (a *Archive) archiveZIP(w io.Writer) error {
 	// Open the archive.
-	reader, err := a.Open()
+	reader, err := a.openForArchiving()
 	if err != nil {
 		return err
 	}
@@ -1064,8 +1137,9 @@ func (a *Archive) archiveZIP(w io.Writer) error {
 	// Now actually emit the contents, file by file.
 	zw := zip.NewWriter(w)
 	seenFiles := make(map[string]bool)
+	deterministic := deterministicArchives()
 	for err == nil {
-		err = addNextFileToZIP(reader, zw, seenFiles)
+		err = addNextFileToZIP(reader, zw, seenFiles, deterministic)
 	}
 	if err != io.EOF {
 		return err
@@ -1090,38 +1164,57 @@ func (a *Archive) ReadSourceArchive() (ArchiveFormat, io.ReadCloser, error) {
 	return NotArchive, nil, nil
 }
 
-// EnsureHash computes the SHA256 hash of the archive's contents and stores it on the object.
+// EnsureHash computes the SHA256 hash of the archive's contents and stores it on the object. The hashes of path
+// archives are cached persistently, keyed by the metadata of the archive file or of every file beneath the archive
+// directory, so that unchanged archives need not be re-read.
 func (a *Archive) EnsureHash() error {
 	if a.Hash == "" {
-		hash := sha256.New()
-
-		// Attempt to compute the hash in the most efficient way.  First try to open the archive directly and copy it
-		// to the hash.  This avoids traversing any of the contents and just treats it as a byte stream.
-		f, r, err := a.ReadSourceArchive()
-		if err != nil {
-			return err
-		}
-		if f != NotArchive && r != nil {
-			defer contract.IgnoreClose(r)
-			_, err = io.Copy(hash, r)
-			if err != nil {
-				return err
+		var hash string
+		var err error
+		if path, ispath := a.GetPath(); ispath {
+			kind := archiveHashCacheKind
+			if deterministicArchives() {
+				kind = deterministicArchiveHashCacheKind
 			}
+			hash, err = cachedHash(kind, path, a.computeHash)
 		} else {
-			// Otherwise, it's not an archive; we'll need to transform it into one.  Pick tar since it avoids
-			// any superfluous compression which doesn't actually help us in this situation.
-			err := a.Archive(TarArchive, hash)
-			if err != nil {
-				return err
-			}
+			hash, err = a.computeHash()
 		}
-
-		// Finally, encode the resulting hash as a string and we're done.
-		a.Hash = hex.EncodeToString(hash.Sum(nil))
+		if err != nil {
+			return err
+		}
+		a.Hash = hash
 	}
 	return nil
 }
 
+// computeHash reads the archive's contents and returns their SHA256 hash.
+func (a *Archive) computeHash() (string, error) {
+	hash := sha256.New()
+
+	// Attempt to compute the hash in the most efficient way.  First try to open the archive directly and copy it
+	// to the hash.  This avoids traversing any of the contents and just treats it as a byte stream.
+	f, r, err := a.ReadSourceArchive()
+	if err != nil {
+		return "", err
+	}
+	if f != NotArchive && r != nil {
+		defer contract.IgnoreClose(r)
+		if _, err = io.Copy(hash, r); err != nil {
+			return "", err
+		}
+	} else {
+		// Otherwise, it's not an archive; we'll need to transform it into one.  Pick tar since it avoids
+		// any superfluous compression which doesn't actually help us in this situation.
+		if err = a.Archive(TarArchive, hash); err != nil {
+			return "", err
+		}
+	}
+
+	// Finally, encode the resulting hash as a string and we're done.
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
 // ArchiveFormat indicates what archive and/or compression format an archive uses.
 type ArchiveFormat int
 
@@ -1184,6 +1277,78 @@ func readArchive(ar io.ReadCloser, format ArchiveFormat) (ArchiveReader, error)
 	}
 }
 
+// sortedArchiveReader presents the members of another archive sorted by name. As the source archive can only be read
+// sequentially, its members are first spooled to a temporary file.
+type sortedArchiveReader struct {
+	spool   *os.File
+	members []sortedArchiveMember
+}
+
+// sortedArchiveMember records the location of a single member within the spool file.
+type sortedArchiveMember struct {
+	name   string
+	offset int64
+	size   int64
+}
+
+func newSortedArchiveReader(r ArchiveReader) (ArchiveReader, error) {
+	defer contract.IgnoreClose(r)
+
+	spool, err := ioutil.TempFile("", "pulumi-archive")
+	if err != nil {
+		return nil, err
+	}
+	sr := &sortedArchiveReader{spool: spool}
+
+	var offset int64
+	for {
+		name, blob, err := r.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			contract.IgnoreClose(sr)
+			return nil, err
+		}
+
+		n, err := io.Copy(spool, blob)
+		contract.IgnoreClose(blob)
+		if err != nil {
+			contract.IgnoreClose(sr)
+			return nil, err
+		}
+		sr.members = append(sr.members, sortedArchiveMember{name: name, offset: offset, size: n})
+		offset += n
+	}
+
+	// Use a stable sort so that the first of several members with the same name still wins.
+	sort.SliceStable(sr.members, func(i, j int) bool {
+		return sr.members[i].name < sr.members[j].name
+	})
+	return sr, nil
+}
+
+func (r *sortedArchiveReader) Next() (string, *Blob, error) {
+	if len(r.members) == 0 {
+		return "", nil, io.EOF
+	}
+
+	m := r.members[0]
+	r.members = r.members[1:]
+	blob := &Blob{
+		rd: ioutil.NopCloser(io.NewSectionReader(r.spool, m.offset, m.size)),
+		sz: m.size,
+	}
+	return m.name, blob, nil
+}
+
+func (r *sortedArchiveReader) Close() error {
+	err := r.spool.Close()
+	if rerr := os.Remove(r.spool.Name()); err == nil {
+		err = rerr
+	}
+	return err
+}
+
 // tarArchiveReader is used to read an archive that is stored in tar format.
 type tarArchiveReader struct {
 	ar io.ReadCloser
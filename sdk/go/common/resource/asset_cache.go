@@ -0,0 +1,310 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/user"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/logging"
+)
+
+const (
+	// AssetHashCacheDisableEnvVar is the environment variable that, when set to "1" or "true", disables the
+	// persistent cache of path asset and path archive hashes.
+	AssetHashCacheDisableEnvVar = "PULUMI_DISABLE_ASSET_HASH_CACHE"
+
+	// pulumiHomeEnvVar and workspaceDir are copied from the workspace package to break an import cycle.
+	pulumiHomeEnvVar = "PULUMI_HOME"
+	workspaceDir     = "workspaces"
+
+	// assetHashCacheDir is the directory beneath the workspace directory that holds hash cache entries.
+	assetHashCacheDir = "asset-hashes"
+
+	// assetHashCacheVersion is bumped whenever the entry format or the fingerprinting scheme changes, so that entries
+	// written by older versions of the CLI are ignored instead of trusted.
+	assetHashCacheVersion = 1
+
+	// assetHashCacheRacyWindow guards against filesystems with coarse timestamps: a file modified this close to the
+	// time at which we hashed it might change again without its fingerprint changing, so we never cache its hash.
+	assetHashCacheRacyWindow = 2 * time.Second
+
+	// assetHashCacheMaxAge is how long an entry may go unused before it is evicted from the cache.
+	assetHashCacheMaxAge = 30 * 24 * time.Hour
+
+	// assetHashCacheMaxEntries bounds the number of entries in the cache. When there are more, the least recently
+	// used entries are evicted.
+	assetHashCacheMaxEntries = 10000
+)
+
+// assetHashCachePruneOnce ensures that the cache is pruned at most once per process.
+var assetHashCachePruneOnce sync.Once
+
+// hashCacheKind distinguishes asset hashes from archive hashes for the same path, as the two are computed differently.
+// Reproducible archives are hashed differently again, so they have a kind of their own.
+type hashCacheKind string
+
+const (
+	assetHashCacheKind                hashCacheKind = "asset"
+	archiveHashCacheKind              hashCacheKind = "archive"
+	deterministicArchiveHashCacheKind hashCacheKind = "deterministic-archive"
+)
+
+// hashCacheEntry is the on-disk representation of a single cached hash.
+type hashCacheEntry struct {
+	Version     int           `json:"version"`
+	Kind        hashCacheKind `json:"kind"`
+	Path        string        `json:"path"`
+	Fingerprint string        `json:"fingerprint"`
+	Hash        string        `json:"hash"`
+}
+
+// assetHashCacheEnabled returns true unless the user has opted out of the hash cache.
+func assetHashCacheEnabled() bool {
+	v := os.Getenv(AssetHashCacheDisableEnvVar)
+	return !(v == "1" || strings.EqualFold(v, "true"))
+}
+
+// assetHashCachePath returns the path of the cache entry file for the given kind and absolute path.
+func assetHashCachePath(kind hashCacheKind, path string) (string, error) {
+	home := os.Getenv(pulumiHomeEnvVar)
+	if home == "" {
+		u, err := user.Current()
+		if err != nil {
+			return "", errors.Wrapf(err, "getting current user")
+		}
+		home = filepath.Join(u.HomeDir, BookkeepingDir)
+	}
+
+	key := sha256.Sum256([]byte(string(kind) + "\x00" + path))
+	return filepath.Join(home, workspaceDir, assetHashCacheDir, hex.EncodeToString(key[:])+".json"), nil
+}
+
+// cachedHash returns the SHA256 hash of the asset or archive at the given path. If the cache holds an entry whose
+// fingerprint matches the current state of the filesystem, that hash is returned without reading any content.
+// Otherwise, compute is called and its result is recorded in the cache for next time. Errors reading or writing the
+// cache are never fatal; they simply cause the hash to be recomputed.
+func cachedHash(kind hashCacheKind, path string, compute func() (string, error)) (string, error) {
+	if !assetHashCacheEnabled() {
+		return compute()
+	}
+
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return compute()
+	}
+	entryPath, err := assetHashCachePath(kind, abs)
+	if err != nil {
+		logging.V(7).Infof("asset hash cache unavailable: %v", err)
+		return compute()
+	}
+
+	// Fingerprint the path before hashing. If we can't, just fall back to hashing; any real error (e.g. a missing
+	// file) will be reported by compute.
+	start := time.Now()
+	before, newest, err := fingerprintPath(kind, abs)
+	if err != nil {
+		return compute()
+	}
+
+	if entry, ok := readHashCacheEntry(entryPath); ok && entry.Kind == kind && entry.Path == abs &&
+		entry.Fingerprint == before {
+		logging.V(9).Infof("asset hash cache hit for %s %s", kind, abs)
+
+		// Record the use of the entry so that it is not evicted while it is still useful.
+		now := time.Now()
+		_ = os.Chtimes(entryPath, now, now)
+		return entry.Hash, nil
+	}
+
+	hash, err := compute()
+	if err != nil {
+		return "", err
+	}
+
+	// Only record the hash if the contents could not have changed underneath us: the fingerprint must be unchanged
+	// after hashing, and no file may have been modified so recently that a subsequent edit could go unnoticed.
+	if newest.After(start.Add(-assetHashCacheRacyWindow)) {
+		return hash, nil
+	}
+	if after, _, err := fingerprintPath(kind, abs); err != nil || after != before {
+		return hash, nil
+	}
+
+	entry := hashCacheEntry{
+		Version:     assetHashCacheVersion,
+		Kind:        kind,
+		Path:        abs,
+		Fingerprint: before,
+		Hash:        hash,
+	}
+	if err := writeHashCacheEntry(entryPath, entry); err != nil {
+		logging.V(7).Infof("failed to write asset hash cache entry for %s: %v", abs, err)
+	}
+	assetHashCachePruneOnce.Do(func() {
+		if err := pruneHashCache(filepath.Dir(entryPath), time.Now()); err != nil {
+			logging.V(7).Infof("failed to prune asset hash cache: %v", err)
+		}
+	})
+	return hash, nil
+}
+
+// pruneHashCache evicts entries from the cache in the given directory. Entries that have not been used for longer
+// than assetHashCacheMaxAge are removed, as are the least recently used entries beyond assetHashCacheMaxEntries.
+// Entries are marked as used by updating their modification times.
+func pruneHashCache(dir string, now time.Time) error {
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	var entries []os.FileInfo
+	for _, info := range infos {
+		if info.IsDir() || filepath.Ext(info.Name()) != ".json" {
+			continue
+		}
+		if now.Sub(info.ModTime()) > assetHashCacheMaxAge {
+			_ = os.Remove(filepath.Join(dir, info.Name()))
+			continue
+		}
+		entries = append(entries, info)
+	}
+
+	if len(entries) > assetHashCacheMaxEntries {
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].ModTime().After(entries[j].ModTime())
+		})
+		for _, info := range entries[assetHashCacheMaxEntries:] {
+			_ = os.Remove(filepath.Join(dir, info.Name()))
+		}
+	}
+	return nil
+}
+
+// readHashCacheEntry reads the cache entry at the given path. Missing, unreadable, corrupt, or outdated entries are
+// all treated as misses.
+func readHashCacheEntry(entryPath string) (hashCacheEntry, bool) {
+	b, err := ioutil.ReadFile(entryPath)
+	if err != nil {
+		return hashCacheEntry{}, false
+	}
+	var entry hashCacheEntry
+	if err = json.Unmarshal(b, &entry); err != nil || entry.Version != assetHashCacheVersion || entry.Hash == "" {
+		return hashCacheEntry{}, false
+	}
+	return entry, true
+}
+
+// writeHashCacheEntry writes a cache entry. The entry is first written to a temporary file and then renamed into
+// place, so that concurrent readers never observe a partially-written entry.
+func writeHashCacheEntry(entryPath string, entry hashCacheEntry) error {
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(entryPath)
+	if err = os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(dir, filepath.Base(entryPath)+".tmp")
+	if err != nil {
+		return err
+	}
+	if _, err = tmp.Write(b); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	if err = os.Rename(tmp.Name(), entryPath); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	return nil
+}
+
+// fingerprintPath computes a fingerprint of the given path from filesystem metadata alone. For a file, this is its
+// size, modification time, inode, and mode. For a directory archive, it is a digest of the fingerprints of every entry
+// beneath it, visited in the same way that a directory is expanded into an archive. The most recent modification time
+// seen is also returned.
+func fingerprintPath(kind hashCacheKind, path string) (string, time.Time, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	if kind == assetHashCacheKind || !info.IsDir() {
+		return fingerprintFile(info), info.ModTime(), nil
+	}
+
+	digest := sha256.New()
+	newest := info.ModTime()
+	err = filepath.Walk(path, func(filePath string, f os.FileInfo, fileerr error) error {
+		if fileerr != nil {
+			return fileerr
+		}
+
+		// Skip .pulumi directories, just as readPath does.
+		if f.Name() == BookkeepingDir {
+			if f.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		// Symlinks are followed when reading the archive, so fingerprint their targets instead.
+		if f.Mode()&os.ModeSymlink != 0 {
+			if f, err = os.Stat(filePath); err != nil {
+				return err
+			}
+		}
+
+		rel, err := filepath.Rel(path, filePath)
+		if err != nil {
+			return err
+		}
+		if f.ModTime().After(newest) {
+			newest = f.ModTime()
+		}
+		_, err = io.WriteString(digest, filepath.ToSlash(rel)+"\x00"+fingerprintFile(f)+"\n")
+		return err
+	})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return hex.EncodeToString(digest.Sum(nil)), newest, nil
+}
+
+// fingerprintFile returns a string that changes whenever the file described by the given info is likely to have
+// changed.
+func fingerprintFile(info os.FileInfo) string {
+	return fmt.Sprintf("%d:%d:%d:%o", info.Size(), info.ModTime().UnixNano(), fileInode(info), info.Mode())
+}
@@ -0,0 +1,248 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// setupHashCache points the hash cache at a fresh temporary directory and returns a function that restores the
+// environment.
+func setupHashCache(t *testing.T) (string, func()) {
+	home, err := ioutil.TempDir("", "pulumi-home")
+	assert.NoError(t, err)
+
+	oldHome, oldDisable := os.Getenv(pulumiHomeEnvVar), os.Getenv(AssetHashCacheDisableEnvVar)
+	os.Setenv(pulumiHomeEnvVar, home)
+	os.Setenv(AssetHashCacheDisableEnvVar, "")
+
+	return home, func() {
+		os.Setenv(pulumiHomeEnvVar, oldHome)
+		os.Setenv(AssetHashCacheDisableEnvVar, oldDisable)
+		os.RemoveAll(home)
+	}
+}
+
+// writeOldFile writes a file and backdates its modification time so that it falls outside of the racy window.
+func writeOldFile(t *testing.T, path, contents string, age time.Duration) {
+	assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+	assert.NoError(t, ioutil.WriteFile(path, []byte(contents), 0600))
+	mtime := time.Now().Add(-age)
+	assert.NoError(t, os.Chtimes(path, mtime, mtime))
+}
+
+// countingHash returns a compute function for cachedHash that records how many times it was called.
+func countingHash(hash string, calls *int) func() (string, error) {
+	return func() (string, error) {
+		*calls++
+		return hash, nil
+	}
+}
+
+func TestAssetHashCache(t *testing.T) {
+	_, cleanup := setupHashCache(t)
+	defer cleanup()
+
+	dir, err := ioutil.TempDir("", "asset-hash-cache")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "file.txt")
+	writeOldFile(t, path, "hello", time.Hour)
+
+	// The first request computes the hash; the second is served from the cache.
+	calls := 0
+	hash, err := cachedHash(assetHashCacheKind, path, countingHash("first", &calls))
+	assert.NoError(t, err)
+	assert.Equal(t, "first", hash)
+	hash, err = cachedHash(assetHashCacheKind, path, countingHash("second", &calls))
+	assert.NoError(t, err)
+	assert.Equal(t, "first", hash)
+	assert.Equal(t, 1, calls)
+
+	// Assets and archives at the same path are cached independently.
+	hash, err = cachedHash(archiveHashCacheKind, path, countingHash("archive", &calls))
+	assert.NoError(t, err)
+	assert.Equal(t, "archive", hash)
+	assert.Equal(t, 2, calls)
+
+	// Changing the file invalidates the entry.
+	writeOldFile(t, path, "hello, world", 30*time.Minute)
+	hash, err = cachedHash(assetHashCacheKind, path, countingHash("third", &calls))
+	assert.NoError(t, err)
+	assert.Equal(t, "third", hash)
+	assert.Equal(t, 3, calls)
+
+	// The real hash computed through EnsureHash matches an uncached computation, both on a miss and on a hit.
+	path = filepath.Join(dir, "other.txt")
+	writeOldFile(t, path, "other", time.Hour)
+	for i := 0; i < 2; i++ {
+		asset, err := NewPathAsset(path)
+		assert.NoError(t, err)
+		expected, err := (&Asset{Path: path}).computeHash()
+		assert.NoError(t, err)
+		assert.Equal(t, expected, asset.Hash)
+	}
+}
+
+func TestAssetHashCacheRacyFiles(t *testing.T) {
+	_, cleanup := setupHashCache(t)
+	defer cleanup()
+
+	dir, err := ioutil.TempDir("", "asset-hash-cache")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// A file modified just now must not be cached, as a further edit might not change its fingerprint.
+	path := filepath.Join(dir, "file.txt")
+	assert.NoError(t, ioutil.WriteFile(path, []byte("hello"), 0600))
+
+	calls := 0
+	for i := 0; i < 2; i++ {
+		_, err = cachedHash(assetHashCacheKind, path, countingHash("hash", &calls))
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 2, calls)
+}
+
+func TestAssetHashCacheDisabled(t *testing.T) {
+	_, cleanup := setupHashCache(t)
+	defer cleanup()
+	os.Setenv(AssetHashCacheDisableEnvVar, "true")
+
+	dir, err := ioutil.TempDir("", "asset-hash-cache")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "file.txt")
+	writeOldFile(t, path, "hello", time.Hour)
+
+	calls := 0
+	for i := 0; i < 2; i++ {
+		_, err = cachedHash(assetHashCacheKind, path, countingHash("hash", &calls))
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 2, calls)
+}
+
+func TestArchiveHashCacheDirectory(t *testing.T) {
+	_, cleanup := setupHashCache(t)
+	defer cleanup()
+
+	dir, err := ioutil.TempDir("", "archive-hash-cache")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	writeOldFile(t, filepath.Join(dir, "a.txt"), "a", time.Hour)
+	writeOldFile(t, filepath.Join(dir, "sub", "b.txt"), "b", time.Hour)
+	writeOldFile(t, filepath.Join(dir, BookkeepingDir, "ignored.txt"), "ignored", time.Hour)
+	for _, d := range []string{filepath.Join(dir, "sub"), filepath.Join(dir, BookkeepingDir), dir} {
+		mtime := time.Now().Add(-time.Hour)
+		assert.NoError(t, os.Chtimes(d, mtime, mtime))
+	}
+
+	calls := 0
+	hash, err := cachedHash(archiveHashCacheKind, dir, countingHash("first", &calls))
+	assert.NoError(t, err)
+	assert.Equal(t, "first", hash)
+	hash, err = cachedHash(archiveHashCacheKind, dir, countingHash("second", &calls))
+	assert.NoError(t, err)
+	assert.Equal(t, "first", hash)
+	assert.Equal(t, 1, calls)
+
+	// Changes inside the bookkeeping directory are not part of the archive and do not invalidate the entry.
+	writeOldFile(t, filepath.Join(dir, BookkeepingDir, "ignored.txt"), "still ignored", 30*time.Minute)
+	hash, err = cachedHash(archiveHashCacheKind, dir, countingHash("third", &calls))
+	assert.NoError(t, err)
+	assert.Equal(t, "first", hash)
+	assert.Equal(t, 1, calls)
+
+	// A change to a nested file invalidates the entry.
+	writeOldFile(t, filepath.Join(dir, "sub", "b.txt"), "bb", 30*time.Minute)
+	hash, err = cachedHash(archiveHashCacheKind, dir, countingHash("fourth", &calls))
+	assert.NoError(t, err)
+	assert.Equal(t, "fourth", hash)
+	assert.Equal(t, 2, calls)
+
+	// The real hash computed through EnsureHash matches an uncached computation, both on a miss and on a hit.
+	sub := filepath.Join(dir, "sub")
+	for i := 0; i < 2; i++ {
+		archive, err := NewPathArchive(sub)
+		assert.NoError(t, err)
+		expected, err := (&Archive{Path: sub}).computeHash()
+		assert.NoError(t, err)
+		assert.Equal(t, expected, archive.Hash)
+	}
+}
+
+func TestAssetHashCacheCorruptEntry(t *testing.T) {
+	_, cleanup := setupHashCache(t)
+	defer cleanup()
+
+	dir, err := ioutil.TempDir("", "asset-hash-cache")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "file.txt")
+	writeOldFile(t, path, "hello", time.Hour)
+
+	calls := 0
+	_, err = cachedHash(assetHashCacheKind, path, countingHash("first", &calls))
+	assert.NoError(t, err)
+
+	// A corrupt entry is treated as a miss and then overwritten.
+	entryPath, err := assetHashCachePath(assetHashCacheKind, path)
+	assert.NoError(t, err)
+	assert.NoError(t, ioutil.WriteFile(entryPath, []byte("{not json"), 0600))
+
+	hash, err := cachedHash(assetHashCacheKind, path, countingHash("second", &calls))
+	assert.NoError(t, err)
+	assert.Equal(t, "second", hash)
+	hash, err = cachedHash(assetHashCacheKind, path, countingHash("third", &calls))
+	assert.NoError(t, err)
+	assert.Equal(t, "second", hash)
+	assert.Equal(t, 2, calls)
+}
+
+func TestAssetHashCachePrune(t *testing.T) {
+	dir, err := ioutil.TempDir("", "asset-hashes")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	now := time.Now()
+	writeOldFile(t, filepath.Join(dir, "stale.json"), "{}", assetHashCacheMaxAge+time.Hour)
+	writeOldFile(t, filepath.Join(dir, "fresh.json"), "{}", time.Hour)
+	for i := 0; i < assetHashCacheMaxEntries; i++ {
+		writeOldFile(t, filepath.Join(dir, fmt.Sprintf("entry-%d.json", i)), "{}", time.Minute)
+	}
+
+	assert.NoError(t, pruneHashCache(dir, now))
+
+	_, err = os.Stat(filepath.Join(dir, "stale.json"))
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(dir, "fresh.json"))
+	assert.True(t, os.IsNotExist(err), "the least recently used entry should be evicted")
+
+	infos, err := ioutil.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Len(t, infos, assetHashCacheMaxEntries)
+}
@@ -0,0 +1,26 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+// +build windows
+
+package resource
+
+import (
+	"os"
+)
+
+// fileInode returns the inode number of the file described by the given info. File IDs are not exposed by os.Stat on
+// Windows, so this always returns 0 and the fingerprint relies on size, modification time, and mode alone.
+func fileInode(info os.FileInfo) uint64 {
+	return 0
+}
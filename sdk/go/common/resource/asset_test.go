@@ -26,6 +26,7 @@ import (
 	"path/filepath"
 	"runtime"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
@@ -569,3 +570,108 @@ func assertAssetBlobEquals(t *testing.T, blob *Blob, expect string) {
 	err = blob.Close()
 	assert.Nil(t, err)
 }
+
+// deterministicArchiveContents are the members of the archive used to test reproducible archives. The names are
+// chosen so that sorting by name differs from the order in which a directory is walked.
+var deterministicArchiveContents = map[string]string{
+	"b.txt":          "b",
+	"a.txt":          "a",
+	"dir.txt":        "dir",
+	"dir/z.txt":      "z",
+	"dir/nested.txt": "nested",
+}
+
+// withDeterministicArchives enables reproducible archives for the duration of a test.
+func withDeterministicArchives(t *testing.T) func() {
+	old := os.Getenv(DeterministicArchivesEnvVar)
+	assert.NoError(t, os.Setenv(DeterministicArchivesEnvVar, "true"))
+	return func() {
+		assert.NoError(t, os.Setenv(DeterministicArchivesEnvVar, old))
+	}
+}
+
+// writeDeterministicArchiveDir writes the contents of the test archive to a new temporary directory, using the given
+// file mode and modification time.
+func writeDeterministicArchiveDir(t *testing.T, mode os.FileMode, mtime time.Time) string {
+	dir, err := ioutil.TempDir("", "deterministic-archive")
+	assert.NoError(t, err)
+	for name, contents := range deterministicArchiveContents {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		assert.NoError(t, ioutil.WriteFile(path, []byte(contents), mode))
+		assert.NoError(t, os.Chmod(path, mode))
+		assert.NoError(t, os.Chtimes(path, mtime, mtime))
+	}
+	return dir
+}
+
+func TestDeterministicArchives(t *testing.T) {
+	defer withDeterministicArchives(t)()
+
+	// Build the same contents as an asset archive with a nested asset archive and as two directories whose files
+	// have different modes and modification times.
+	nested, err := NewAssetArchive(map[string]interface{}{
+		"z.txt":      &Asset{Text: deterministicArchiveContents["dir/z.txt"]},
+		"nested.txt": &Asset{Text: deterministicArchiveContents["dir/nested.txt"]},
+	})
+	assert.NoError(t, err)
+	assets, err := NewAssetArchive(map[string]interface{}{
+		"b.txt":   &Asset{Text: deterministicArchiveContents["b.txt"]},
+		"a.txt":   &Asset{Text: deterministicArchiveContents["a.txt"]},
+		"dir.txt": &Asset{Text: deterministicArchiveContents["dir.txt"]},
+		"dir":     nested,
+	})
+	assert.NoError(t, err)
+
+	dir1 := writeDeterministicArchiveDir(t, 0600, time.Date(2001, time.February, 3, 4, 5, 6, 0, time.UTC))
+	defer os.RemoveAll(dir1)
+	dir2 := writeDeterministicArchiveDir(t, 0755, time.Now())
+	defer os.RemoveAll(dir2)
+
+	archives := map[string]*Archive{
+		"assets": assets,
+		"dir1":   {Path: dir1},
+		"dir2":   {Path: dir2},
+	}
+
+	formats := map[ArchiveFormat]string{
+		TarArchive:     "deterministic.tar",
+		TarGZIPArchive: "deterministic.tgz",
+		ZIPArchive:     "deterministic.zip",
+	}
+	for format, golden := range formats {
+		expected, err := ioutil.ReadFile(filepath.Join("../../../../pkg/resource/testdata", golden))
+		assert.NoError(t, err)
+
+		for name, arch := range archives {
+			t.Run(golden+"/"+name, func(t *testing.T) {
+				actual, err := arch.Bytes(format)
+				assert.NoError(t, err)
+				assert.Equal(t, expected, actual)
+			})
+		}
+	}
+}
+
+func TestDeterministicArchiveSortsSourceArchives(t *testing.T) {
+	defer withDeterministicArchives(t)()
+
+	// Translating a source archive into another format sorts its members by name, even though the source is
+	// read sequentially.
+	arch, err := NewPathArchive("../../../../pkg/resource/testdata/deterministic.tar")
+	assert.NoError(t, err)
+	b, err := arch.Bytes(ZIPArchive)
+	assert.NoError(t, err)
+
+	expected, err := ioutil.ReadFile("../../../../pkg/resource/testdata/deterministic.zip")
+	assert.NoError(t, err)
+	assert.Equal(t, expected, b)
+
+	zr, err := zip.NewReader(bytes.NewReader(b), int64(len(b)))
+	assert.NoError(t, err)
+	var names []string
+	for _, f := range zr.File {
+		names = append(names, f.Name)
+	}
+	assert.Equal(t, []string{"a.txt", "b.txt", "dir.txt", "dir/nested.txt", "dir/z.txt"}, names)
+}
@@ -16,9 +16,13 @@ package plugin
 
 import (
 	"io"
+	"sync"
+
+	"github.com/pkg/errors"
 
 	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/config"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/workspace"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
 )
 
 // LanguageRuntime is a convenient interface for interacting with language runtime plugins.  These tend to be
@@ -38,8 +42,16 @@ type LanguageRuntime interface {
 	Run(info RunInfo) (string, bool, error)
 	// GetPluginInfo returns this plugin's information.
 	GetPluginInfo() (workspace.PluginInfo, error)
+	// InstallDependencies installs the dependencies of the program in the given directory, writing the output of the
+	// installation to stdout and stderr. isTerminal is true if that output will be displayed in a terminal. If the
+	// language runtime cannot install dependencies, InstallDependencies returns ErrInstallDependenciesNotSupported.
+	InstallDependencies(directory string, isTerminal bool, stdout, stderr io.Writer) error
 }
 
+// ErrInstallDependenciesNotSupported is returned by LanguageRuntime.InstallDependencies if the language runtime does not
+// support installing a program's dependencies.
+var ErrInstallDependenciesNotSupported = errors.New("language runtime does not support installing dependencies")
+
 // ProgInfo contains minimal information about the program to be run.
 type ProgInfo struct {
 	Proj    *workspace.Project // the program project/package.
@@ -60,3 +72,36 @@ type RunInfo struct {
 	QueryMode      bool                  // true if we're only doing a query.
 	Parallel       int                   // the degree of parallelism for resource operations (<=1 for serial).
 }
+
+// InstallDependenciesWriters returns a pair of writers that send their output to the caller of a language host's
+// InstallDependencies RPC as standard output and standard error, respectively. The writers may be used concurrently,
+// e.g. as the Stdout and Stderr of an exec.Cmd.
+func InstallDependenciesWriters(
+	server pulumirpc.LanguageRuntime_InstallDependenciesServer) (stdout io.Writer, stderr io.Writer) {
+
+	var mutex sync.Mutex
+	send := func(resp *pulumirpc.InstallDependenciesResponse) error {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return server.Send(resp)
+	}
+
+	stdout = installDependenciesWriter(func(p []byte) error {
+		return send(&pulumirpc.InstallDependenciesResponse{Stdout: p})
+	})
+	stderr = installDependenciesWriter(func(p []byte) error {
+		return send(&pulumirpc.InstallDependenciesResponse{Stderr: p})
+	})
+	return stdout, stderr
+}
+
+// installDependenciesWriter is an io.Writer that sends each chunk of output using the given function.
+type installDependenciesWriter func(p []byte) error
+
+func (w installDependenciesWriter) Write(p []byte) (int, error) {
+	// The chunk must be copied, as the caller may reuse p once Write returns.
+	if err := w(append([]byte(nil), p...)); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
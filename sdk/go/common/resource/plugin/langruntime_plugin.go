@@ -16,6 +16,7 @@ package plugin
 
 import (
 	"fmt"
+	"io"
 	"strings"
 
 	"github.com/blang/semver"
@@ -192,6 +193,55 @@ func (h *langhost) GetPluginInfo() (workspace.PluginInfo, error) {
 	}, nil
 }
 
+// InstallDependencies installs the dependencies of the program in the given directory, writing the output of the
+// installation to stdout and stderr.
+func (h *langhost) InstallDependencies(directory string, isTerminal bool, stdout, stderr io.Writer) error {
+	logging.V(7).Infof("langhost[%v].InstallDependencies(directory=%s) executing", h.runtime, directory)
+	stream, err := h.client.InstallDependencies(h.ctx.Request(), &pulumirpc.InstallDependenciesRequest{
+		Directory:  directory,
+		IsTerminal: isTerminal,
+	})
+	if err != nil {
+		rpcError := rpcerror.Convert(err)
+		logging.V(7).Infof("langhost[%v].InstallDependencies(directory=%s) failed: err=%v", h.runtime, directory, rpcError)
+		return rpcError
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			rpcError := rpcerror.Convert(err)
+			logging.V(7).Infof("langhost[%v].InstallDependencies(directory=%s) failed: err=%v",
+				h.runtime, directory, rpcError)
+
+			// Older language hosts do not implement InstallDependencies. Report this so that the caller can install
+			// the program's dependencies some other way.
+			if rpcError.Code() == codes.Unimplemented {
+				return ErrInstallDependenciesNotSupported
+			}
+
+			return rpcError
+		}
+
+		if len(resp.Stdout) != 0 {
+			if _, err := stdout.Write(resp.Stdout); err != nil {
+				return err
+			}
+		}
+		if len(resp.Stderr) != 0 {
+			if _, err := stderr.Write(resp.Stderr); err != nil {
+				return err
+			}
+		}
+	}
+
+	logging.V(7).Infof("langhost[%v].InstallDependencies(directory=%s) success", h.runtime, directory)
+	return nil
+}
+
 // Close tears down the underlying plugin RPC connection and process.
 func (h *langhost) Close() error {
 	return h.plug.Close()
@@ -0,0 +1,56 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	pulumirpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+type testInstallDependenciesServer struct {
+	grpc.ServerStream
+
+	responses []*pulumirpc.InstallDependenciesResponse
+}
+
+func (s *testInstallDependenciesServer) Send(resp *pulumirpc.InstallDependenciesResponse) error {
+	s.responses = append(s.responses, resp)
+	return nil
+}
+
+func TestInstallDependenciesWriters(t *testing.T) {
+	server := &testInstallDependenciesServer{}
+	stdout, stderr := InstallDependenciesWriters(server)
+
+	buf := []byte("out")
+	n, err := stdout.Write(buf)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, n)
+
+	// Reusing the buffer must not change what was already sent.
+	copy(buf, "err")
+	n, err = stderr.Write(buf)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, n)
+
+	assert.Equal(t, []*pulumirpc.InstallDependenciesResponse{
+		{Stdout: []byte("out")},
+		{Stderr: []byte("err")},
+	}, server.responses)
+}
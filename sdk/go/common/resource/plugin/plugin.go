@@ -178,10 +178,24 @@ func newPlugin(ctx *Context, pwd, bin, prefix string, args, env []string) (*plug
 	go runtrace(plug.Stdout, false, stdoutDone)
 
 	// Now that we have the port, go ahead and create a gRPC client connection to it.
+	conn, err := dialPlugin(port, bin, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	// Done; store the connection and return the plugin info.
+	plug.Conn = conn
+	return plug, nil
+}
+
+// dialPlugin creates a gRPC client connection to the plugin listening on the given port and waits for the plugin to
+// begin responding to RPCs.
+func dialPlugin(port, bin, prefix string) (*grpc.ClientConn, error) {
 	conn, err := grpc.Dial(
 		"127.0.0.1:"+port,
 		grpc.WithInsecure(),
 		grpc.WithUnaryInterceptor(rpcutil.OpenTracingClientInterceptor()),
+		grpc.WithStreamInterceptor(rpcutil.OpenTracingStreamClientInterceptor()),
 		rpcutil.GrpcChannelOptions(),
 	)
 	if err != nil {
@@ -230,9 +244,7 @@ func newPlugin(ctx *Context, pwd, bin, prefix string, args, env []string) (*plug
 		}
 	}
 
-	// Done; store the connection and return the plugin info.
-	plug.Conn = conn
-	return plug, nil
+	return conn, nil
 }
 
 // execPlugin starts the plugin executable.
@@ -296,6 +308,11 @@ func (p *plugin) Close() error {
 		contract.IgnoreClose(p.Conn)
 	}
 
+	// Plugins that we attached to rather than launched are not ours to kill.
+	if p.Proc == nil {
+		return nil
+	}
+
 	var result error
 
 	// On each platform, plugins are not loaded directly, instead a shell launches each plugin as a child process, so
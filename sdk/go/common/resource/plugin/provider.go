@@ -16,8 +16,12 @@ package plugin
 
 import (
 	"io"
+	"time"
+
+	"github.com/pkg/errors"
 
 	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/config"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/tokens"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/contract"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/workspace"
@@ -80,6 +84,18 @@ type Provider interface {
 		tok tokens.ModuleMember,
 		args resource.PropertyMap,
 		onNext func(resource.PropertyMap) error) ([]CheckFailure, error)
+	// Construct creates a new component resource. The provider registers the component and its children with the
+	// resource monitor given in info and returns the component's URN and outputs.
+	Construct(info ConstructInfo, typ tokens.Type, name tokens.QName, parent resource.URN, inputs resource.PropertyMap,
+		options ConstructOptions) (ConstructResult, error)
+	// Call calls the method with the given token on the resource with the given URN and state. The method's arguments
+	// may refer to other resources, and its results record the resources that they depend on.
+	Call(tok tokens.ModuleMember, urn resource.URN, state resource.PropertyMap, args resource.PropertyMap,
+		info CallInfo, options CallOptions) (CallResult, error)
+	// GetLogs streams the log entries produced by a resource, optionally restricted to those between startTime and
+	// endTime. If the provider does not support logs for the resource, GetLogs returns ErrLogsNotSupported.
+	GetLogs(urn resource.URN, id resource.ID, state resource.PropertyMap, startTime, endTime *time.Time,
+		onNext func(LogEntry) error) error
 	// GetPluginInfo returns this plugin's information.
 	GetPluginInfo() (workspace.PluginInfo, error)
 
@@ -91,6 +107,9 @@ type Provider interface {
 	SignalCancellation() error
 }
 
+// ErrLogsNotSupported is returned by Provider.GetLogs if the provider does not support logs for a resource.
+var ErrLogsNotSupported = errors.New("provider does not support logs")
+
 // CheckFailure indicates that a call to check failed; it contains the property and reason for the failure.
 type CheckFailure struct {
 	Property resource.PropertyKey // the property that failed checking.
@@ -209,3 +228,70 @@ type ReadResult struct {
 	// exist.
 	Outputs resource.PropertyMap
 }
+
+// ConstructInfo contains all of the information required to register resources as part of a call to Construct.
+type ConstructInfo struct {
+	Project        string                // the project name housing the program being run.
+	Stack          string                // the stack name being evaluated.
+	Config         map[config.Key]string // the configuration variables to apply before running.
+	DryRun         bool                  // true if we are performing a dry-run (preview).
+	Parallel       int                   // the degree of parallelism for resource operations (<=1 for serial).
+	MonitorAddress string                // the RPC address to the host resource monitor.
+}
+
+// ConstructOptions captures options for a call to Construct.
+type ConstructOptions struct {
+	// Aliases is the set of aliases for the component.
+	Aliases []resource.URN
+	// Dependencies is the list of resources this component depends on.
+	Dependencies []resource.URN
+	// Protect is true if the component is protected.
+	Protect bool
+	// Providers is a map from package name to provider reference.
+	Providers map[string]string
+	// PropertyDependencies is a map from property name to a list of resources that property depends on.
+	PropertyDependencies map[resource.PropertyKey][]resource.URN
+}
+
+// ConstructResult is the result of a call to Construct.
+type ConstructResult struct {
+	// The URN of the constructed component resource.
+	URN resource.URN
+	// The output properties of the component resource.
+	Outputs resource.PropertyMap
+	// The resources that each output property depends on.
+	OutputDependencies map[resource.PropertyKey][]resource.URN
+}
+
+// LogEntry is a single entry in the logs produced by a resource.
+type LogEntry struct {
+	ID        string // an identifier for the source of this entry (e.g. a log stream).
+	Timestamp int64  // the Unix timestamp of this entry, in milliseconds.
+	Message   string // the message for this entry.
+}
+
+// CallInfo contains all of the information required to register resources as part of a call to Call.
+type CallInfo struct {
+	Project        string                // the project name housing the program being run.
+	Stack          string                // the stack name being evaluated.
+	Config         map[config.Key]string // the configuration variables to apply before running.
+	DryRun         bool                  // true if we are performing a dry-run (preview).
+	Parallel       int                   // the degree of parallelism for resource operations (<=1 for serial).
+	MonitorAddress string                // the RPC address to the host resource monitor.
+}
+
+// CallOptions captures options for a call to Call.
+type CallOptions struct {
+	// ArgDependencies is a map from argument name to a list of resources that argument depends on.
+	ArgDependencies map[resource.PropertyKey][]resource.URN
+}
+
+// CallResult is the result of a call to Call.
+type CallResult struct {
+	// The returned values, if the call was successful.
+	Return resource.PropertyMap
+	// The resources that each returned value depends on.
+	ReturnDependencies map[resource.PropertyKey][]resource.URN
+	// The failures if any arguments didn't pass verification.
+	Failures []CheckFailure
+}
@@ -18,7 +18,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/blang/semver"
 	pbempty "github.com/golang/protobuf/ptypes/empty"
@@ -50,6 +53,37 @@ const nodejsDynamicProviderType = "pulumi:providers:pulumi-nodejs"
 // This is needed because we have to handle some buggy behavior that previous versions of this provider implemented.
 const kubernetesProviderType = "pulumi:providers:kubernetes"
 
+// AttachProvidersEnvVar is the environment variable that lists providers which are already running and should be
+// attached to rather than launched. Its value is a comma-separated list of `package:port` pairs, e.g.
+// `aws:12345,random:12346`.
+const AttachProvidersEnvVar = "PULUMI_ATTACH_PROVIDERS"
+
+// GetProviderAttachPort returns the port of the already-running provider for the given package, if the
+// AttachProvidersEnvVar environment variable lists one.
+func GetProviderAttachPort(pkg tokens.Package) (*int, error) {
+	providers := os.Getenv(AttachProvidersEnvVar)
+	if providers == "" {
+		return nil, nil
+	}
+
+	for _, provider := range strings.Split(providers, ",") {
+		parts := strings.SplitN(provider, ":", 2)
+		if len(parts) != 2 {
+			return nil, errors.Errorf("invalid provider %q in %s: expected package:port", provider,
+				AttachProvidersEnvVar)
+		}
+		if parts[0] != string(pkg) {
+			continue
+		}
+		port, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid port for provider %q in %s", parts[0], AttachProvidersEnvVar)
+		}
+		return &port, nil
+	}
+	return nil, nil
+}
+
 // provider reflects a resource plugin, loaded dynamically for a single package.
 type provider struct {
 	ctx           *Context                         // a plugin context for caching, etc.
@@ -65,6 +99,26 @@ type provider struct {
 // NewProvider attempts to bind to a given package's resource plugin and then creates a gRPC connection to it.  If the
 // plugin could not be found, or an error occurs while creating the child process, an error is returned.
 func NewProvider(host Host, ctx *Context, pkg tokens.Package, version *semver.Version) (Provider, error) {
+	// If the provider for this package is already running, attach to it instead of launching a new one.
+	attachPort, err := GetProviderAttachPort(pkg)
+	if err != nil {
+		return nil, err
+	}
+	if attachPort != nil {
+		prefix := fmt.Sprintf("%v (resource)", pkg)
+		conn, err := dialPlugin(strconv.Itoa(*attachPort), string(pkg), prefix)
+		if err != nil {
+			return nil, err
+		}
+		return &provider{
+			ctx:       ctx,
+			pkg:       pkg,
+			plug:      &plugin{Conn: conn},
+			clientRaw: pulumirpc.NewResourceProviderClient(conn),
+			cfgdone:   make(chan bool),
+		}, nil
+	}
+
 	// Load the plugin's path by using the standard workspace logic.
 	_, path, err := workspace.GetPluginPath(
 		workspace.ResourcePlugin, strings.Replace(string(pkg), tokens.QNameDelimiter, "_", -1), version)
@@ -1078,6 +1132,285 @@ func (p *provider) StreamInvoke(
 	}
 }
 
+// Construct creates a new component resource.
+func (p *provider) Construct(info ConstructInfo, typ tokens.Type, name tokens.QName, parent resource.URN,
+	inputs resource.PropertyMap, options ConstructOptions) (ConstructResult, error) {
+
+	contract.Assert(typ != "")
+	contract.Assert(name != "")
+	contract.Assert(inputs != nil)
+
+	label := fmt.Sprintf("%s.Construct(%s, %s, %s)", p.label(), typ, name, parent)
+	logging.V(7).Infof("%s executing (#inputs=%v)", label, len(inputs))
+
+	// Get the RPC client and ensure it's configured.
+	client, err := p.getClient()
+	if err != nil {
+		return ConstructResult{}, err
+	}
+
+	// We keep unknowns during dry runs so that the provider can construct the component's children, and we always
+	// keep secrets: the component is responsible for propagating them to the children that consume them.
+	minputs, err := MarshalProperties(inputs, MarshalOptions{
+		Label:        fmt.Sprintf("%s.inputs", label),
+		KeepUnknowns: info.DryRun,
+		KeepSecrets:  true,
+	})
+	if err != nil {
+		return ConstructResult{}, err
+	}
+
+	// Marshal the aliases, dependencies, and property dependencies.
+	aliases := make([]string, len(options.Aliases))
+	for i, alias := range options.Aliases {
+		aliases[i] = string(alias)
+	}
+	dependencies := make([]string, len(options.Dependencies))
+	for i, dep := range options.Dependencies {
+		dependencies[i] = string(dep)
+	}
+	inputDependencies := make(map[string]*pulumirpc.ConstructRequest_PropertyDependencies)
+	for name, dependencies := range options.PropertyDependencies {
+		urns := make([]string, len(dependencies))
+		for i, urn := range dependencies {
+			urns[i] = string(urn)
+		}
+		inputDependencies[string(name)] = &pulumirpc.ConstructRequest_PropertyDependencies{Urns: urns}
+	}
+
+	// Marshal the config.
+	config := map[string]string{}
+	for k, v := range info.Config {
+		config[k.String()] = v
+	}
+
+	resp, err := client.Construct(p.ctx.Request(), &pulumirpc.ConstructRequest{
+		Project:           info.Project,
+		Stack:             info.Stack,
+		Config:            config,
+		DryRun:            info.DryRun,
+		Parallel:          int32(info.Parallel),
+		MonitorEndpoint:   info.MonitorAddress,
+		Type:              string(typ),
+		Name:              string(name),
+		Parent:            string(parent),
+		Inputs:            minputs,
+		InputDependencies: inputDependencies,
+		Protect:           options.Protect,
+		Providers:         options.Providers,
+		Aliases:           aliases,
+		Dependencies:      dependencies,
+	})
+	if err != nil {
+		rpcError := rpcerror.Convert(err)
+		logging.V(7).Infof("%s failed: %v", label, rpcError.Message())
+		if rpcError.Code() == codes.Unimplemented {
+			return ConstructResult{}, errors.Errorf("provider for package %v does not support constructing components",
+				p.pkg)
+		}
+		return ConstructResult{}, rpcError
+	}
+
+	outputs, err := UnmarshalProperties(resp.GetState(), MarshalOptions{
+		Label:        fmt.Sprintf("%s.outputs", label),
+		KeepUnknowns: info.DryRun,
+		KeepSecrets:  true,
+	})
+	if err != nil {
+		return ConstructResult{}, err
+	}
+
+	outputDependencies := map[resource.PropertyKey][]resource.URN{}
+	for k, rpcDeps := range resp.GetStateDependencies() {
+		urns := make([]resource.URN, len(rpcDeps.Urns))
+		for i, d := range rpcDeps.Urns {
+			urns[i] = resource.URN(d)
+		}
+		outputDependencies[resource.PropertyKey(k)] = urns
+	}
+
+	logging.V(7).Infof("%s success: #outputs=%d", label, len(outputs))
+	return ConstructResult{
+		URN:                resource.URN(resp.GetUrn()),
+		Outputs:            outputs,
+		OutputDependencies: outputDependencies,
+	}, nil
+}
+
+// Call calls the method with the given token on the resource with the given URN and state.
+func (p *provider) Call(tok tokens.ModuleMember, urn resource.URN, state resource.PropertyMap,
+	args resource.PropertyMap, info CallInfo, options CallOptions) (CallResult, error) {
+
+	contract.Assert(tok != "")
+	contract.Assert(urn != "")
+
+	label := fmt.Sprintf("%s.Call(%s, %s)", p.label(), tok, urn)
+	logging.V(7).Infof("%s executing (#args=%d)", label, len(args))
+
+	// Get the RPC client and ensure it's configured.
+	client, err := p.getClient()
+	if err != nil {
+		return CallResult{}, err
+	}
+
+	// If the provider is not fully configured, return an empty result.
+	if !p.cfgknown {
+		return CallResult{}, nil
+	}
+
+	// As with Construct, we keep unknowns during dry runs and always keep secrets: the method is responsible for
+	// propagating them to its results.
+	marshalOptions := MarshalOptions{KeepUnknowns: info.DryRun, KeepSecrets: true}
+	marshalOptions.Label = fmt.Sprintf("%s.state", label)
+	mstate, err := MarshalProperties(state, marshalOptions)
+	if err != nil {
+		return CallResult{}, err
+	}
+	marshalOptions.Label = fmt.Sprintf("%s.args", label)
+	margs, err := MarshalProperties(args, marshalOptions)
+	if err != nil {
+		return CallResult{}, err
+	}
+
+	argDependencies := make(map[string]*pulumirpc.CallRequest_ArgumentDependencies)
+	for name, dependencies := range options.ArgDependencies {
+		urns := make([]string, len(dependencies))
+		for i, urn := range dependencies {
+			urns[i] = string(urn)
+		}
+		argDependencies[string(name)] = &pulumirpc.CallRequest_ArgumentDependencies{Urns: urns}
+	}
+
+	// Marshal the config.
+	config := map[string]string{}
+	for k, v := range info.Config {
+		config[k.String()] = v
+	}
+
+	resp, err := client.Call(p.ctx.Request(), &pulumirpc.CallRequest{
+		Tok:             string(tok),
+		Urn:             string(urn),
+		State:           mstate,
+		Args:            margs,
+		ArgDependencies: argDependencies,
+		Project:         info.Project,
+		Stack:           info.Stack,
+		Config:          config,
+		DryRun:          info.DryRun,
+		Parallel:        int32(info.Parallel),
+		MonitorEndpoint: info.MonitorAddress,
+	})
+	if err != nil {
+		rpcError := rpcerror.Convert(err)
+		logging.V(7).Infof("%s failed: %v", label, rpcError.Message())
+		if rpcError.Code() == codes.Unimplemented {
+			return CallResult{}, errors.Errorf("provider for package %v does not support calling methods", p.pkg)
+		}
+		return CallResult{}, rpcError
+	}
+
+	marshalOptions.Label = fmt.Sprintf("%s.returns", label)
+	ret, err := UnmarshalProperties(resp.GetReturn(), marshalOptions)
+	if err != nil {
+		return CallResult{}, err
+	}
+
+	returnDependencies := map[resource.PropertyKey][]resource.URN{}
+	for k, rpcDeps := range resp.GetReturnDependencies() {
+		urns := make([]resource.URN, len(rpcDeps.Urns))
+		for i, d := range rpcDeps.Urns {
+			urns[i] = resource.URN(d)
+		}
+		returnDependencies[resource.PropertyKey(k)] = urns
+	}
+
+	var failures []CheckFailure
+	for _, failure := range resp.GetFailures() {
+		failures = append(failures, CheckFailure{resource.PropertyKey(failure.Property), failure.Reason})
+	}
+
+	logging.V(7).Infof("%s success (#ret=%d,#failures=%d)", label, len(ret), len(failures))
+	return CallResult{Return: ret, ReturnDependencies: returnDependencies, Failures: failures}, nil
+}
+
+// GetLogs streams the log entries produced by a resource.
+func (p *provider) GetLogs(urn resource.URN, id resource.ID, state resource.PropertyMap, startTime, endTime *time.Time,
+	onNext func(LogEntry) error) error {
+
+	contract.Assert(urn != "")
+	contract.Assert(id != "")
+	contract.Assert(onNext != nil)
+
+	label := fmt.Sprintf("%s.GetLogs(%s,%s)", p.label(), urn, id)
+	logging.V(7).Infof("%s executing (#state=%d)", label, len(state))
+
+	// Get the RPC client and ensure it's configured.
+	client, err := p.getClient()
+	if err != nil {
+		return err
+	}
+
+	// If the provider is not fully configured, there is no way for it to fetch logs. Report this as unsupported so that
+	// callers can fall back to other sources of logs rather than mistaking it for an empty log.
+	if !p.cfgknown {
+		logging.V(7).Infof("%s failed: provider configuration is not known", label)
+		return ErrLogsNotSupported
+	}
+
+	mstate, err := MarshalProperties(state, MarshalOptions{
+		Label:              fmt.Sprintf("%s.state", label),
+		ElideAssetContents: true,
+		KeepSecrets:        p.acceptSecrets,
+	})
+	if err != nil {
+		return err
+	}
+
+	req := &pulumirpc.GetLogsRequest{
+		Id:         string(id),
+		Urn:        string(urn),
+		Properties: mstate,
+	}
+	if startTime != nil {
+		req.StartTime = startTime.UnixNano() / int64(time.Millisecond)
+	}
+	if endTime != nil {
+		req.EndTime = endTime.UnixNano() / int64(time.Millisecond)
+	}
+
+	// Unimplemented errors may be reported either by the call itself or by the first receive on the stream.
+	unsupported := func(err error) error {
+		rpcError := rpcerror.Convert(err)
+		logging.V(7).Infof("%s failed: %v", label, rpcError.Message())
+		if rpcError.Code() == codes.Unimplemented {
+			return ErrLogsNotSupported
+		}
+		return rpcError
+	}
+
+	streamClient, err := client.GetLogs(p.ctx.Request(), req)
+	if err != nil {
+		return unsupported(err)
+	}
+
+	entries := 0
+	for {
+		in, err := streamClient.Recv()
+		if err == io.EOF {
+			logging.V(7).Infof("%s success (#entries=%d)", label, entries)
+			return nil
+		}
+		if err != nil {
+			return unsupported(err)
+		}
+
+		entries++
+		if err := onNext(LogEntry{ID: in.GetId(), Timestamp: in.GetTimestamp(), Message: in.GetMessage()}); err != nil {
+			return err
+		}
+	}
+}
+
 // GetPluginInfo returns this plugin's information.
 func (p *provider) GetPluginInfo() (workspace.PluginInfo, error) {
 	label := fmt.Sprintf("%s.GetPluginInfo()", p.label())
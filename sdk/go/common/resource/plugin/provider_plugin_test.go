@@ -1,12 +1,14 @@
 package plugin
 
 import (
+	"os"
 	"reflect"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/contract"
 )
 
 func TestAnnotateSecrets(t *testing.T) {
@@ -217,3 +219,37 @@ func TestNestedSecret(t *testing.T) {
 
 	assert.Truef(t, reflect.DeepEqual(to, expected), "did not match expected after annotation")
 }
+
+func TestGetProviderAttachPort(t *testing.T) {
+	old, hadOld := os.LookupEnv(AttachProvidersEnvVar)
+	defer func() {
+		if hadOld {
+			contract.IgnoreError(os.Setenv(AttachProvidersEnvVar, old))
+		} else {
+			contract.IgnoreError(os.Unsetenv(AttachProvidersEnvVar))
+		}
+	}()
+
+	contract.IgnoreError(os.Unsetenv(AttachProvidersEnvVar))
+	port, err := GetProviderAttachPort("aws")
+	assert.NoError(t, err)
+	assert.Nil(t, port)
+
+	contract.AssertNoError(os.Setenv(AttachProvidersEnvVar, "aws:12345,random:12346"))
+	port, err = GetProviderAttachPort("random")
+	assert.NoError(t, err)
+	if assert.NotNil(t, port) {
+		assert.Equal(t, 12346, *port)
+	}
+	port, err = GetProviderAttachPort("gcp")
+	assert.NoError(t, err)
+	assert.Nil(t, port)
+
+	contract.AssertNoError(os.Setenv(AttachProvidersEnvVar, "aws:notaport"))
+	_, err = GetProviderAttachPort("aws")
+	assert.Error(t, err)
+
+	contract.AssertNoError(os.Setenv(AttachProvidersEnvVar, "aws"))
+	_, err = GetProviderAttachPort("aws")
+	assert.Error(t, err)
+}
@@ -0,0 +1,596 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	pbempty "github.com/golang/protobuf/ptypes/empty"
+	_struct "github.com/golang/protobuf/ptypes/struct"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/config"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/rpcutil/rpcerror"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+// providerServer adapts an in-process Provider to the ResourceProvider gRPC interface, so that it may be served to
+// the engine as if it were a provider plugin.
+type providerServer struct {
+	provider Provider
+}
+
+// NewProviderServer returns a gRPC server for the given provider. Together with AttachProvidersEnvVar, this allows
+// an in-process Provider (for example, a fake used in tests) to stand in for the real plugin for its package.
+func NewProviderServer(provider Provider) pulumirpc.ResourceProviderServer {
+	return &providerServer{provider: provider}
+}
+
+// unmarshalOptions are used to decode every property map sent by the engine. The engine only sends unknowns and
+// secrets when it is prepared to receive them, so we always keep both.
+var unmarshalOptions = MarshalOptions{KeepUnknowns: true, KeepSecrets: true}
+
+func (p *providerServer) unmarshal(props *_struct.Struct) (resource.PropertyMap, error) {
+	return UnmarshalProperties(props, unmarshalOptions)
+}
+
+func (p *providerServer) marshal(props resource.PropertyMap) (*_struct.Struct, error) {
+	return MarshalProperties(props, MarshalOptions{KeepUnknowns: true, KeepSecrets: true})
+}
+
+func (p *providerServer) marshalFailures(failures []CheckFailure) []*pulumirpc.CheckFailure {
+	rpcFailures := make([]*pulumirpc.CheckFailure, len(failures))
+	for i, f := range failures {
+		rpcFailures[i] = &pulumirpc.CheckFailure{Property: string(f.Property), Reason: f.Reason}
+	}
+	return rpcFailures
+}
+
+func (p *providerServer) marshalDiff(diff DiffResult) (*pulumirpc.DiffResponse, error) {
+	changes := pulumirpc.DiffResponse_DIFF_UNKNOWN
+	switch diff.Changes {
+	case DiffNone:
+		changes = pulumirpc.DiffResponse_DIFF_NONE
+	case DiffSome:
+		changes = pulumirpc.DiffResponse_DIFF_SOME
+	}
+
+	// Infer the result from the detailed diff if the provider did not supply one.
+	if diff.Changes == DiffUnknown && diff.DetailedDiff != nil {
+		changes = pulumirpc.DiffResponse_DIFF_NONE
+		if len(diff.DetailedDiff) != 0 {
+			changes = pulumirpc.DiffResponse_DIFF_SOME
+		}
+	}
+
+	var detailedDiff map[string]*pulumirpc.PropertyDiff
+	if diff.DetailedDiff != nil {
+		detailedDiff = make(map[string]*pulumirpc.PropertyDiff)
+		for path, diff := range diff.DetailedDiff {
+			var kind pulumirpc.PropertyDiff_Kind
+			switch diff.Kind {
+			case DiffAdd:
+				kind = pulumirpc.PropertyDiff_ADD
+			case DiffAddReplace:
+				kind = pulumirpc.PropertyDiff_ADD_REPLACE
+			case DiffDelete:
+				kind = pulumirpc.PropertyDiff_DELETE
+			case DiffDeleteReplace:
+				kind = pulumirpc.PropertyDiff_DELETE_REPLACE
+			case DiffUpdate:
+				kind = pulumirpc.PropertyDiff_UPDATE
+			case DiffUpdateReplace:
+				kind = pulumirpc.PropertyDiff_UPDATE_REPLACE
+			default:
+				return nil, errors.Errorf("unknown property diff kind %v", diff.Kind)
+			}
+			detailedDiff[path] = &pulumirpc.PropertyDiff{Kind: kind, InputDiff: diff.InputDiff}
+		}
+	}
+
+	keys := func(ks []resource.PropertyKey) []string {
+		result := make([]string, len(ks))
+		for i, k := range ks {
+			result[i] = string(k)
+		}
+		return result
+	}
+
+	return &pulumirpc.DiffResponse{
+		Replaces:            keys(diff.ReplaceKeys),
+		Stables:             keys(diff.StableKeys),
+		DeleteBeforeReplace: diff.DeleteBeforeReplace,
+		Changes:             changes,
+		Diffs:               keys(diff.ChangedKeys),
+		DetailedDiff:        detailedDiff,
+		HasDetailedDiff:     detailedDiff != nil,
+	}, nil
+}
+
+// resourceError converts an error returned by a resource operation into a gRPC error that the engine will interpret
+// with the given status. Partial failures carry the resource's ID and state along with the error so that the engine
+// can record them.
+func (p *providerServer) resourceError(status resource.Status, err error, id resource.ID,
+	state, inputs resource.PropertyMap) error {
+
+	if status == resource.StatusPartialFailure {
+		var reasons []string
+		if initErr, ok := err.(*InitError); ok {
+			reasons = initErr.Reasons
+		} else {
+			reasons = []string{err.Error()}
+		}
+
+		rpcState, merr := p.marshal(state)
+		if merr != nil {
+			return merr
+		}
+		var rpcInputs *_struct.Struct
+		if inputs != nil {
+			if rpcInputs, merr = p.marshal(inputs); merr != nil {
+				return merr
+			}
+		}
+		return rpcerror.WithDetails(
+			rpcerror.New(codes.Unknown, err.Error()),
+			&pulumirpc.ErrorResourceInitFailed{
+				Id:         string(id),
+				Properties: rpcState,
+				Reasons:    reasons,
+				Inputs:     rpcInputs,
+			})
+	}
+
+	// Errors that already carry a gRPC status are passed through unchanged.
+	if _, ok := rpcerror.FromError(err); ok {
+		return err
+	}
+	if status == resource.StatusUnknown {
+		return rpcerror.New(codes.Unknown, err.Error())
+	}
+	return rpcerror.New(codes.FailedPrecondition, err.Error())
+}
+
+func (p *providerServer) GetSchema(ctx context.Context,
+	req *pulumirpc.GetSchemaRequest) (*pulumirpc.GetSchemaResponse, error) {
+
+	schema, err := p.provider.GetSchema(int(req.GetVersion()))
+	if err != nil {
+		return nil, err
+	}
+	return &pulumirpc.GetSchemaResponse{Schema: string(schema)}, nil
+}
+
+func (p *providerServer) CheckConfig(ctx context.Context,
+	req *pulumirpc.CheckRequest) (*pulumirpc.CheckResponse, error) {
+
+	olds, err := p.unmarshal(req.GetOlds())
+	if err != nil {
+		return nil, err
+	}
+	news, err := p.unmarshal(req.GetNews())
+	if err != nil {
+		return nil, err
+	}
+
+	inputs, failures, err := p.provider.CheckConfig(resource.URN(req.GetUrn()), olds, news, true)
+	if err != nil {
+		return nil, err
+	}
+	rpcInputs, err := p.marshal(inputs)
+	if err != nil {
+		return nil, err
+	}
+	return &pulumirpc.CheckResponse{Inputs: rpcInputs, Failures: p.marshalFailures(failures)}, nil
+}
+
+func (p *providerServer) DiffConfig(ctx context.Context,
+	req *pulumirpc.DiffRequest) (*pulumirpc.DiffResponse, error) {
+
+	olds, err := p.unmarshal(req.GetOlds())
+	if err != nil {
+		return nil, err
+	}
+	news, err := p.unmarshal(req.GetNews())
+	if err != nil {
+		return nil, err
+	}
+
+	diff, err := p.provider.DiffConfig(resource.URN(req.GetUrn()), olds, news, true, req.GetIgnoreChanges())
+	if err != nil {
+		return nil, err
+	}
+	return p.marshalDiff(diff)
+}
+
+func (p *providerServer) Configure(ctx context.Context,
+	req *pulumirpc.ConfigureRequest) (*pulumirpc.ConfigureResponse, error) {
+
+	var inputs resource.PropertyMap
+	if req.GetArgs() != nil {
+		args, err := p.unmarshal(req.GetArgs())
+		if err != nil {
+			return nil, err
+		}
+		inputs = args
+	} else {
+		// Older engines only send configuration variables, keyed by "pkg:config:name".
+		inputs = resource.PropertyMap{}
+		for k, v := range req.GetVariables() {
+			key := k
+			if idx := strings.LastIndex(k, ":"); idx != -1 {
+				key = k[idx+1:]
+			}
+			inputs[resource.PropertyKey(key)] = resource.NewStringProperty(v)
+		}
+	}
+
+	if err := p.provider.Configure(inputs); err != nil {
+		return nil, err
+	}
+	return &pulumirpc.ConfigureResponse{AcceptSecrets: true}, nil
+}
+
+func (p *providerServer) Invoke(ctx context.Context, req *pulumirpc.InvokeRequest) (*pulumirpc.InvokeResponse, error) {
+	args, err := p.unmarshal(req.GetArgs())
+	if err != nil {
+		return nil, err
+	}
+
+	result, failures, err := p.provider.Invoke(tokens.ModuleMember(req.GetTok()), args)
+	if err != nil {
+		return nil, err
+	}
+	rpcResult, err := p.marshal(result)
+	if err != nil {
+		return nil, err
+	}
+	return &pulumirpc.InvokeResponse{Return: rpcResult, Failures: p.marshalFailures(failures)}, nil
+}
+
+func (p *providerServer) StreamInvoke(req *pulumirpc.InvokeRequest,
+	server pulumirpc.ResourceProvider_StreamInvokeServer) error {
+
+	args, err := p.unmarshal(req.GetArgs())
+	if err != nil {
+		return err
+	}
+
+	failures, err := p.provider.StreamInvoke(tokens.ModuleMember(req.GetTok()), args,
+		func(item resource.PropertyMap) error {
+			rpcItem, err := p.marshal(item)
+			if err != nil {
+				return err
+			}
+			return server.Send(&pulumirpc.InvokeResponse{Return: rpcItem})
+		})
+	if err != nil {
+		return err
+	}
+	if len(failures) == 0 {
+		return nil
+	}
+	return server.Send(&pulumirpc.InvokeResponse{Failures: p.marshalFailures(failures)})
+}
+
+func (p *providerServer) Check(ctx context.Context, req *pulumirpc.CheckRequest) (*pulumirpc.CheckResponse, error) {
+	olds, err := p.unmarshal(req.GetOlds())
+	if err != nil {
+		return nil, err
+	}
+	news, err := p.unmarshal(req.GetNews())
+	if err != nil {
+		return nil, err
+	}
+
+	inputs, failures, err := p.provider.Check(resource.URN(req.GetUrn()), olds, news, true)
+	if err != nil {
+		return nil, err
+	}
+	rpcInputs, err := p.marshal(inputs)
+	if err != nil {
+		return nil, err
+	}
+	return &pulumirpc.CheckResponse{Inputs: rpcInputs, Failures: p.marshalFailures(failures)}, nil
+}
+
+func (p *providerServer) Diff(ctx context.Context, req *pulumirpc.DiffRequest) (*pulumirpc.DiffResponse, error) {
+	olds, err := p.unmarshal(req.GetOlds())
+	if err != nil {
+		return nil, err
+	}
+	news, err := p.unmarshal(req.GetNews())
+	if err != nil {
+		return nil, err
+	}
+
+	diff, err := p.provider.Diff(resource.URN(req.GetUrn()), resource.ID(req.GetId()), olds, news, true,
+		req.GetIgnoreChanges())
+	if err != nil {
+		return nil, err
+	}
+	return p.marshalDiff(diff)
+}
+
+func (p *providerServer) Create(ctx context.Context, req *pulumirpc.CreateRequest) (*pulumirpc.CreateResponse, error) {
+	inputs, err := p.unmarshal(req.GetProperties())
+	if err != nil {
+		return nil, err
+	}
+
+	id, state, status, err := p.provider.Create(resource.URN(req.GetUrn()), inputs, req.GetTimeout())
+	if err != nil {
+		return nil, p.resourceError(status, err, id, state, nil)
+	}
+	rpcState, err := p.marshal(state)
+	if err != nil {
+		return nil, err
+	}
+	return &pulumirpc.CreateResponse{Id: string(id), Properties: rpcState}, nil
+}
+
+func (p *providerServer) Read(ctx context.Context, req *pulumirpc.ReadRequest) (*pulumirpc.ReadResponse, error) {
+	var inputs resource.PropertyMap
+	if req.GetInputs() != nil {
+		ins, err := p.unmarshal(req.GetInputs())
+		if err != nil {
+			return nil, err
+		}
+		inputs = ins
+	}
+	state, err := p.unmarshal(req.GetProperties())
+	if err != nil {
+		return nil, err
+	}
+
+	result, status, err := p.provider.Read(resource.URN(req.GetUrn()), resource.ID(req.GetId()), inputs, state)
+	if err != nil {
+		return nil, p.resourceError(status, err, result.ID, result.Outputs, result.Inputs)
+	}
+
+	rpcState, err := p.marshal(result.Outputs)
+	if err != nil {
+		return nil, err
+	}
+	var rpcInputs *_struct.Struct
+	if result.Inputs != nil {
+		if rpcInputs, err = p.marshal(result.Inputs); err != nil {
+			return nil, err
+		}
+	}
+	return &pulumirpc.ReadResponse{Id: string(result.ID), Properties: rpcState, Inputs: rpcInputs}, nil
+}
+
+func (p *providerServer) Update(ctx context.Context, req *pulumirpc.UpdateRequest) (*pulumirpc.UpdateResponse, error) {
+	olds, err := p.unmarshal(req.GetOlds())
+	if err != nil {
+		return nil, err
+	}
+	news, err := p.unmarshal(req.GetNews())
+	if err != nil {
+		return nil, err
+	}
+
+	id := resource.ID(req.GetId())
+	state, status, err := p.provider.Update(resource.URN(req.GetUrn()), id, olds, news, req.GetTimeout(),
+		req.GetIgnoreChanges())
+	if err != nil {
+		return nil, p.resourceError(status, err, id, state, nil)
+	}
+	rpcState, err := p.marshal(state)
+	if err != nil {
+		return nil, err
+	}
+	return &pulumirpc.UpdateResponse{Properties: rpcState}, nil
+}
+
+func (p *providerServer) Delete(ctx context.Context, req *pulumirpc.DeleteRequest) (*pbempty.Empty, error) {
+	props, err := p.unmarshal(req.GetProperties())
+	if err != nil {
+		return nil, err
+	}
+
+	id := resource.ID(req.GetId())
+	status, err := p.provider.Delete(resource.URN(req.GetUrn()), id, props, req.GetTimeout())
+	if err != nil {
+		return nil, p.resourceError(status, err, id, props, nil)
+	}
+	return &pbempty.Empty{}, nil
+}
+
+func (p *providerServer) Construct(ctx context.Context,
+	req *pulumirpc.ConstructRequest) (*pulumirpc.ConstructResponse, error) {
+
+	cfg := map[config.Key]string{}
+	for k, v := range req.GetConfig() {
+		key, err := config.ParseKey(k)
+		if err != nil {
+			return nil, err
+		}
+		cfg[key] = v
+	}
+	info := ConstructInfo{
+		Project:        req.GetProject(),
+		Stack:          req.GetStack(),
+		Config:         cfg,
+		DryRun:         req.GetDryRun(),
+		Parallel:       int(req.GetParallel()),
+		MonitorAddress: req.GetMonitorEndpoint(),
+	}
+
+	inputs, err := p.unmarshal(req.GetInputs())
+	if err != nil {
+		return nil, err
+	}
+
+	urns := func(ss []string) []resource.URN {
+		result := make([]resource.URN, len(ss))
+		for i, s := range ss {
+			result[i] = resource.URN(s)
+		}
+		return result
+	}
+	propertyDependencies := map[resource.PropertyKey][]resource.URN{}
+	for k, deps := range req.GetInputDependencies() {
+		propertyDependencies[resource.PropertyKey(k)] = urns(deps.GetUrns())
+	}
+	options := ConstructOptions{
+		Aliases:              urns(req.GetAliases()),
+		Dependencies:         urns(req.GetDependencies()),
+		Protect:              req.GetProtect(),
+		Providers:            req.GetProviders(),
+		PropertyDependencies: propertyDependencies,
+	}
+
+	result, err := p.provider.Construct(info, tokens.Type(req.GetType()), tokens.QName(req.GetName()),
+		resource.URN(req.GetParent()), inputs, options)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcState, err := p.marshal(result.Outputs)
+	if err != nil {
+		return nil, err
+	}
+	stateDependencies := map[string]*pulumirpc.ConstructResponse_PropertyDependencies{}
+	for k, deps := range result.OutputDependencies {
+		rpcDeps := make([]string, len(deps))
+		for i, d := range deps {
+			rpcDeps[i] = string(d)
+		}
+		stateDependencies[string(k)] = &pulumirpc.ConstructResponse_PropertyDependencies{Urns: rpcDeps}
+	}
+	return &pulumirpc.ConstructResponse{
+		Urn:               string(result.URN),
+		State:             rpcState,
+		StateDependencies: stateDependencies,
+	}, nil
+}
+
+func (p *providerServer) Call(ctx context.Context, req *pulumirpc.CallRequest) (*pulumirpc.CallResponse, error) {
+	cfg := map[config.Key]string{}
+	for k, v := range req.GetConfig() {
+		key, err := config.ParseKey(k)
+		if err != nil {
+			return nil, err
+		}
+		cfg[key] = v
+	}
+	info := CallInfo{
+		Project:        req.GetProject(),
+		Stack:          req.GetStack(),
+		Config:         cfg,
+		DryRun:         req.GetDryRun(),
+		Parallel:       int(req.GetParallel()),
+		MonitorAddress: req.GetMonitorEndpoint(),
+	}
+
+	state, err := p.unmarshal(req.GetState())
+	if err != nil {
+		return nil, err
+	}
+	args, err := p.unmarshal(req.GetArgs())
+	if err != nil {
+		return nil, err
+	}
+
+	argDependencies := map[resource.PropertyKey][]resource.URN{}
+	for k, deps := range req.GetArgDependencies() {
+		urns := make([]resource.URN, len(deps.GetUrns()))
+		for i, urn := range deps.GetUrns() {
+			urns[i] = resource.URN(urn)
+		}
+		argDependencies[resource.PropertyKey(k)] = urns
+	}
+
+	result, err := p.provider.Call(tokens.ModuleMember(req.GetTok()), resource.URN(req.GetUrn()), state, args, info,
+		CallOptions{ArgDependencies: argDependencies})
+	if err != nil {
+		return nil, err
+	}
+
+	rpcReturn, err := p.marshal(result.Return)
+	if err != nil {
+		return nil, err
+	}
+	returnDependencies := map[string]*pulumirpc.CallResponse_ReturnDependencies{}
+	for k, deps := range result.ReturnDependencies {
+		rpcDeps := make([]string, len(deps))
+		for i, d := range deps {
+			rpcDeps[i] = string(d)
+		}
+		returnDependencies[string(k)] = &pulumirpc.CallResponse_ReturnDependencies{Urns: rpcDeps}
+	}
+	return &pulumirpc.CallResponse{
+		Return:             rpcReturn,
+		ReturnDependencies: returnDependencies,
+		Failures:           p.marshalFailures(result.Failures),
+	}, nil
+}
+
+func (p *providerServer) GetLogs(req *pulumirpc.GetLogsRequest, server pulumirpc.ResourceProvider_GetLogsServer) error {
+	state, err := p.unmarshal(req.GetProperties())
+	if err != nil {
+		return err
+	}
+
+	var startTime, endTime *time.Time
+	if req.GetStartTime() != 0 {
+		t := time.Unix(0, req.GetStartTime()*int64(time.Millisecond))
+		startTime = &t
+	}
+	if req.GetEndTime() != 0 {
+		t := time.Unix(0, req.GetEndTime()*int64(time.Millisecond))
+		endTime = &t
+	}
+
+	err = p.provider.GetLogs(resource.URN(req.GetUrn()), resource.ID(req.GetId()), state, startTime, endTime,
+		func(entry LogEntry) error {
+			return server.Send(&pulumirpc.GetLogsResponse{
+				Id:        entry.ID,
+				Timestamp: entry.Timestamp,
+				Message:   entry.Message,
+			})
+		})
+	if err == ErrLogsNotSupported {
+		return status.Error(codes.Unimplemented, err.Error())
+	}
+	return err
+}
+
+func (p *providerServer) Cancel(ctx context.Context, req *pbempty.Empty) (*pbempty.Empty, error) {
+	if err := p.provider.SignalCancellation(); err != nil {
+		return nil, err
+	}
+	return &pbempty.Empty{}, nil
+}
+
+func (p *providerServer) GetPluginInfo(ctx context.Context, req *pbempty.Empty) (*pulumirpc.PluginInfo, error) {
+	info, err := p.provider.GetPluginInfo()
+	if err != nil {
+		return nil, err
+	}
+	var version string
+	if info.Version != nil {
+		version = info.Version.String()
+	}
+	return &pulumirpc.PluginInfo{Version: version}, nil
+}
@@ -37,13 +37,17 @@ type Goal struct {
 	Aliases                 []URN                 // additional URNs that should be aliased to this resource.
 	ID                      ID                    // the expected ID of the resource, if any.
 	CustomTimeouts          CustomTimeouts        // an optional config object for resource options
+	RetryPolicy             RetryPolicy           // an optional policy for retrying failed resource operations
+	RetainOnDelete          bool                  // true to leave the resource in the cloud when it is deleted.
+	DeletedWith             URN                   // the resource whose deletion also deletes this resource.
 }
 
 // NewGoal allocates a new resource goal state.
 func NewGoal(t tokens.Type, name tokens.QName, custom bool, props PropertyMap,
 	parent URN, protect bool, dependencies []URN, provider string, initErrors []string,
 	propertyDependencies map[PropertyKey][]URN, deleteBeforeReplace *bool, ignoreChanges []string,
-	additionalSecretOutputs []PropertyKey, aliases []URN, id ID, customTimeouts *CustomTimeouts) *Goal {
+	additionalSecretOutputs []PropertyKey, aliases []URN, id ID, customTimeouts *CustomTimeouts,
+	retryPolicy *RetryPolicy, retainOnDelete bool, deletedWith URN) *Goal {
 
 	g := &Goal{
 		Type:                    t,
@@ -61,11 +65,17 @@ func NewGoal(t tokens.Type, name tokens.QName, custom bool, props PropertyMap,
 		AdditionalSecretOutputs: additionalSecretOutputs,
 		Aliases:                 aliases,
 		ID:                      id,
+		RetainOnDelete:          retainOnDelete,
+		DeletedWith:             deletedWith,
 	}
 
 	if customTimeouts != nil {
 		g.CustomTimeouts = *customTimeouts
 	}
 
+	if retryPolicy != nil {
+		g.RetryPolicy = *retryPolicy
+	}
+
 	return g
 }
@@ -43,6 +43,9 @@ type State struct {
 	Aliases                 []URN                 // TODO
 	CustomTimeouts          CustomTimeouts        // A config block that will be used to configure timeouts for CRUD operations
 	ImportID                ID                    // the resource's import id, if this was an imported resource.
+	RetryPolicy             RetryPolicy           // A policy for retrying failed create, update, and delete operations
+	RetainOnDelete          bool                  // true if the resource should be left in the cloud when it is deleted from Pulumi.
+	DeletedWith             URN                   // the resource whose deletion also deletes this resource, if any.
 }
 
 // NewState creates a new resource value from existing resource state information.
@@ -51,7 +54,7 @@ func NewState(t tokens.Type, urn URN, custom bool, del bool, id ID,
 	external bool, dependencies []URN, initErrors []string, provider string,
 	propertyDependencies map[PropertyKey][]URN, pendingReplacement bool,
 	additionalSecretOutputs []PropertyKey, aliases []URN, timeouts *CustomTimeouts,
-	importID ID) *State {
+	importID ID, retryPolicy *RetryPolicy, retainOnDelete bool, deletedWith URN) *State {
 
 	contract.Assertf(t != "", "type was empty")
 	contract.Assertf(custom || id == "", "is custom or had empty ID")
@@ -76,11 +79,17 @@ func NewState(t tokens.Type, urn URN, custom bool, del bool, id ID,
 		AdditionalSecretOutputs: additionalSecretOutputs,
 		Aliases:                 aliases,
 		ImportID:                importID,
+		RetainOnDelete:          retainOnDelete,
+		DeletedWith:             deletedWith,
 	}
 
 	if timeouts != nil {
 		s.CustomTimeouts = *timeouts
 	}
 
+	if retryPolicy != nil {
+		s.RetryPolicy = *retryPolicy
+	}
+
 	return s
 }
@@ -0,0 +1,100 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"math"
+	"regexp"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// RetryPolicy controls how the engine retries a resource's create, update, or delete operation when the provider
+// reports an error, e.g. because of eventual consistency or rate limiting.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of times to attempt the operation, including the first. Values less than two
+	// disable retries.
+	MaxAttempts int `json:"maxAttempts,omitempty" yaml:"maxAttempts,omitempty"`
+	// Backoff is the number of seconds to wait before the first retry. The wait doubles with each further retry, and
+	// is never less than MinRetryDelay.
+	Backoff float64 `json:"backoff,omitempty" yaml:"backoff,omitempty"`
+	// ErrorMatchers is a list of regular expressions. If it is non-empty, only errors whose messages match at least
+	// one of the expressions are retried.
+	ErrorMatchers []string `json:"errorMatchers,omitempty" yaml:"errorMatchers,omitempty"`
+
+	matchers []*regexp.Regexp // the compiled ErrorMatchers, set by Validate.
+}
+
+// MinRetryDelay is the shortest time to wait before retrying an operation, so that a policy without a backoff does
+// not retry in a tight loop.
+const MinRetryDelay = time.Second
+
+// IsNotEmpty returns true if any part of the policy is set.
+func (r *RetryPolicy) IsNotEmpty() bool {
+	return r.MaxAttempts != 0 || r.Backoff != 0 || len(r.ErrorMatchers) != 0
+}
+
+// Validate returns an error if the policy is malformed, and compiles its error matchers for use by ShouldRetry.
+func (r *RetryPolicy) Validate() error {
+	if r.MaxAttempts < 0 {
+		return errors.Errorf("retry policy maxAttempts must not be negative (got %d)", r.MaxAttempts)
+	}
+	if r.Backoff < 0 {
+		return errors.Errorf("retry policy backoff must not be negative (got %v)", r.Backoff)
+	}
+	var matchers []*regexp.Regexp
+	for _, m := range r.ErrorMatchers {
+		re, err := regexp.Compile(m)
+		if err != nil {
+			return errors.Wrapf(err, "invalid retry policy error matcher %q", m)
+		}
+		matchers = append(matchers, re)
+	}
+	r.matchers = matchers
+	return nil
+}
+
+// ShouldRetry returns true if an operation that failed with the given error on the given attempt, counting from one,
+// should be attempted again.
+func (r *RetryPolicy) ShouldRetry(attempt int, err error) bool {
+	if attempt >= r.MaxAttempts {
+		return false
+	}
+	if len(r.ErrorMatchers) == 0 {
+		return true
+	}
+	if len(r.matchers) != len(r.ErrorMatchers) {
+		// The policy has not been validated, so compile its matchers now.
+		if r.Validate() != nil {
+			return false
+		}
+	}
+	for _, re := range r.matchers {
+		if re.MatchString(err.Error()) {
+			return true
+		}
+	}
+	return false
+}
+
+// Delay returns the time to wait before retrying an operation that failed on the given attempt, counting from one.
+func (r *RetryPolicy) Delay(attempt int) time.Duration {
+	seconds := r.Backoff * math.Pow(2, float64(attempt-1))
+	if delay := time.Duration(seconds * float64(time.Second)); delay > MinRetryDelay {
+		return delay
+	}
+	return MinRetryDelay
+}
@@ -0,0 +1,57 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryPolicyShouldRetry(t *testing.T) {
+	err := errors.New("throttled: rate exceeded")
+
+	policy := RetryPolicy{MaxAttempts: 3}
+	assert.True(t, policy.ShouldRetry(1, err))
+	assert.True(t, policy.ShouldRetry(2, err))
+	assert.False(t, policy.ShouldRetry(3, err))
+
+	policy.ErrorMatchers = []string{"^not found", "rate exceeded"}
+	assert.NoError(t, policy.Validate())
+	assert.True(t, policy.ShouldRetry(1, err))
+	assert.False(t, policy.ShouldRetry(1, errors.New("access denied")))
+
+	assert.False(t, (&RetryPolicy{}).ShouldRetry(1, err))
+}
+
+func TestRetryPolicyDelay(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 4, Backoff: 2}
+	assert.Equal(t, 2*time.Second, policy.Delay(1))
+	assert.Equal(t, 4*time.Second, policy.Delay(2))
+	assert.Equal(t, 8*time.Second, policy.Delay(3))
+
+	// Short or missing backoffs are raised to the minimum delay.
+	assert.Equal(t, MinRetryDelay, (&RetryPolicy{MaxAttempts: 4}).Delay(1))
+	assert.Equal(t, MinRetryDelay, (&RetryPolicy{MaxAttempts: 4, Backoff: 0.25}).Delay(2))
+}
+
+func TestRetryPolicyValidate(t *testing.T) {
+	assert.NoError(t, (&RetryPolicy{MaxAttempts: 2, Backoff: 1, ErrorMatchers: []string{"timeout"}}).Validate())
+	assert.Error(t, (&RetryPolicy{MaxAttempts: -1}).Validate())
+	assert.Error(t, (&RetryPolicy{Backoff: -1}).Validate())
+	assert.Error(t, (&RetryPolicy{ErrorMatchers: []string{"("}}).Validate())
+}
@@ -120,7 +120,11 @@ func RunResultFunc(run func(cmd *cobra.Command, args []string) result.Result) fu
 			// to quit at this point (with an error code so no one thinks we succeeded).  Bailing
 			// always indicates a failure, just one we don't need to print a message for.
 			if res.IsBail() {
-				os.Exit(-1)
+				code := -1
+				if exit, ok := res.(*exitCodeResult); ok {
+					code = exit.code
+				}
+				os.Exit(code)
 				return
 			}
 
@@ -140,6 +144,21 @@ func RunResultFunc(run func(cmd *cobra.Command, args []string) result.Result) fu
 	}
 }
 
+// exitCodeResult is a bail result that carries the exit code that the command should exit with.
+type exitCodeResult struct {
+	code int
+}
+
+func (r *exitCodeResult) Error() error { return nil }
+func (r *exitCodeResult) IsBail() bool { return true }
+
+// BailWithExitCode produces a bail result that causes a command wrapped with [RunResultFunc] to exit with the given
+// exit code rather than the standard error exit code.  Commands use this to report outcomes that scripts need to tell
+// apart from failures, and are expected to have already printed a message.
+func BailWithExitCode(code int) result.Result {
+	return &exitCodeResult{code: code}
+}
+
 // Exit exits with a given error.
 func Exit(err error) {
 	ExitError(errorMessage(err))
@@ -19,9 +19,11 @@ import (
 	"log"
 	"net/url"
 	"os"
+	"strings"
 
 	opentracing "github.com/opentracing/opentracing-go"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/contract"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/otlp"
 	jaeger "github.com/uber/jaeger-client-go"
 	"github.com/uber/jaeger-client-go/transport/zipkin"
 	"sourcegraph.com/sourcegraph/appdash"
@@ -53,7 +55,14 @@ func IsTracingEnabled() bool {
 	return TracingEndpoint != ""
 }
 
-// InitTracing initializes tracing
+// InitTracing initializes tracing. The endpoint determines where spans are sent:
+//
+//   - file:<path> writes an appdash trace to path when tracing is closed,
+//   - tcp://<host:port> sends spans to a remote appdash collector,
+//   - otlp://<host:port> sends spans to an OTLP/gRPC collector,
+//   - otlp+http://<host:port>[/path] or otlp+https://... sends spans to an OTLP/HTTP collector,
+//   - otlp+file:<path> appends OTLP/JSON spans to path, and
+//   - any other URL sends spans to a Zipkin collector.
 func InitTracing(name, rootSpanName, tracingEndpoint string) {
 	// If no tracing endpoint was provided, just return. The default global tracer is already a no-op tracer.
 	if tracingEndpoint == "" {
@@ -95,6 +104,32 @@ func InitTracing(name, rootSpanName, tracingEndpoint string) {
 		collector := appdash.NewRemoteCollector(tracingEndpoint)
 		traceCloser = collector
 		tracer = appdash_opentracing.NewTracer(collector)
+	case strings.HasPrefix(endpointURL.Scheme, "otlp"):
+		// If the endpoint scheme is otlp, export spans using the OpenTelemetry protocol. Unlike file:// endpoints,
+		// OTLP file endpoints are appended to as spans are finished, so plugins may share them with the CLI.
+		var exporter otlp.Exporter
+		switch endpointURL.Scheme {
+		case "otlp":
+			exporter, err = otlp.NewGRPCExporter(endpointURL.Host)
+		case "otlp+http", "otlp+https":
+			collectorURL := *endpointURL
+			collectorURL.Scheme = strings.TrimPrefix(endpointURL.Scheme, "otlp+")
+			exporter, err = otlp.NewHTTPExporter(collectorURL.String())
+		case "otlp+file":
+			path := endpointURL.Path
+			if path == "" {
+				path = endpointURL.Opaque
+			}
+			exporter, err = otlp.NewFileExporter(path)
+		default:
+			log.Fatalf("invalid tracing endpoint: unsupported scheme %q", endpointURL.Scheme)
+		}
+		if err != nil {
+			log.Fatalf("Cannot initialize OTLP exporter: %v", err)
+		}
+
+		recorder := otlp.NewRecorder(name, exporter)
+		tracer, traceCloser = otlp.NewTracer(recorder), recorder
 	default:
 		// Jaeger tracer can be initialized with a transport that will
 		// report tracing Spans to a Zipkin backend
@@ -0,0 +1,158 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/contract"
+	collectorpb "github.com/pulumi/pulumi/sdk/v2/proto/go/otlp/opentelemetry/proto/collector/trace/v1"
+)
+
+// exportTimeout bounds the time spent sending a single batch of spans to a collector.
+const exportTimeout = 10 * time.Second
+
+// Exporter sends batches of spans to their destination.
+type Exporter interface {
+	// Export sends a single trace message.
+	Export(ctx context.Context, data *TracesData) error
+	// Close releases any resources held by the exporter.
+	Close() error
+}
+
+// httpExporter sends spans to an OTLP/HTTP collector using the JSON encoding.
+type httpExporter struct {
+	url    string
+	client *http.Client
+}
+
+// NewHTTPExporter creates an exporter that POSTs OTLP/JSON trace messages to the given collector URL. If the URL has
+// no path, the standard "/v1/traces" path is used.
+func NewHTTPExporter(endpoint string) (Exporter, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid OTLP collector URL")
+	}
+	if u.Path == "" || u.Path == "/" {
+		u.Path = "/v1/traces"
+	}
+	return &httpExporter{url: u.String(), client: &http.Client{Timeout: exportTimeout}}, nil
+}
+
+func (e *httpExporter) Export(ctx context.Context, data *TracesData) error {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("POST", e.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "exporting spans to %s", e.url)
+	}
+	defer contract.IgnoreClose(resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := ioutil.ReadAll(resp.Body)
+		return errors.Errorf("exporting spans to %s: %s: %s", e.url, resp.Status, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+func (e *httpExporter) Close() error {
+	return nil
+}
+
+// grpcExporter sends spans to an OTLP/gRPC collector.
+type grpcExporter struct {
+	conn   *grpc.ClientConn
+	client collectorpb.TraceServiceClient
+}
+
+// NewGRPCExporter creates an exporter that sends trace messages to the OTLP/gRPC collector at the given address.
+// The connection is not secured; collectors are expected to be local.
+func NewGRPCExporter(address string) (Exporter, error) {
+	// Note that we deliberately do not install the tracing interceptors on this connection: doing so would trace the
+	// export of each batch of spans, which would in turn produce more spans to export.
+	conn, err := grpc.Dial(address, grpc.WithInsecure())
+	if err != nil {
+		return nil, errors.Wrapf(err, "connecting to OTLP collector at %s", address)
+	}
+	return &grpcExporter{conn: conn, client: collectorpb.NewTraceServiceClient(conn)}, nil
+}
+
+func (e *grpcExporter) Export(ctx context.Context, data *TracesData) error {
+	req, err := data.ExportRequest()
+	if err != nil {
+		return err
+	}
+	if _, err = e.client.Export(ctx, req); err != nil {
+		return errors.Wrapf(err, "exporting spans to %s", e.conn.Target())
+	}
+	return nil
+}
+
+func (e *grpcExporter) Close() error {
+	return e.conn.Close()
+}
+
+// fileExporter appends OTLP/JSON trace messages to a file, one per line.
+type fileExporter struct {
+	m sync.Mutex
+	f *os.File
+}
+
+// NewFileExporter creates an exporter that appends OTLP/JSON trace messages to the file at the given path, creating
+// it if necessary. Each message is written with a single append, so several processes (e.g. the CLI and its plugins)
+// may share a file.
+func NewFileExporter(path string) (Exporter, error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, errors.Wrapf(err, "opening trace file")
+	}
+	return &fileExporter{f: f}, nil
+}
+
+func (e *fileExporter) Export(ctx context.Context, data *TracesData) error {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	e.m.Lock()
+	defer e.m.Unlock()
+	_, err = e.f.Write(append(b, '\n'))
+	return err
+}
+
+func (e *fileExporter) Close() error {
+	return e.f.Close()
+}
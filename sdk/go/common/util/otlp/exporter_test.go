@@ -0,0 +1,145 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlp
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	opentracing "github.com/opentracing/opentracing-go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	collectorpb "github.com/pulumi/pulumi/sdk/v2/proto/go/otlp/opentelemetry/proto/collector/trace/v1"
+)
+
+func TestHTTPExporter(t *testing.T) {
+	var received TracesData
+	var path, contentType string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path, contentType = r.URL.Path, r.Header.Get("Content-Type")
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+	}))
+	defer server.Close()
+
+	exporter, err := NewHTTPExporter(server.URL)
+	assert.NoError(t, err)
+	defer exporter.Close()
+
+	data := NewTracesData("pulumi-cli", []Span{NewSpan(testRawSpan())})
+	assert.NoError(t, exporter.Export(context.Background(), data))
+	assert.Equal(t, "/v1/traces", path)
+	assert.Equal(t, "application/json", contentType)
+	assert.Equal(t, *data, received)
+}
+
+func TestHTTPExporterError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "bad request", http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	exporter, err := NewHTTPExporter(server.URL + "/custom/traces")
+	assert.NoError(t, err)
+	defer exporter.Close()
+
+	err = exporter.Export(context.Background(), NewTracesData("pulumi-cli", nil))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "/custom/traces")
+	assert.Contains(t, err.Error(), "bad request")
+}
+
+// traceService is a fake OTLP/gRPC collector.
+type traceService struct {
+	requests chan *collectorpb.ExportTraceServiceRequest
+}
+
+func (s *traceService) Export(ctx context.Context,
+	req *collectorpb.ExportTraceServiceRequest) (*collectorpb.ExportTraceServiceResponse, error) {
+
+	s.requests <- req
+	return &collectorpb.ExportTraceServiceResponse{}, nil
+}
+
+func TestGRPCExporter(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+
+	service := &traceService{requests: make(chan *collectorpb.ExportTraceServiceRequest, 1)}
+	server := grpc.NewServer()
+	collectorpb.RegisterTraceServiceServer(server, service)
+	go func() { _ = server.Serve(lis) }()
+	defer server.Stop()
+
+	exporter, err := NewGRPCExporter(lis.Addr().String())
+	assert.NoError(t, err)
+	defer exporter.Close()
+
+	data := NewTracesData("pulumi-cli", []Span{NewSpan(testRawSpan())})
+	assert.NoError(t, exporter.Export(context.Background(), data))
+
+	expected, err := data.ExportRequest()
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(expected, <-service.requests))
+}
+
+func TestRecorderWithFileExporter(t *testing.T) {
+	dir, err := ioutil.TempDir("", "otlp")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "trace.json")
+
+	// Two recorders share the file, as the CLI and its plugins would.
+	var recorders []*Recorder
+	for _, service := range []string{"pulumi-cli", "pulumi-language-go"} {
+		exporter, err := NewFileExporter(path)
+		assert.NoError(t, err)
+		recorders = append(recorders, NewRecorder(service, exporter))
+	}
+
+	root := NewTracer(recorders[0]).StartSpan("pulumi")
+	child := NewTracer(recorders[1]).StartSpan("run", opentracing.ChildOf(root.Context()))
+	child.Finish()
+	root.Finish()
+
+	for _, r := range recorders {
+		assert.NoError(t, r.Close())
+	}
+
+	f, err := os.Open(path)
+	assert.NoError(t, err)
+	defer f.Close()
+	spans, err := ReadTraces(f)
+	assert.NoError(t, err)
+	assert.Len(t, spans, 2)
+
+	byName := map[string]int{}
+	for i, span := range spans {
+		byName[span.Operation] = i
+	}
+	rootSpan, childSpan := spans[byName["pulumi"]], spans[byName["run"]]
+	assert.Equal(t, rootSpan.Context.TraceID, childSpan.Context.TraceID)
+	assert.Equal(t, rootSpan.Context.SpanID, childSpan.ParentSpanID)
+	assert.Equal(t, "pulumi-cli", rootSpan.Tags["service.name"])
+	assert.Equal(t, "pulumi-language-go", childSpan.Tags["service.name"])
+}
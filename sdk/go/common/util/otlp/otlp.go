@@ -0,0 +1,443 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package otlp exports OpenTracing spans using the OpenTelemetry protocol (OTLP). Spans are produced by a basictracer
+// tracer and converted into the OTLP trace data model, which can then be sent to a collector over gRPC or HTTP or
+// appended to a local file.
+//
+// The tracer is basictracer-go, the reference OpenTracing implementation, which the CLI already depends on through
+// appdash. It is used, rather than an OpenTelemetry SDK, because the CLI and its plugins instrument their code with
+// the OpenTracing API; basictracer hands each finished span to a recorder, which is all an exporter needs.
+//
+// The JSON types in this package follow the OTLP/JSON encoding, which the OTLP protobuf definitions' standard JSON
+// mapping does not produce (trace and span IDs are hex rather than base64 encoded). The OTLP/gRPC exporter converts
+// them to the protobuf messages generated from the OpenTelemetry definitions in sdk/proto/otlp.
+package otlp
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"time"
+
+	basictracer "github.com/opentracing/basictracer-go"
+	opentracing "github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+	"github.com/opentracing/opentracing-go/log"
+)
+
+// ScopeName is the instrumentation scope reported for all spans exported by this package.
+const ScopeName = "github.com/pulumi/pulumi"
+
+// Span kinds, as defined by the OTLP trace data model.
+const (
+	SpanKindUnspecified = 0
+	SpanKindInternal    = 1
+	SpanKindServer      = 2
+	SpanKindClient      = 3
+	SpanKindProducer    = 4
+	SpanKindConsumer    = 5
+)
+
+// Status codes, as defined by the OTLP trace data model.
+const (
+	StatusCodeUnset = 0
+	StatusCodeOK    = 1
+	StatusCodeError = 2
+)
+
+// TracesData is the top-level OTLP trace message. Its JSON encoding is the OTLP/JSON encoding used by the OTLP/HTTP
+// protocol and by OpenTelemetry collector file exporters.
+type TracesData struct {
+	ResourceSpans []ResourceSpans `json:"resourceSpans"`
+}
+
+// ResourceSpans is a collection of spans produced by a single resource (in OTLP terms, a single process).
+type ResourceSpans struct {
+	Resource   Resource     `json:"resource"`
+	ScopeSpans []ScopeSpans `json:"scopeSpans"`
+}
+
+// Resource describes the entity that produced a set of spans.
+type Resource struct {
+	Attributes []KeyValue `json:"attributes,omitempty"`
+}
+
+// ScopeSpans is a collection of spans produced by a single instrumentation scope.
+type ScopeSpans struct {
+	Scope Scope  `json:"scope"`
+	Spans []Span `json:"spans"`
+}
+
+// Scope describes an instrumentation scope.
+type Scope struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+// Span is a single OTLP span. Trace and span IDs are hex-encoded and timestamps are decimal strings, per OTLP/JSON.
+type Span struct {
+	TraceID           string     `json:"traceId"`
+	SpanID            string     `json:"spanId"`
+	ParentSpanID      string     `json:"parentSpanId,omitempty"`
+	Name              string     `json:"name"`
+	Kind              int        `json:"kind"`
+	StartTimeUnixNano string     `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string     `json:"endTimeUnixNano"`
+	Attributes        []KeyValue `json:"attributes,omitempty"`
+	Events            []Event    `json:"events,omitempty"`
+	Status            *Status    `json:"status,omitempty"`
+}
+
+// Event is a timestamped annotation on a span. OpenTracing log records are exported as events.
+type Event struct {
+	TimeUnixNano string     `json:"timeUnixNano"`
+	Name         string     `json:"name"`
+	Attributes   []KeyValue `json:"attributes,omitempty"`
+}
+
+// Status is the status of a span.
+type Status struct {
+	Message string `json:"message,omitempty"`
+	Code    int    `json:"code"`
+}
+
+// KeyValue is a single attribute.
+type KeyValue struct {
+	Key   string   `json:"key"`
+	Value AnyValue `json:"value"`
+}
+
+// AnyValue is an attribute value. Exactly one field is set.
+type AnyValue struct {
+	StringValue *string  `json:"stringValue,omitempty"`
+	BoolValue   *bool    `json:"boolValue,omitempty"`
+	IntValue    *string  `json:"intValue,omitempty"`
+	DoubleValue *float64 `json:"doubleValue,omitempty"`
+}
+
+// String returns the value formatted as a string.
+func (v AnyValue) String() string {
+	switch {
+	case v.StringValue != nil:
+		return *v.StringValue
+	case v.BoolValue != nil:
+		return strconv.FormatBool(*v.BoolValue)
+	case v.IntValue != nil:
+		return *v.IntValue
+	case v.DoubleValue != nil:
+		return strconv.FormatFloat(*v.DoubleValue, 'g', -1, 64)
+	default:
+		return ""
+	}
+}
+
+// Interface returns the value as a string, bool, int64, or float64.
+func (v AnyValue) Interface() interface{} {
+	switch {
+	case v.StringValue != nil:
+		return *v.StringValue
+	case v.BoolValue != nil:
+		return *v.BoolValue
+	case v.IntValue != nil:
+		if i, err := strconv.ParseInt(*v.IntValue, 10, 64); err == nil {
+			return i
+		}
+		return *v.IntValue
+	case v.DoubleValue != nil:
+		return *v.DoubleValue
+	default:
+		return nil
+	}
+}
+
+// NewValue converts an OpenTracing tag or log value into an attribute value. Values that are not strings, booleans,
+// or numbers are formatted as strings.
+func NewValue(v interface{}) AnyValue {
+	switch v := v.(type) {
+	case string:
+		return AnyValue{StringValue: &v}
+	case bool:
+		return AnyValue{BoolValue: &v}
+	case int:
+		return intValue(int64(v))
+	case int8:
+		return intValue(int64(v))
+	case int16:
+		return intValue(int64(v))
+	case int32:
+		return intValue(int64(v))
+	case int64:
+		return intValue(v)
+	case uint:
+		return uintValue(uint64(v))
+	case uint8:
+		return intValue(int64(v))
+	case uint16:
+		return intValue(int64(v))
+	case uint32:
+		return intValue(int64(v))
+	case uint64:
+		return uintValue(v)
+	case float32:
+		f := float64(v)
+		return AnyValue{DoubleValue: &f}
+	case float64:
+		return AnyValue{DoubleValue: &v}
+	case fmt.Stringer:
+		s := v.String()
+		return AnyValue{StringValue: &s}
+	default:
+		s := fmt.Sprintf("%+v", v)
+		return AnyValue{StringValue: &s}
+	}
+}
+
+func intValue(i int64) AnyValue {
+	s := strconv.FormatInt(i, 10)
+	return AnyValue{IntValue: &s}
+}
+
+func uintValue(u uint64) AnyValue {
+	if u > math.MaxInt64 {
+		s := strconv.FormatUint(u, 10)
+		return AnyValue{StringValue: &s}
+	}
+	return intValue(int64(u))
+}
+
+// FormatTraceID formats a 64-bit OpenTracing trace ID as a 16-byte OTLP trace ID.
+func FormatTraceID(id uint64) string {
+	return fmt.Sprintf("%032x", id)
+}
+
+// FormatSpanID formats a 64-bit OpenTracing span ID as an 8-byte OTLP span ID.
+func FormatSpanID(id uint64) string {
+	return fmt.Sprintf("%016x", id)
+}
+
+// parseID parses a hex-encoded OTLP trace or span ID. Trace IDs are truncated to their low 64 bits.
+func parseID(id string) (uint64, error) {
+	if len(id) > 16 {
+		id = id[len(id)-16:]
+	}
+	return strconv.ParseUint(id, 16, 64)
+}
+
+func formatTime(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano(), 10)
+}
+
+func parseTime(s string) (time.Time, error) {
+	ns, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(0, ns), nil
+}
+
+// NewSpan converts a finished basictracer span into an OTLP span.
+func NewSpan(raw basictracer.RawSpan) Span {
+	span := Span{
+		TraceID:           FormatTraceID(raw.Context.TraceID),
+		SpanID:            FormatSpanID(raw.Context.SpanID),
+		Name:              raw.Operation,
+		Kind:              SpanKindInternal,
+		StartTimeUnixNano: formatTime(raw.Start),
+		EndTimeUnixNano:   formatTime(raw.Start.Add(raw.Duration)),
+	}
+	if raw.ParentSpanID != 0 {
+		span.ParentSpanID = FormatSpanID(raw.ParentSpanID)
+	}
+
+	for k, v := range raw.Tags {
+		switch k {
+		case string(ext.SpanKind):
+			switch fmt.Sprint(v) {
+			case string(ext.SpanKindRPCServerEnum):
+				span.Kind = SpanKindServer
+			case string(ext.SpanKindRPCClientEnum):
+				span.Kind = SpanKindClient
+			case string(ext.SpanKindProducerEnum):
+				span.Kind = SpanKindProducer
+			case string(ext.SpanKindConsumerEnum):
+				span.Kind = SpanKindConsumer
+			}
+		case string(ext.Error):
+			if isErr, ok := v.(bool); ok && isErr {
+				span.Status = &Status{Code: StatusCodeError}
+			}
+		}
+		span.Attributes = append(span.Attributes, KeyValue{Key: k, Value: NewValue(v)})
+	}
+	for k, v := range raw.Context.Baggage {
+		span.Attributes = append(span.Attributes, KeyValue{Key: k, Value: NewValue(v)})
+	}
+
+	for _, record := range raw.Logs {
+		enc := &fieldEncoder{name: "log"}
+		for _, f := range record.Fields {
+			f.Marshal(enc)
+		}
+		span.Events = append(span.Events, Event{
+			TimeUnixNano: formatTime(record.Timestamp),
+			Name:         enc.name,
+			Attributes:   enc.attributes,
+		})
+	}
+
+	return span
+}
+
+// RawSpans converts the spans in an OTLP trace message back into basictracer spans.
+func (d *TracesData) RawSpans() ([]basictracer.RawSpan, error) {
+	var spans []basictracer.RawSpan
+	for _, rs := range d.ResourceSpans {
+		for _, ss := range rs.ScopeSpans {
+			for _, s := range ss.Spans {
+				raw, err := s.RawSpan()
+				if err != nil {
+					return nil, err
+				}
+				for _, attr := range rs.Resource.Attributes {
+					if _, has := raw.Tags[attr.Key]; !has {
+						raw.Tags[attr.Key] = attr.Value.Interface()
+					}
+				}
+				spans = append(spans, raw)
+			}
+		}
+	}
+	return spans, nil
+}
+
+// RawSpan converts an OTLP span into a basictracer span.
+func (s *Span) RawSpan() (basictracer.RawSpan, error) {
+	traceID, err := parseID(s.TraceID)
+	if err != nil {
+		return basictracer.RawSpan{}, fmt.Errorf("invalid trace ID %q", s.TraceID)
+	}
+	spanID, err := parseID(s.SpanID)
+	if err != nil {
+		return basictracer.RawSpan{}, fmt.Errorf("invalid span ID %q", s.SpanID)
+	}
+	var parentSpanID uint64
+	if s.ParentSpanID != "" {
+		if parentSpanID, err = parseID(s.ParentSpanID); err != nil {
+			return basictracer.RawSpan{}, fmt.Errorf("invalid parent span ID %q", s.ParentSpanID)
+		}
+	}
+	start, err := parseTime(s.StartTimeUnixNano)
+	if err != nil {
+		return basictracer.RawSpan{}, fmt.Errorf("invalid start time %q", s.StartTimeUnixNano)
+	}
+	end, err := parseTime(s.EndTimeUnixNano)
+	if err != nil {
+		return basictracer.RawSpan{}, fmt.Errorf("invalid end time %q", s.EndTimeUnixNano)
+	}
+
+	raw := basictracer.RawSpan{
+		Context: basictracer.SpanContext{
+			TraceID: traceID,
+			SpanID:  spanID,
+			Sampled: true,
+		},
+		ParentSpanID: parentSpanID,
+		Operation:    s.Name,
+		Start:        start,
+		Duration:     end.Sub(start),
+		Tags:         map[string]interface{}{},
+	}
+	for _, attr := range s.Attributes {
+		raw.Tags[attr.Key] = attr.Value.Interface()
+	}
+	for _, e := range s.Events {
+		ts, err := parseTime(e.TimeUnixNano)
+		if err != nil {
+			return basictracer.RawSpan{}, fmt.Errorf("invalid event time %q", e.TimeUnixNano)
+		}
+		fields := []log.Field{log.String("event", e.Name)}
+		for _, attr := range e.Attributes {
+			fields = append(fields, log.Object(attr.Key, attr.Value.Interface()))
+		}
+		raw.Logs = append(raw.Logs, opentracing.LogRecord{Timestamp: ts, Fields: fields})
+	}
+	return raw, nil
+}
+
+// NewTracesData builds an OTLP trace message for spans produced by the named service.
+func NewTracesData(serviceName string, spans []Span) *TracesData {
+	return &TracesData{
+		ResourceSpans: []ResourceSpans{{
+			Resource: Resource{
+				Attributes: []KeyValue{{Key: "service.name", Value: NewValue(serviceName)}},
+			},
+			ScopeSpans: []ScopeSpans{{
+				Scope: Scope{Name: ScopeName},
+				Spans: spans,
+			}},
+		}},
+	}
+}
+
+// ReadTraces reads a stream of OTLP/JSON trace messages, such as a file written by a file exporter, and returns the
+// spans they contain.
+func ReadTraces(r io.Reader) ([]basictracer.RawSpan, error) {
+	var spans []basictracer.RawSpan
+	dec := json.NewDecoder(r)
+	for {
+		var data TracesData
+		if err := dec.Decode(&data); err != nil {
+			if err == io.EOF {
+				return spans, nil
+			}
+			return nil, err
+		}
+		raw, err := data.RawSpans()
+		if err != nil {
+			return nil, err
+		}
+		spans = append(spans, raw...)
+	}
+}
+
+// fieldEncoder converts OpenTracing log fields into event attributes. The "event" field, if present, names the event.
+type fieldEncoder struct {
+	name       string
+	attributes []KeyValue
+}
+
+func (e *fieldEncoder) emit(key string, value interface{}) {
+	if key == "event" {
+		if s, ok := value.(string); ok {
+			e.name = s
+			return
+		}
+	}
+	e.attributes = append(e.attributes, KeyValue{Key: key, Value: NewValue(value)})
+}
+
+func (e *fieldEncoder) EmitString(key, value string)             { e.emit(key, value) }
+func (e *fieldEncoder) EmitBool(key string, value bool)          { e.emit(key, value) }
+func (e *fieldEncoder) EmitInt(key string, value int)            { e.emit(key, value) }
+func (e *fieldEncoder) EmitInt32(key string, value int32)        { e.emit(key, value) }
+func (e *fieldEncoder) EmitInt64(key string, value int64)        { e.emit(key, value) }
+func (e *fieldEncoder) EmitUint32(key string, value uint32)      { e.emit(key, value) }
+func (e *fieldEncoder) EmitUint64(key string, value uint64)      { e.emit(key, value) }
+func (e *fieldEncoder) EmitFloat32(key string, value float32)    { e.emit(key, value) }
+func (e *fieldEncoder) EmitFloat64(key string, value float64)    { e.emit(key, value) }
+func (e *fieldEncoder) EmitObject(key string, value interface{}) { e.emit(key, value) }
+func (e *fieldEncoder) EmitLazyLogger(value log.LazyLogger)      { value(e) }
@@ -0,0 +1,170 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlp
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	basictracer "github.com/opentracing/basictracer-go"
+	opentracing "github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"github.com/stretchr/testify/assert"
+
+	collectorpb "github.com/pulumi/pulumi/sdk/v2/proto/go/otlp/opentelemetry/proto/collector/trace/v1"
+	tracepb "github.com/pulumi/pulumi/sdk/v2/proto/go/otlp/opentelemetry/proto/trace/v1"
+)
+
+func testRawSpan() basictracer.RawSpan {
+	start := time.Unix(1588000000, 500)
+	return basictracer.RawSpan{
+		Context: basictracer.SpanContext{
+			TraceID: 0x0102030405060708,
+			SpanID:  0xaabbccddeeff0011,
+			Sampled: true,
+		},
+		ParentSpanID: 0x10,
+		Operation:    "/pulumirpc.ResourceProvider/Create",
+		Start:        start,
+		Duration:     2 * time.Second,
+		Tags: map[string]interface{}{
+			"span.kind": "server",
+			"error":     true,
+			"retries":   3,
+		},
+		Logs: []opentracing.LogRecord{{
+			Timestamp: start.Add(time.Second),
+			Fields:    []log.Field{log.String("event", "gRPC request"), log.Float64("ratio", 0.5)},
+		}},
+	}
+}
+
+func TestNewSpan(t *testing.T) {
+	span := NewSpan(testRawSpan())
+
+	assert.Equal(t, "00000000000000000102030405060708", span.TraceID)
+	assert.Equal(t, "aabbccddeeff0011", span.SpanID)
+	assert.Equal(t, "0000000000000010", span.ParentSpanID)
+	assert.Equal(t, SpanKindServer, span.Kind)
+	assert.Equal(t, "1588000000000000500", span.StartTimeUnixNano)
+	assert.Equal(t, "1588000002000000500", span.EndTimeUnixNano)
+	assert.Equal(t, &Status{Code: StatusCodeError}, span.Status)
+
+	attrs := map[string]interface{}{}
+	for _, attr := range span.Attributes {
+		attrs[attr.Key] = attr.Value.Interface()
+	}
+	assert.Equal(t, map[string]interface{}{"span.kind": "server", "error": true, "retries": int64(3)}, attrs)
+
+	assert.Len(t, span.Events, 1)
+	assert.Equal(t, "gRPC request", span.Events[0].Name)
+	assert.Equal(t, "1588000001000000500", span.Events[0].TimeUnixNano)
+	assert.Equal(t, []KeyValue{{Key: "ratio", Value: NewValue(0.5)}}, span.Events[0].Attributes)
+}
+
+func TestJSONEncoding(t *testing.T) {
+	raw := testRawSpan()
+	raw.Tags = map[string]interface{}{"retries": 3}
+	raw.Logs = nil
+
+	b, err := json.Marshal(NewTracesData("pulumi-cli", []Span{NewSpan(raw)}))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"resourceSpans": [{
+			"resource": {"attributes": [{"key": "service.name", "value": {"stringValue": "pulumi-cli"}}]},
+			"scopeSpans": [{
+				"scope": {"name": "github.com/pulumi/pulumi"},
+				"spans": [{
+					"traceId": "00000000000000000102030405060708",
+					"spanId": "aabbccddeeff0011",
+					"parentSpanId": "0000000000000010",
+					"name": "/pulumirpc.ResourceProvider/Create",
+					"kind": 1,
+					"startTimeUnixNano": "1588000000000000500",
+					"endTimeUnixNano": "1588000002000000500",
+					"attributes": [{"key": "retries", "value": {"intValue": "3"}}]
+				}]
+			}]
+		}]
+	}`, string(b))
+}
+
+func TestReadTraces(t *testing.T) {
+	raw := testRawSpan()
+
+	var buf bytes.Buffer
+	for _, service := range []string{"pulumi-cli", "pulumi-resource-aws"} {
+		b, err := json.Marshal(NewTracesData(service, []Span{NewSpan(raw)}))
+		assert.NoError(t, err)
+		buf.Write(append(b, '\n'))
+	}
+
+	spans, err := ReadTraces(&buf)
+	assert.NoError(t, err)
+	assert.Len(t, spans, 2)
+
+	span := spans[1]
+	assert.Equal(t, raw.Context.TraceID, span.Context.TraceID)
+	assert.Equal(t, raw.Context.SpanID, span.Context.SpanID)
+	assert.Equal(t, raw.ParentSpanID, span.ParentSpanID)
+	assert.Equal(t, raw.Operation, span.Operation)
+	assert.True(t, raw.Start.Equal(span.Start))
+	assert.Equal(t, raw.Duration, span.Duration)
+	assert.Equal(t, "pulumi-resource-aws", span.Tags["service.name"])
+	assert.Equal(t, int64(3), span.Tags["retries"])
+	assert.Len(t, span.Logs, 1)
+
+	_, err = ReadTraces(strings.NewReader(`{"resourceSpans": [{"scopeSpans": [{"spans": [{"traceId": "xyz"}]}]}]}`))
+	assert.Error(t, err)
+}
+
+func TestExportRequest(t *testing.T) {
+	req, err := NewTracesData("pulumi-cli", []Span{NewSpan(testRawSpan())}).ExportRequest()
+	assert.NoError(t, err)
+
+	// Round-trip the request through the protobuf wire format.
+	b, err := proto.Marshal(req)
+	assert.NoError(t, err)
+	var decoded collectorpb.ExportTraceServiceRequest
+	assert.NoError(t, proto.Unmarshal(b, &decoded))
+
+	if !assert.Len(t, decoded.ResourceSpans, 1) {
+		return
+	}
+	rs := decoded.ResourceSpans[0]
+	assert.Equal(t, "service.name", rs.Resource.Attributes[0].Key)
+	assert.Equal(t, "pulumi-cli", rs.Resource.Attributes[0].Value.GetStringValue())
+
+	if !assert.Len(t, rs.ScopeSpans, 1) || !assert.Len(t, rs.ScopeSpans[0].Spans, 1) {
+		return
+	}
+	assert.Equal(t, ScopeName, rs.ScopeSpans[0].Scope.Name)
+
+	span := rs.ScopeSpans[0].Spans[0]
+	assert.Equal(t, []byte{0, 0, 0, 0, 0, 0, 0, 0, 1, 2, 3, 4, 5, 6, 7, 8}, span.TraceId)
+	assert.Equal(t, []byte{0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff, 0x00, 0x11}, span.SpanId)
+	assert.Equal(t, []byte{0, 0, 0, 0, 0, 0, 0, 0x10}, span.ParentSpanId)
+	assert.Equal(t, "/pulumirpc.ResourceProvider/Create", span.Name)
+	assert.Equal(t, tracepb.Span_SPAN_KIND_SERVER, span.Kind)
+	assert.Equal(t, uint64(1588000000000000500), span.StartTimeUnixNano)
+	assert.Equal(t, uint64(1588000002000000500), span.EndTimeUnixNano)
+	assert.Len(t, span.Attributes, 3)
+	assert.Len(t, span.Events, 1)
+	assert.Equal(t, tracepb.Status_STATUS_CODE_ERROR, span.Status.Code)
+}
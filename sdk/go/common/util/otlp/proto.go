@@ -0,0 +1,127 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlp
+
+import (
+	"encoding/hex"
+	"strconv"
+
+	collectorpb "github.com/pulumi/pulumi/sdk/v2/proto/go/otlp/opentelemetry/proto/collector/trace/v1"
+	commonpb "github.com/pulumi/pulumi/sdk/v2/proto/go/otlp/opentelemetry/proto/common/v1"
+	resourcepb "github.com/pulumi/pulumi/sdk/v2/proto/go/otlp/opentelemetry/proto/resource/v1"
+	tracepb "github.com/pulumi/pulumi/sdk/v2/proto/go/otlp/opentelemetry/proto/trace/v1"
+)
+
+// ExportRequest converts the trace message into an OTLP collector export request, for use with the OTLP/gRPC
+// protocol. The protobuf messages are generated from the OpenTelemetry definitions in sdk/proto/otlp.
+func (d *TracesData) ExportRequest() (*collectorpb.ExportTraceServiceRequest, error) {
+	req := &collectorpb.ExportTraceServiceRequest{}
+	for _, rs := range d.ResourceSpans {
+		pbResourceSpans := &tracepb.ResourceSpans{
+			Resource: &resourcepb.Resource{Attributes: protoAttributes(rs.Resource.Attributes)},
+		}
+		for _, ss := range rs.ScopeSpans {
+			pbScopeSpans := &tracepb.ScopeSpans{
+				Scope: &commonpb.InstrumentationScope{Name: ss.Scope.Name, Version: ss.Scope.Version},
+			}
+			for _, s := range ss.Spans {
+				pbSpan, err := s.proto()
+				if err != nil {
+					return nil, err
+				}
+				pbScopeSpans.Spans = append(pbScopeSpans.Spans, pbSpan)
+			}
+			pbResourceSpans.ScopeSpans = append(pbResourceSpans.ScopeSpans, pbScopeSpans)
+		}
+		req.ResourceSpans = append(req.ResourceSpans, pbResourceSpans)
+	}
+	return req, nil
+}
+
+func (s *Span) proto() (*tracepb.Span, error) {
+	traceID, err := hex.DecodeString(s.TraceID)
+	if err != nil {
+		return nil, err
+	}
+	spanID, err := hex.DecodeString(s.SpanID)
+	if err != nil {
+		return nil, err
+	}
+	parentSpanID, err := hex.DecodeString(s.ParentSpanID)
+	if err != nil {
+		return nil, err
+	}
+	start, err := strconv.ParseUint(s.StartTimeUnixNano, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	end, err := strconv.ParseUint(s.EndTimeUnixNano, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	span := &tracepb.Span{
+		TraceId:           traceID,
+		SpanId:            spanID,
+		ParentSpanId:      parentSpanID,
+		Name:              s.Name,
+		Kind:              tracepb.Span_SpanKind(s.Kind),
+		StartTimeUnixNano: start,
+		EndTimeUnixNano:   end,
+		Attributes:        protoAttributes(s.Attributes),
+	}
+	for _, ev := range s.Events {
+		ts, err := strconv.ParseUint(ev.TimeUnixNano, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		span.Events = append(span.Events, &tracepb.Span_Event{
+			TimeUnixNano: ts,
+			Name:         ev.Name,
+			Attributes:   protoAttributes(ev.Attributes),
+		})
+	}
+	if s.Status != nil {
+		span.Status = &tracepb.Status{Message: s.Status.Message, Code: tracepb.Status_StatusCode(s.Status.Code)}
+	}
+	return span, nil
+}
+
+func protoAttributes(attrs []KeyValue) []*commonpb.KeyValue {
+	var result []*commonpb.KeyValue
+	for _, kv := range attrs {
+		result = append(result, &commonpb.KeyValue{Key: kv.Key, Value: kv.Value.proto()})
+	}
+	return result
+}
+
+func (v AnyValue) proto() *commonpb.AnyValue {
+	switch {
+	case v.StringValue != nil:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: *v.StringValue}}
+	case v.BoolValue != nil:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_BoolValue{BoolValue: *v.BoolValue}}
+	case v.IntValue != nil:
+		i, err := strconv.ParseInt(*v.IntValue, 10, 64)
+		if err != nil {
+			return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: *v.IntValue}}
+		}
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: i}}
+	case v.DoubleValue != nil:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_DoubleValue{DoubleValue: *v.DoubleValue}}
+	default:
+		return &commonpb.AnyValue{}
+	}
+}
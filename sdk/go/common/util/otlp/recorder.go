@@ -0,0 +1,140 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlp
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	basictracer "github.com/opentracing/basictracer-go"
+	opentracing "github.com/opentracing/opentracing-go"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/logging"
+)
+
+const (
+	// maxBatchSize is the number of buffered spans that triggers an immediate export.
+	maxBatchSize = 512
+	// flushInterval is the maximum time a finished span is buffered before it is exported.
+	flushInterval = 2 * time.Second
+)
+
+// Recorder is a basictracer.SpanRecorder that buffers finished spans and exports them in batches. Spans are exported
+// when the buffer fills, periodically, and when the recorder is closed. In addition, the buffer is exported
+// synchronously whenever a server-side RPC span finishes: plugins are killed rather than shut down gracefully, so this
+// ensures that a plugin's spans have been exported by the time its host has received the plugin's last response.
+type Recorder struct {
+	serviceName string
+	exporter    Exporter
+
+	m     sync.Mutex
+	spans []Span
+
+	exportLock sync.Mutex // serializes exports.
+	done       chan bool
+	wg         sync.WaitGroup
+	closeOnce  sync.Once
+}
+
+// NewRecorder creates a recorder that exports the spans produced by the named service using the given exporter. The
+// recorder owns the exporter.
+func NewRecorder(serviceName string, exporter Exporter) *Recorder {
+	r := &Recorder{
+		serviceName: serviceName,
+		exporter:    exporter,
+		done:        make(chan bool),
+	}
+	r.wg.Add(1)
+	go r.flushPeriodically()
+	return r
+}
+
+// NewTracer creates an OpenTracing tracer that samples every span and records it with the given recorder.
+func NewTracer(r *Recorder) opentracing.Tracer {
+	opts := basictracer.DefaultOptions()
+	opts.ShouldSample = func(traceID uint64) bool { return true }
+	opts.Recorder = r
+	return basictracer.NewWithOptions(opts)
+}
+
+// RecordSpan implements basictracer.SpanRecorder.
+func (r *Recorder) RecordSpan(raw basictracer.RawSpan) {
+	if !raw.Context.Sampled {
+		return
+	}
+
+	span := NewSpan(raw)
+
+	r.m.Lock()
+	r.spans = append(r.spans, span)
+	full := len(r.spans) >= maxBatchSize
+	r.m.Unlock()
+
+	switch {
+	case span.Kind == SpanKindServer:
+		r.Flush()
+	case full:
+		go r.Flush()
+	}
+}
+
+// Flush exports all buffered spans.
+func (r *Recorder) Flush() {
+	r.exportLock.Lock()
+	defer r.exportLock.Unlock()
+
+	r.m.Lock()
+	spans := r.spans
+	r.spans = nil
+	r.m.Unlock()
+
+	if len(spans) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), exportTimeout)
+	defer cancel()
+	if err := r.exporter.Export(ctx, NewTracesData(r.serviceName, spans)); err != nil {
+		logging.V(5).Infof("failed to export %d spans: %v", len(spans), err)
+	}
+}
+
+func (r *Recorder) flushPeriodically() {
+	defer r.wg.Done()
+
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			r.Flush()
+		case <-r.done:
+			return
+		}
+	}
+}
+
+// Close exports any buffered spans and closes the recorder's exporter.
+func (r *Recorder) Close() error {
+	var err error
+	r.closeOnce.Do(func() {
+		close(r.done)
+		r.wg.Wait()
+		r.Flush()
+		err = r.exporter.Close()
+	})
+	return err
+}
@@ -69,18 +69,54 @@ func OpenTracingServerInterceptor(parentSpan opentracing.Span) grpc.UnaryServerI
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler) (interface{}, error) {
 
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			md = metadata.New(nil)
-		}
-		carrier := metadataReaderWriter{md}
-		_, err := opentracing.GlobalTracer().Extract(opentracing.HTTPHeaders, carrier)
-		if err == opentracing.ErrSpanContextNotFound {
-			contract.IgnoreError(opentracing.GlobalTracer().Inject(spanContext, opentracing.HTTPHeaders, carrier))
-		}
-		return tracingInterceptor(ctx, req, info, handler)
+		return tracingInterceptor(withParentSpanContext(ctx, spanContext), req, info, handler)
+	}
+
+}
+
+// OpenTracingStreamServerInterceptor provides a default gRPC server interceptor for emitting tracing of streaming
+// RPCs to the global OpenTracing tracer.
+func OpenTracingStreamServerInterceptor(parentSpan opentracing.Span) grpc.StreamServerInterceptor {
+	tracingInterceptor := otgrpc.OpenTracingStreamServerInterceptor(
+		// Use the globally installed tracer
+		opentracing.GlobalTracer(),
+		// Log full payloads along with trace spans
+		otgrpc.LogPayloads(),
+	)
+	if parentSpan == nil {
+		return tracingInterceptor
+	}
+	spanContext := parentSpan.Context()
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := withParentSpanContext(ss.Context(), spanContext)
+		return tracingInterceptor(srv, &serverStream{ServerStream: ss, ctx: ctx}, info, handler)
+	}
+}
+
+// withParentSpanContext returns a context whose incoming metadata carries the given span context if the metadata
+// does not already carry one. This parents spans for requests from callers that do not propagate a span context.
+func withParentSpanContext(ctx context.Context, spanContext opentracing.SpanContext) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		md = metadata.New(nil)
+		ctx = metadata.NewIncomingContext(ctx, md)
 	}
+	carrier := metadataReaderWriter{md}
+	_, err := opentracing.GlobalTracer().Extract(opentracing.HTTPHeaders, carrier)
+	if err == opentracing.ErrSpanContextNotFound {
+		contract.IgnoreError(opentracing.GlobalTracer().Inject(spanContext, opentracing.HTTPHeaders, carrier))
+	}
+	return ctx
+}
 
+// serverStream overrides the context of a grpc.ServerStream.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (ss *serverStream) Context() context.Context {
+	return ss.ctx
 }
 
 // OpenTracingClientInterceptor provides a default gRPC client interceptor for emitting tracing to the global
@@ -96,3 +132,17 @@ func OpenTracingClientInterceptor() grpc.UnaryClientInterceptor {
 			return method != ""
 		}))
 }
+
+// OpenTracingStreamClientInterceptor provides a default gRPC client interceptor for emitting tracing of streaming
+// RPCs to the global OpenTracing tracer.
+func OpenTracingStreamClientInterceptor() grpc.StreamClientInterceptor {
+	return otgrpc.OpenTracingStreamClientInterceptor(
+		// Use the globally installed tracer
+		opentracing.GlobalTracer(),
+		// Log full payloads along with trace spans
+		otgrpc.LogPayloads(),
+		// Do not trace calls to the empty method
+		otgrpc.IncludingSpans(func(_ opentracing.SpanContext, method string, _, _ interface{}) bool {
+			return method != ""
+		}))
+}
@@ -58,6 +58,7 @@ func Serve(port int, cancel chan bool, registers []func(*grpc.Server) error,
 	// Now new up a gRPC server and register any RPC interfaces the caller wants.
 	srv := grpc.NewServer(
 		grpc.UnaryInterceptor(OpenTracingServerInterceptor(parentSpan)),
+		grpc.StreamInterceptor(OpenTracingStreamServerInterceptor(parentSpan)),
 		grpc.MaxRecvMsgSize(maxRPCMessageSize),
 	)
 	for _, register := range registers {
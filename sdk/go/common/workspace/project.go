@@ -23,6 +23,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/pulumi/pulumi/sdk/v2/go/common/encoding"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/config"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/tokens"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/contract"
@@ -166,6 +167,12 @@ type ProjectStack struct {
 	EncryptionSalt string `json:"encryptionsalt,omitempty" yaml:"encryptionsalt,omitempty"`
 	// Config is an optional config bag.
 	Config config.Map `json:"config,omitempty" yaml:"config,omitempty"`
+	// DeterministicArchives indicates that archives sent to providers should be produced in a reproducible form, so
+	// that identical contents always produce identical bytes. New stacks enable this by default.
+	DeterministicArchives bool `json:"deterministicarchives,omitempty" yaml:"deterministicarchives,omitempty"`
+	// Retry is an optional policy for retrying failed resource operations, used for resources that do not specify
+	// their own.
+	Retry *resource.RetryPolicy `json:"retry,omitempty" yaml:"retry,omitempty"`
 }
 
 // Save writes a project definition to a file.
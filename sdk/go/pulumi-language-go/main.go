@@ -32,6 +32,7 @@ import (
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
 
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/buildutil"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/cmdutil"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/executable"
@@ -317,3 +318,23 @@ func (host *goLanguageHost) GetPluginInfo(ctx context.Context, req *pbempty.Empt
 		Version: version.Version,
 	}, nil
 }
+
+// InstallDependencies downloads the modules that the program depends upon by running `go mod download`.
+func (host *goLanguageHost) InstallDependencies(
+	req *pulumirpc.InstallDependenciesRequest, server pulumirpc.LanguageRuntime_InstallDependenciesServer) error {
+
+	gobin, err := executable.FindExecutable("go")
+	if err != nil {
+		return err
+	}
+
+	stdout, stderr := plugin.InstallDependenciesWriters(server)
+	cmd := exec.Command(gobin, "mod", "download")
+	cmd.Dir = req.GetDirectory()
+	cmd.Env = os.Environ()
+	cmd.Stdout, cmd.Stderr = stdout, stderr
+	if err := cmd.Run(); err != nil {
+		return errors.Wrap(err, "`go mod download` failed to install dependencies")
+	}
+	return nil
+}
@@ -0,0 +1,380 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// pulumi-resource-pulumi-go is the resource provider plugin for the dynamic resources defined by Go programs. The
+// program binaries that implement dynamic resources' providers are kept in the Pulumi home directory under the project
+// and stack in the resource's URN. The plugin launches the binary that serves a resource in a mode where it serves its
+// dynamic providers, and forwards each resource operation to it.
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	pbempty "github.com/golang/protobuf/ptypes/empty"
+	structpb "github.com/golang/protobuf/ptypes/struct"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/cmdutil"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/logging"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/rpcutil"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/version"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi/dynamic"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+// programStartTimeout is the amount of time to wait for a program binary to begin serving its dynamic providers.
+const programStartTimeout = 30 * time.Second
+
+// Launches the dynamic provider plugin, which in turn fires up an RPC server implementing the ResourceProviderServer
+// endpoint.
+func main() {
+	var tracing string
+	flag.StringVar(&tracing, "tracing", "", "Emit tracing to a Zipkin-compatible tracing endpoint")
+
+	flag.Parse()
+	args := flag.Args()
+	logging.InitLogging(false, 0, false)
+	cmdutil.InitTracing("pulumi-resource-pulumi-go", "pulumi-resource-pulumi-go", tracing)
+
+	// The engine passes the address of its host RPC as our only argument.
+	if len(args) == 0 {
+		cmdutil.Exit(errors.New("missing required engine RPC address argument"))
+	}
+
+	// Fire up a gRPC server, letting the kernel choose a free port.
+	provider := newDynamicProvider()
+	port, done, err := rpcutil.Serve(0, nil, []func(*grpc.Server) error{
+		func(srv *grpc.Server) error {
+			pulumirpc.RegisterResourceProviderServer(srv, provider)
+			return nil
+		},
+	}, nil)
+	if err != nil {
+		cmdutil.Exit(errors.Wrapf(err, "could not start dynamic provider RPC server"))
+	}
+
+	// Otherwise, print out the port so that the spawner knows how to reach us.
+	fmt.Printf("%d\n", port)
+
+	// And finally wait for the server to stop serving. Any programs that we launched exit once their stdin is closed
+	// along with this process.
+	if err := <-done; err != nil {
+		cmdutil.Exit(errors.Wrapf(err, "dynamic provider RPC stopped serving"))
+	}
+}
+
+// program is a running program binary that is serving its dynamic providers.
+type program struct {
+	cmd    *exec.Cmd
+	stdin  io.WriteCloser // the program stops serving once its stdin is closed.
+	client pulumirpc.ResourceProviderClient
+}
+
+// dynamicProvider implements the ResourceProviderServer interface by forwarding each resource operation to a program
+// binary. Resources are checked, created, and updated by the binary of the most recent run of their program, and are
+// read and deleted by the binary whose hash is recorded in their state, if it is available.
+type dynamicProvider struct {
+	m         sync.Mutex
+	configure *pulumirpc.ConfigureRequest
+	programs  map[string]*program
+}
+
+func newDynamicProvider() *dynamicProvider {
+	return &dynamicProvider{programs: map[string]*program{}}
+}
+
+// binaryPath returns the path of the program binary that serves the provider of the resource with the given URN. The
+// path is resolved on the machine that runs the engine, so a resource's state does not depend upon where it was
+// created. If the given state records the binary that last served the resource and that binary is available, it is
+// used, so that the resource can be refreshed or deleted even if the current program no longer registers its provider.
+// Otherwise, the binary of the most recent run of the program for the resource's project and stack is used.
+func binaryPath(urn string, state *structpb.Struct) (string, error) {
+	u := resource.URN(urn)
+	if !u.IsValid() {
+		return "", errors.Errorf("invalid resource URN %q", urn)
+	}
+	project, stack := string(u.Project()), string(u.Stack())
+
+	var recorded string
+	if state != nil {
+		props, err := plugin.UnmarshalProperties(state, plugin.MarshalOptions{KeepUnknowns: true, KeepSecrets: true})
+		if err != nil {
+			return "", err
+		}
+		if recorded = dynamic.RecordedProgramBinary(props); recorded != "" {
+			path, err := dynamic.ProgramBinaryPath(project, stack, recorded)
+			if err != nil {
+				return "", err
+			}
+			if _, err = os.Stat(path); err == nil {
+				return path, nil
+			} else if !os.IsNotExist(err) {
+				return "", err
+			}
+			logging.V(5).Infof("program binary %s for %s is not available; using the current binary", recorded, urn)
+		}
+	}
+
+	current, err := dynamic.CurrentProgramBinary(project, stack)
+	if err != nil {
+		return "", err
+	}
+	if current == "" {
+		if recorded != "" {
+			return "", errors.Errorf("the program binary %s that last served %s is not available on this machine, "+
+				"and no other binary has been kept for stack %s; run the program's deployment on this machine with a "+
+				"version of the program that registers the resource's provider to restore it", recorded, urn, stack)
+		}
+		return "", errors.Errorf("no program binary has been kept for stack %s; run the program's deployment on "+
+			"this machine to restore it", stack)
+	}
+	return dynamic.ProgramBinaryPath(project, stack, current)
+}
+
+// program returns a client for the program binary that serves the provider of the resource with the given URN and
+// state, launching the binary if it is not already running.
+func (p *dynamicProvider) program(ctx context.Context, urn string,
+	state *structpb.Struct) (pulumirpc.ResourceProviderClient, error) {
+
+	binary, err := binaryPath(urn, state)
+	if err != nil {
+		return nil, err
+	}
+
+	p.m.Lock()
+	defer p.m.Unlock()
+	if prog, ok := p.programs[binary]; ok {
+		return prog.client, nil
+	}
+
+	prog, err := startProgram(binary)
+	if err != nil {
+		return nil, err
+	}
+	if p.configure != nil {
+		if _, err = prog.client.Configure(ctx, p.configure); err != nil {
+			return nil, errors.Wrapf(err, "configuring dynamic providers in %v", binary)
+		}
+	}
+	p.programs[binary] = prog
+	return prog.client, nil
+}
+
+// startProgram launches a program binary in the mode where it serves its dynamic providers, and connects to it.
+func startProgram(binary string) (*program, error) {
+	if _, err := os.Stat(binary); err != nil {
+		return nil, errors.Wrapf(err, "the program binary that implements this dynamic resource is not available; "+
+			"run the program's deployment on this machine to restore it")
+	}
+
+	cmd := exec.Command(binary)
+	cmd.Env = append(os.Environ(), pulumi.EnvDynamicProviders+"=true")
+	cmd.Stderr = os.Stderr
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err = cmd.Start(); err != nil {
+		return nil, errors.Wrapf(err, "launching %v", binary)
+	}
+
+	// Like a plugin, the program writes the port it is listening on as the first line of its output. Anything that it
+	// writes afterwards is passed along to our stderr.
+	out := bufio.NewReader(stdout)
+	line, err := out.ReadString('\n')
+	if err != nil {
+		stopProgram(cmd)
+		return nil, errors.Wrapf(err, "reading the port of the dynamic providers in %v", binary)
+	}
+	port, err := strconv.Atoi(strings.TrimSpace(line))
+	if err != nil {
+		stopProgram(cmd)
+		return nil, errors.Errorf("%v wrote %q instead of a port; does it call pulumi.Run?", binary, line)
+	}
+	go func() {
+		_, _ = io.Copy(os.Stderr, out)
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), programStartTimeout)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, "127.0.0.1:"+strconv.Itoa(port), grpc.WithInsecure(), grpc.WithBlock(),
+		rpcutil.GrpcChannelOptions())
+	if err != nil {
+		stopProgram(cmd)
+		return nil, errors.Wrapf(err, "connecting to the dynamic providers in %v", binary)
+	}
+
+	return &program{cmd: cmd, stdin: stdin, client: pulumirpc.NewResourceProviderClient(conn)}, nil
+}
+
+// stopProgram kills a program binary that failed to start serving its dynamic providers.
+func stopProgram(cmd *exec.Cmd) {
+	_ = cmd.Process.Kill()
+	_ = cmd.Wait()
+}
+
+// GetSchema is not supported: dynamic resources do not have a schema.
+func (p *dynamicProvider) GetSchema(ctx context.Context,
+	req *pulumirpc.GetSchemaRequest) (*pulumirpc.GetSchemaResponse, error) {
+
+	return nil, status.Error(codes.Unimplemented, "dynamic providers do not have a schema")
+}
+
+// CheckConfig accepts any configuration.
+func (p *dynamicProvider) CheckConfig(ctx context.Context,
+	req *pulumirpc.CheckRequest) (*pulumirpc.CheckResponse, error) {
+
+	return &pulumirpc.CheckResponse{Inputs: req.GetNews()}, nil
+}
+
+// DiffConfig reports that configuration changes have no effect.
+func (p *dynamicProvider) DiffConfig(ctx context.Context,
+	req *pulumirpc.DiffRequest) (*pulumirpc.DiffResponse, error) {
+
+	return &pulumirpc.DiffResponse{}, nil
+}
+
+// Configure records the provider's configuration so that it can be passed along to each program that is launched.
+func (p *dynamicProvider) Configure(ctx context.Context,
+	req *pulumirpc.ConfigureRequest) (*pulumirpc.ConfigureResponse, error) {
+
+	p.m.Lock()
+	defer p.m.Unlock()
+	p.configure = req
+	for binary, prog := range p.programs {
+		if _, err := prog.client.Configure(ctx, req); err != nil {
+			return nil, errors.Wrapf(err, "configuring dynamic providers in %v", binary)
+		}
+	}
+	return &pulumirpc.ConfigureResponse{AcceptSecrets: true}, nil
+}
+
+// Invoke is not supported.
+func (p *dynamicProvider) Invoke(ctx context.Context, req *pulumirpc.InvokeRequest) (*pulumirpc.InvokeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "unknown function %v", req.GetTok())
+}
+
+// StreamInvoke is not supported.
+func (p *dynamicProvider) StreamInvoke(req *pulumirpc.InvokeRequest,
+	server pulumirpc.ResourceProvider_StreamInvokeServer) error {
+
+	return status.Errorf(codes.Unimplemented, "unknown function %v", req.GetTok())
+}
+
+// Construct is not supported.
+func (p *dynamicProvider) Construct(ctx context.Context,
+	req *pulumirpc.ConstructRequest) (*pulumirpc.ConstructResponse, error) {
+
+	return nil, status.Errorf(codes.Unimplemented, "unknown component resource type %v", req.GetType())
+}
+
+// Call is not supported.
+func (p *dynamicProvider) Call(ctx context.Context, req *pulumirpc.CallRequest) (*pulumirpc.CallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "unknown method %v", req.GetTok())
+}
+
+// GetLogs is not supported.
+func (p *dynamicProvider) GetLogs(req *pulumirpc.GetLogsRequest,
+	server pulumirpc.ResourceProvider_GetLogsServer) error {
+
+	return status.Error(codes.Unimplemented, "dynamic providers do not support logs")
+}
+
+func (p *dynamicProvider) Check(ctx context.Context, req *pulumirpc.CheckRequest) (*pulumirpc.CheckResponse, error) {
+	client, err := p.program(ctx, req.GetUrn(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return client.Check(ctx, req)
+}
+
+func (p *dynamicProvider) Diff(ctx context.Context, req *pulumirpc.DiffRequest) (*pulumirpc.DiffResponse, error) {
+	client, err := p.program(ctx, req.GetUrn(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return client.Diff(ctx, req)
+}
+
+func (p *dynamicProvider) Create(ctx context.Context,
+	req *pulumirpc.CreateRequest) (*pulumirpc.CreateResponse, error) {
+
+	client, err := p.program(ctx, req.GetUrn(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return client.Create(ctx, req)
+}
+
+func (p *dynamicProvider) Read(ctx context.Context, req *pulumirpc.ReadRequest) (*pulumirpc.ReadResponse, error) {
+	client, err := p.program(ctx, req.GetUrn(), req.GetProperties())
+	if err != nil {
+		return nil, err
+	}
+	return client.Read(ctx, req)
+}
+
+func (p *dynamicProvider) Update(ctx context.Context,
+	req *pulumirpc.UpdateRequest) (*pulumirpc.UpdateResponse, error) {
+
+	client, err := p.program(ctx, req.GetUrn(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return client.Update(ctx, req)
+}
+
+func (p *dynamicProvider) Delete(ctx context.Context, req *pulumirpc.DeleteRequest) (*pbempty.Empty, error) {
+	client, err := p.program(ctx, req.GetUrn(), req.GetProperties())
+	if err != nil {
+		return nil, err
+	}
+	return client.Delete(ctx, req)
+}
+
+// Cancel asks each running program to cancel any operations that are in progress.
+func (p *dynamicProvider) Cancel(ctx context.Context, req *pbempty.Empty) (*pbempty.Empty, error) {
+	p.m.Lock()
+	defer p.m.Unlock()
+	for _, prog := range p.programs {
+		if _, err := prog.client.Cancel(ctx, req); err != nil {
+			return nil, err
+		}
+	}
+	return &pbempty.Empty{}, nil
+}
+
+func (p *dynamicProvider) GetPluginInfo(ctx context.Context, req *pbempty.Empty) (*pulumirpc.PluginInfo, error) {
+	return &pulumirpc.PluginInfo{Version: version.Version}, nil
+}
@@ -0,0 +1,173 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	structpb "github.com/golang/protobuf/ptypes/struct"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/workspace"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi/dynamic"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+const testURN = "urn:pulumi:stack::project::pulumi-go:dynamic:Resource::res"
+
+// keepProgram builds the test program, keeps a copy of it for the test project and stack in the same way as a run of
+// the program would, and returns the copy's hash.
+func keepProgram(t *testing.T, dir string, register bool) string {
+	out := filepath.Join(dir, "program")
+	if register {
+		out += "-register"
+	}
+	cmd := exec.Command("go", "build", "-o", out, "-ldflags", "-X main.register="+strconv.FormatBool(register),
+		"./testdata/program")
+	output, err := cmd.CombinedOutput()
+	require.NoError(t, err, "%s", output)
+
+	contents, err := ioutil.ReadFile(out)
+	require.NoError(t, err)
+	sum := sha256.Sum256(contents)
+	hash := hex.EncodeToString(sum[:])
+
+	path, err := dynamic.ProgramBinaryPath("project", "stack", hash)
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+	require.NoError(t, ioutil.WriteFile(path, contents, 0700))
+	return hash
+}
+
+// setCurrentProgram records the program with the given hash as the most recent run of the test project and stack.
+func setCurrentProgram(t *testing.T, hash string) {
+	path, err := workspace.GetPulumiPath("dynamic", "go", "project", "stack", "current")
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(path, []byte(hash), 0600))
+}
+
+func state(t *testing.T, binary string) *structpb.Struct {
+	provider := resource.PropertyMap{"name": resource.NewStringProperty("widget")}
+	if binary != "" {
+		provider["binary"] = resource.NewStringProperty(binary)
+	}
+	props, err := plugin.MarshalProperties(resource.PropertyMap{
+		"__provider": resource.NewObjectProperty(provider),
+	}, plugin.MarshalOptions{})
+	require.NoError(t, err)
+	return props
+}
+
+func TestBinaryPath(t *testing.T) {
+	home, err := ioutil.TempDir("", "pulumi-home")
+	require.NoError(t, err)
+	defer os.RemoveAll(home)
+	defer os.Setenv(workspace.PulumiHomeEnvVar, os.Getenv(workspace.PulumiHomeEnvVar))
+	os.Setenv(workspace.PulumiHomeEnvVar, home)
+
+	// Without a current binary, resources can be served only by the binary that they record.
+	_, err = binaryPath(testURN, nil)
+	assert.Error(t, err)
+	_, err = binaryPath(testURN, state(t, "0123"))
+	assert.Error(t, err)
+
+	current, err := dynamic.ProgramBinaryPath("project", "stack", "4567")
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Dir(current), 0700))
+	require.NoError(t, ioutil.WriteFile(current, nil, 0700))
+	setCurrentProgram(t, "4567")
+
+	// Resources that record a binary that is not available, or none at all, are served by the current binary.
+	path, err := binaryPath(testURN, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, current, path)
+	path, err = binaryPath(testURN, state(t, ""))
+	assert.NoError(t, err)
+	assert.Equal(t, current, path)
+	path, err = binaryPath(testURN, state(t, "0123"))
+	assert.NoError(t, err)
+	assert.Equal(t, current, path)
+
+	// Resources that record an available binary are served by it.
+	recorded, err := dynamic.ProgramBinaryPath("project", "stack", "0123")
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Dir(recorded), 0700))
+	require.NoError(t, ioutil.WriteFile(recorded, nil, 0700))
+	path, err = binaryPath(testURN, state(t, "0123"))
+	assert.NoError(t, err)
+	assert.Equal(t, recorded, path)
+}
+
+// TestDeleteAfterProviderRemoved deletes a resource whose provider is no longer registered by the most recent run of
+// its program.
+func TestDeleteAfterProviderRemoved(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping test that builds Go programs in short mode")
+	}
+
+	home, err := ioutil.TempDir("", "pulumi-home")
+	require.NoError(t, err)
+	defer os.RemoveAll(home)
+	defer os.Setenv(workspace.PulumiHomeEnvVar, os.Getenv(workspace.PulumiHomeEnvVar))
+	os.Setenv(workspace.PulumiHomeEnvVar, home)
+
+	deleted := filepath.Join(home, "deleted")
+	defer os.Setenv("WIDGET_DELETED", os.Getenv("WIDGET_DELETED"))
+	os.Setenv("WIDGET_DELETED", deleted)
+
+	// The resource was created by a version of the program that registered its provider, and the most recent run of
+	// the program no longer registers it.
+	created := keepProgram(t, home, true)
+	setCurrentProgram(t, keepProgram(t, home, false))
+
+	p := newDynamicProvider()
+	defer func() {
+		for _, prog := range p.programs {
+			assert.NoError(t, prog.stdin.Close())
+			assert.NoError(t, prog.cmd.Wait())
+		}
+	}()
+
+	// The current program cannot delete the resource...
+	_, err = p.Delete(context.Background(), &pulumirpc.DeleteRequest{
+		Urn:        testURN,
+		Id:         "widget",
+		Properties: state(t, ""),
+	})
+	assert.Error(t, err)
+
+	// ...but the program binary that is recorded in the resource's state can.
+	_, err = p.Delete(context.Background(), &pulumirpc.DeleteRequest{
+		Urn:        testURN,
+		Id:         "widget",
+		Properties: state(t, created),
+	})
+	assert.NoError(t, err)
+
+	ids, err := ioutil.ReadFile(deleted)
+	assert.NoError(t, err)
+	assert.Equal(t, "widget\n", string(ids))
+}
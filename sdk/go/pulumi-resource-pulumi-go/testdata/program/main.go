@@ -0,0 +1,59 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// program is a Go program that registers the "widget" dynamic provider if it is built with
+// -ldflags "-X main.register=true". The provider's Delete method records the IDs of the resources that it deletes in
+// the file named by the WIDGET_DELETED environment variable.
+package main
+
+import (
+	"context"
+	"os"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi/dynamic"
+)
+
+var register = "false"
+
+type widget struct{}
+
+func (widget) Create(ctx context.Context, inputs resource.PropertyMap) (string, resource.PropertyMap, error) {
+	return "widget", inputs, nil
+}
+
+func (widget) Delete(ctx context.Context, id string, props resource.PropertyMap) error {
+	f, err := os.OpenFile(os.Getenv("WIDGET_DELETED"), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err = f.WriteString(id + "\n"); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func init() {
+	if register == "true" {
+		dynamic.RegisterProvider("widget", widget{})
+	}
+}
+
+func main() {
+	pulumi.Run(func(ctx *pulumi.Context) error {
+		return nil
+	})
+}
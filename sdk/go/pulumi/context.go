@@ -18,7 +18,6 @@ package pulumi
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"reflect"
 	"sort"
@@ -27,6 +26,7 @@ import (
 
 	structpb "github.com/golang/protobuf/ptypes/struct"
 	multierror "github.com/hashicorp/go-multierror"
+	"github.com/pkg/errors"
 	"google.golang.org/grpc"
 
 	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
@@ -246,6 +246,164 @@ func (ctx *Context) Invoke(tok string, args interface{}, result interface{}, opt
 	return nil
 }
 
+// Call calls the method identified by its token tok on the resource self. The method is implemented by the provider
+// for the method's package, which receives self's URN and output properties along with the method's arguments.
+//
+// args must be an Input whose value is a struct or map. output is a value of the Output type that the result of the
+// call should have; its element type must be appropriately tagged and typed for use with Pulumi. The returned Output
+// depends on self and on any resources that the provider reports as dependencies of the result.
+func (ctx *Context) Call(tok string, args Input, output Output, self Resource, opts ...InvokeOption) (Output, error) {
+	if tok == "" {
+		return nil, errors.New("call token must not be empty")
+	}
+	if self == nil {
+		return nil, errors.New("call receiver must not be nil")
+	}
+	if output == nil {
+		return nil, errors.New("call output must not be nil")
+	}
+
+	options := &invokeOptions{}
+	for _, o := range opts {
+		o.applyInvokeOption(options)
+	}
+
+	provider := options.Provider
+	if provider == nil {
+		provider = self.getProviders()[getPackage(tok)]
+	}
+
+	// Note that we're about to make an outstanding RPC request, so that we can rendezvous during shutdown.
+	if err := ctx.beginRPC(); err != nil {
+		return nil, err
+	}
+
+	result := newOutput(reflect.TypeOf(output), self)
+	go func() {
+		var err error
+		defer func() {
+			if err != nil {
+				result.getState().reject(err)
+			}
+			ctx.endRPC(err)
+		}()
+
+		err = ctx.call(tok, args, result, self, provider)
+	}()
+
+	return result, nil
+}
+
+// call performs the Call RPC for Context.Call and resolves its result.
+func (ctx *Context) call(tok string, args Input, result Output, self Resource, provider ProviderResource) error {
+	var providerRef string
+	if provider != nil {
+		pr, err := ctx.resolveProviderReference(provider)
+		if err != nil {
+			return err
+		}
+		providerRef = pr
+	}
+
+	// Await the receiver's URN and output properties.
+	urn, _, _, err := self.URN().awaitURN(ctx.ctx)
+	if err != nil {
+		return err
+	}
+	var state resource.PropertyMap
+	if rawOutputs := self.getRawOutputs(); rawOutputs != nil {
+		v, _, _, err := rawOutputs.await(ctx.ctx)
+		if err != nil {
+			return err
+		}
+		state, _ = v.(resource.PropertyMap)
+	}
+
+	// Serialize the arguments, awaiting any outputs and recording their dependencies.
+	resolvedArgs, argDeps, _, err := marshalInputs(args)
+	if err != nil {
+		return errors.Wrap(err, "marshaling arguments")
+	}
+
+	keepUnknowns := ctx.DryRun()
+	rpcArgs, err := plugin.MarshalProperties(
+		resolvedArgs,
+		plugin.MarshalOptions{KeepUnknowns: keepUnknowns, KeepSecrets: true},
+	)
+	if err != nil {
+		return errors.Wrap(err, "marshaling arguments")
+	}
+	rpcState, err := plugin.MarshalProperties(
+		state,
+		plugin.MarshalOptions{KeepUnknowns: keepUnknowns, KeepSecrets: true},
+	)
+	if err != nil {
+		return errors.Wrap(err, "marshaling state")
+	}
+
+	rpcArgDeps := make(map[string]*pulumirpc.CallRequest_ArgumentDependencies)
+	for k, deps := range argDeps {
+		urns := make([]string, len(deps))
+		for i, d := range deps {
+			urns[i] = string(d)
+		}
+		rpcArgDeps[k] = &pulumirpc.CallRequest_ArgumentDependencies{Urns: urns}
+	}
+
+	logging.V(9).Infof("Call(%s, %s, #args=%d): RPC call being made", tok, urn, len(resolvedArgs))
+	resp, err := ctx.monitor.Call(ctx.ctx, &pulumirpc.CallRequest{
+		Tok:             tok,
+		Urn:             string(urn),
+		State:           rpcState,
+		Args:            rpcArgs,
+		ArgDependencies: rpcArgDeps,
+		Provider:        providerRef,
+	})
+	if err != nil {
+		logging.V(9).Infof("Call(%s, ...): error: %v", tok, err)
+		return err
+	}
+
+	// If there were any failures from the provider, return them.
+	if len(resp.Failures) > 0 {
+		logging.V(9).Infof("Call(%s, ...): success: w/ %d failures", tok, len(resp.Failures))
+		var ferr error
+		for _, failure := range resp.Failures {
+			ferr = multierror.Append(ferr,
+				errors.Errorf("%s call failed: %s (%s)", tok, failure.Reason, failure.Property))
+		}
+		return ferr
+	}
+
+	outProps, err := plugin.UnmarshalProperties(
+		resp.Return,
+		plugin.MarshalOptions{KeepSecrets: true, KeepUnknowns: keepUnknowns},
+	)
+	if err != nil {
+		return err
+	}
+
+	// Add the dependencies of the result to those of the output before resolving it.
+	outputState := result.getState()
+	outputState.mutex.Lock()
+	for _, deps := range resp.GetReturnDependencies() {
+		for _, urn := range deps.GetUrns() {
+			outputState.deps = append(outputState.deps, newDependencyResource(URN(urn)))
+		}
+	}
+	outputState.mutex.Unlock()
+
+	v := resource.NewObjectProperty(outProps)
+	dest := reflect.New(result.ElementType()).Elem()
+	secret, err := unmarshalOutput(v, dest)
+	if err != nil {
+		return err
+	}
+	outputState.resolve(dest.Interface(), !v.ContainsUnknowns(), secret)
+	logging.V(9).Infof("Call(%s, ...): success: w/ %d outs", tok, len(outProps))
+	return nil
+}
+
 // ReadResource reads an existing custom resource's state from the resource monitor. t is the fully qualified type
 // token and name is the "name" part to use in creating a stable and globally unique URN for the object. id is the ID
 // of the resource to read, and props contains any state necessary to perform the read (typically props will be nil).
@@ -394,6 +552,12 @@ func (ctx *Context) ReadResource(
 //
 func (ctx *Context) RegisterResource(
 	t, name string, props Input, resource Resource, opts ...ResourceOption) error {
+
+	return ctx.registerResource(t, name, props, resource, false /*remote*/, opts...)
+}
+
+func (ctx *Context) registerResource(
+	t, name string, props Input, resource Resource, remote bool, opts ...ResourceOption) error {
 	if t == "" {
 		return errors.New("resource type argument cannot be empty")
 	} else if name == "" {
@@ -465,6 +629,21 @@ func (ctx *Context) RegisterResource(
 			return
 		}
 
+		// Remote components pass their providers along to the provider that constructs them, so that the
+		// component's children may use them.
+		var providerRefs map[string]string
+		if remote {
+			providerRefs = make(map[string]string)
+			for pkg, provider := range providers {
+				ref, refErr := ctx.resolveProviderReference(provider)
+				if refErr != nil {
+					err = refErr
+					return
+				}
+				providerRefs[pkg] = ref
+			}
+		}
+
 		logging.V(9).Infof("RegisterResource(%s, %s): Goroutine spawned, RPC call being made", t, name)
 		resp, err := ctx.monitor.RegisterResource(ctx.ctx, &pulumirpc.RegisterResourceRequest{
 			Type:                    t,
@@ -479,10 +658,15 @@ func (ctx *Context) RegisterResource(
 			DeleteBeforeReplace:     inputs.deleteBeforeReplace,
 			ImportId:                inputs.importID,
 			CustomTimeouts:          inputs.customTimeouts,
+			RetryPolicy:             inputs.retryPolicy,
+			RetainOnDelete:          inputs.retainOnDelete,
+			DeletedWith:             inputs.deletedWith,
 			IgnoreChanges:           inputs.ignoreChanges,
 			Aliases:                 inputs.aliases,
 			AcceptSecrets:           true,
 			AdditionalSecretOutputs: inputs.additionalSecretOutputs,
+			Remote:                  remote,
+			Providers:               providerRefs,
 		})
 		if err != nil {
 			logging.V(9).Infof("RegisterResource(%s, %s): error: %v", t, name, err)
@@ -504,6 +688,15 @@ func (ctx *Context) RegisterComponentResource(
 	return ctx.RegisterResource(t, name, nil, resource, opts...)
 }
 
+// RegisterRemoteComponentResource registers a component resource that is constructed by the provider for its
+// package rather than by this program. The provider registers the component and its children, and the component's
+// outputs are resolved from the state the provider returns.
+func (ctx *Context) RegisterRemoteComponentResource(
+	t, name string, props Input, resource ComponentResource, opts ...ResourceOption) error {
+
+	return ctx.registerResource(t, name, props, resource, true /*remote*/, opts...)
+}
+
 // resourceState contains the results of a resource registration operation.
 type resourceState struct {
 	outputs         map[string]Output
@@ -511,6 +704,7 @@ type resourceState struct {
 	aliases         []URNOutput
 	name            string
 	transformations []ResourceTransformation
+	rawOutputs      *OutputState
 }
 
 // Apply transformations and return the transformations themselves, as well as the transformed props and opts.
@@ -691,6 +885,8 @@ func makeResourceState(t, name string, resourceV Resource, providers map[string]
 		rs.aliases = aliases
 		state.transformations = transformations
 		rs.transformations = transformations
+		state.rawOutputs = newOutputState(propertyMapType, resourceV)
+		rs.rawOutputs = state.rawOutputs
 	}
 	populateResourceStateResolvers()
 
@@ -718,9 +914,17 @@ func (state *resourceState) resolve(dryrun bool, err error, inputs *resourceInpu
 		for _, output := range state.outputs {
 			output.reject(err)
 		}
+		if state.rawOutputs != nil {
+			state.rawOutputs.reject(err)
+		}
 		return
 	}
 
+	// Record the raw outputs so that they can be sent along with any method calls on the resource.
+	if state.rawOutputs != nil {
+		state.rawOutputs.resolve(outprops.Copy(), true, false)
+	}
+
 	outprops["urn"] = resource.NewStringProperty(urn)
 	if id != "" || !dryrun {
 		outprops["id"] = resource.NewStringProperty(id)
@@ -763,6 +967,9 @@ type resourceInputs struct {
 	deleteBeforeReplace     bool
 	importID                string
 	customTimeouts          *pulumirpc.RegisterResourceRequest_CustomTimeouts
+	retryPolicy             *pulumirpc.RegisterResourceRequest_RetryPolicy
+	retainOnDelete          bool
+	deletedWith             string
 	ignoreChanges           []string
 	aliases                 []string
 	additionalSecretOutputs []string
@@ -836,6 +1043,16 @@ func (ctx *Context) prepareResourceInputs(props Input, t string,
 		aliases[i] = string(urn)
 	}
 
+	// Await the URN of the resource this one is deleted with, if any.
+	var deletedWith URN
+	if opts.DeletedWith != nil {
+		urn, _, _, err := opts.DeletedWith.URN().awaitURN(context.Background())
+		if err != nil {
+			return nil, fmt.Errorf("error waiting for DeletedWith URN to resolve: %w", err)
+		}
+		deletedWith = urn
+	}
+
 	return &resourceInputs{
 		parent:                  string(parent),
 		deps:                    deps,
@@ -847,6 +1064,9 @@ func (ctx *Context) prepareResourceInputs(props Input, t string,
 		deleteBeforeReplace:     deleteBeforeReplace,
 		importID:                string(importID),
 		customTimeouts:          getTimeouts(opts.CustomTimeouts),
+		retryPolicy:             getRetryPolicy(opts.RetryPolicy),
+		retainOnDelete:          opts.RetainOnDelete,
+		deletedWith:             string(deletedWith),
 		ignoreChanges:           ignoreChanges,
 		aliases:                 aliases,
 		additionalSecretOutputs: additionalSecretOutputs,
@@ -863,6 +1083,21 @@ func getTimeouts(custom *CustomTimeouts) *pulumirpc.RegisterResourceRequest_Cust
 	return &timeouts
 }
 
+func getRetryPolicy(policy *RetryPolicy) *pulumirpc.RegisterResourceRequest_RetryPolicy {
+	if policy == nil {
+		return nil
+	}
+	var backoff string
+	if policy.Backoff != 0 {
+		backoff = policy.Backoff.String()
+	}
+	return &pulumirpc.RegisterResourceRequest_RetryPolicy{
+		MaxAttempts:   int32(policy.MaxAttempts),
+		Backoff:       backoff,
+		ErrorMatchers: policy.ErrorMatchers,
+	}
+}
+
 // getOpts returns a set of resource options from an array of them. This includes the parent URN, any dependency URNs,
 // a boolean indicating whether the resource is to be protected, and the URN and ID of the resource's provider, if any.
 func (ctx *Context) getOpts(t string, providers map[string]ProviderResource, opts *resourceOptions) (
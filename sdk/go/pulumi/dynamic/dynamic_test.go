@@ -0,0 +1,286 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dynamic
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/workspace"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+// counter is a dynamic provider whose resources hold a count that may be updated in place. Changes to the resource's
+// name require replacement.
+type counter struct {
+	deleted []string
+}
+
+func (c *counter) Create(ctx context.Context, inputs resource.PropertyMap) (string, resource.PropertyMap, error) {
+	outs := inputs.Copy()
+	outs["created"] = resource.NewBoolProperty(true)
+	return inputs["name"].StringValue(), outs, nil
+}
+
+func (c *counter) Check(ctx context.Context,
+	olds, news resource.PropertyMap) (resource.PropertyMap, []CheckFailure, error) {
+
+	if !news["count"].IsNumber() {
+		return news, []CheckFailure{{Property: "count", Reason: "count must be a number"}}, nil
+	}
+	return news, nil, nil
+}
+
+func (c *counter) Diff(ctx context.Context, id string, olds, news resource.PropertyMap) (DiffResult, error) {
+	var result DiffResult
+	if !olds["count"].DeepEquals(news["count"]) {
+		result.Changes = true
+	}
+	if !olds["name"].DeepEquals(news["name"]) {
+		result.Changes, result.Replaces = true, []string{"name"}
+	}
+	return result, nil
+}
+
+func (c *counter) Read(ctx context.Context, id string, props resource.PropertyMap) (resource.PropertyMap, error) {
+	if id == "gone" {
+		return nil, ErrNotFound
+	}
+	return props, nil
+}
+
+func (c *counter) Update(ctx context.Context, id string, olds, news resource.PropertyMap) (resource.PropertyMap, error) {
+	outs := news.Copy()
+	outs["created"] = olds["created"]
+	return outs, nil
+}
+
+func (c *counter) Delete(ctx context.Context, id string, props resource.PropertyMap) error {
+	c.deleted = append(c.deleted, id)
+	return nil
+}
+
+// createOnly is a dynamic provider that implements only Create.
+type createOnly struct{}
+
+func (createOnly) Create(ctx context.Context, inputs resource.PropertyMap) (string, resource.PropertyMap, error) {
+	return "id", nil, nil
+}
+
+var testCounter = &counter{}
+
+func init() {
+	RegisterProvider("counter", testCounter)
+	RegisterProvider("createOnly", createOnly{})
+}
+
+func recorded(name string) resource.PropertyValue {
+	return resource.NewObjectProperty(resource.PropertyMap{
+		"name": resource.NewStringProperty(name),
+	})
+}
+
+func recordedBinary(name, binary string) resource.PropertyValue {
+	return resource.NewObjectProperty(resource.PropertyMap{
+		"name":    resource.NewStringProperty(name),
+		binaryKey: resource.NewStringProperty(binary),
+	})
+}
+
+func TestRegisterProvider(t *testing.T) {
+	assert.Panics(t, func() { RegisterProvider("", createOnly{}) })
+	assert.Panics(t, func() { RegisterProvider("nil", nil) })
+	assert.Panics(t, func() { RegisterProvider("counter", createOnly{}) })
+
+	_, err := getProvider("missing")
+	assert.Error(t, err)
+}
+
+func TestServerLifecycle(t *testing.T) {
+	s := newServer("0123abcd")
+	urn := resource.URN("urn:pulumi:stack::project::pulumi-go:dynamic:Resource::res")
+	provider := recorded("counter")
+
+	news := resource.PropertyMap{
+		"name":      resource.NewStringProperty("a"),
+		"count":     resource.NewNumberProperty(1),
+		providerKey: provider,
+	}
+
+	// Check passes the inputs to the provider without the recorded provider, and records it again in the result.
+	inputs, failures, err := s.Check(urn, nil, news, true)
+	assert.NoError(t, err)
+	assert.Empty(t, failures)
+	assert.Equal(t, news, inputs)
+
+	bad := news.Copy()
+	bad["count"] = resource.NewStringProperty("one")
+	_, failures, err = s.Check(urn, nil, bad, true)
+	assert.NoError(t, err)
+	assert.Equal(t, []plugin.CheckFailure{{Property: "count", Reason: "count must be a number"}}, failures)
+
+	// Create records the provider and the program binary that served it in the resource's outputs.
+	id, state, status, err := s.Create(urn, inputs, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, resource.StatusOK, status)
+	assert.Equal(t, resource.ID("a"), id)
+	assert.Equal(t, resource.PropertyMap{
+		"name":      resource.NewStringProperty("a"),
+		"count":     resource.NewNumberProperty(1),
+		"created":   resource.NewBoolProperty(true),
+		providerKey: recordedBinary("counter", "0123abcd"),
+	}, state)
+	assert.Equal(t, "0123abcd", RecordedProgramBinary(state))
+	assert.Equal(t, "", RecordedProgramBinary(inputs))
+
+	// Diff distinguishes updates from replacements.
+	diff, err := s.Diff(urn, id, state, inputs, true, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, plugin.DiffNone, diff.Changes)
+
+	update := inputs.Copy()
+	update["count"] = resource.NewNumberProperty(2)
+	diff, err = s.Diff(urn, id, state, update, true, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, plugin.DiffSome, diff.Changes)
+	assert.Empty(t, diff.ReplaceKeys)
+
+	replace := inputs.Copy()
+	replace["name"] = resource.NewStringProperty("b")
+	diff, err = s.Diff(urn, id, state, replace, true, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []resource.PropertyKey{"name"}, diff.ReplaceKeys)
+
+	// Update and Read record the provider in the resource's new outputs, along with the binary that served them.
+	s = newServer("4567cdef")
+	state, status, err = s.Update(urn, id, state, update, 0, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, resource.StatusOK, status)
+	assert.Equal(t, resource.NewNumberProperty(2), state["count"])
+	assert.Equal(t, resource.NewBoolProperty(true), state["created"])
+	assert.Equal(t, recordedBinary("counter", "4567cdef"), state[providerKey])
+
+	read, _, err := s.Read(urn, id, update, state)
+	assert.NoError(t, err)
+	assert.Equal(t, state, read.Outputs)
+
+	read, _, err = s.Read(urn, "gone", update, state)
+	assert.NoError(t, err)
+	assert.Nil(t, read.Outputs)
+
+	_, err = s.Delete(urn, id, state, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a"}, testCounter.deleted)
+}
+
+func TestServerOptionalMethods(t *testing.T) {
+	s := newServer("")
+	urn := resource.URN("urn:pulumi:stack::project::pulumi-go:dynamic:Resource::res")
+	news := resource.PropertyMap{"foo": resource.NewStringProperty("bar"), providerKey: recorded("createOnly")}
+
+	inputs, failures, err := s.Check(urn, nil, news, true)
+	assert.NoError(t, err)
+	assert.Empty(t, failures)
+	assert.Equal(t, news, inputs)
+
+	diff, err := s.Diff(urn, "id", news, news, true, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, plugin.DiffUnknown, diff.Changes)
+
+	_, state, _, err := s.Create(urn, news, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, resource.PropertyMap{providerKey: recorded("createOnly")}, state)
+
+	read, _, err := s.Read(urn, "id", news, state)
+	assert.NoError(t, err)
+	assert.Equal(t, state, read.Outputs)
+
+	_, _, err = s.Update(urn, "id", state, news, 0, nil)
+	assert.Error(t, err)
+
+	_, err = s.Delete(urn, "id", state, 0)
+	assert.NoError(t, err)
+
+	// Resources that do not record a provider are rejected.
+	_, _, _, err = s.Create(urn, resource.PropertyMap{}, 0)
+	assert.Error(t, err)
+}
+
+type mocks struct {
+	inputs map[string]resource.PropertyMap
+}
+
+func (m *mocks) Call(token string, args resource.PropertyMap, provider string) (resource.PropertyMap, error) {
+	return args, nil
+}
+
+func (m *mocks) NewResource(typeToken, name string, inputs resource.PropertyMap,
+	provider, id string) (string, resource.PropertyMap, error) {
+
+	m.inputs[typeToken+"::"+name] = inputs
+	return name, inputs, nil
+}
+
+func TestNewResource(t *testing.T) {
+	home, err := ioutil.TempDir("", "pulumi-home")
+	assert.NoError(t, err)
+	defer os.RemoveAll(home)
+	defer os.Setenv(workspace.PulumiHomeEnvVar, os.Getenv(workspace.PulumiHomeEnvVar))
+	os.Setenv(workspace.PulumiHomeEnvVar, home)
+
+	m := &mocks{inputs: map[string]resource.PropertyMap{}}
+	err = pulumi.RunErr(func(ctx *pulumi.Context) error {
+		if _, err := NewResource(ctx, "counter", "res", pulumi.Map{"count": pulumi.Int(1)}); err != nil {
+			return err
+		}
+
+		_, err := NewResource(ctx, "missing", "missing", pulumi.Map{})
+		assert.Error(t, err)
+		_, err = NewResource(ctx, "counter", "reserved", pulumi.Map{providerKey: pulumi.String("")})
+		assert.Error(t, err)
+		return nil
+	}, pulumi.WithMocks("project", "stack", m))
+	assert.NoError(t, err)
+
+	// The resource's inputs record only its provider, and a copy of the program binary is kept under its hash as the
+	// current binary for the project and stack.
+	hash, err := CurrentProgramBinary("project", "stack")
+	assert.NoError(t, err)
+	expected, err := hashProgramBinary()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, hash)
+	binary, err := ProgramBinaryPath("project", "stack", hash)
+	assert.NoError(t, err)
+	assert.FileExists(t, binary)
+
+	hash, err = CurrentProgramBinary("project", "other")
+	assert.NoError(t, err)
+	assert.Equal(t, "", hash)
+
+	inputs, ok := m.inputs[ResourceType+"::res"]
+	assert.True(t, ok)
+	assert.Equal(t, resource.PropertyMap{
+		"count": resource.NewNumberProperty(1),
+		providerKey: resource.NewObjectProperty(resource.PropertyMap{
+			"name": resource.NewStringProperty("counter"),
+		}),
+	}, inputs)
+}
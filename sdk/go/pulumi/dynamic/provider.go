@@ -0,0 +1,130 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package dynamic allows a Go program to define resources whose lifecycle is implemented by the program itself.
+//
+// A dynamic provider is a Go value that implements ResourceProvider and any of the optional Checker, Differ, Reader,
+// Updater, and Deleter interfaces. Providers are registered by name using RegisterProvider before the program calls
+// pulumi.Run, and resources that are managed by a provider are created using NewResource or RegisterResource.
+//
+// Dynamic providers are served to the engine by the pulumi-resource-pulumi-go plugin, which launches a copy of the
+// program's binary in a mode where it serves its registered providers instead of running its body. Each run of the
+// program keeps a copy of its binary in the Pulumi home directory under the resource's project and stack and the hash
+// of the binary's contents (see ProgramBinaryPath). A resource records the hash of the binary that created or last
+// updated it in its outputs, so that it can be refreshed or destroyed by that binary even if later versions of the
+// program no longer register its provider, and even if the program's source is no longer available. Copies are not
+// removed automatically, and may be deleted once no resource records their hash. If a resource's recorded binary is not
+// available, for example on a different machine, the binary of the most recent run of the program is used instead.
+package dynamic
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+)
+
+// ErrNotFound may be returned by a provider's Read method to indicate that the resource no longer exists.
+var ErrNotFound = errors.New("resource not found")
+
+// ResourceProvider is implemented by every dynamic provider.
+type ResourceProvider interface {
+	// Create creates a new resource with the given inputs, and returns its ID and output properties.
+	Create(ctx context.Context, inputs resource.PropertyMap) (string, resource.PropertyMap, error)
+}
+
+// Checker may be implemented by a dynamic provider to validate a resource's inputs. It returns the inputs that should
+// be passed to later calls to Diff, Create, and Update, along with any validation failures. Providers that do not
+// implement Checker accept their inputs unchanged.
+type Checker interface {
+	Check(ctx context.Context, olds, news resource.PropertyMap) (resource.PropertyMap, []CheckFailure, error)
+}
+
+// Differ may be implemented by a dynamic provider to compare a resource's current output properties with its new
+// inputs. If a provider does not implement Differ, the engine compares the resource's old and new inputs, and applies
+// any change with Update.
+type Differ interface {
+	Diff(ctx context.Context, id string, olds, news resource.PropertyMap) (DiffResult, error)
+}
+
+// Reader may be implemented by a dynamic provider to read the current output properties of a resource. Read returns
+// ErrNotFound if the resource no longer exists. If a provider does not implement Reader, refreshing a resource leaves
+// its recorded state unchanged.
+type Reader interface {
+	Read(ctx context.Context, id string, props resource.PropertyMap) (resource.PropertyMap, error)
+}
+
+// Updater may be implemented by a dynamic provider to update a resource in place, returning its new output properties.
+// A provider that does not implement Updater must implement Differ and report all changes as replacements.
+type Updater interface {
+	Update(ctx context.Context, id string, olds, news resource.PropertyMap) (resource.PropertyMap, error)
+}
+
+// Deleter may be implemented by a dynamic provider to delete a resource. If a provider does not implement Deleter,
+// deleted resources are simply removed from the stack's state.
+type Deleter interface {
+	Delete(ctx context.Context, id string, props resource.PropertyMap) error
+}
+
+// CheckFailure describes an invalid input property.
+type CheckFailure struct {
+	Property string // the name of the invalid property.
+	Reason   string // the reason the property is invalid.
+}
+
+// DiffResult describes the changes between a resource's current properties and its new inputs.
+type DiffResult struct {
+	Changes             bool     // true if the resource must be updated or replaced.
+	Replaces            []string // the names of the changed properties that require the resource to be replaced.
+	Stables             []string // the names of the output properties that will not change.
+	DeleteBeforeReplace bool     // true if the resource must be deleted before its replacement is created.
+}
+
+var providers = struct {
+	sync.RWMutex
+	m map[string]ResourceProvider
+}{m: map[string]ResourceProvider{}}
+
+// RegisterProvider registers a dynamic provider with the given name. Providers must be registered before the program
+// calls pulumi.Run, typically from an init function, and must be registered under the same name in every version of
+// the program that manages their resources. RegisterProvider panics if the name is empty or already registered.
+func RegisterProvider(name string, provider ResourceProvider) {
+	if name == "" {
+		panic("dynamic: provider name must not be empty")
+	}
+	if provider == nil {
+		panic(fmt.Sprintf("dynamic: provider %q is nil", name))
+	}
+
+	providers.Lock()
+	defer providers.Unlock()
+	if _, has := providers.m[name]; has {
+		panic(fmt.Sprintf("dynamic: provider %q is already registered", name))
+	}
+	providers.m[name] = provider
+}
+
+// getProvider returns the provider registered with the given name.
+func getProvider(name string) (ResourceProvider, error) {
+	providers.RLock()
+	defer providers.RUnlock()
+	p, ok := providers.m[name]
+	if !ok {
+		return nil, errors.Errorf("unknown dynamic provider %q; providers must be registered before pulumi.Run", name)
+	}
+	return p, nil
+}
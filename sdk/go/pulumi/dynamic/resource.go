@@ -0,0 +1,250 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dynamic
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/contract"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/workspace"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi"
+)
+
+const (
+	// ResourceType is the type token shared by all dynamic resources. Its package is served by the
+	// pulumi-resource-pulumi-go plugin.
+	ResourceType = "pulumi-go:dynamic:Resource"
+
+	// providerKey is the property that records a resource's dynamic provider. Its name field holds the name of the
+	// provider, and in the resource's outputs, its binary field holds the hash of the program binary that served it.
+	providerKey = "__provider"
+	// binaryKey is the field of the recorded provider that holds the hash of the program binary that served it.
+	binaryKey = "binary"
+)
+
+// Resource is a resource that is managed by a dynamic provider. Programs that need typed access to a dynamic
+// resource's outputs may instead pass their own resource struct to RegisterResource.
+type Resource struct {
+	pulumi.CustomResourceState
+}
+
+// NewResource registers a resource that is managed by the dynamic provider with the given name.
+func NewResource(ctx *pulumi.Context, provider, name string, inputs pulumi.Map,
+	opts ...pulumi.ResourceOption) (*Resource, error) {
+
+	var res Resource
+	if err := RegisterResource(ctx, provider, name, inputs, &res, opts...); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// RegisterResource registers a resource that is managed by the dynamic provider with the given name. The resource's
+// outputs are resolved into the fields of res in the same way as for pulumi.Context.RegisterResource.
+func RegisterResource(ctx *pulumi.Context, provider, name string, inputs pulumi.Map, res pulumi.CustomResource,
+	opts ...pulumi.ResourceOption) error {
+
+	if _, err := getProvider(provider); err != nil {
+		return err
+	}
+	if _, has := inputs[providerKey]; has {
+		return errors.Errorf("the input property %q is reserved for use by dynamic providers", providerKey)
+	}
+
+	if err := saveProgramBinary(ctx); err != nil {
+		return errors.Wrap(err, "saving the program binary for dynamic providers")
+	}
+
+	props := pulumi.Map{}
+	for k, v := range inputs {
+		props[k] = v
+	}
+	props[providerKey] = pulumi.Map{
+		"name": pulumi.String(provider),
+	}
+	return ctx.RegisterResource(ResourceType, name, props, res, opts...)
+}
+
+var savedBinary struct {
+	once sync.Once
+	err  error
+}
+
+// ProgramBinaryPath returns the path in the Pulumi home directory at which the program binary with the given hash is
+// kept for the given project and stack. Each binary is kept under the SHA-256 hash of its contents, which dynamic
+// resources record in their state, so that a resource can be refreshed or deleted by the binary that last served it
+// even after later versions of the program have stopped registering its provider.
+func ProgramBinaryPath(project, stack, hash string) (string, error) {
+	name := "program"
+	if runtime.GOOS == "windows" {
+		name += ".exe"
+	}
+	return workspace.GetPulumiPath("dynamic", "go", project, stack, hash, name)
+}
+
+// CurrentProgramBinary returns the hash of the program binary that was most recently run for the given project and
+// stack, or the empty string if there is none. The pulumi-resource-pulumi-go plugin uses this binary to check, create,
+// and update resources, and to serve any resource whose recorded binary is not available.
+func CurrentProgramBinary(project, stack string) (string, error) {
+	path, err := currentBinaryPath(project, stack)
+	if err != nil {
+		return "", err
+	}
+	hash, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(hash)), nil
+}
+
+// RecordedProgramBinary returns the hash of the program binary that is recorded in the given resource properties, or
+// the empty string if the properties do not record one.
+func RecordedProgramBinary(props resource.PropertyMap) string {
+	v, ok := props[providerKey]
+	if !ok || !v.IsObject() {
+		return ""
+	}
+	binary := v.ObjectValue()[binaryKey]
+	if !binary.IsString() {
+		return ""
+	}
+	return binary.StringValue()
+}
+
+// currentBinaryPath returns the path of the file that records the hash of the current program binary for the given
+// project and stack.
+func currentBinaryPath(project, stack string) (string, error) {
+	return workspace.GetPulumiPath("dynamic", "go", project, stack, "current")
+}
+
+// saveProgramBinary keeps a copy of the running program's binary at its ProgramBinaryPath, and records it as the
+// current binary for the program's project and stack. Programs that are launched with `go run` are built into a
+// temporary directory that is removed when the program exits, so the copy is what allows later deployments to serve
+// the program's providers.
+func saveProgramBinary(ctx *pulumi.Context) error {
+	savedBinary.once.Do(func() {
+		savedBinary.err = copyProgramBinary(ctx.Project(), ctx.Stack())
+	})
+	return savedBinary.err
+}
+
+func copyProgramBinary(project, stack string) error {
+	current, err := currentBinaryPath(project, stack)
+	if err != nil {
+		return err
+	}
+	dir := filepath.Dir(current)
+	if err = os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+
+	exe, err := os.Executable()
+	if err != nil {
+		return err
+	}
+	src, err := os.Open(exe)
+	if err != nil {
+		return err
+	}
+	defer contract.IgnoreClose(src)
+
+	// Copy the binary to a temporary file while hashing it, and then move it into place if no binary with the same
+	// contents is already kept, so that a copy that is in use by a running provider is never partially overwritten.
+	tmp, err := ioutil.TempFile(dir, "program.*")
+	if err != nil {
+		return err
+	}
+	defer func() { contract.IgnoreError(os.Remove(tmp.Name())) }()
+
+	h := sha256.New()
+	_, err = io.Copy(io.MultiWriter(tmp, h), src)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	hash := hex.EncodeToString(h.Sum(nil))
+
+	path, err := ProgramBinaryPath(project, stack, hash)
+	if err != nil {
+		return err
+	}
+	if _, err = os.Stat(path); os.IsNotExist(err) {
+		if err = os.Chmod(tmp.Name(), 0700); err != nil {
+			return err
+		}
+		if err = os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+			return err
+		}
+		if err = os.Rename(tmp.Name(), path); err != nil {
+			return err
+		}
+	} else if err != nil {
+		return err
+	}
+
+	return writeFileAtomic(current, []byte(hash))
+}
+
+// hashProgramBinary returns the hash of the running program's binary.
+func hashProgramBinary() (string, error) {
+	exe, err := os.Executable()
+	if err != nil {
+		return "", err
+	}
+	f, err := os.Open(exe)
+	if err != nil {
+		return "", err
+	}
+	defer contract.IgnoreClose(f)
+
+	h := sha256.New()
+	if _, err = io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// writeFileAtomic writes the given contents to a temporary file and then moves it into place at the given path.
+func writeFileAtomic(path string, contents []byte) error {
+	tmp, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer func() { contract.IgnoreError(os.Remove(tmp.Name())) }()
+
+	_, err = tmp.Write(contents)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
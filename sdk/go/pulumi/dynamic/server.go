@@ -0,0 +1,367 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dynamic
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/rpcutil"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/workspace"
+	hook "github.com/pulumi/pulumi/sdk/v2/go/pulumi/internal/dynamic"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+func init() {
+	hook.Serve = serve
+}
+
+// serve serves the program's dynamic providers to the pulumi-resource-pulumi-go plugin that launched the program. Like
+// a resource provider plugin, the program writes the port it is listening on to stdout. It stops serving once the
+// plugin closes its stdin.
+func serve() error {
+	binary, err := hashProgramBinary()
+	if err != nil {
+		return errors.Wrap(err, "hashing the program binary")
+	}
+
+	cancel := make(chan bool)
+	go func() {
+		_, _ = io.Copy(ioutil.Discard, os.Stdin)
+		close(cancel)
+	}()
+
+	port, done, err := rpcutil.Serve(0, cancel, []func(*grpc.Server) error{
+		func(srv *grpc.Server) error {
+			pulumirpc.RegisterResourceProviderServer(srv, plugin.NewProviderServer(newServer(binary)))
+			return nil
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%d\n", port)
+	return <-done
+}
+
+// server implements plugin.Provider by dispatching each resource operation to the dynamic provider that is recorded
+// in the resource's properties.
+type server struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	binary string // the hash of the program binary, which is recorded in the outputs of each resource.
+}
+
+var _ plugin.Provider = (*server)(nil)
+
+func newServer(binary string) *server {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &server{ctx: ctx, cancel: cancel, binary: binary}
+}
+
+// getProvider returns the dynamic provider recorded in the first of the given property maps that records one, along
+// with the value that records it.
+func (s *server) getProvider(props ...resource.PropertyMap) (ResourceProvider, resource.PropertyValue, error) {
+	for _, m := range props {
+		v, ok := m[providerKey]
+		if !ok {
+			continue
+		}
+		if !v.IsObject() || !v.ObjectValue()["name"].IsString() {
+			return nil, resource.PropertyValue{}, errors.Errorf("malformed %v property", providerKey)
+		}
+		p, err := getProvider(v.ObjectValue()["name"].StringValue())
+		return p, v, err
+	}
+	return nil, resource.PropertyValue{}, errors.New("resource does not record a dynamic provider")
+}
+
+// timeoutContext returns a context for a resource operation with the given timeout in seconds, if any.
+func (s *server) timeoutContext(timeout float64) (context.Context, context.CancelFunc) {
+	if timeout == 0 {
+		return context.WithCancel(s.ctx)
+	}
+	return context.WithTimeout(s.ctx, time.Duration(timeout*float64(time.Second)))
+}
+
+// strip returns a copy of the given properties without the property that records the dynamic provider.
+func strip(props resource.PropertyMap) resource.PropertyMap {
+	if props == nil {
+		return nil
+	}
+	result := props.Copy()
+	delete(result, providerKey)
+	return result
+}
+
+// record returns a copy of the given properties that records the given dynamic provider.
+func record(props resource.PropertyMap, provider resource.PropertyValue) resource.PropertyMap {
+	result := resource.PropertyMap{}
+	for k, v := range props {
+		result[k] = v
+	}
+	result[providerKey] = provider
+	return result
+}
+
+// recordOutputs returns a copy of the given output properties that records the given dynamic provider along with the
+// program binary that served it, so that the resource can later be refreshed or deleted by the same binary.
+func (s *server) recordOutputs(outs resource.PropertyMap, provider resource.PropertyValue) resource.PropertyMap {
+	recorded := resource.PropertyMap{"name": provider.ObjectValue()["name"]}
+	if s.binary != "" {
+		recorded[binaryKey] = resource.NewStringProperty(s.binary)
+	}
+	return record(outs, resource.NewObjectProperty(recorded))
+}
+
+// Close closes the server. It has no effect.
+func (s *server) Close() error {
+	return nil
+}
+
+// Pkg returns the reserved package of dynamic resources.
+func (s *server) Pkg() tokens.Package {
+	return tokens.Type(ResourceType).Package()
+}
+
+// GetSchema is not supported.
+func (s *server) GetSchema(version int) ([]byte, error) {
+	return nil, errors.New("dynamic providers do not have a schema")
+}
+
+// CheckConfig accepts any configuration.
+func (s *server) CheckConfig(urn resource.URN, olds, news resource.PropertyMap,
+	allowUnknowns bool) (resource.PropertyMap, []plugin.CheckFailure, error) {
+
+	return news, nil, nil
+}
+
+// DiffConfig reports that configuration changes have no effect.
+func (s *server) DiffConfig(urn resource.URN, olds, news resource.PropertyMap, allowUnknowns bool,
+	ignoreChanges []string) (plugin.DiffResult, error) {
+
+	return plugin.DiffResult{}, nil
+}
+
+// Configure does nothing.
+func (s *server) Configure(inputs resource.PropertyMap) error {
+	return nil
+}
+
+// Check validates a resource's inputs by calling its provider's Check method, if any.
+func (s *server) Check(urn resource.URN, olds, news resource.PropertyMap,
+	allowUnknowns bool) (resource.PropertyMap, []plugin.CheckFailure, error) {
+
+	p, recorded, err := s.getProvider(news)
+	if err != nil {
+		return nil, nil, err
+	}
+	checker, ok := p.(Checker)
+	if !ok {
+		return news, nil, nil
+	}
+
+	inputs, failures, err := checker.Check(s.ctx, strip(olds), strip(news))
+	if err != nil {
+		return nil, nil, err
+	}
+	var checkFailures []plugin.CheckFailure
+	for _, f := range failures {
+		checkFailures = append(checkFailures, plugin.CheckFailure{
+			Property: resource.PropertyKey(f.Property),
+			Reason:   f.Reason,
+		})
+	}
+	return record(inputs, recorded), checkFailures, nil
+}
+
+// Diff compares a resource's old state with its new inputs by calling its provider's Diff method, if any. If the
+// provider has no Diff method, the engine compares the resource's old and new inputs itself.
+func (s *server) Diff(urn resource.URN, id resource.ID, olds resource.PropertyMap, news resource.PropertyMap,
+	allowUnknowns bool, ignoreChanges []string) (plugin.DiffResult, error) {
+
+	p, _, err := s.getProvider(news, olds)
+	if err != nil {
+		return plugin.DiffResult{}, err
+	}
+	differ, ok := p.(Differ)
+	if !ok {
+		return plugin.DiffResult{Changes: plugin.DiffUnknown}, nil
+	}
+
+	diff, err := differ.Diff(s.ctx, string(id), strip(olds), strip(news))
+	if err != nil {
+		return plugin.DiffResult{}, err
+	}
+	result := plugin.DiffResult{Changes: plugin.DiffNone, DeleteBeforeReplace: diff.DeleteBeforeReplace}
+	if diff.Changes {
+		result.Changes = plugin.DiffSome
+	}
+	for _, k := range diff.Replaces {
+		result.ReplaceKeys = append(result.ReplaceKeys, resource.PropertyKey(k))
+	}
+	for _, k := range diff.Stables {
+		result.StableKeys = append(result.StableKeys, resource.PropertyKey(k))
+	}
+	return result, nil
+}
+
+// Create creates a resource by calling its provider's Create method.
+func (s *server) Create(urn resource.URN, news resource.PropertyMap, timeout float64) (resource.ID,
+	resource.PropertyMap, resource.Status, error) {
+
+	p, recorded, err := s.getProvider(news)
+	if err != nil {
+		return "", nil, resource.StatusOK, err
+	}
+
+	ctx, cancel := s.timeoutContext(timeout)
+	defer cancel()
+
+	id, outs, err := p.Create(ctx, strip(news))
+	if err != nil {
+		return "", nil, resource.StatusOK, err
+	}
+	if id == "" {
+		return "", nil, resource.StatusOK, errors.New("dynamic provider did not return an ID")
+	}
+	return resource.ID(id), s.recordOutputs(outs, recorded), resource.StatusOK, nil
+}
+
+// Read reads the current state of a resource by calling its provider's Read method. If the provider has no Read
+// method, the resource's recorded state is returned unchanged.
+func (s *server) Read(urn resource.URN, id resource.ID,
+	inputs, state resource.PropertyMap) (plugin.ReadResult, resource.Status, error) {
+
+	p, recorded, err := s.getProvider(state, inputs)
+	if err != nil {
+		return plugin.ReadResult{}, resource.StatusUnknown, err
+	}
+	reader, ok := p.(Reader)
+	if !ok {
+		return plugin.ReadResult{ID: id, Inputs: inputs, Outputs: state}, resource.StatusOK, nil
+	}
+
+	outs, err := reader.Read(s.ctx, string(id), strip(state))
+	if err == ErrNotFound {
+		return plugin.ReadResult{}, resource.StatusOK, nil
+	} else if err != nil {
+		return plugin.ReadResult{}, resource.StatusUnknown, err
+	}
+	return plugin.ReadResult{ID: id, Inputs: inputs, Outputs: s.recordOutputs(outs, recorded)}, resource.StatusOK, nil
+}
+
+// Update updates a resource by calling its provider's Update method.
+func (s *server) Update(urn resource.URN, id resource.ID, olds resource.PropertyMap, news resource.PropertyMap,
+	timeout float64, ignoreChanges []string) (resource.PropertyMap, resource.Status, error) {
+
+	p, recorded, err := s.getProvider(news, olds)
+	if err != nil {
+		return nil, resource.StatusOK, err
+	}
+	updater, ok := p.(Updater)
+	if !ok {
+		return nil, resource.StatusOK, errors.Errorf("the dynamic provider for %v does not support updates", urn)
+	}
+
+	ctx, cancel := s.timeoutContext(timeout)
+	defer cancel()
+
+	outs, err := updater.Update(ctx, string(id), strip(olds), strip(news))
+	if err != nil {
+		return nil, resource.StatusUnknown, err
+	}
+	return s.recordOutputs(outs, recorded), resource.StatusOK, nil
+}
+
+// Delete deletes a resource by calling its provider's Delete method, if any.
+func (s *server) Delete(urn resource.URN, id resource.ID, props resource.PropertyMap,
+	timeout float64) (resource.Status, error) {
+
+	p, _, err := s.getProvider(props)
+	if err != nil {
+		return resource.StatusOK, err
+	}
+	deleter, ok := p.(Deleter)
+	if !ok {
+		return resource.StatusOK, nil
+	}
+
+	ctx, cancel := s.timeoutContext(timeout)
+	defer cancel()
+
+	if err = deleter.Delete(ctx, string(id), strip(props)); err != nil {
+		return resource.StatusUnknown, err
+	}
+	return resource.StatusOK, nil
+}
+
+// Invoke is not supported.
+func (s *server) Invoke(tok tokens.ModuleMember,
+	args resource.PropertyMap) (resource.PropertyMap, []plugin.CheckFailure, error) {
+
+	return nil, nil, errors.Errorf("unknown function %v", tok)
+}
+
+// StreamInvoke is not supported.
+func (s *server) StreamInvoke(tok tokens.ModuleMember, args resource.PropertyMap,
+	onNext func(resource.PropertyMap) error) ([]plugin.CheckFailure, error) {
+
+	return nil, errors.Errorf("unknown function %v", tok)
+}
+
+// Construct is not supported.
+func (s *server) Construct(info plugin.ConstructInfo, typ tokens.Type, name tokens.QName, parent resource.URN,
+	inputs resource.PropertyMap, options plugin.ConstructOptions) (plugin.ConstructResult, error) {
+
+	return plugin.ConstructResult{}, errors.Errorf("unknown component resource type %v", typ)
+}
+
+// Call is not supported.
+func (s *server) Call(tok tokens.ModuleMember, urn resource.URN, state resource.PropertyMap,
+	args resource.PropertyMap, info plugin.CallInfo, options plugin.CallOptions) (plugin.CallResult, error) {
+
+	return plugin.CallResult{}, errors.Errorf("unknown method %v", tok)
+}
+
+// GetLogs is not supported.
+func (s *server) GetLogs(urn resource.URN, id resource.ID, state resource.PropertyMap, startTime,
+	endTime *time.Time, onNext func(plugin.LogEntry) error) error {
+
+	return plugin.ErrLogsNotSupported
+}
+
+// GetPluginInfo returns the name of the dynamic provider package. Dynamic providers are part of the program that
+// defines them, and do not have a version of their own.
+func (s *server) GetPluginInfo() (workspace.PluginInfo, error) {
+	return workspace.PluginInfo{Name: string(s.Pkg()), Kind: workspace.ResourcePlugin}, nil
+}
+
+// SignalCancellation cancels the context passed to any provider methods that are in progress or are called later.
+func (s *server) SignalCancellation() error {
+	s.cancel()
+	return nil
+}
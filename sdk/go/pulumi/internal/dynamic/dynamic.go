@@ -0,0 +1,21 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package dynamic connects the Go SDK's run loop to the dynamic provider support in the public dynamic package without
+// requiring the pulumi package to import it.
+package dynamic
+
+// Serve serves the dynamic providers that have been registered by the running program, and returns once the
+// provider has been shut down. It is nil unless the program links the public dynamic package.
+var Serve func() error
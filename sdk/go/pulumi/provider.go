@@ -0,0 +1,225 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulumi
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/util/contract"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+// ConstructFunc is the signature of a function that constructs a component resource on behalf of a provider's
+// Construct RPC. The function should register the component resource with the given type, name, and options, register
+// its children, and then return the component's URN and output state.
+//
+// Each of the component's inputs is an AnyOutput that carries the dependencies of the original input value.
+type ConstructFunc func(ctx *Context, typ, name string, inputs Map, options ResourceOption) (*ConstructResult, error)
+
+// ConstructResult is the result of a call to a ConstructFunc.
+type ConstructResult struct {
+	// URN is the URN of the constructed component resource.
+	URN URNInput
+	// State is the output state of the component resource. It must be a struct or map or a pointer to a struct or map.
+	State Input
+}
+
+// Construct implements the Construct RPC of a resource provider using the given ConstructFunc. engineAddr is the
+// address of the engine's RPC endpoint, which is passed to every provider plugin on the command line, and is used for
+// logging.
+func Construct(ctx context.Context, req *pulumirpc.ConstructRequest, engineAddr string,
+	constructF ConstructFunc) (*pulumirpc.ConstructResponse, error) {
+
+	info := RunInfo{
+		Project:     req.GetProject(),
+		Stack:       req.GetStack(),
+		Config:      req.GetConfig(),
+		Parallel:    int(req.GetParallel()),
+		DryRun:      req.GetDryRun(),
+		MonitorAddr: req.GetMonitorEndpoint(),
+		EngineAddr:  engineAddr,
+	}
+	pulumiCtx, err := NewContext(ctx, info)
+	if err != nil {
+		return nil, errors.Wrap(err, "constructing run context")
+	}
+	defer func() {
+		contract.IgnoreError(pulumiCtx.Close())
+	}()
+
+	inputs, err := constructInputs(req)
+	if err != nil {
+		return nil, err
+	}
+	options, err := constructOptions(req)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := constructF(pulumiCtx, req.GetType(), req.GetName(), inputs, options)
+	if err != nil {
+		return nil, err
+	}
+
+	// Wait for the component's registrations to complete before returning its state.
+	urn, _, _, err := result.URN.ToURNOutput().awaitURN(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var state resource.PropertyMap
+	var stateDeps map[string][]URN
+	if result.State != nil {
+		state, stateDeps, _, err = marshalInputs(result.State)
+		if err != nil {
+			return nil, errors.Wrap(err, "marshaling state")
+		}
+	}
+
+	pulumiCtx.waitForRPCs()
+	if pulumiCtx.rpcError != nil {
+		return nil, pulumiCtx.rpcError
+	}
+
+	rpcState, err := plugin.MarshalProperties(state, plugin.MarshalOptions{
+		KeepUnknowns: req.GetDryRun(),
+		KeepSecrets:  true,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "marshaling state")
+	}
+
+	rpcStateDeps := make(map[string]*pulumirpc.ConstructResponse_PropertyDependencies)
+	for k, deps := range stateDeps {
+		urns := make([]string, len(deps))
+		for i, d := range deps {
+			urns[i] = string(d)
+		}
+		rpcStateDeps[k] = &pulumirpc.ConstructResponse_PropertyDependencies{Urns: urns}
+	}
+
+	return &pulumirpc.ConstructResponse{
+		Urn:               string(urn),
+		State:             rpcState,
+		StateDependencies: rpcStateDeps,
+	}, nil
+}
+
+// constructInputs converts the inputs of a Construct request into outputs that carry the dependencies of each input.
+func constructInputs(req *pulumirpc.ConstructRequest) (Map, error) {
+	inputs, err := plugin.UnmarshalProperties(req.GetInputs(), plugin.MarshalOptions{
+		KeepUnknowns: true,
+		KeepSecrets:  true,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "unmarshaling inputs")
+	}
+
+	result := Map{}
+	for k, v := range inputs {
+		var deps []Resource
+		if inputDeps, ok := req.GetInputDependencies()[string(k)]; ok {
+			for _, urn := range inputDeps.GetUrns() {
+				deps = append(deps, newDependencyResource(URN(urn)))
+			}
+		}
+
+		value, secret, err := unmarshalPropertyValue(v)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unmarshaling input %s", k)
+		}
+
+		output := newOutput(anyOutputType, deps...)
+		output.getState().resolve(value, !v.ContainsUnknowns(), secret)
+		result[string(k)] = output
+	}
+	return result, nil
+}
+
+// constructOptions converts the options of a Construct request into a resource option that may be passed to the
+// component resource's registration.
+func constructOptions(req *pulumirpc.ConstructRequest) (ResourceOption, error) {
+	var parent Resource
+	if req.GetParent() != "" {
+		parent = newDependencyResource(URN(req.GetParent()))
+	}
+
+	aliases := make([]Alias, len(req.GetAliases()))
+	for i, urn := range req.GetAliases() {
+		aliases[i] = Alias{URN: URN(urn)}
+	}
+
+	dependencies := make([]Resource, len(req.GetDependencies()))
+	for i, urn := range req.GetDependencies() {
+		dependencies[i] = newDependencyResource(URN(urn))
+	}
+
+	providers := make(map[string]ProviderResource)
+	for pkg, ref := range req.GetProviders() {
+		provider, err := newDependencyProviderResource(ref)
+		if err != nil {
+			return nil, err
+		}
+		providers[pkg] = provider
+	}
+
+	return resourceOption(func(ro *resourceOptions) {
+		ro.Parent = parent
+		ro.Aliases = append(ro.Aliases, aliases...)
+		ro.DependsOn = append(ro.DependsOn, dependencies...)
+		ro.Protect = req.GetProtect()
+		if ro.Providers == nil {
+			ro.Providers = make(map[string]ProviderResource)
+		}
+		for pkg, provider := range providers {
+			ro.Providers[pkg] = provider
+		}
+	}), nil
+}
+
+// newDependencyResource returns a resource that stands in for the already-registered resource with the given URN.
+func newDependencyResource(urn URN) Resource {
+	var res ResourceState
+	res.urn = URNOutput{newOutputState(urnType, &res)}
+	res.urn.resolve(urn, true, false)
+	return &res
+}
+
+// newDependencyProviderResource returns a provider resource that stands in for the already-registered provider with
+// the given reference.
+func newDependencyProviderResource(ref string) (ProviderResource, error) {
+	idx := strings.LastIndex(ref, "::")
+	if idx == -1 {
+		return nil, errors.Errorf("expected '::' in provider reference '%s'", ref)
+	}
+	urn, id := URN(ref[:idx]), ID(ref[idx+2:])
+	typ := string(resource.URN(urn).Type())
+	if !strings.HasPrefix(typ, "pulumi:providers:") {
+		return nil, errors.Errorf("provider reference '%s' does not refer to a provider", ref)
+	}
+
+	var res ProviderResourceState
+	res.urn = URNOutput{newOutputState(urnType, &res)}
+	res.id = IDOutput{newOutputState(idType, &res)}
+	res.pkg = typ[len("pulumi:providers:"):]
+	res.urn.resolve(urn, true, false)
+	res.id.resolve(id, id != rpcTokenUnknownValue, false)
+	return &res, nil
+}
@@ -0,0 +1,505 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package provider is a framework for authoring resource provider plugins in Go. A provider's resources are described
+// by Go structs and implemented by typed Go methods, from which the framework derives the provider's checking and
+// diffing behavior and its schema.
+package provider
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/blang/semver"
+	"github.com/pkg/errors"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/workspace"
+)
+
+// Provider is a resource provider whose resources are implemented by Go values. A Provider implements
+// plugin.Provider, and may be served to the engine using Main.
+type Provider struct {
+	name        tokens.Package
+	version     semver.Version
+	description string
+	config      reflect.Value // a pointer to the struct that receives the provider's configuration, if any.
+	resources   map[tokens.Type]*resourceType
+
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+var _ plugin.Provider = (*Provider)(nil)
+
+// NewProvider creates a new provider for the package with the given name and version.
+func NewProvider(name, version string) (*Provider, error) {
+	if !tokens.IsName(name) {
+		return nil, errors.Errorf("%q is not a valid package name", name)
+	}
+	v, err := semver.ParseTolerant(version)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parsing version %q", version)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Provider{
+		name:      tokens.Package(name),
+		version:   v,
+		resources: make(map[tokens.Type]*resourceType),
+		ctx:       ctx,
+		cancel:    cancel,
+	}, nil
+}
+
+// SetDescription sets the description of the provider's package.
+func (p *Provider) SetDescription(description string) {
+	p.description = description
+}
+
+// SetConfig sets the struct that receives the provider's configuration. The struct's fields describe the package's
+// configuration variables in the same way that the fields of a resource's Args struct describe its inputs, and are
+// set when the provider is configured.
+func (p *Provider) SetConfig(config interface{}) error {
+	v := reflect.ValueOf(config)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return errors.New("provider configuration must be a pointer to a struct")
+	}
+	if _, err := newObject(v.Elem().Type()); err != nil {
+		return errors.Wrap(err, "provider configuration")
+	}
+	p.config = v
+	return nil
+}
+
+// RegisterResource registers the implementation of the resource type with the given token, which must belong to the
+// provider's package. The resource's behavior is implemented by the methods of a Go value:
+//
+//	Create(ctx context.Context, name string, inputs Args) (id string, state State, err error)
+//	Read(ctx context.Context, id string, state State) (State, error)
+//	Update(ctx context.Context, id string, olds State, news Args) (State, error)
+//	Delete(ctx context.Context, id string, state State) error
+//
+// Args and State are struct types that describe the resource's input and output properties, respectively, and State
+// typically embeds Args. Only Create is required. A resource without a Read method keeps its recorded state when
+// refreshed, a resource without an Update method is replaced when its inputs change, and a resource without a Delete
+// method is simply forgotten when deleted. Read may return ErrNotFound to indicate that the resource no longer exists.
+// If Create returns an ID along with an error, the resource is recorded as created but not fully initialized. If the
+// implementation has a `Description() string` method, its result is used as the resource's description.
+func (p *Provider) RegisterResource(token tokens.Type, impl interface{}) error {
+	if _, err := tokens.ParseTypeToken(string(token)); err != nil || token.Package() != p.name {
+		return errors.Errorf("%q is not a valid resource token for package %v", token, p.name)
+	}
+	if _, has := p.resources[token]; has {
+		return errors.Errorf("resource %v is already registered", token)
+	}
+
+	res, err := newResourceType(token, impl)
+	if err != nil {
+		return err
+	}
+	p.resources[token] = res
+	return nil
+}
+
+func (p *Provider) getResource(urn resource.URN) (*resourceType, error) {
+	res, ok := p.resources[urn.Type()]
+	if !ok {
+		return nil, errors.Errorf("unknown resource type %v", urn.Type())
+	}
+	return res, nil
+}
+
+// Close closes the provider. It has no effect.
+func (p *Provider) Close() error {
+	return nil
+}
+
+// Pkg returns the provider's package.
+func (p *Provider) Pkg() tokens.Package {
+	return p.name
+}
+
+// GetSchema returns the provider's schema.
+func (p *Provider) GetSchema(version int) ([]byte, error) {
+	if version != 0 {
+		return nil, errors.Errorf("unsupported schema version %d", version)
+	}
+	return p.Schema()
+}
+
+// CheckConfig validates the provider's configuration.
+func (p *Provider) CheckConfig(urn resource.URN, olds, news resource.PropertyMap,
+	allowUnknowns bool) (resource.PropertyMap, []plugin.CheckFailure, error) {
+
+	if !p.config.IsValid() {
+		return news, nil, nil
+	}
+	obj, err := newObject(p.config.Elem().Type())
+	if err != nil {
+		return nil, nil, err
+	}
+	return checkObject(obj, news, true)
+}
+
+// DiffConfig diffs the provider's configuration. Changes to configuration never require replacement of the provider
+// unless the changed variable is marked with "replaceOnChanges".
+func (p *Provider) DiffConfig(urn resource.URN, olds, news resource.PropertyMap, allowUnknowns bool,
+	ignoreChanges []string) (plugin.DiffResult, error) {
+
+	if !p.config.IsValid() {
+		return plugin.DiffResult{}, nil
+	}
+	obj, err := newObject(p.config.Elem().Type())
+	if err != nil {
+		return plugin.DiffResult{}, err
+	}
+	return diffObject(obj, olds, news, true, ignoreChanges), nil
+}
+
+// Configure decodes the provider's configuration into the struct passed to SetConfig, if any.
+func (p *Provider) Configure(inputs resource.PropertyMap) error {
+	if !p.config.IsValid() {
+		return nil
+	}
+	obj, err := newObject(p.config.Elem().Type())
+	if err != nil {
+		return err
+	}
+	return obj.decode(inputs, p.config.Elem())
+}
+
+// Check validates a resource's inputs against the fields of its Args struct. Secret fields are marked as such.
+func (p *Provider) Check(urn resource.URN, olds, news resource.PropertyMap,
+	allowUnknowns bool) (resource.PropertyMap, []plugin.CheckFailure, error) {
+
+	res, err := p.getResource(urn)
+	if err != nil {
+		return nil, nil, err
+	}
+	return checkObject(res.inputs, news, false)
+}
+
+// Diff compares a resource's old state with its new inputs. Changes to fields marked with "replaceOnChanges" require
+// replacement, as do all changes to resources that do not have an Update method.
+func (p *Provider) Diff(urn resource.URN, id resource.ID, olds resource.PropertyMap, news resource.PropertyMap,
+	allowUnknowns bool, ignoreChanges []string) (plugin.DiffResult, error) {
+
+	res, err := p.getResource(urn)
+	if err != nil {
+		return plugin.DiffResult{}, err
+	}
+	return diffObject(res.inputs, olds, news, res.update.IsValid(), ignoreChanges), nil
+}
+
+// Create creates a new resource by calling its Create method.
+func (p *Provider) Create(urn resource.URN, news resource.PropertyMap, timeout float64) (resource.ID,
+	resource.PropertyMap, resource.Status, error) {
+
+	res, err := p.getResource(urn)
+	if err != nil {
+		return "", nil, resource.StatusOK, err
+	}
+	inputs, err := res.decodeInputs(news)
+	if err != nil {
+		return "", nil, resource.StatusOK, err
+	}
+
+	ctx, cancel := p.timeoutContext(timeout)
+	defer cancel()
+
+	results, createErr := call(res.create, reflect.ValueOf(ctx), reflect.ValueOf(string(urn.Name())), inputs)
+	id := resource.ID(results[0].String())
+	if id == "" {
+		if createErr == nil {
+			createErr = errors.Errorf("%v.Create did not return an ID", res.token)
+		}
+		return "", nil, resource.StatusOK, createErr
+	}
+
+	state, err := res.encodeState(results[1], news)
+	if err != nil {
+		return "", nil, resource.StatusUnknown, err
+	}
+	if createErr != nil {
+		// The resource was created, but did not initialize successfully.
+		return id, state, resource.StatusPartialFailure, &plugin.InitError{Reasons: []string{createErr.Error()}}
+	}
+	return id, state, resource.StatusOK, nil
+}
+
+// Read reads the current state of a resource by calling its Read method. If the resource has no Read method, its
+// recorded state is returned unchanged.
+func (p *Provider) Read(urn resource.URN, id resource.ID,
+	inputs, state resource.PropertyMap) (plugin.ReadResult, resource.Status, error) {
+
+	res, err := p.getResource(urn)
+	if err != nil {
+		return plugin.ReadResult{}, resource.StatusUnknown, err
+	}
+	if !res.read.IsValid() {
+		if inputs == nil {
+			inputs = res.stateInputs(state)
+		}
+		return plugin.ReadResult{ID: id, Inputs: inputs, Outputs: state}, resource.StatusOK, nil
+	}
+
+	old, err := res.decodeState(state)
+	if err != nil {
+		return plugin.ReadResult{}, resource.StatusUnknown, err
+	}
+	results, err := call(res.read, reflect.ValueOf(p.ctx), reflect.ValueOf(string(id)), old)
+	if err == ErrNotFound {
+		return plugin.ReadResult{}, resource.StatusOK, nil
+	} else if err != nil {
+		return plugin.ReadResult{}, resource.StatusUnknown, err
+	}
+
+	newState, err := res.encodeState(results[0], state)
+	if err != nil {
+		return plugin.ReadResult{}, resource.StatusUnknown, err
+	}
+	return plugin.ReadResult{ID: id, Inputs: res.stateInputs(newState), Outputs: newState}, resource.StatusOK, nil
+}
+
+// Update updates a resource by calling its Update method.
+func (p *Provider) Update(urn resource.URN, id resource.ID, olds resource.PropertyMap, news resource.PropertyMap,
+	timeout float64, ignoreChanges []string) (resource.PropertyMap, resource.Status, error) {
+
+	res, err := p.getResource(urn)
+	if err != nil {
+		return nil, resource.StatusOK, err
+	}
+	if !res.update.IsValid() {
+		return nil, resource.StatusOK, errors.Errorf("resource %v does not support updates", res.token)
+	}
+	old, err := res.decodeState(olds)
+	if err != nil {
+		return nil, resource.StatusOK, err
+	}
+	inputs, err := res.decodeInputs(news)
+	if err != nil {
+		return nil, resource.StatusOK, err
+	}
+
+	ctx, cancel := p.timeoutContext(timeout)
+	defer cancel()
+
+	results, err := call(res.update, reflect.ValueOf(ctx), reflect.ValueOf(string(id)), old, inputs)
+	if err != nil {
+		return nil, resource.StatusUnknown, err
+	}
+	state, err := res.encodeState(results[0], olds, news)
+	if err != nil {
+		return nil, resource.StatusUnknown, err
+	}
+	return state, resource.StatusOK, nil
+}
+
+// Delete deletes a resource by calling its Delete method, if any.
+func (p *Provider) Delete(urn resource.URN, id resource.ID, props resource.PropertyMap,
+	timeout float64) (resource.Status, error) {
+
+	res, err := p.getResource(urn)
+	if err != nil {
+		return resource.StatusOK, err
+	}
+	if !res.delete.IsValid() {
+		return resource.StatusOK, nil
+	}
+	state, err := res.decodeState(props)
+	if err != nil {
+		return resource.StatusOK, err
+	}
+
+	ctx, cancel := p.timeoutContext(timeout)
+	defer cancel()
+
+	if _, err = call(res.delete, reflect.ValueOf(ctx), reflect.ValueOf(string(id)), state); err != nil {
+		return resource.StatusUnknown, err
+	}
+	return resource.StatusOK, nil
+}
+
+// Invoke is not supported.
+func (p *Provider) Invoke(tok tokens.ModuleMember,
+	args resource.PropertyMap) (resource.PropertyMap, []plugin.CheckFailure, error) {
+
+	return nil, nil, errors.Errorf("unknown function %v", tok)
+}
+
+// StreamInvoke is not supported.
+func (p *Provider) StreamInvoke(tok tokens.ModuleMember, args resource.PropertyMap,
+	onNext func(resource.PropertyMap) error) ([]plugin.CheckFailure, error) {
+
+	return nil, errors.Errorf("unknown function %v", tok)
+}
+
+// Construct is not supported.
+func (p *Provider) Construct(info plugin.ConstructInfo, typ tokens.Type, name tokens.QName, parent resource.URN,
+	inputs resource.PropertyMap, options plugin.ConstructOptions) (plugin.ConstructResult, error) {
+
+	return plugin.ConstructResult{}, errors.Errorf("unknown component resource type %v", typ)
+}
+
+// Call is not supported.
+func (p *Provider) Call(tok tokens.ModuleMember, urn resource.URN, state resource.PropertyMap,
+	args resource.PropertyMap, info plugin.CallInfo, options plugin.CallOptions) (plugin.CallResult, error) {
+
+	return plugin.CallResult{}, errors.Errorf("unknown method %v", tok)
+}
+
+// GetLogs is not supported.
+func (p *Provider) GetLogs(urn resource.URN, id resource.ID, state resource.PropertyMap, startTime,
+	endTime *time.Time, onNext func(plugin.LogEntry) error) error {
+
+	return plugin.ErrLogsNotSupported
+}
+
+// GetPluginInfo returns the provider's name and version.
+func (p *Provider) GetPluginInfo() (workspace.PluginInfo, error) {
+	version := p.version
+	return workspace.PluginInfo{
+		Name:    string(p.name),
+		Kind:    workspace.ResourcePlugin,
+		Version: &version,
+	}, nil
+}
+
+// SignalCancellation cancels the context passed to any resource methods that are in progress or are called later.
+func (p *Provider) SignalCancellation() error {
+	p.cancel()
+	return nil
+}
+
+// timeoutContext returns a context for a resource operation with the given timeout in seconds, if any.
+func (p *Provider) timeoutContext(timeout float64) (context.Context, context.CancelFunc) {
+	if timeout == 0 {
+		return context.WithCancel(p.ctx)
+	}
+	return context.WithTimeout(p.ctx, time.Duration(timeout*float64(time.Second)))
+}
+
+// checkObject validates a property map against the fields of the given object. Unknown values are allowed, but any
+// known values must be assignable to their fields. Secret fields are marked as such in the returned properties.
+func checkObject(obj *object, news resource.PropertyMap,
+	allowExtra bool) (resource.PropertyMap, []plugin.CheckFailure, error) {
+
+	var failures []plugin.CheckFailure
+	checked := news.Copy()
+	for _, p := range obj.properties {
+		v, ok := news[p.key]
+		if !ok || v.IsNull() {
+			if !p.optional {
+				failures = append(failures, plugin.CheckFailure{
+					Property: p.key,
+					Reason:   "missing required property '" + string(p.key) + "'",
+				})
+			}
+			continue
+		}
+
+		if err := decodeValue(v, reflect.New(p.typ).Elem()); err != nil {
+			failures = append(failures, plugin.CheckFailure{
+				Property: p.key,
+				Reason:   "property '" + string(p.key) + "': " + err.Error(),
+			})
+			continue
+		}
+		if p.secret && !v.IsSecret() {
+			checked[p.key] = resource.MakeSecret(v)
+		}
+	}
+
+	if !allowExtra {
+		for _, k := range news.StableKeys() {
+			if _, has := obj.property(k); !has && !resource.IsInternalPropertyKey(k) {
+				failures = append(failures, plugin.CheckFailure{
+					Property: k,
+					Reason:   "unknown property '" + string(k) + "'",
+				})
+			}
+		}
+	}
+
+	return checked, failures, nil
+}
+
+// diffObject compares the properties of the given object in a resource's old state and its new inputs. If the
+// resource cannot be updated in place, all changes require replacement.
+func diffObject(obj *object, olds, news resource.PropertyMap, canUpdate bool,
+	ignoreChanges []string) plugin.DiffResult {
+
+	ignore := make(map[resource.PropertyKey]bool)
+	for _, k := range ignoreChanges {
+		ignore[resource.PropertyKey(k)] = true
+	}
+
+	unwrap := func(v resource.PropertyValue) resource.PropertyValue {
+		for v.IsSecret() {
+			v = v.SecretValue().Element
+		}
+		return v
+	}
+	present := func(v resource.PropertyValue) bool {
+		return v.HasValue() || v.ContainsUnknowns()
+	}
+
+	result := plugin.DiffResult{Changes: plugin.DiffNone, DetailedDiff: map[string]plugin.PropertyDiff{}}
+	for _, p := range obj.properties {
+		if ignore[p.key] {
+			continue
+		}
+
+		old, new := unwrap(olds[p.key]), unwrap(news[p.key])
+		replace := p.replaceOnChanges || !canUpdate
+		var kind plugin.DiffKind
+		switch {
+		case !present(old) && !present(new):
+			continue
+		case !present(old):
+			kind = plugin.DiffAdd
+			if replace {
+				kind = plugin.DiffAddReplace
+			}
+		case !present(new):
+			kind = plugin.DiffDelete
+			if replace {
+				kind = plugin.DiffDeleteReplace
+			}
+		case !new.ContainsUnknowns() && old.DeepEquals(new):
+			continue
+		default:
+			kind = plugin.DiffUpdate
+			if replace {
+				kind = plugin.DiffUpdateReplace
+			}
+		}
+
+		if replace {
+			result.ReplaceKeys = append(result.ReplaceKeys, p.key)
+		}
+		result.Changes = plugin.DiffSome
+		result.ChangedKeys = append(result.ChangedKeys, p.key)
+		result.DetailedDiff[string(p.key)] = plugin.PropertyDiff{Kind: kind}
+	}
+
+	sort.Slice(result.ChangedKeys, func(i, j int) bool { return result.ChangedKeys[i] < result.ChangedKeys[j] })
+	sort.Slice(result.ReplaceKeys, func(i, j int) bool { return result.ReplaceKeys[i] < result.ReplaceKeys[j] })
+	return result
+}
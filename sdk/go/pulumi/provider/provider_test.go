@@ -0,0 +1,385 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/tokens"
+)
+
+type testConfig struct {
+	Region string `pulumi:"region" description:"The region in which to create buckets."`
+}
+
+type corsRule struct {
+	Origins []string `pulumi:"origins"`
+	MaxAge  *int     `pulumi:"maxAge"`
+}
+
+type bucketArgs struct {
+	Name     string            `pulumi:"name,replaceOnChanges"`
+	Tags     map[string]string `pulumi:"tags,optional"`
+	Cors     []corsRule        `pulumi:"cors,optional"`
+	Password string            `pulumi:"password,optional,secret"`
+}
+
+type bucketState struct {
+	bucketArgs
+
+	Region string `pulumi:"region"`
+	Size   int    `pulumi:"size"`
+}
+
+type bucket struct {
+	config  *testConfig
+	buckets map[string]bucketState
+}
+
+func (b *bucket) Description() string {
+	return "A bucket."
+}
+
+func (b *bucket) Create(ctx context.Context, name string, inputs bucketArgs) (string, bucketState, error) {
+	state := bucketState{bucketArgs: inputs, Region: b.config.Region}
+	b.buckets[inputs.Name] = state
+	return inputs.Name, state, nil
+}
+
+func (b *bucket) Read(ctx context.Context, id string, state bucketState) (bucketState, error) {
+	current, ok := b.buckets[id]
+	if !ok {
+		return bucketState{}, ErrNotFound
+	}
+	return current, nil
+}
+
+func (b *bucket) Update(ctx context.Context, id string, olds bucketState, news bucketArgs) (bucketState, error) {
+	state := bucketState{bucketArgs: news, Region: olds.Region, Size: olds.Size}
+	b.buckets[id] = state
+	return state, nil
+}
+
+func (b *bucket) Delete(ctx context.Context, id string, state bucketState) error {
+	delete(b.buckets, id)
+	return nil
+}
+
+type objectArgs struct {
+	Bucket string `pulumi:"bucket"`
+}
+
+type objectState struct {
+	objectArgs
+}
+
+type storageObject struct{}
+
+func (storageObject) Create(ctx context.Context, name string, inputs objectArgs) (string, objectState, error) {
+	return "", objectState{}, errors.New("failed")
+}
+
+func newTestProvider(t *testing.T) (*Provider, *bucket) {
+	p, err := NewProvider("test", "1.0.0")
+	assert.NoError(t, err)
+
+	config := &testConfig{}
+	assert.NoError(t, p.SetConfig(config))
+
+	b := &bucket{config: config, buckets: map[string]bucketState{}}
+	assert.NoError(t, p.RegisterResource("test:index:Bucket", b))
+	assert.NoError(t, p.RegisterResource("test:storage:Object", storageObject{}))
+	return p, b
+}
+
+func testURN(typ tokens.Type, name string) resource.URN {
+	return resource.NewURN("stack", "project", "", typ, tokens.QName(name))
+}
+
+func TestRegisterResource(t *testing.T) {
+	p, err := NewProvider("test", "1.0.0")
+	assert.NoError(t, err)
+
+	// Tokens must belong to the provider's package.
+	assert.Error(t, p.RegisterResource("other:index:Bucket", &bucket{}))
+	assert.Error(t, p.RegisterResource("Bucket", &bucket{}))
+
+	// Create is required.
+	assert.Error(t, p.RegisterResource("test:index:Missing", struct{}{}))
+
+	assert.NoError(t, p.RegisterResource("test:index:Bucket", &bucket{}))
+	assert.Error(t, p.RegisterResource("test:index:Bucket", &bucket{}))
+}
+
+type badReadArgs struct {
+	Name string `pulumi:"name"`
+}
+
+type badRead struct{}
+
+func (badRead) Create(ctx context.Context, name string, inputs badReadArgs) (string, badReadArgs, error) {
+	return name, inputs, nil
+}
+
+func (badRead) Read(ctx context.Context, id string, state bucketState) (bucketState, error) {
+	return state, nil
+}
+
+func TestRegisterResourceSignatures(t *testing.T) {
+	p, err := NewProvider("test", "1.0.0")
+	assert.NoError(t, err)
+
+	// Read must accept and return the state type returned by Create.
+	assert.Error(t, p.RegisterResource("test:index:BadRead", badRead{}))
+}
+
+func TestCheck(t *testing.T) {
+	p, _ := newTestProvider(t)
+	urn := testURN("test:index:Bucket", "b")
+
+	// Missing required properties, mistyped properties, and unknown properties are all reported.
+	_, failures, err := p.Check(urn, nil, resource.PropertyMap{
+		"tags":  resource.NewStringProperty("oops"),
+		"color": resource.NewStringProperty("red"),
+	}, true)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []resource.PropertyKey{"name", "tags", "color"}, failureKeys(failures))
+
+	// Unknown values are accepted, and secret properties are marked as such.
+	unknown := resource.MakeComputed(resource.NewStringProperty(""))
+	news := resource.PropertyMap{
+		"name":     unknown,
+		"cors":     resource.NewArrayProperty([]resource.PropertyValue{unknown}),
+		"password": resource.NewStringProperty("hunter2"),
+	}
+	checked, failures, err := p.Check(urn, nil, news, true)
+	assert.NoError(t, err)
+	assert.Empty(t, failures)
+	assert.True(t, checked["password"].IsSecret())
+	assert.True(t, checked["name"].IsComputed())
+}
+
+func failureKeys(failures []plugin.CheckFailure) []resource.PropertyKey {
+	var keys []resource.PropertyKey
+	for _, f := range failures {
+		keys = append(keys, f.Property)
+	}
+	return keys
+}
+
+func TestDiff(t *testing.T) {
+	p, _ := newTestProvider(t)
+	urn := testURN("test:index:Bucket", "b")
+
+	olds := resource.PropertyMap{
+		"name":     resource.NewStringProperty("b"),
+		"tags":     resource.NewObjectProperty(resource.PropertyMap{"a": resource.NewStringProperty("b")}),
+		"password": resource.MakeSecret(resource.NewStringProperty("hunter2")),
+		"region":   resource.NewStringProperty("us-west-2"),
+		"size":     resource.NewNumberProperty(42),
+	}
+
+	// Output-only properties and secretness are ignored.
+	diff, err := p.Diff(urn, "b", olds, resource.PropertyMap{
+		"name":     resource.NewStringProperty("b"),
+		"tags":     resource.NewObjectProperty(resource.PropertyMap{"a": resource.NewStringProperty("b")}),
+		"password": resource.NewStringProperty("hunter2"),
+	}, true, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, plugin.DiffNone, diff.Changes)
+
+	// Changes to ordinary properties are updates, and unknowns are changes.
+	diff, err = p.Diff(urn, "b", olds, resource.PropertyMap{
+		"name": resource.NewStringProperty("b"),
+		"tags": resource.MakeComputed(resource.NewStringProperty("")),
+		"cors": resource.NewArrayProperty(nil),
+	}, true, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, plugin.DiffSome, diff.Changes)
+	assert.Empty(t, diff.ReplaceKeys)
+	assert.Equal(t, map[string]plugin.PropertyDiff{
+		"tags":     {Kind: plugin.DiffUpdate},
+		"cors":     {Kind: plugin.DiffAdd},
+		"password": {Kind: plugin.DiffDelete},
+	}, diff.DetailedDiff)
+
+	// Changes to properties marked "replaceOnChanges" are replacements, unless they are ignored.
+	diff, err = p.Diff(urn, "b", olds, resource.PropertyMap{
+		"name": resource.NewStringProperty("c"),
+		"tags": olds["tags"],
+	}, true, []string{"password"})
+	assert.NoError(t, err)
+	assert.Equal(t, []resource.PropertyKey{"name"}, diff.ReplaceKeys)
+	assert.Equal(t, map[string]plugin.PropertyDiff{"name": {Kind: plugin.DiffUpdateReplace}}, diff.DetailedDiff)
+
+	// All changes to resources without an Update method are replacements.
+	urn = testURN("test:storage:Object", "o")
+	diff, err = p.Diff(urn, "o", resource.PropertyMap{"bucket": resource.NewStringProperty("a")},
+		resource.PropertyMap{"bucket": resource.NewStringProperty("b")}, true, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []resource.PropertyKey{"bucket"}, diff.ReplaceKeys)
+}
+
+func TestLifecycle(t *testing.T) {
+	p, b := newTestProvider(t)
+	urn := testURN("test:index:Bucket", "b")
+
+	assert.NoError(t, p.Configure(resource.PropertyMap{"region": resource.NewStringProperty("us-west-2")}))
+
+	// Create decodes the inputs and encodes the state, keeping secret inputs secret.
+	id, state, status, err := p.Create(urn, resource.PropertyMap{
+		"name": resource.NewStringProperty("b"),
+		"tags": resource.MakeSecret(resource.NewObjectProperty(resource.PropertyMap{
+			"a": resource.NewStringProperty("b"),
+		})),
+		"cors": resource.NewArrayProperty([]resource.PropertyValue{
+			resource.NewObjectProperty(resource.PropertyMap{
+				"origins": resource.NewArrayProperty([]resource.PropertyValue{resource.NewStringProperty("*")}),
+			}),
+		}),
+	}, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, resource.StatusOK, status)
+	assert.Equal(t, resource.ID("b"), id)
+	assert.Equal(t, resource.PropertyMap{
+		"name": resource.NewStringProperty("b"),
+		"tags": resource.MakeSecret(resource.NewObjectProperty(resource.PropertyMap{
+			"a": resource.NewStringProperty("b"),
+		})),
+		"cors": resource.NewArrayProperty([]resource.PropertyValue{
+			resource.NewObjectProperty(resource.PropertyMap{
+				"origins": resource.NewArrayProperty([]resource.PropertyValue{resource.NewStringProperty("*")}),
+			}),
+		}),
+		"password": resource.MakeSecret(resource.NewStringProperty("")),
+		"region":   resource.NewStringProperty("us-west-2"),
+		"size":     resource.NewNumberProperty(0),
+	}, state)
+
+	// Update passes the old state and new inputs.
+	b.buckets["b"] = bucketState{bucketArgs: b.buckets["b"].bucketArgs, Region: "us-west-2", Size: 42}
+	state, status, err = p.Update(urn, id, state, resource.PropertyMap{
+		"name":     resource.NewStringProperty("b"),
+		"password": resource.NewStringProperty("hunter2"),
+	}, 0, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, resource.StatusOK, status)
+	assert.Equal(t, resource.MakeSecret(resource.NewStringProperty("hunter2")), state["password"])
+	assert.Equal(t, resource.NewStringProperty("us-west-2"), state["region"])
+
+	// Read returns the current state, and its inputs.
+	b.buckets["b"] = bucketState{bucketArgs: bucketArgs{Name: "b"}, Region: "us-west-2", Size: 42}
+	read, status, err := p.Read(urn, id, nil, state)
+	assert.NoError(t, err)
+	assert.Equal(t, resource.StatusOK, status)
+	assert.Equal(t, resource.NewNumberProperty(42), read.Outputs["size"])
+	assert.Equal(t, resource.PropertyMap{
+		"name":     resource.NewStringProperty("b"),
+		"password": resource.MakeSecret(resource.NewStringProperty("")),
+	}, read.Inputs)
+
+	// Delete removes the resource, after which Read reports that it no longer exists.
+	status, err = p.Delete(urn, id, state, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, resource.StatusOK, status)
+	read, _, err = p.Read(urn, id, nil, state)
+	assert.NoError(t, err)
+	assert.Nil(t, read.Outputs)
+
+	// A resource whose Create method fails without returning an ID reports the error.
+	_, _, _, err = p.Create(testURN("test:storage:Object", "o"),
+		resource.PropertyMap{"bucket": resource.NewStringProperty("b")}, 0)
+	assert.EqualError(t, err, "failed")
+}
+
+func TestSchema(t *testing.T) {
+	p, _ := newTestProvider(t)
+	p.SetDescription("A test package.")
+
+	bytes, err := p.GetSchema(0)
+	assert.NoError(t, err)
+
+	var spec map[string]interface{}
+	assert.NoError(t, json.Unmarshal(bytes, &spec))
+
+	expected := `{
+		"name": "test",
+		"version": "1.0.0",
+		"description": "A test package.",
+		"config": {
+			"variables": {
+				"region": {"type": "string", "description": "The region in which to create buckets."}
+			},
+			"defaults": ["region"]
+		},
+		"provider": {
+			"inputProperties": {
+				"region": {"type": "string", "description": "The region in which to create buckets."}
+			},
+			"requiredInputs": ["region"]
+		},
+		"types": {
+			"test:index:corsRule": {
+				"type": "object",
+				"properties": {
+					"origins": {"type": "array", "items": {"type": "string"}},
+					"maxAge": {"type": "integer"}
+				},
+				"required": ["origins"]
+			}
+		},
+		"resources": {
+			"test:index:Bucket": {
+				"description": "A bucket.",
+				"properties": {
+					"name": {"type": "string"},
+					"tags": {"type": "object", "additionalProperties": {"type": "string"}},
+					"cors": {"type": "array", "items": {"$ref": "#/types/test:index:corsRule"}},
+					"password": {"type": "string"},
+					"region": {"type": "string"},
+					"size": {"type": "integer"}
+				},
+				"required": ["name", "region", "size"],
+				"inputProperties": {
+					"name": {"type": "string"},
+					"tags": {"type": "object", "additionalProperties": {"type": "string"}},
+					"cors": {"type": "array", "items": {"$ref": "#/types/test:index:corsRule"}},
+					"password": {"type": "string"}
+				},
+				"requiredInputs": ["name"]
+			},
+			"test:storage:Object": {
+				"properties": {
+					"bucket": {"type": "string"}
+				},
+				"required": ["bucket"],
+				"inputProperties": {
+					"bucket": {"type": "string"}
+				},
+				"requiredInputs": ["bucket"]
+			}
+		}
+	}`
+	var expectedSpec map[string]interface{}
+	assert.NoError(t, json.Unmarshal([]byte(expected), &expectedSpec))
+	assert.Equal(t, expectedSpec, spec)
+}
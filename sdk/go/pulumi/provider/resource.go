@@ -0,0 +1,188 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/tokens"
+)
+
+// ErrNotFound may be returned by a resource's Read method to indicate that the resource no longer exists.
+var ErrNotFound = errors.New("resource not found")
+
+var (
+	contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+	errorType   = reflect.TypeOf((*error)(nil)).Elem()
+	stringType  = reflect.TypeOf("")
+)
+
+// resourceType describes a resource type that has been registered with a provider, along with the methods that
+// implement it.
+type resourceType struct {
+	token       tokens.Type
+	description string
+	inputs      *object
+	state       *object
+
+	create reflect.Value
+	read   reflect.Value
+	update reflect.Value
+	delete reflect.Value
+}
+
+func newResourceType(token tokens.Type, impl interface{}) (*resourceType, error) {
+	v := reflect.ValueOf(impl)
+	if !v.IsValid() {
+		return nil, errors.Errorf("resource %v has no implementation", token)
+	}
+
+	res := &resourceType{
+		token:  token,
+		create: v.MethodByName("Create"),
+		read:   v.MethodByName("Read"),
+		update: v.MethodByName("Update"),
+		delete: v.MethodByName("Delete"),
+	}
+	if d, ok := impl.(interface{ Description() string }); ok {
+		res.description = d.Description()
+	}
+
+	// Create determines the resource's input and state types.
+	if !res.create.IsValid() {
+		return nil, errors.Errorf("resource %v must have a Create method", token)
+	}
+	create := res.create.Type()
+	if err := checkSignature(token, "Create", create, 3, 3); err != nil {
+		return nil, err
+	}
+	if create.In(1) != stringType || create.Out(0) != stringType {
+		return nil, errors.Errorf("%v.Create must accept a name and return an ID", token)
+	}
+
+	var err error
+	if res.inputs, err = newObject(create.In(2)); err != nil {
+		return nil, errors.Wrapf(err, "%v inputs", token)
+	}
+	if res.state, err = newObject(create.Out(1)); err != nil {
+		return nil, errors.Wrapf(err, "%v state", token)
+	}
+
+	// The remaining methods must agree with Create.
+	if res.read.IsValid() {
+		read := res.read.Type()
+		if err := checkSignature(token, "Read", read, 3, 2); err != nil {
+			return nil, err
+		}
+		if read.In(1) != stringType || read.In(2) != res.state.typ || read.Out(0) != res.state.typ {
+			return nil, errors.Errorf("%v.Read must accept an ID and a %v, and return a %v",
+				token, res.state.typ, res.state.typ)
+		}
+	}
+	if res.update.IsValid() {
+		update := res.update.Type()
+		if err := checkSignature(token, "Update", update, 4, 2); err != nil {
+			return nil, err
+		}
+		if update.In(1) != stringType || update.In(2) != res.state.typ || update.In(3) != res.inputs.typ ||
+			update.Out(0) != res.state.typ {
+			return nil, errors.Errorf("%v.Update must accept an ID, a %v, and a %v, and return a %v",
+				token, res.state.typ, res.inputs.typ, res.state.typ)
+		}
+	}
+	if res.delete.IsValid() {
+		del := res.delete.Type()
+		if err := checkSignature(token, "Delete", del, 3, 1); err != nil {
+			return nil, err
+		}
+		if del.In(1) != stringType || del.In(2) != res.state.typ {
+			return nil, errors.Errorf("%v.Delete must accept an ID and a %v", token, res.state.typ)
+		}
+	}
+
+	return res, nil
+}
+
+// checkSignature checks that a resource method accepts a context and the given number of arguments in total, and
+// returns an error and the given number of results in total.
+func checkSignature(token tokens.Type, name string, method reflect.Type, in, out int) error {
+	if method.NumIn() != in || method.In(0) != contextType {
+		return errors.Errorf("%v.%v must accept a context.Context and %d other arguments", token, name, in-1)
+	}
+	if method.NumOut() != out || method.Out(out-1) != errorType {
+		return errors.Errorf("%v.%v must return %d results, the last of which is an error", token, name, out)
+	}
+	return nil
+}
+
+// call calls a resource method, and splits its results into its values and its error.
+func call(method reflect.Value, args ...reflect.Value) ([]reflect.Value, error) {
+	results := method.Call(args)
+	last := len(results) - 1
+	if err := results[last].Interface(); err != nil {
+		return results[:last], err.(error)
+	}
+	return results[:last], nil
+}
+
+// decodeInputs decodes the given inputs into a new value of the resource's input type.
+func (res *resourceType) decodeInputs(inputs resource.PropertyMap) (reflect.Value, error) {
+	v := reflect.New(res.inputs.typ).Elem()
+	if err := res.inputs.decode(inputs, v); err != nil {
+		return reflect.Value{}, err
+	}
+	return v, nil
+}
+
+// decodeState decodes the given state into a new value of the resource's state type.
+func (res *resourceType) decodeState(state resource.PropertyMap) (reflect.Value, error) {
+	v := reflect.New(res.state.typ).Elem()
+	if err := res.state.decode(state, v); err != nil {
+		return reflect.Value{}, err
+	}
+	return v, nil
+}
+
+// encodeState encodes the given value of the resource's state type. Any property that is secret in one of the given
+// property maps is also marked secret in the result.
+func (res *resourceType) encodeState(v reflect.Value, secrets ...resource.PropertyMap) (resource.PropertyMap, error) {
+	state, err := res.state.encode(v)
+	if err != nil {
+		return nil, err
+	}
+	for _, props := range secrets {
+		for k, pv := range props {
+			if out, ok := state[k]; ok && pv.IsSecret() && !out.IsSecret() {
+				state[k] = resource.MakeSecret(out)
+			}
+		}
+	}
+	return state, nil
+}
+
+// stateInputs returns the input properties that are present in the given state.
+func (res *resourceType) stateInputs(state resource.PropertyMap) resource.PropertyMap {
+	inputs := resource.PropertyMap{}
+	for _, p := range res.inputs.properties {
+		if v, ok := state[p.key]; ok {
+			inputs[p.key] = v
+		}
+	}
+	return inputs
+}
@@ -0,0 +1,239 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/tokens"
+)
+
+// The types below are the subset of the serializable schema types defined by pkg/codegen/schema that are needed to
+// describe a provider's package. Their JSON encoding is that of a schema.PackageSpec.
+
+type typeSpec struct {
+	Type                 string    `json:"type,omitempty"`
+	Ref                  string    `json:"$ref,omitempty"`
+	AdditionalProperties *typeSpec `json:"additionalProperties,omitempty"`
+	Items                *typeSpec `json:"items,omitempty"`
+}
+
+type propertySpec struct {
+	typeSpec
+
+	Description string `json:"description,omitempty"`
+}
+
+type objectTypeSpec struct {
+	Description string                  `json:"description,omitempty"`
+	Properties  map[string]propertySpec `json:"properties,omitempty"`
+	Type        string                  `json:"type,omitempty"`
+	Required    []string                `json:"required,omitempty"`
+}
+
+type resourceSpec struct {
+	objectTypeSpec
+
+	InputProperties map[string]propertySpec `json:"inputProperties,omitempty"`
+	RequiredInputs  []string                `json:"requiredInputs,omitempty"`
+}
+
+type configSpec struct {
+	Variables map[string]propertySpec `json:"variables,omitempty"`
+	Required  []string                `json:"defaults,omitempty"`
+}
+
+type packageSpec struct {
+	Name        string                    `json:"name"`
+	Version     string                    `json:"version,omitempty"`
+	Description string                    `json:"description,omitempty"`
+	Config      configSpec                `json:"config"`
+	Types       map[string]objectTypeSpec `json:"types,omitempty"`
+	Provider    resourceSpec              `json:"provider"`
+	Resources   map[string]resourceSpec   `json:"resources,omitempty"`
+}
+
+// Schema returns the provider's schema, serialized as a JSON schema.PackageSpec. The schema describes the provider's
+// configuration and each of its resources. Struct types used by the properties of a resource are described as object
+// types in the resource's module, named after the Go type.
+func (p *Provider) Schema() ([]byte, error) {
+	g := &schemaGenerator{pkg: p.name, types: map[string]objectTypeSpec{}, tokens: map[reflect.Type]string{}}
+
+	spec := packageSpec{
+		Name:        string(p.name),
+		Version:     p.version.String(),
+		Description: p.description,
+		Resources:   map[string]resourceSpec{},
+	}
+
+	if p.config.IsValid() {
+		obj, err := newObject(p.config.Elem().Type())
+		if err != nil {
+			return nil, err
+		}
+		props, required, err := g.properties(obj, "index")
+		if err != nil {
+			return nil, errors.Wrap(err, "provider configuration")
+		}
+		spec.Config = configSpec{Variables: props, Required: required}
+		spec.Provider = resourceSpec{InputProperties: props, RequiredInputs: required}
+	}
+
+	// Generate the resources in a stable order so that object types are always assigned to the same module.
+	resourceTokens := make([]string, 0, len(p.resources))
+	for token := range p.resources {
+		resourceTokens = append(resourceTokens, string(token))
+	}
+	sort.Strings(resourceTokens)
+
+	for _, t := range resourceTokens {
+		token, res := tokens.Type(t), p.resources[tokens.Type(t)]
+		module := string(token.Module().Name())
+
+		inputs, requiredInputs, err := g.properties(res.inputs, module)
+		if err != nil {
+			return nil, errors.Wrapf(err, "%v inputs", token)
+		}
+		outputs, required, err := g.properties(res.state, module)
+		if err != nil {
+			return nil, errors.Wrapf(err, "%v state", token)
+		}
+		spec.Resources[string(token)] = resourceSpec{
+			objectTypeSpec: objectTypeSpec{
+				Description: res.description,
+				Properties:  outputs,
+				Required:    required,
+			},
+			InputProperties: inputs,
+			RequiredInputs:  requiredInputs,
+		}
+	}
+
+	if len(g.types) != 0 {
+		spec.Types = g.types
+	}
+	return json.Marshal(spec)
+}
+
+// schemaGenerator accumulates the object types referenced by a provider's resources.
+type schemaGenerator struct {
+	pkg    tokens.Package
+	types  map[string]objectTypeSpec
+	tokens map[reflect.Type]string
+}
+
+// properties returns the property specs for the given object, along with the sorted names of its required properties.
+func (g *schemaGenerator) properties(obj *object, module string) (map[string]propertySpec, []string, error) {
+	props := map[string]propertySpec{}
+	var required []string
+	for _, p := range obj.properties {
+		typ, err := g.typeSpec(p.typ, module)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "property %v", p.key)
+		}
+		props[string(p.key)] = propertySpec{typeSpec: typ, Description: p.description}
+		if !p.optional {
+			required = append(required, string(p.key))
+		}
+	}
+	sort.Strings(required)
+	return props, required, nil
+}
+
+// typeSpec returns the type spec for the given Go type. Struct types are added to the package's object types.
+func (g *schemaGenerator) typeSpec(typ reflect.Type, module string) (typeSpec, error) {
+	switch typ {
+	case assetType:
+		return typeSpec{Ref: "pulumi.json#/Asset"}, nil
+	case archiveType:
+		return typeSpec{Ref: "pulumi.json#/Archive"}, nil
+	}
+
+	switch typ.Kind() {
+	case reflect.Ptr:
+		return g.typeSpec(typ.Elem(), module)
+	case reflect.Interface:
+		if typ.NumMethod() != 0 {
+			return typeSpec{}, errors.Errorf("values of type %v are not supported", typ)
+		}
+		return typeSpec{Ref: "pulumi.json#/Any"}, nil
+	case reflect.Bool:
+		return typeSpec{Type: "boolean"}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return typeSpec{Type: "integer"}, nil
+	case reflect.Float32, reflect.Float64:
+		return typeSpec{Type: "number"}, nil
+	case reflect.String:
+		return typeSpec{Type: "string"}, nil
+	case reflect.Slice, reflect.Array:
+		items, err := g.typeSpec(typ.Elem(), module)
+		if err != nil {
+			return typeSpec{}, err
+		}
+		return typeSpec{Type: "array", Items: &items}, nil
+	case reflect.Map:
+		if typ.Key().Kind() != reflect.String {
+			return typeSpec{}, errors.Errorf("map keys must be strings, not %v", typ.Key())
+		}
+		elem, err := g.typeSpec(typ.Elem(), module)
+		if err != nil {
+			return typeSpec{}, err
+		}
+		return typeSpec{Type: "object", AdditionalProperties: &elem}, nil
+	case reflect.Struct:
+		token, err := g.objectType(typ, module)
+		if err != nil {
+			return typeSpec{}, err
+		}
+		return typeSpec{Ref: "#/types/" + token}, nil
+	default:
+		return typeSpec{}, errors.Errorf("values of type %v are not supported", typ)
+	}
+}
+
+// objectType adds the given struct type to the package's object types if it is not already present, and returns its
+// token.
+func (g *schemaGenerator) objectType(typ reflect.Type, module string) (string, error) {
+	if token, ok := g.tokens[typ]; ok {
+		return token, nil
+	}
+	if typ.Name() == "" {
+		return "", errors.Errorf("anonymous struct types are not supported")
+	}
+
+	token := string(g.pkg) + ":" + module + ":" + typ.Name()
+	if _, has := g.types[token]; has {
+		return "", errors.Errorf("more than one type is named %v", token)
+	}
+	// Record the token before generating the type's properties so that recursive types terminate.
+	g.tokens[typ] = token
+	g.types[token] = objectTypeSpec{}
+
+	obj, err := newObject(typ)
+	if err != nil {
+		return "", err
+	}
+	props, required, err := g.properties(obj, module)
+	if err != nil {
+		return "", errors.Wrapf(err, "%v", typ)
+	}
+	g.types[token] = objectTypeSpec{Type: "object", Properties: props, Required: required}
+	return token, nil
+}
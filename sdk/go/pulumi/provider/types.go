@@ -0,0 +1,347 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"math"
+	"reflect"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+)
+
+var (
+	assetType   = reflect.TypeOf(resource.Asset{})
+	archiveType = reflect.TypeOf(resource.Archive{})
+)
+
+// property describes a field of a Go struct that holds the value of a resource property.
+type property struct {
+	key              resource.PropertyKey // the name of the property.
+	index            []int                // the index sequence of the struct field that holds the property.
+	typ              reflect.Type         // the type of the struct field.
+	description      string               // the description of the property, if any.
+	optional         bool                 // true if the property may be omitted.
+	secret           bool                 // true if the property's value is always secret.
+	replaceOnChanges bool                 // true if changes to the property require the resource to be replaced.
+}
+
+// object describes a Go struct that holds a bag of resource properties.
+type object struct {
+	typ        reflect.Type
+	properties []*property
+}
+
+// property returns the property with the given name, if any.
+func (o *object) property(key resource.PropertyKey) (*property, bool) {
+	for _, p := range o.properties {
+		if p.key == key {
+			return p, true
+		}
+	}
+	return nil, false
+}
+
+// newObject returns a description of the properties held by the given struct type. Each exported field with a
+// `pulumi:"name"` tag holds a property. The tag may be followed by any of the options "optional", "secret", and
+// "replaceOnChanges", separated by commas, and a `description:"..."` tag supplies the property's documentation.
+// Untagged embedded structs contribute their properties to the embedding struct.
+func newObject(typ reflect.Type) (*object, error) {
+	if typ.Kind() != reflect.Struct {
+		return nil, errors.Errorf("%v is not a struct type", typ)
+	}
+
+	obj := &object{typ: typ}
+	if err := obj.addFields(typ, nil); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+func (o *object) addFields(typ reflect.Type, index []int) error {
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		fieldIndex := append(append([]int{}, index...), i)
+
+		tag, hasTag := field.Tag.Lookup("pulumi")
+		if field.Anonymous && !hasTag && field.Type.Kind() == reflect.Struct {
+			if err := o.addFields(field.Type, fieldIndex); err != nil {
+				return err
+			}
+			continue
+		}
+		if !hasTag || field.PkgPath != "" {
+			continue
+		}
+
+		parts := strings.Split(tag, ",")
+		if parts[0] == "" {
+			return errors.Errorf("field %v.%v has an empty property name", o.typ, field.Name)
+		}
+		p := &property{
+			key:         resource.PropertyKey(parts[0]),
+			index:       fieldIndex,
+			typ:         field.Type,
+			description: field.Tag.Get("description"),
+			optional:    field.Type.Kind() == reflect.Ptr,
+		}
+		for _, option := range parts[1:] {
+			switch option {
+			case "optional":
+				p.optional = true
+			case "secret":
+				p.secret = true
+			case "replaceOnChanges":
+				p.replaceOnChanges = true
+			default:
+				return errors.Errorf("field %v.%v has unknown option %q", o.typ, field.Name, option)
+			}
+		}
+		if _, has := o.property(p.key); has {
+			return errors.Errorf("%v has more than one field for property %q", o.typ, p.key)
+		}
+		o.properties = append(o.properties, p)
+	}
+	return nil
+}
+
+// encode converts a struct value of the object's type into a property map. Nil fields are omitted, and secret
+// properties are marked as such.
+func (o *object) encode(v reflect.Value) (resource.PropertyMap, error) {
+	if v.Type() != o.typ {
+		return nil, errors.Errorf("cannot encode a %v as a %v", v.Type(), o.typ)
+	}
+
+	props := resource.PropertyMap{}
+	for _, p := range o.properties {
+		pv, err := encodeValue(v.FieldByIndex(p.index))
+		if err != nil {
+			return nil, errors.Wrapf(err, "%s", p.key)
+		}
+		if pv.IsNull() {
+			continue
+		}
+		if p.secret {
+			pv = resource.MakeSecret(pv)
+		}
+		props[p.key] = pv
+	}
+	return props, nil
+}
+
+// decode converts a property map into a struct value of the object's type. Properties that are missing, null, or
+// unknown leave their fields with the zero value, and secrets are decoded as their underlying values.
+func (o *object) decode(props resource.PropertyMap, v reflect.Value) error {
+	for _, p := range o.properties {
+		pv, ok := props[p.key]
+		if !ok {
+			continue
+		}
+		if err := decodeValue(pv, v.FieldByIndex(p.index)); err != nil {
+			return errors.Wrapf(err, "%s", p.key)
+		}
+	}
+	return nil
+}
+
+// encodeValue converts a Go value into a property value.
+func encodeValue(v reflect.Value) (resource.PropertyValue, error) {
+	switch v.Type() {
+	case assetType:
+		asset := v.Interface().(resource.Asset)
+		return resource.NewAssetProperty(&asset), nil
+	case archiveType:
+		archive := v.Interface().(resource.Archive)
+		return resource.NewArchiveProperty(&archive), nil
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return resource.NewNullProperty(), nil
+		}
+		if v.Kind() == reflect.Interface {
+			return resource.NewPropertyValue(v.Interface()), nil
+		}
+		return encodeValue(v.Elem())
+	case reflect.Bool:
+		return resource.NewBoolProperty(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return resource.NewNumberProperty(float64(v.Int())), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return resource.NewNumberProperty(float64(v.Uint())), nil
+	case reflect.Float32, reflect.Float64:
+		return resource.NewNumberProperty(v.Float()), nil
+	case reflect.String:
+		return resource.NewStringProperty(v.String()), nil
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return resource.NewNullProperty(), nil
+		}
+		arr := make([]resource.PropertyValue, v.Len())
+		for i := range arr {
+			elem, err := encodeValue(v.Index(i))
+			if err != nil {
+				return resource.PropertyValue{}, errors.Wrapf(err, "[%d]", i)
+			}
+			arr[i] = elem
+		}
+		return resource.NewArrayProperty(arr), nil
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return resource.PropertyValue{}, errors.Errorf("map keys must be strings, not %v", v.Type().Key())
+		}
+		if v.IsNil() {
+			return resource.NewNullProperty(), nil
+		}
+		obj := resource.PropertyMap{}
+		for iter := v.MapRange(); iter.Next(); {
+			elem, err := encodeValue(iter.Value())
+			if err != nil {
+				return resource.PropertyValue{}, errors.Wrapf(err, "%s", iter.Key().String())
+			}
+			obj[resource.PropertyKey(iter.Key().String())] = elem
+		}
+		return resource.NewObjectProperty(obj), nil
+	case reflect.Struct:
+		o, err := newObject(v.Type())
+		if err != nil {
+			return resource.PropertyValue{}, err
+		}
+		obj, err := o.encode(v)
+		if err != nil {
+			return resource.PropertyValue{}, err
+		}
+		return resource.NewObjectProperty(obj), nil
+	default:
+		return resource.PropertyValue{}, errors.Errorf("values of type %v are not supported", v.Type())
+	}
+}
+
+// decodeValue converts a property value into the given settable Go value.
+func decodeValue(pv resource.PropertyValue, v reflect.Value) error {
+	// Secrets decode as their underlying values, and unknowns and nulls decode as the zero value.
+	for pv.IsSecret() {
+		pv = pv.SecretValue().Element
+	}
+	if pv.IsNull() || pv.IsComputed() || pv.IsOutput() {
+		v.Set(reflect.Zero(v.Type()))
+		return nil
+	}
+
+	switch v.Type() {
+	case assetType:
+		if !pv.IsAsset() {
+			return errors.Errorf("expected an asset, got %v", pv.TypeString())
+		}
+		v.Set(reflect.ValueOf(*pv.AssetValue()))
+		return nil
+	case archiveType:
+		if !pv.IsArchive() {
+			return errors.Errorf("expected an archive, got %v", pv.TypeString())
+		}
+		v.Set(reflect.ValueOf(*pv.ArchiveValue()))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		elem := reflect.New(v.Type().Elem())
+		if err := decodeValue(pv, elem.Elem()); err != nil {
+			return err
+		}
+		v.Set(elem)
+		return nil
+	case reflect.Interface:
+		if v.NumMethod() != 0 {
+			return errors.Errorf("values of type %v are not supported", v.Type())
+		}
+		v.Set(reflect.ValueOf(pv.Mappable()))
+		return nil
+	case reflect.Bool:
+		if !pv.IsBool() {
+			return errors.Errorf("expected a boolean, got %v", pv.TypeString())
+		}
+		v.SetBool(pv.BoolValue())
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if !pv.IsNumber() || pv.NumberValue() != math.Trunc(pv.NumberValue()) {
+			return errors.Errorf("expected an integer, got %v", pv.TypeString())
+		}
+		v.SetInt(int64(pv.NumberValue()))
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if !pv.IsNumber() || pv.NumberValue() != math.Trunc(pv.NumberValue()) || pv.NumberValue() < 0 {
+			return errors.Errorf("expected a non-negative integer, got %v", pv.TypeString())
+		}
+		v.SetUint(uint64(pv.NumberValue()))
+		return nil
+	case reflect.Float32, reflect.Float64:
+		if !pv.IsNumber() {
+			return errors.Errorf("expected a number, got %v", pv.TypeString())
+		}
+		v.SetFloat(pv.NumberValue())
+		return nil
+	case reflect.String:
+		if !pv.IsString() {
+			return errors.Errorf("expected a string, got %v", pv.TypeString())
+		}
+		v.SetString(pv.StringValue())
+		return nil
+	case reflect.Slice:
+		if !pv.IsArray() {
+			return errors.Errorf("expected an array, got %v", pv.TypeString())
+		}
+		arr := pv.ArrayValue()
+		slice := reflect.MakeSlice(v.Type(), len(arr), len(arr))
+		for i, elem := range arr {
+			if err := decodeValue(elem, slice.Index(i)); err != nil {
+				return errors.Wrapf(err, "[%d]", i)
+			}
+		}
+		v.Set(slice)
+		return nil
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return errors.Errorf("map keys must be strings, not %v", v.Type().Key())
+		}
+		if !pv.IsObject() {
+			return errors.Errorf("expected an object, got %v", pv.TypeString())
+		}
+		m := reflect.MakeMap(v.Type())
+		for k, elem := range pv.ObjectValue() {
+			ev := reflect.New(v.Type().Elem()).Elem()
+			if err := decodeValue(elem, ev); err != nil {
+				return errors.Wrapf(err, "%s", k)
+			}
+			m.SetMapIndex(reflect.ValueOf(string(k)).Convert(v.Type().Key()), ev)
+		}
+		v.Set(m)
+		return nil
+	case reflect.Struct:
+		if !pv.IsObject() {
+			return errors.Errorf("expected an object, got %v", pv.TypeString())
+		}
+		o, err := newObject(v.Type())
+		if err != nil {
+			return err
+		}
+		return o.decode(pv.ObjectValue(), v)
+	default:
+		return errors.Errorf("values of type %v are not supported", v.Type())
+	}
+}
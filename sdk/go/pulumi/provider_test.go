@@ -0,0 +1,85 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulumi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
+)
+
+func TestConstructInputs(t *testing.T) {
+	inputs, err := plugin.MarshalProperties(resource.PropertyMap{
+		"foo": resource.NewStringProperty("bar"),
+		"baz": resource.MakeComputed(resource.NewStringProperty("")),
+		"qux": resource.MakeSecret(resource.NewNumberProperty(42)),
+	}, plugin.MarshalOptions{KeepUnknowns: true, KeepSecrets: true})
+	assert.NoError(t, err)
+
+	depURN := "urn:pulumi:stack::project::pkg:m:typ::dep"
+	m, err := constructInputs(&pulumirpc.ConstructRequest{
+		Inputs: inputs,
+		InputDependencies: map[string]*pulumirpc.ConstructRequest_PropertyDependencies{
+			"foo": {Urns: []string{depURN}},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, m, 3)
+
+	v, known, secret, err := m["foo"].(AnyOutput).await(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "bar", v)
+	assert.True(t, known)
+	assert.False(t, secret)
+
+	deps := m["foo"].(AnyOutput).dependencies()
+	if assert.Len(t, deps, 1) {
+		urn, _, _, err := deps[0].URN().awaitURN(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, URN(depURN), urn)
+	}
+
+	_, known, _, err = m["baz"].(AnyOutput).await(context.Background())
+	assert.NoError(t, err)
+	assert.False(t, known)
+
+	v, known, secret, err = m["qux"].(AnyOutput).await(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 42.0, v)
+	assert.True(t, known)
+	assert.True(t, secret)
+}
+
+func TestDependencyProviderResource(t *testing.T) {
+	ref := "urn:pulumi:stack::project::pulumi:providers:aws::default::some-id"
+	prov, err := newDependencyProviderResource(ref)
+	assert.NoError(t, err)
+	assert.Equal(t, "aws", prov.getPackage())
+
+	ctx := &Context{}
+	actual, err := ctx.resolveProviderReference(prov)
+	assert.NoError(t, err)
+	assert.Equal(t, ref, actual)
+
+	_, err = newDependencyProviderResource("urn:pulumi:stack::project::aws:s3/bucket:Bucket::b::some-id")
+	assert.Error(t, err)
+	_, err = newDependencyProviderResource("not-a-reference")
+	assert.Error(t, err)
+}
@@ -16,6 +16,9 @@ package pulumi
 
 import (
 	"reflect"
+	"time"
+
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource"
 )
 
 type (
@@ -28,6 +31,7 @@ type (
 var resourceStateType = reflect.TypeOf(ResourceState{})
 var customResourceStateType = reflect.TypeOf(CustomResourceState{})
 var providerResourceStateType = reflect.TypeOf(ProviderResourceState{})
+var propertyMapType = reflect.TypeOf(resource.PropertyMap{})
 
 // ResourceState is the base
 type ResourceState struct {
@@ -40,6 +44,8 @@ type ResourceState struct {
 	name string
 
 	transformations []ResourceTransformation
+
+	rawOutputs *OutputState
 }
 
 func (s ResourceState) URN() URNOutput {
@@ -66,6 +72,10 @@ func (s ResourceState) getTransformations() []ResourceTransformation {
 	return s.transformations
 }
 
+func (s ResourceState) getRawOutputs() *OutputState {
+	return s.rawOutputs
+}
+
 func (s *ResourceState) addTransformation(t ResourceTransformation) {
 	s.transformations = append(s.transformations, t)
 }
@@ -116,6 +126,9 @@ type Resource interface {
 
 	// addTransformation adds a single transformation to the resource.
 	addTransformation(t ResourceTransformation)
+
+	// getRawOutputs returns an output that resolves to the resource's output properties as returned by the engine.
+	getRawOutputs() *OutputState
 }
 
 // CustomResource is a cloud resource whose create, read, update, and delete (CRUD) operations are managed by performing
@@ -151,6 +164,19 @@ type CustomTimeouts struct {
 	Delete string
 }
 
+// RetryPolicy controls how the engine retries a resource's create, update, or delete operation when the provider
+// reports an error. Policies are not recorded in the stack's state: a replaced resource is deleted using the policy of
+// its replacement, and a resource that is removed from the program is deleted using the stack's default policy.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of times to attempt the operation, including the first.
+	MaxAttempts int
+	// Backoff is the time to wait before the first retry. The wait doubles with each further retry.
+	Backoff time.Duration
+	// ErrorMatchers is an optional list of regular expressions. If it is non-empty, only errors whose messages match
+	// at least one of the expressions are retried.
+	ErrorMatchers []string
+}
+
 type resourceOptions struct {
 	// Parent is an optional parent resource to which this resource belongs.
 	Parent Resource
@@ -171,6 +197,13 @@ type resourceOptions struct {
 	Import IDInput
 	// CustomTimeouts is an optional configuration block used for CRUD operations
 	CustomTimeouts *CustomTimeouts
+	// RetryPolicy is an optional policy for retrying failed create, update, or delete operations.
+	RetryPolicy *RetryPolicy
+	// RetainOnDelete, when set to true, leaves the resource in the cloud when it is deleted from the stack.
+	RetainOnDelete bool
+	// DeletedWith is an optional resource whose deletion also deletes this resource. When both are deleted at once,
+	// the provider is not asked to delete this resource.
+	DeletedWith Resource
 	// Ignore changes to any of the specified properties.
 	IgnoreChanges []string
 	// Aliases is an optional list of identifiers used to find and use existing resources.
@@ -315,6 +348,28 @@ func Timeouts(o *CustomTimeouts) ResourceOption {
 	})
 }
 
+// Retry is an optional policy for retrying failed create, update, or delete operations
+func Retry(o *RetryPolicy) ResourceOption {
+	return resourceOption(func(ro *resourceOptions) {
+		ro.RetryPolicy = o
+	})
+}
+
+// RetainOnDelete, when set to true, leaves the resource in the cloud when it is deleted from the stack.
+func RetainOnDelete(o bool) ResourceOption {
+	return resourceOption(func(ro *resourceOptions) {
+		ro.RetainOnDelete = o
+	})
+}
+
+// DeletedWith marks the resource as being deleted along with another resource, so that the provider is not asked to
+// delete it when both are deleted at once.
+func DeletedWith(o Resource) ResourceOption {
+	return resourceOption(func(ro *resourceOptions) {
+		ro.DeletedWith = o
+	})
+}
+
 // Ignore changes to any of the specified properties.
 func IgnoreChanges(o []string) ResourceOption {
 	return resourceOption(func(ro *resourceOptions) {
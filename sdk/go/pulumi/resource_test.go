@@ -139,6 +139,25 @@ func TestResourceOptionMergingDeleteBeforeReplace(t *testing.T) {
 	assert.Equal(t, false, opts.DeleteBeforeReplace)
 }
 
+func TestResourceOptionMergingRetainOnDelete(t *testing.T) {
+	// last value wins
+	opts := merge(RetainOnDelete(true), RetainOnDelete(false))
+	assert.Equal(t, false, opts.RetainOnDelete)
+}
+
+func TestResourceOptionMergingDeletedWith(t *testing.T) {
+	r1 := &testRes{foo: "a"}
+	r2 := &testRes{foo: "b"}
+
+	// last value wins
+	opts := merge(DeletedWith(r1), DeletedWith(r2))
+	assert.Equal(t, r2, opts.DeletedWith)
+
+	// second value nil
+	opts = merge(DeletedWith(r1), DeletedWith(nil))
+	assert.Equal(t, nil, opts.DeletedWith)
+}
+
 func TestResourceOptionMergingImport(t *testing.T) {
 	id1 := ID("a")
 	id2 := ID("a")
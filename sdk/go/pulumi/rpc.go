@@ -177,6 +177,13 @@ func marshalInput(v interface{}, destType reflect.Type, await bool) (resource.Pr
 	return val, deps, nil
 }
 
+// isPlainPointer returns true if the given value is a pointer of the given destination type, and is therefore a plain
+// value rather than an input even if it implements Input.
+func isPlainPointer(v interface{}, destType reflect.Type) bool {
+	t := reflect.TypeOf(v)
+	return t != nil && t.Kind() == reflect.Ptr && t == destType
+}
+
 // marshalInputAndDetermineSecret marshals an input value with information about secret status
 func marshalInputAndDetermineSecret(v interface{},
 	destType reflect.Type,
@@ -186,8 +193,11 @@ func marshalInputAndDetermineSecret(v interface{},
 		valueType := reflect.TypeOf(v)
 
 		// If this is an Input, make sure it is of the proper type and await it if it is an output/
+		//
+		// Pointers to plain values that are also inputs (e.g. optional enum values in plain structs) are dereferenced
+		// below instead, as their ElementType methods may not be callable on nil pointers.
 		var deps []Resource
-		if input, ok := v.(Input); ok {
+		if input, ok := v.(Input); ok && !isPlainPointer(v, destType) {
 			valueType = input.ElementType()
 
 			// If the element type of the input is not identical to the type of the destination and the destination is
@@ -544,3 +544,24 @@ func TestMapInputMarhsalling(t *testing.T) {
 		}
 	}
 }
+
+type testEnum string
+
+func (testEnum) ElementType() reflect.Type {
+	return reflect.TypeOf((*testEnum)(nil)).Elem()
+}
+
+// Tests that pointers to plain values that are also inputs (e.g. optional enum values) marshal as the values to which
+// they point, or as null if they are nil.
+func TestMarshalEnumPointers(t *testing.T) {
+	type args struct {
+		Size *testEnum `pulumi:"size"`
+		Kind *testEnum `pulumi:"kind"`
+	}
+
+	kind := testEnum("big")
+	v, _, err := marshalInput(args{Kind: &kind}, reflect.TypeOf(args{}), true)
+	assert.NoError(t, err)
+	assert.True(t, v.ObjectValue()["size"].IsNull())
+	assert.Equal(t, "big", v.ObjectValue()["kind"].StringValue())
+}
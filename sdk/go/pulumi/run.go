@@ -25,6 +25,7 @@ import (
 	multierror "github.com/hashicorp/go-multierror"
 
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/contract"
+	"github.com/pulumi/pulumi/sdk/v2/go/pulumi/internal/dynamic"
 )
 
 var ErrPlugins = errors.New("pulumi: plugins requested")
@@ -56,6 +57,14 @@ func RunErr(body RunFunc, opts ...RunOption) error {
 	if info.getPlugins {
 		return ErrPlugins
 	}
+	if info.serveDynamicProviders {
+		// The program has been launched by the dynamic provider plugin in order to serve its dynamic providers
+		// rather than to run its body.
+		if dynamic.Serve == nil {
+			return errors.New("program does not define any dynamic providers")
+		}
+		return dynamic.Serve()
+	}
 
 	for _, o := range opts {
 		o(&info)
@@ -132,6 +141,8 @@ type RunInfo struct {
 	EngineAddr  string
 	Mocks       MockResourceMonitor
 	getPlugins  bool
+
+	serveDynamicProviders bool
 }
 
 // getEnvInfo reads various program information from the process environment.
@@ -140,6 +151,7 @@ func getEnvInfo() RunInfo {
 	parallel, _ := strconv.Atoi(os.Getenv(EnvParallel))
 	dryRun, _ := strconv.ParseBool(os.Getenv(EnvDryRun))
 	getPlugins, _ := strconv.ParseBool(os.Getenv(envPlugins))
+	serveDynamicProviders, _ := strconv.ParseBool(os.Getenv(EnvDynamicProviders))
 
 	var config map[string]string
 	if cfg := os.Getenv(EnvConfig); cfg != "" {
@@ -155,6 +167,8 @@ func getEnvInfo() RunInfo {
 		MonitorAddr: os.Getenv(EnvMonitor),
 		EngineAddr:  os.Getenv(EnvEngine),
 		getPlugins:  getPlugins,
+
+		serveDynamicProviders: serveDynamicProviders,
 	}
 }
 
@@ -175,6 +189,9 @@ const (
 	EnvEngine = "PULUMI_ENGINE"
 	// envPlugins is the envvar used to request that the Pulumi program print its set of required plugins and exit.
 	envPlugins = "PULUMI_PLUGINS"
+	// EnvDynamicProviders is the envvar used to request that the Pulumi program serve its dynamic providers instead of
+	// running its body.
+	EnvDynamicProviders = "PULUMI_GO_DYNAMIC_PROVIDERS"
 )
 
 type PackageInfo struct {
@@ -194,3 +194,47 @@ func TestInvoke(t *testing.T) {
 	}, WithMocks("project", "stack", mocks))
 	assert.NoError(t, err)
 }
+
+func TestCall(t *testing.T) {
+	mocks := &testMonitor{
+		CallF: func(token string, args resource.PropertyMap, provider string) (resource.PropertyMap, error) {
+			assert.Equal(t, "test:resource:type/method", token)
+			assert.True(t, args.DeepEquals(resource.NewPropertyMapFromMap(map[string]interface{}{
+				"arg": "gra",
+			})))
+			return resource.NewPropertyMapFromMap(map[string]interface{}{
+				"foo": "oof",
+			}), nil
+		},
+		NewResourceF: func(typeToken, name string, inputs resource.PropertyMap,
+			provider, id string) (string, resource.PropertyMap, error) {
+
+			return name, resource.NewPropertyMapFromMap(map[string]interface{}{
+				"foo": "qux",
+			}), nil
+		},
+	}
+
+	err := RunErr(func(ctx *Context) error {
+		var res testResource2
+		err := ctx.RegisterResource("test:resource:type", "resA", &testResource2Inputs{}, &res)
+		assert.NoError(t, err)
+
+		result, err := ctx.Call("test:resource:type/method", Map{"arg": String("gra")}, MapOutput{}, &res)
+		assert.NoError(t, err)
+
+		v, known, secret, err := await(result)
+		assert.NoError(t, err)
+		assert.True(t, known)
+		assert.False(t, secret)
+		assert.Equal(t, map[string]interface{}{"foo": "oof"}, v)
+
+		// The result of a call depends upon its receiver.
+		assert.Equal(t, []Resource{&res}, result.getState().dependencies())
+
+		_, err = ctx.Call("", nil, MapOutput{}, &res)
+		assert.Error(t, err)
+		return nil
+	}, WithMocks("project", "stack", mocks))
+	assert.NoError(t, err)
+}
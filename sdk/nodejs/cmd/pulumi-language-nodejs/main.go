@@ -46,11 +46,13 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/config"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/cmdutil"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/contract"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/logging"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/rpcutil"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/version"
+	"github.com/pulumi/pulumi/sdk/v2/nodejs/npm"
 	pulumirpc "github.com/pulumi/pulumi/sdk/v2/proto/go"
 	"google.golang.org/grpc"
 
@@ -612,3 +614,15 @@ func (host *nodeLanguageHost) GetPluginInfo(ctx context.Context, req *pbempty.Em
 		Version: version.Version,
 	}, nil
 }
+
+// InstallDependencies installs the program's packages by running `npm install`, or `yarn install` if the
+// `PULUMI_PREFER_YARN` environment variable is set.
+func (host *nodeLanguageHost) InstallDependencies(
+	req *pulumirpc.InstallDependenciesRequest, server pulumirpc.LanguageRuntime_InstallDependenciesServer) error {
+
+	stdout, stderr := plugin.InstallDependenciesWriters(server)
+	if bin, err := npm.Install(req.GetDirectory(), stdout, stderr); err != nil {
+		return errors.Wrapf(err, "%s install failed to install dependencies", bin)
+	}
+	return nil
+}
@@ -33,6 +33,28 @@ function deserialize_google_protobuf_Empty(buffer_arg) {
   return google_protobuf_empty_pb.Empty.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_pulumirpc_CallRequest(arg) {
+  if (!(arg instanceof provider_pb.CallRequest)) {
+    throw new Error('Expected argument of type pulumirpc.CallRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_CallRequest(buffer_arg) {
+  return provider_pb.CallRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pulumirpc_CallResponse(arg) {
+  if (!(arg instanceof provider_pb.CallResponse)) {
+    throw new Error('Expected argument of type pulumirpc.CallResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_CallResponse(buffer_arg) {
+  return provider_pb.CallResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_pulumirpc_CheckRequest(arg) {
   if (!(arg instanceof provider_pb.CheckRequest)) {
     throw new Error('Expected argument of type pulumirpc.CheckRequest');
@@ -77,6 +99,28 @@ function deserialize_pulumirpc_ConfigureResponse(buffer_arg) {
   return provider_pb.ConfigureResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_pulumirpc_ConstructRequest(arg) {
+  if (!(arg instanceof provider_pb.ConstructRequest)) {
+    throw new Error('Expected argument of type pulumirpc.ConstructRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_ConstructRequest(buffer_arg) {
+  return provider_pb.ConstructRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pulumirpc_ConstructResponse(arg) {
+  if (!(arg instanceof provider_pb.ConstructResponse)) {
+    throw new Error('Expected argument of type pulumirpc.ConstructResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_ConstructResponse(buffer_arg) {
+  return provider_pb.ConstructResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_pulumirpc_CreateRequest(arg) {
   if (!(arg instanceof provider_pb.CreateRequest)) {
     throw new Error('Expected argument of type pulumirpc.CreateRequest');
@@ -132,6 +176,28 @@ function deserialize_pulumirpc_DiffResponse(buffer_arg) {
   return provider_pb.DiffResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_pulumirpc_GetLogsRequest(arg) {
+  if (!(arg instanceof provider_pb.GetLogsRequest)) {
+    throw new Error('Expected argument of type pulumirpc.GetLogsRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_GetLogsRequest(buffer_arg) {
+  return provider_pb.GetLogsRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pulumirpc_GetLogsResponse(arg) {
+  if (!(arg instanceof provider_pb.GetLogsResponse)) {
+    throw new Error('Expected argument of type pulumirpc.GetLogsResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_GetLogsResponse(buffer_arg) {
+  return provider_pb.GetLogsResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_pulumirpc_GetSchemaRequest(arg) {
   if (!(arg instanceof provider_pb.GetSchemaRequest)) {
     throw new Error('Expected argument of type pulumirpc.GetSchemaRequest');
@@ -308,6 +374,46 @@ streamInvoke: {
     responseSerialize: serialize_pulumirpc_InvokeResponse,
     responseDeserialize: deserialize_pulumirpc_InvokeResponse,
   },
+  // Construct creates a new instance of the provided component resource and returns its state. The provider
+// registers the component and any of its children with the resource monitor at monitorEndpoint.
+construct: {
+    path: '/pulumirpc.ResourceProvider/Construct',
+    requestStream: false,
+    responseStream: false,
+    requestType: provider_pb.ConstructRequest,
+    responseType: provider_pb.ConstructResponse,
+    requestSerialize: serialize_pulumirpc_ConstructRequest,
+    requestDeserialize: deserialize_pulumirpc_ConstructRequest,
+    responseSerialize: serialize_pulumirpc_ConstructResponse,
+    responseDeserialize: deserialize_pulumirpc_ConstructResponse,
+  },
+  // Call dynamically executes a method in the provider associated with a resource. The method receives the
+// resource's URN and current state along with its arguments, and returns its results along with the resources
+// that each result depends on.
+call: {
+    path: '/pulumirpc.ResourceProvider/Call',
+    requestStream: false,
+    responseStream: false,
+    requestType: provider_pb.CallRequest,
+    responseType: provider_pb.CallResponse,
+    requestSerialize: serialize_pulumirpc_CallRequest,
+    requestDeserialize: deserialize_pulumirpc_CallRequest,
+    responseSerialize: serialize_pulumirpc_CallResponse,
+    responseDeserialize: deserialize_pulumirpc_CallResponse,
+  },
+  // GetLogs streams the log entries produced by a resource, such as the output of a function or a container.
+// Providers that do not support logs for a resource should return an Unimplemented error.
+getLogs: {
+    path: '/pulumirpc.ResourceProvider/GetLogs',
+    requestStream: false,
+    responseStream: true,
+    requestType: provider_pb.GetLogsRequest,
+    responseType: provider_pb.GetLogsResponse,
+    requestSerialize: serialize_pulumirpc_GetLogsRequest,
+    requestDeserialize: deserialize_pulumirpc_GetLogsRequest,
+    responseSerialize: serialize_pulumirpc_GetLogsResponse,
+    responseDeserialize: deserialize_pulumirpc_GetLogsResponse,
+  },
   // Check validates that the given property bag is valid for a resource of the given type and returns the inputs
 // that should be passed to successive calls to Diff, Create, or Update for this resource. As a rule, the provider
 // inputs returned by a call to Check should preserve the original representation of the properties as present in
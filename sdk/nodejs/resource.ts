@@ -207,8 +207,10 @@ export abstract class Resource {
      * @param custom True to indicate that this is a custom resource, managed by a plugin.
      * @param props The arguments to use to populate the new resource.
      * @param opts A bag of options that control this resource's behavior.
+     * @param remote True if this is a component resource that is constructed by its provider.
      */
-    constructor(t: string, name: string, custom: boolean, props: Inputs = {}, opts: ResourceOptions = {}) {
+    constructor(t: string, name: string, custom: boolean, props: Inputs = {}, opts: ResourceOptions = {},
+                remote: boolean = false) {
         if (opts.parent && !Resource.isInstance(opts.parent)) {
             throw new Error(`Resource parent is not a valid Resource: ${opts.parent}`);
         }
@@ -325,7 +327,7 @@ export abstract class Resource {
             // resource's properties will be resolved asynchronously after the operation completes, so
             // that dependent computations resolve normally.  If we are just planning, on the other
             // hand, values will never resolve.
-            registerResource(this, t, name, custom, props, opts);
+            registerResource(this, t, name, custom, props, opts, remote);
         }
     }
 }
@@ -778,8 +780,11 @@ export class ComponentResource<TData = any> extends Resource {
      * @param name The _unique_ name of the resource.
      * @param args Information passed to [initialize] method.
      * @param opts A bag of options that control this resource's behavior.
+     * @param remote True if this component is constructed by the provider for its package rather than by this
+     *     program.
      */
-    constructor(type: string, name: string, args: Inputs = {}, opts: ComponentResourceOptions = {}) {
+    constructor(type: string, name: string, args: Inputs = {}, opts: ComponentResourceOptions = {},
+                remote: boolean = false) {
         // Explicitly ignore the props passed in.  We allow them for back compat reasons.  However,
         // we explicitly do not want to pass them along to the engine.  The ComponentResource acts
         // only as a container for other resources.  Another way to think about this is that a normal
@@ -788,8 +793,17 @@ export class ComponentResource<TData = any> extends Resource {
         // for a component resource.  The component is just used for organizational purposes and does
         // not correspond to a real piece of cloud infrastructure.  As such, changes to it *itself*
         // do not have any effect on the cloud side of things at all.
-        super(type, name, /*custom:*/ false, /*props:*/ {}, opts);
-        this.__data = this.initializeAndRegisterOutputs(args);
+        //
+        // Remote components are the exception: they are constructed by their provider, so the args are
+        // passed along as the component's inputs. The provider registers the component's children and
+        // outputs, and the outputs are resolved from the provider's result.
+        super(type, name, /*custom:*/ false, /*props:*/ remote ? args : {}, opts, remote);
+        if (remote) {
+            this.__registered = true;
+            this.__data = Promise.resolve(<TData>undefined!);
+        } else {
+            this.__data = this.initializeAndRegisterOutputs(args);
+        }
     }
 
     /** @internal */
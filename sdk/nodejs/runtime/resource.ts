@@ -158,11 +158,12 @@ export function readResource(res: Resource, t: string, name: string, props: Inpu
  * registerResource registers a new resource object with a given type t and name.  It returns the auto-generated
  * URN and the ID that will resolve after the deployment has completed.  All properties will be initialized to property
  * objects that the registration operation will resolve at the right time (or remain unresolved for deployments).
+ * If remote is true, the resource is a component that is constructed by its provider rather than by this program.
  */
 export function registerResource(res: Resource, t: string, name: string, custom: boolean,
-                                 props: Inputs, opts: ResourceOptions): void {
+                                 props: Inputs, opts: ResourceOptions, remote: boolean = false): void {
     const label = `resource:${name}[${t}]`;
-    log.debug(`Registering resource: t=${t}, name=${name}, custom=${custom}`);
+    log.debug(`Registering resource: t=${t}, name=${name}, custom=${custom}, remote=${remote}`);
 
     const monitor = getMonitor();
     const resopAsync = prepareResource(label, res, custom, props, opts);
@@ -194,6 +195,7 @@ export function registerResource(res: Resource, t: string, name: string, custom:
         req.setAliasesList(resop.aliases);
         req.setImportid(resop.import || "");
         req.setSupportspartialvalues(true);
+        req.setRemote(remote);
 
         const customTimeouts = new resproto.RegisterResourceRequest.CustomTimeouts();
         if (opts.customTimeouts != null) {
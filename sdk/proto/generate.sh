@@ -26,8 +26,11 @@ PROTOC="$DOCKER_RUN protoc"
 # dynamic client (written in JS) using the protobuf notion of "details" -- arbitrary protobuf
 # messages packaged up inside of an error. Hence, `JS_PROTO_FILES` includes it and `PROTO_FILES`
 # does not.
-PROTO_FILES=$(find . -name "*.proto" -not -name "status.proto")
-JS_PROTO_FILES=$(find . -name "*.proto")
+#
+# The OpenTelemetry (OTLP) trace definitions under `otlp` are only used by the Go SDK's trace exporter, so they are
+# generated separately, and only for Go.
+PROTO_FILES=$(find . -name "*.proto" -not -name "status.proto" -not -path "./otlp/*")
+JS_PROTO_FILES=$(find . -name "*.proto" -not -path "./otlp/*")
 
 echo "* Generating Protobuf/gRPC SDK files:"
 echo -e "\tVERSION: $($PROTOC --version)"
@@ -39,6 +42,14 @@ echo -e "\tGo: $GO_PULUMIRPC [$GO_PROTOFLAGS]"
 mkdir -p $GO_PULUMIRPC
 $PROTOC --go_out=$GO_PROTOFLAGS:$GO_PULUMIRPC $PROTO_FILES
 
+GO_OTLP=./go/otlp
+GO_OTLP_PROTOFLAGS="plugins=grpc,paths=source_relative"
+echo -e "\tGo: $GO_OTLP [$GO_OTLP_PROTOFLAGS]"
+mkdir -p $GO_OTLP
+for OTLP_PACKAGE in $(find ./otlp -name "*.proto" -exec dirname {} \; | sort -u); do
+    $PROTOC -I./otlp --go_out=$GO_OTLP_PROTOFLAGS:$GO_OTLP $(find "$OTLP_PACKAGE" -maxdepth 1 -name "*.proto")
+done
+
 # Protoc for JavaScript has a bug where it emits Google Closure Compiler directives in the module prologue that mutate
 # the global object, which causes side-by-side bugs in pulumi/pulumi (pulumi/pulumi#2401). The protoc compiler
 # absolutely should not be emitting commonjs modules that mutate global, but alas, it does, and we have to sed the
@@ -52,7 +63,7 @@ $PROTOC --go_out=$GO_PROTOFLAGS:$GO_PULUMIRPC $PROTO_FILES
 # This sets up the remainder of the protobuf file so that it works fine, but doesn't mess with global.
 $DOCKER_RUN /bin/bash -c 'set -x && JS_PULUMIRPC=/nodejs/proto && \
     JS_PROTOFLAGS="import_style=commonjs,binary"    && \
-    JS_HACK_PROTOS=$(find . -name "*.proto" -not -name "status.proto") && \
+    JS_HACK_PROTOS=$(find . -name "*.proto" -not -name "status.proto" -not -path "./otlp/*") && \
     echo -e "\tJS: $JS_PULUMIRPC [$JS_PROTOFLAGS]"  && \
     TEMP_DIR=/tmp/nodejs-build                      && \
     echo -e "\tJS temp dir: $TEMP_DIR"              && \
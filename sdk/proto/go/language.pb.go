@@ -283,48 +283,152 @@ func (m *RunResponse) GetBail() bool {
 	return false
 }
 
+// InstallDependenciesRequest asks the language host to install the dependencies of a program.
+type InstallDependenciesRequest struct {
+	Directory            string   `protobuf:"bytes,1,opt,name=directory,proto3" json:"directory,omitempty"`
+	IsTerminal           bool     `protobuf:"varint,2,opt,name=isTerminal,proto3" json:"isTerminal,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InstallDependenciesRequest) Reset()         { *m = InstallDependenciesRequest{} }
+func (m *InstallDependenciesRequest) String() string { return proto.CompactTextString(m) }
+func (*InstallDependenciesRequest) ProtoMessage()    {}
+func (*InstallDependenciesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e123c61d1ddd0892, []int{4}
+}
+
+func (m *InstallDependenciesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InstallDependenciesRequest.Unmarshal(m, b)
+}
+func (m *InstallDependenciesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InstallDependenciesRequest.Marshal(b, m, deterministic)
+}
+func (m *InstallDependenciesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InstallDependenciesRequest.Merge(m, src)
+}
+func (m *InstallDependenciesRequest) XXX_Size() int {
+	return xxx_messageInfo_InstallDependenciesRequest.Size(m)
+}
+func (m *InstallDependenciesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InstallDependenciesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InstallDependenciesRequest proto.InternalMessageInfo
+
+func (m *InstallDependenciesRequest) GetDirectory() string {
+	if m != nil {
+		return m.Directory
+	}
+	return ""
+}
+
+func (m *InstallDependenciesRequest) GetIsTerminal() bool {
+	if m != nil {
+		return m.IsTerminal
+	}
+	return false
+}
+
+// InstallDependenciesResponse carries a chunk of the output of a dependency installation.
+type InstallDependenciesResponse struct {
+	Stdout               []byte   `protobuf:"bytes,1,opt,name=stdout,proto3" json:"stdout,omitempty"`
+	Stderr               []byte   `protobuf:"bytes,2,opt,name=stderr,proto3" json:"stderr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InstallDependenciesResponse) Reset()         { *m = InstallDependenciesResponse{} }
+func (m *InstallDependenciesResponse) String() string { return proto.CompactTextString(m) }
+func (*InstallDependenciesResponse) ProtoMessage()    {}
+func (*InstallDependenciesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e123c61d1ddd0892, []int{5}
+}
+
+func (m *InstallDependenciesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InstallDependenciesResponse.Unmarshal(m, b)
+}
+func (m *InstallDependenciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InstallDependenciesResponse.Marshal(b, m, deterministic)
+}
+func (m *InstallDependenciesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InstallDependenciesResponse.Merge(m, src)
+}
+func (m *InstallDependenciesResponse) XXX_Size() int {
+	return xxx_messageInfo_InstallDependenciesResponse.Size(m)
+}
+func (m *InstallDependenciesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InstallDependenciesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InstallDependenciesResponse proto.InternalMessageInfo
+
+func (m *InstallDependenciesResponse) GetStdout() []byte {
+	if m != nil {
+		return m.Stdout
+	}
+	return nil
+}
+
+func (m *InstallDependenciesResponse) GetStderr() []byte {
+	if m != nil {
+		return m.Stderr
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GetRequiredPluginsRequest)(nil), "pulumirpc.GetRequiredPluginsRequest")
 	proto.RegisterType((*GetRequiredPluginsResponse)(nil), "pulumirpc.GetRequiredPluginsResponse")
 	proto.RegisterType((*RunRequest)(nil), "pulumirpc.RunRequest")
 	proto.RegisterMapType((map[string]string)(nil), "pulumirpc.RunRequest.ConfigEntry")
 	proto.RegisterType((*RunResponse)(nil), "pulumirpc.RunResponse")
+	proto.RegisterType((*InstallDependenciesRequest)(nil), "pulumirpc.InstallDependenciesRequest")
+	proto.RegisterType((*InstallDependenciesResponse)(nil), "pulumirpc.InstallDependenciesResponse")
 }
 
 func init() { proto.RegisterFile("language.proto", fileDescriptor_e123c61d1ddd0892) }
 
 var fileDescriptor_e123c61d1ddd0892 = []byte{
-	// 477 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xdf, 0x6e, 0xd3, 0x30,
-	0x14, 0xc6, 0x97, 0x66, 0xfd, 0x77, 0x0a, 0x1b, 0xb2, 0xb6, 0xca, 0x64, 0x5c, 0x94, 0x08, 0x44,
-	0xaf, 0x32, 0x69, 0x88, 0x3f, 0xe3, 0x0a, 0x04, 0xd3, 0x84, 0x04, 0x12, 0x32, 0x0f, 0x80, 0xdc,
-	0xe4, 0x34, 0x0a, 0x4b, 0x6d, 0xcf, 0xb1, 0x41, 0x79, 0x68, 0x24, 0x1e, 0x01, 0xd9, 0x4e, 0xbb,
-	0xc2, 0x8a, 0x76, 0x77, 0xbe, 0x93, 0xef, 0x24, 0xbf, 0x7c, 0x3e, 0x86, 0x83, 0x9a, 0x8b, 0xd2,
-	0xf2, 0x12, 0x33, 0xa5, 0xa5, 0x91, 0x64, 0xac, 0x6c, 0x6d, 0x57, 0x95, 0x56, 0x79, 0x72, 0x4f,
-	0xd5, 0xb6, 0xac, 0x44, 0x78, 0x90, 0x9c, 0x94, 0x52, 0x96, 0x35, 0x9e, 0x7a, 0xb5, 0xb0, 0xcb,
-	0x53, 0x5c, 0x29, 0xd3, 0x86, 0x87, 0x29, 0x87, 0x87, 0x97, 0x68, 0x18, 0x5e, 0xdb, 0x4a, 0x63,
-	0xf1, 0xc5, 0xcf, 0x35, 0x4e, 0x62, 0x63, 0x08, 0x85, 0xa1, 0xd2, 0xf2, 0x3b, 0xe6, 0x86, 0x46,
-	0xb3, 0x68, 0x3e, 0x66, 0x6b, 0x49, 0x1e, 0x40, 0xac, 0x7e, 0x16, 0xb4, 0xe7, 0xbb, 0xae, 0xec,
-	0xbc, 0xa5, 0xe6, 0x2b, 0x1a, 0x6f, 0xbc, 0x4e, 0xa6, 0x5f, 0x21, 0xd9, 0xf5, 0x89, 0x46, 0x49,
-	0xd1, 0x20, 0x79, 0x01, 0xc3, 0x40, 0xdb, 0xd0, 0x68, 0x16, 0xcf, 0x27, 0x67, 0x27, 0xd9, 0xe6,
-	0x47, 0xb2, 0x60, 0xfe, 0x80, 0x0a, 0x45, 0x81, 0x22, 0x6f, 0xd9, 0xda, 0x9b, 0xfe, 0xea, 0x01,
-	0x30, 0x2b, 0xee, 0x26, 0x3d, 0x82, 0x7e, 0x63, 0x78, 0x7e, 0xd5, 0xb1, 0x06, 0xb1, 0xe6, 0x8f,
-	0x77, 0xf2, 0xef, 0xff, 0xc5, 0x4f, 0x08, 0xec, 0x73, 0x5d, 0x36, 0xb4, 0x3f, 0x8b, 0xe7, 0x63,
-	0xe6, 0x6b, 0x72, 0x0e, 0x83, 0x5c, 0x8a, 0x65, 0x55, 0xd2, 0x81, 0x87, 0x7e, 0xbc, 0x05, 0x7d,
-	0x83, 0x95, 0xbd, 0xf7, 0x9e, 0x0b, 0x61, 0x74, 0xcb, 0xba, 0x01, 0x32, 0x85, 0x41, 0xa1, 0x5b,
-	0x66, 0x05, 0x1d, 0xce, 0xa2, 0xf9, 0x88, 0x75, 0x8a, 0x24, 0x30, 0x52, 0x5c, 0xf3, 0xba, 0xc6,
-	0x9a, 0x8e, 0x66, 0xd1, 0xbc, 0xcf, 0x36, 0x9a, 0x3c, 0x83, 0xc3, 0x95, 0x14, 0x95, 0x91, 0xfa,
-	0x1b, 0x2f, 0x0a, 0x8d, 0x4d, 0x43, 0xc7, 0x1e, 0xf2, 0xa0, 0x6b, 0xbf, 0x0b, 0x5d, 0xf2, 0x08,
-	0xc6, 0xd7, 0x16, 0x75, 0xfb, 0x59, 0x16, 0x48, 0xc1, 0xbf, 0xff, 0xa6, 0x91, 0x9c, 0xc3, 0x64,
-	0x8b, 0xc8, 0x85, 0x70, 0x85, 0x6d, 0x17, 0x98, 0x2b, 0x5d, 0x58, 0x3f, 0x78, 0x6d, 0x71, 0x1d,
-	0x96, 0x17, 0x6f, 0x7a, 0xaf, 0xa3, 0xf4, 0x15, 0x4c, 0xfc, 0x7f, 0x75, 0xa7, 0x76, 0x04, 0x7d,
-	0xd4, 0x5a, 0xea, 0x6e, 0x38, 0x08, 0x97, 0xd4, 0x82, 0x57, 0xb5, 0x9f, 0x1e, 0x31, 0x5f, 0x9f,
-	0xfd, 0x8e, 0xe0, 0xf0, 0x53, 0xb7, 0xa9, 0xcc, 0x0a, 0x53, 0xad, 0x90, 0xe4, 0x40, 0x6e, 0x6f,
-	0x04, 0x79, 0xb2, 0x95, 0xe1, 0x7f, 0x77, 0x32, 0x79, 0x7a, 0x87, 0x2b, 0x00, 0xa6, 0x7b, 0xe4,
-	0x25, 0xc4, 0x2e, 0xd6, 0xe3, 0x9d, 0x27, 0x93, 0x4c, 0xff, 0x6d, 0x6f, 0xe6, 0xde, 0xc2, 0xfd,
-	0x4b, 0x34, 0xe1, 0x7d, 0x1f, 0xc5, 0x52, 0x92, 0x69, 0x16, 0x2e, 0x50, 0xb6, 0xbe, 0x40, 0xd9,
-	0x85, 0xbb, 0x40, 0xc9, 0xf1, 0xad, 0x45, 0x75, 0xf6, 0x74, 0x6f, 0x31, 0xf0, 0xc6, 0xe7, 0x7f,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0x4e, 0xdb, 0x96, 0xaa, 0xa2, 0x03, 0x00, 0x00,
+	// 561 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x5b, 0x4f, 0xd4, 0x40,
+	0x14, 0xc7, 0xe9, 0x2e, 0xbb, 0xec, 0x1e, 0x10, 0xcc, 0x08, 0x9b, 0xb1, 0x10, 0xb3, 0x36, 0x5e,
+	0xf6, 0xa9, 0x18, 0x8c, 0x17, 0x7c, 0xd2, 0x28, 0x21, 0x24, 0x92, 0x98, 0xd1, 0x27, 0x5f, 0xcc,
+	0xd0, 0x1e, 0x6a, 0xa5, 0x3b, 0x53, 0xe6, 0xa2, 0xe9, 0x57, 0xf5, 0x3b, 0xf8, 0x1d, 0x4c, 0xa7,
+	0xd3, 0xb2, 0xca, 0x12, 0xde, 0xe6, 0xff, 0xef, 0x39, 0xd3, 0xdf, 0x9c, 0x0b, 0x6c, 0x16, 0x5c,
+	0x64, 0x96, 0x67, 0x18, 0x97, 0x4a, 0x1a, 0x49, 0xc6, 0xa5, 0x2d, 0xec, 0x3c, 0x57, 0x65, 0x12,
+	0x6e, 0x94, 0x85, 0xcd, 0x72, 0xd1, 0x7c, 0x08, 0x77, 0x33, 0x29, 0xb3, 0x02, 0xf7, 0x9d, 0x3a,
+	0xb3, 0xe7, 0xfb, 0x38, 0x2f, 0x4d, 0xd5, 0x7c, 0x8c, 0x38, 0xdc, 0x3f, 0x46, 0xc3, 0xf0, 0xd2,
+	0xe6, 0x0a, 0xd3, 0x4f, 0x2e, 0x4f, 0xd7, 0x12, 0xb5, 0x21, 0x14, 0xd6, 0x4a, 0x25, 0x7f, 0x60,
+	0x62, 0x68, 0x30, 0x0d, 0x66, 0x63, 0xd6, 0x4a, 0x72, 0x17, 0xfa, 0xe5, 0xaf, 0x94, 0xf6, 0x9c,
+	0x5b, 0x1f, 0x7d, 0x6c, 0xa6, 0xf8, 0x9c, 0xf6, 0xbb, 0xd8, 0x5a, 0x46, 0x9f, 0x21, 0x5c, 0xf6,
+	0x0b, 0x5d, 0x4a, 0xa1, 0x91, 0xbc, 0x80, 0xb5, 0x86, 0x56, 0xd3, 0x60, 0xda, 0x9f, 0xad, 0x1f,
+	0xec, 0xc6, 0xdd, 0x43, 0xe2, 0x26, 0xf8, 0x03, 0x96, 0x28, 0x52, 0x14, 0x49, 0xc5, 0xda, 0xd8,
+	0xe8, 0x4f, 0x0f, 0x80, 0x59, 0x71, 0x3b, 0xe9, 0x36, 0x0c, 0xb4, 0xe1, 0xc9, 0x85, 0x67, 0x6d,
+	0x44, 0xcb, 0xdf, 0x5f, 0xca, 0xbf, 0xfa, 0x0f, 0x3f, 0x21, 0xb0, 0xca, 0x55, 0xa6, 0xe9, 0x60,
+	0xda, 0x9f, 0x8d, 0x99, 0x3b, 0x93, 0x43, 0x18, 0x26, 0x52, 0x9c, 0xe7, 0x19, 0x1d, 0x3a, 0xe8,
+	0x87, 0x0b, 0xd0, 0x57, 0x58, 0xf1, 0x7b, 0x17, 0x73, 0x24, 0x8c, 0xaa, 0x98, 0x4f, 0x20, 0x13,
+	0x18, 0xa6, 0xaa, 0x62, 0x56, 0xd0, 0xb5, 0x69, 0x30, 0x1b, 0x31, 0xaf, 0x48, 0x08, 0xa3, 0x92,
+	0x2b, 0x5e, 0x14, 0x58, 0xd0, 0xd1, 0x34, 0x98, 0x0d, 0x58, 0xa7, 0xc9, 0x53, 0xd8, 0x9a, 0x4b,
+	0x91, 0x1b, 0xa9, 0xbe, 0xf1, 0x34, 0x55, 0xa8, 0x35, 0x1d, 0x3b, 0xc8, 0x4d, 0x6f, 0xbf, 0x6b,
+	0x5c, 0xb2, 0x07, 0xe3, 0x4b, 0x8b, 0xaa, 0x3a, 0x95, 0x29, 0x52, 0x70, 0xf7, 0x5f, 0x19, 0xe1,
+	0x21, 0xac, 0x2f, 0x10, 0xd5, 0x45, 0xb8, 0xc0, 0xca, 0x17, 0xac, 0x3e, 0xd6, 0xc5, 0xfa, 0xc9,
+	0x0b, 0x8b, 0x6d, 0xb1, 0x9c, 0x78, 0xd3, 0x7b, 0x1d, 0x44, 0xaf, 0x60, 0xdd, 0xbd, 0xcb, 0x77,
+	0x6d, 0x1b, 0x06, 0xa8, 0x94, 0x54, 0x3e, 0xb9, 0x11, 0x75, 0xa5, 0xce, 0x78, 0x5e, 0xb8, 0xec,
+	0x11, 0x73, 0xe7, 0xe8, 0x2b, 0x84, 0x27, 0x42, 0x1b, 0x5e, 0x14, 0x5d, 0x1b, 0x73, 0xec, 0x26,
+	0x6c, 0x0f, 0xc6, 0x69, 0xae, 0x30, 0x31, 0x52, 0xb5, 0x20, 0x57, 0x06, 0x79, 0x00, 0x90, 0xeb,
+	0x2f, 0xa8, 0xe6, 0xb9, 0xe0, 0xed, 0xad, 0x0b, 0x4e, 0x74, 0x0a, 0xbb, 0x4b, 0xef, 0xf6, 0x90,
+	0x13, 0x18, 0x6a, 0x93, 0x4a, 0xdb, 0xcc, 0xc4, 0x06, 0xf3, 0xca, 0xfb, 0xa8, 0x14, 0xed, 0x75,
+	0x3e, 0x2a, 0x75, 0xf0, 0xbb, 0x07, 0x5b, 0x1f, 0xfd, 0x52, 0x31, 0x2b, 0x4c, 0x3e, 0x47, 0x92,
+	0x00, 0xb9, 0x3e, 0xbc, 0xe4, 0xd1, 0x42, 0xbb, 0x6f, 0x5c, 0x9f, 0xf0, 0xf1, 0x2d, 0x51, 0x0d,
+	0x66, 0xb4, 0x42, 0x5e, 0x42, 0xbf, 0x9e, 0x80, 0x9d, 0xa5, 0x43, 0x14, 0x4e, 0xfe, 0xb7, 0xbb,
+	0xbc, 0xb7, 0x70, 0xe7, 0x18, 0x4d, 0x73, 0xdf, 0x89, 0x38, 0x97, 0x64, 0x12, 0x37, 0xbb, 0x1e,
+	0xb7, 0xbb, 0x1e, 0x1f, 0xd5, 0xbb, 0x1e, 0xee, 0x5c, 0xdb, 0xa9, 0x3a, 0x3c, 0x5a, 0x21, 0xdf,
+	0xe1, 0xde, 0x92, 0x0a, 0x92, 0x45, 0xf2, 0x9b, 0xbb, 0x17, 0x3e, 0xb9, 0x2d, 0xac, 0x25, 0x7d,
+	0x16, 0x9c, 0x0d, 0x1d, 0xd2, 0xf3, 0xbf, 0x03, 0x00, 0x25, 0x4c, 0xb4, 0x69, 0xb7, 0x04, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -345,6 +449,9 @@ type LanguageRuntimeClient interface {
 	Run(ctx context.Context, in *RunRequest, opts ...grpc.CallOption) (*RunResponse, error)
 	// GetPluginInfo returns generic information about this plugin, like its version.
 	GetPluginInfo(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*PluginInfo, error)
+	// InstallDependencies installs the dependencies of the program in the given directory, streaming the output of
+	// the installation back to the caller.
+	InstallDependencies(ctx context.Context, in *InstallDependenciesRequest, opts ...grpc.CallOption) (LanguageRuntime_InstallDependenciesClient, error)
 }
 
 type languageRuntimeClient struct {
@@ -382,6 +489,38 @@ func (c *languageRuntimeClient) GetPluginInfo(ctx context.Context, in *empty.Emp
 	return out, nil
 }
 
+func (c *languageRuntimeClient) InstallDependencies(ctx context.Context, in *InstallDependenciesRequest, opts ...grpc.CallOption) (LanguageRuntime_InstallDependenciesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_LanguageRuntime_serviceDesc.Streams[0], "/pulumirpc.LanguageRuntime/InstallDependencies", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &languageRuntimeInstallDependenciesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type LanguageRuntime_InstallDependenciesClient interface {
+	Recv() (*InstallDependenciesResponse, error)
+	grpc.ClientStream
+}
+
+type languageRuntimeInstallDependenciesClient struct {
+	grpc.ClientStream
+}
+
+func (x *languageRuntimeInstallDependenciesClient) Recv() (*InstallDependenciesResponse, error) {
+	m := new(InstallDependenciesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // LanguageRuntimeServer is the server API for LanguageRuntime service.
 type LanguageRuntimeServer interface {
 	// GetRequiredPlugins computes the complete set of anticipated plugins required by a program.
@@ -390,6 +529,9 @@ type LanguageRuntimeServer interface {
 	Run(context.Context, *RunRequest) (*RunResponse, error)
 	// GetPluginInfo returns generic information about this plugin, like its version.
 	GetPluginInfo(context.Context, *empty.Empty) (*PluginInfo, error)
+	// InstallDependencies installs the dependencies of the program in the given directory, streaming the output of
+	// the installation back to the caller.
+	InstallDependencies(*InstallDependenciesRequest, LanguageRuntime_InstallDependenciesServer) error
 }
 
 // UnimplementedLanguageRuntimeServer can be embedded to have forward compatible implementations.
@@ -405,6 +547,9 @@ func (*UnimplementedLanguageRuntimeServer) Run(ctx context.Context, req *RunRequ
 func (*UnimplementedLanguageRuntimeServer) GetPluginInfo(ctx context.Context, req *empty.Empty) (*PluginInfo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPluginInfo not implemented")
 }
+func (*UnimplementedLanguageRuntimeServer) InstallDependencies(req *InstallDependenciesRequest, srv LanguageRuntime_InstallDependenciesServer) error {
+	return status.Errorf(codes.Unimplemented, "method InstallDependencies not implemented")
+}
 
 func RegisterLanguageRuntimeServer(s *grpc.Server, srv LanguageRuntimeServer) {
 	s.RegisterService(&_LanguageRuntime_serviceDesc, srv)
@@ -464,6 +609,27 @@ func _LanguageRuntime_GetPluginInfo_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LanguageRuntime_InstallDependencies_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(InstallDependenciesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LanguageRuntimeServer).InstallDependencies(m, &languageRuntimeInstallDependenciesServer{stream})
+}
+
+type LanguageRuntime_InstallDependenciesServer interface {
+	Send(*InstallDependenciesResponse) error
+	grpc.ServerStream
+}
+
+type languageRuntimeInstallDependenciesServer struct {
+	grpc.ServerStream
+}
+
+func (x *languageRuntimeInstallDependenciesServer) Send(m *InstallDependenciesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _LanguageRuntime_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "pulumirpc.LanguageRuntime",
 	HandlerType: (*LanguageRuntimeServer)(nil),
@@ -481,6 +647,12 @@ var _LanguageRuntime_serviceDesc = grpc.ServiceDesc{
 			Handler:    _LanguageRuntime_GetPluginInfo_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "InstallDependencies",
+			Handler:       _LanguageRuntime_InstallDependencies_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "language.proto",
 }
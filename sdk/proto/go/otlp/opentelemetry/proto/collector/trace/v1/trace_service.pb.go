@@ -0,0 +1,269 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: opentelemetry/proto/collector/trace/v1/trace_service.proto
+
+package v1
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	v1 "github.com/pulumi/pulumi/sdk/v2/proto/go/otlp/opentelemetry/proto/trace/v1"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type ExportTraceServiceRequest struct {
+	ResourceSpans        []*v1.ResourceSpans `protobuf:"bytes,1,rep,name=resource_spans,json=resourceSpans,proto3" json:"resource_spans,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ExportTraceServiceRequest) Reset()         { *m = ExportTraceServiceRequest{} }
+func (m *ExportTraceServiceRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportTraceServiceRequest) ProtoMessage()    {}
+func (*ExportTraceServiceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_192a962890318cf4, []int{0}
+}
+
+func (m *ExportTraceServiceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportTraceServiceRequest.Unmarshal(m, b)
+}
+func (m *ExportTraceServiceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportTraceServiceRequest.Marshal(b, m, deterministic)
+}
+func (m *ExportTraceServiceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportTraceServiceRequest.Merge(m, src)
+}
+func (m *ExportTraceServiceRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportTraceServiceRequest.Size(m)
+}
+func (m *ExportTraceServiceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportTraceServiceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportTraceServiceRequest proto.InternalMessageInfo
+
+func (m *ExportTraceServiceRequest) GetResourceSpans() []*v1.ResourceSpans {
+	if m != nil {
+		return m.ResourceSpans
+	}
+	return nil
+}
+
+type ExportTraceServiceResponse struct {
+	PartialSuccess       *ExportTracePartialSuccess `protobuf:"bytes,1,opt,name=partial_success,json=partialSuccess,proto3" json:"partial_success,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *ExportTraceServiceResponse) Reset()         { *m = ExportTraceServiceResponse{} }
+func (m *ExportTraceServiceResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportTraceServiceResponse) ProtoMessage()    {}
+func (*ExportTraceServiceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_192a962890318cf4, []int{1}
+}
+
+func (m *ExportTraceServiceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportTraceServiceResponse.Unmarshal(m, b)
+}
+func (m *ExportTraceServiceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportTraceServiceResponse.Marshal(b, m, deterministic)
+}
+func (m *ExportTraceServiceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportTraceServiceResponse.Merge(m, src)
+}
+func (m *ExportTraceServiceResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportTraceServiceResponse.Size(m)
+}
+func (m *ExportTraceServiceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportTraceServiceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportTraceServiceResponse proto.InternalMessageInfo
+
+func (m *ExportTraceServiceResponse) GetPartialSuccess() *ExportTracePartialSuccess {
+	if m != nil {
+		return m.PartialSuccess
+	}
+	return nil
+}
+
+type ExportTracePartialSuccess struct {
+	RejectedSpans        int64    `protobuf:"varint,1,opt,name=rejected_spans,json=rejectedSpans,proto3" json:"rejected_spans,omitempty"`
+	ErrorMessage         string   `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportTracePartialSuccess) Reset()         { *m = ExportTracePartialSuccess{} }
+func (m *ExportTracePartialSuccess) String() string { return proto.CompactTextString(m) }
+func (*ExportTracePartialSuccess) ProtoMessage()    {}
+func (*ExportTracePartialSuccess) Descriptor() ([]byte, []int) {
+	return fileDescriptor_192a962890318cf4, []int{2}
+}
+
+func (m *ExportTracePartialSuccess) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportTracePartialSuccess.Unmarshal(m, b)
+}
+func (m *ExportTracePartialSuccess) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportTracePartialSuccess.Marshal(b, m, deterministic)
+}
+func (m *ExportTracePartialSuccess) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportTracePartialSuccess.Merge(m, src)
+}
+func (m *ExportTracePartialSuccess) XXX_Size() int {
+	return xxx_messageInfo_ExportTracePartialSuccess.Size(m)
+}
+func (m *ExportTracePartialSuccess) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportTracePartialSuccess.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportTracePartialSuccess proto.InternalMessageInfo
+
+func (m *ExportTracePartialSuccess) GetRejectedSpans() int64 {
+	if m != nil {
+		return m.RejectedSpans
+	}
+	return 0
+}
+
+func (m *ExportTracePartialSuccess) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ExportTraceServiceRequest)(nil), "opentelemetry.proto.collector.trace.v1.ExportTraceServiceRequest")
+	proto.RegisterType((*ExportTraceServiceResponse)(nil), "opentelemetry.proto.collector.trace.v1.ExportTraceServiceResponse")
+	proto.RegisterType((*ExportTracePartialSuccess)(nil), "opentelemetry.proto.collector.trace.v1.ExportTracePartialSuccess")
+}
+
+func init() {
+	proto.RegisterFile("opentelemetry/proto/collector/trace/v1/trace_service.proto", fileDescriptor_192a962890318cf4)
+}
+
+var fileDescriptor_192a962890318cf4 = []byte{
+	// 371 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x4d, 0x4b, 0xfb, 0x30,
+	0x18, 0xff, 0x67, 0x83, 0xc1, 0x3f, 0x7b, 0x11, 0x7b, 0xda, 0x76, 0x1a, 0x15, 0x47, 0x45, 0x48,
+	0x58, 0xbd, 0x79, 0x73, 0xe2, 0x51, 0x1c, 0xdd, 0xf0, 0xe0, 0x65, 0x74, 0xd9, 0x43, 0xed, 0x6c,
+	0x9b, 0x98, 0xa4, 0x45, 0xbf, 0x81, 0x57, 0xbf, 0x82, 0x47, 0xbf, 0x84, 0x5f, 0x4d, 0xda, 0x6c,
+	0xa5, 0x85, 0x09, 0x43, 0x4f, 0xed, 0xf3, 0xe3, 0xf9, 0xbd, 0xb5, 0x09, 0xbe, 0xe4, 0x02, 0x12,
+	0x0d, 0x11, 0xc4, 0xa0, 0xe5, 0x2b, 0x15, 0x92, 0x6b, 0x4e, 0x19, 0x8f, 0x22, 0x60, 0x9a, 0x4b,
+	0xaa, 0xa5, 0xcf, 0x80, 0x66, 0x13, 0xf3, 0xb2, 0x54, 0x20, 0xb3, 0x90, 0x01, 0x29, 0xd6, 0xac,
+	0x71, 0x8d, 0x6b, 0x40, 0x52, 0x72, 0x49, 0x41, 0x21, 0xd9, 0x64, 0xe8, 0xec, 0xf3, 0xa8, 0x2b,
+	0x1b, 0xb2, 0xcd, 0xf1, 0xe0, 0xe6, 0x45, 0x70, 0xa9, 0x17, 0x39, 0x38, 0x37, 0x6e, 0x1e, 0x3c,
+	0xa7, 0xa0, 0xb4, 0xe5, 0xe1, 0x9e, 0x04, 0xc5, 0x53, 0x99, 0x07, 0x11, 0x7e, 0xa2, 0xfa, 0x68,
+	0xd4, 0x74, 0xda, 0xee, 0x39, 0xd9, 0x97, 0x63, 0xe7, 0x4e, 0xbc, 0x2d, 0x67, 0x9e, 0x53, 0xbc,
+	0xae, 0xac, 0x8e, 0xf6, 0x1b, 0xc2, 0xc3, 0x7d, 0x8e, 0x4a, 0xf0, 0x44, 0x81, 0xb5, 0xc1, 0x47,
+	0xc2, 0x97, 0x3a, 0xf4, 0xa3, 0xa5, 0x4a, 0x19, 0x03, 0x95, 0x7b, 0x22, 0xa7, 0xed, 0x5e, 0x91,
+	0xc3, 0xba, 0x93, 0x8a, 0xf8, 0xcc, 0x28, 0xcd, 0x8d, 0x90, 0xd7, 0x13, 0xb5, 0xd9, 0x0e, 0xf0,
+	0xe0, 0xc7, 0x65, 0xeb, 0x34, 0xef, 0xbe, 0x01, 0xa6, 0x61, 0x5d, 0x76, 0x47, 0x4e, 0xd3, 0xeb,
+	0xee, 0xd0, 0xa2, 0x8e, 0x75, 0x82, 0xbb, 0x20, 0x25, 0x97, 0xcb, 0x18, 0x94, 0xf2, 0x03, 0xe8,
+	0x37, 0x46, 0xc8, 0xf9, 0xef, 0x75, 0x0a, 0xf0, 0xd6, 0x60, 0xee, 0x07, 0xc2, 0x9d, 0x6a, 0x5b,
+	0xeb, 0x1d, 0xe1, 0x96, 0xb1, 0xb6, 0x7e, 0xd3, 0xab, 0xfe, 0x9b, 0x86, 0xd3, 0xbf, 0x48, 0x98,
+	0xef, 0x6e, 0xff, 0x9b, 0x7e, 0x21, 0x7c, 0x16, 0xf2, 0x03, 0xa5, 0xa6, 0xc7, 0x55, 0x95, 0x59,
+	0xbe, 0x35, 0x43, 0x0f, 0x8b, 0x20, 0xd4, 0x8f, 0xe9, 0x8a, 0x30, 0x1e, 0x53, 0x91, 0x46, 0x69,
+	0x1c, 0xee, 0x1e, 0x6a, 0xfd, 0x44, 0x33, 0x77, 0x7b, 0x10, 0x03, 0x4e, 0xb9, 0x8e, 0x04, 0x3d,
+	0xec, 0x22, 0x7c, 0x36, 0xc6, 0x77, 0x02, 0x92, 0x45, 0x99, 0xa9, 0x70, 0x23, 0xd7, 0x65, 0xa6,
+	0x22, 0x09, 0xb9, 0x9f, 0xac, 0x5a, 0x85, 0xc4, 0xc5, 0xf7, 0x00, 0x9b, 0x3a, 0x29, 0x6e, 0x62,
+	0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// TraceServiceClient is the client API for TraceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type TraceServiceClient interface {
+	Export(ctx context.Context, in *ExportTraceServiceRequest, opts ...grpc.CallOption) (*ExportTraceServiceResponse, error)
+}
+
+type traceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTraceServiceClient(cc grpc.ClientConnInterface) TraceServiceClient {
+	return &traceServiceClient{cc}
+}
+
+func (c *traceServiceClient) Export(ctx context.Context, in *ExportTraceServiceRequest, opts ...grpc.CallOption) (*ExportTraceServiceResponse, error) {
+	out := new(ExportTraceServiceResponse)
+	err := c.cc.Invoke(ctx, "/opentelemetry.proto.collector.trace.v1.TraceService/Export", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TraceServiceServer is the server API for TraceService service.
+type TraceServiceServer interface {
+	Export(context.Context, *ExportTraceServiceRequest) (*ExportTraceServiceResponse, error)
+}
+
+// UnimplementedTraceServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedTraceServiceServer struct {
+}
+
+func (*UnimplementedTraceServiceServer) Export(ctx context.Context, req *ExportTraceServiceRequest) (*ExportTraceServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+
+func RegisterTraceServiceServer(s *grpc.Server, srv TraceServiceServer) {
+	s.RegisterService(&_TraceService_serviceDesc, srv)
+}
+
+func _TraceService_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportTraceServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TraceServiceServer).Export(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/opentelemetry.proto.collector.trace.v1.TraceService/Export",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TraceServiceServer).Export(ctx, req.(*ExportTraceServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _TraceService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "opentelemetry.proto.collector.trace.v1.TraceService",
+	HandlerType: (*TraceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Export",
+			Handler:    _TraceService_Export_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "opentelemetry/proto/collector/trace/v1/trace_service.proto",
+}
@@ -0,0 +1,646 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: opentelemetry/proto/trace/v1/trace.proto
+
+package v1
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	v11 "github.com/pulumi/pulumi/sdk/v2/proto/go/otlp/opentelemetry/proto/common/v1"
+	v1 "github.com/pulumi/pulumi/sdk/v2/proto/go/otlp/opentelemetry/proto/resource/v1"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type Span_SpanKind int32
+
+const (
+	Span_SPAN_KIND_UNSPECIFIED Span_SpanKind = 0
+	Span_SPAN_KIND_INTERNAL    Span_SpanKind = 1
+	Span_SPAN_KIND_SERVER      Span_SpanKind = 2
+	Span_SPAN_KIND_CLIENT      Span_SpanKind = 3
+	Span_SPAN_KIND_PRODUCER    Span_SpanKind = 4
+	Span_SPAN_KIND_CONSUMER    Span_SpanKind = 5
+)
+
+var Span_SpanKind_name = map[int32]string{
+	0: "SPAN_KIND_UNSPECIFIED",
+	1: "SPAN_KIND_INTERNAL",
+	2: "SPAN_KIND_SERVER",
+	3: "SPAN_KIND_CLIENT",
+	4: "SPAN_KIND_PRODUCER",
+	5: "SPAN_KIND_CONSUMER",
+}
+
+var Span_SpanKind_value = map[string]int32{
+	"SPAN_KIND_UNSPECIFIED": 0,
+	"SPAN_KIND_INTERNAL":    1,
+	"SPAN_KIND_SERVER":      2,
+	"SPAN_KIND_CLIENT":      3,
+	"SPAN_KIND_PRODUCER":    4,
+	"SPAN_KIND_CONSUMER":    5,
+}
+
+func (x Span_SpanKind) String() string {
+	return proto.EnumName(Span_SpanKind_name, int32(x))
+}
+
+func (Span_SpanKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5c407ac9c675a601, []int{3, 0}
+}
+
+type Status_StatusCode int32
+
+const (
+	Status_STATUS_CODE_UNSET Status_StatusCode = 0
+	Status_STATUS_CODE_OK    Status_StatusCode = 1
+	Status_STATUS_CODE_ERROR Status_StatusCode = 2
+)
+
+var Status_StatusCode_name = map[int32]string{
+	0: "STATUS_CODE_UNSET",
+	1: "STATUS_CODE_OK",
+	2: "STATUS_CODE_ERROR",
+}
+
+var Status_StatusCode_value = map[string]int32{
+	"STATUS_CODE_UNSET": 0,
+	"STATUS_CODE_OK":    1,
+	"STATUS_CODE_ERROR": 2,
+}
+
+func (x Status_StatusCode) String() string {
+	return proto.EnumName(Status_StatusCode_name, int32(x))
+}
+
+func (Status_StatusCode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5c407ac9c675a601, []int{4, 0}
+}
+
+type TracesData struct {
+	ResourceSpans        []*ResourceSpans `protobuf:"bytes,1,rep,name=resource_spans,json=resourceSpans,proto3" json:"resource_spans,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *TracesData) Reset()         { *m = TracesData{} }
+func (m *TracesData) String() string { return proto.CompactTextString(m) }
+func (*TracesData) ProtoMessage()    {}
+func (*TracesData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c407ac9c675a601, []int{0}
+}
+
+func (m *TracesData) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TracesData.Unmarshal(m, b)
+}
+func (m *TracesData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TracesData.Marshal(b, m, deterministic)
+}
+func (m *TracesData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TracesData.Merge(m, src)
+}
+func (m *TracesData) XXX_Size() int {
+	return xxx_messageInfo_TracesData.Size(m)
+}
+func (m *TracesData) XXX_DiscardUnknown() {
+	xxx_messageInfo_TracesData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TracesData proto.InternalMessageInfo
+
+func (m *TracesData) GetResourceSpans() []*ResourceSpans {
+	if m != nil {
+		return m.ResourceSpans
+	}
+	return nil
+}
+
+type ResourceSpans struct {
+	Resource             *v1.Resource  `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	ScopeSpans           []*ScopeSpans `protobuf:"bytes,2,rep,name=scope_spans,json=scopeSpans,proto3" json:"scope_spans,omitempty"`
+	SchemaUrl            string        `protobuf:"bytes,3,opt,name=schema_url,json=schemaUrl,proto3" json:"schema_url,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ResourceSpans) Reset()         { *m = ResourceSpans{} }
+func (m *ResourceSpans) String() string { return proto.CompactTextString(m) }
+func (*ResourceSpans) ProtoMessage()    {}
+func (*ResourceSpans) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c407ac9c675a601, []int{1}
+}
+
+func (m *ResourceSpans) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResourceSpans.Unmarshal(m, b)
+}
+func (m *ResourceSpans) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResourceSpans.Marshal(b, m, deterministic)
+}
+func (m *ResourceSpans) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceSpans.Merge(m, src)
+}
+func (m *ResourceSpans) XXX_Size() int {
+	return xxx_messageInfo_ResourceSpans.Size(m)
+}
+func (m *ResourceSpans) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceSpans.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceSpans proto.InternalMessageInfo
+
+func (m *ResourceSpans) GetResource() *v1.Resource {
+	if m != nil {
+		return m.Resource
+	}
+	return nil
+}
+
+func (m *ResourceSpans) GetScopeSpans() []*ScopeSpans {
+	if m != nil {
+		return m.ScopeSpans
+	}
+	return nil
+}
+
+func (m *ResourceSpans) GetSchemaUrl() string {
+	if m != nil {
+		return m.SchemaUrl
+	}
+	return ""
+}
+
+type ScopeSpans struct {
+	Scope                *v11.InstrumentationScope `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`
+	Spans                []*Span                   `protobuf:"bytes,2,rep,name=spans,proto3" json:"spans,omitempty"`
+	SchemaUrl            string                    `protobuf:"bytes,3,opt,name=schema_url,json=schemaUrl,proto3" json:"schema_url,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *ScopeSpans) Reset()         { *m = ScopeSpans{} }
+func (m *ScopeSpans) String() string { return proto.CompactTextString(m) }
+func (*ScopeSpans) ProtoMessage()    {}
+func (*ScopeSpans) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c407ac9c675a601, []int{2}
+}
+
+func (m *ScopeSpans) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScopeSpans.Unmarshal(m, b)
+}
+func (m *ScopeSpans) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScopeSpans.Marshal(b, m, deterministic)
+}
+func (m *ScopeSpans) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeSpans.Merge(m, src)
+}
+func (m *ScopeSpans) XXX_Size() int {
+	return xxx_messageInfo_ScopeSpans.Size(m)
+}
+func (m *ScopeSpans) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeSpans.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScopeSpans proto.InternalMessageInfo
+
+func (m *ScopeSpans) GetScope() *v11.InstrumentationScope {
+	if m != nil {
+		return m.Scope
+	}
+	return nil
+}
+
+func (m *ScopeSpans) GetSpans() []*Span {
+	if m != nil {
+		return m.Spans
+	}
+	return nil
+}
+
+func (m *ScopeSpans) GetSchemaUrl() string {
+	if m != nil {
+		return m.SchemaUrl
+	}
+	return ""
+}
+
+type Span struct {
+	TraceId                []byte          `protobuf:"bytes,1,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
+	SpanId                 []byte          `protobuf:"bytes,2,opt,name=span_id,json=spanId,proto3" json:"span_id,omitempty"`
+	TraceState             string          `protobuf:"bytes,3,opt,name=trace_state,json=traceState,proto3" json:"trace_state,omitempty"`
+	ParentSpanId           []byte          `protobuf:"bytes,4,opt,name=parent_span_id,json=parentSpanId,proto3" json:"parent_span_id,omitempty"`
+	Name                   string          `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Kind                   Span_SpanKind   `protobuf:"varint,6,opt,name=kind,proto3,enum=opentelemetry.proto.trace.v1.Span_SpanKind" json:"kind,omitempty"`
+	StartTimeUnixNano      uint64          `protobuf:"fixed64,7,opt,name=start_time_unix_nano,json=startTimeUnixNano,proto3" json:"start_time_unix_nano,omitempty"`
+	EndTimeUnixNano        uint64          `protobuf:"fixed64,8,opt,name=end_time_unix_nano,json=endTimeUnixNano,proto3" json:"end_time_unix_nano,omitempty"`
+	Attributes             []*v11.KeyValue `protobuf:"bytes,9,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	DroppedAttributesCount uint32          `protobuf:"varint,10,opt,name=dropped_attributes_count,json=droppedAttributesCount,proto3" json:"dropped_attributes_count,omitempty"`
+	Events                 []*Span_Event   `protobuf:"bytes,11,rep,name=events,proto3" json:"events,omitempty"`
+	DroppedEventsCount     uint32          `protobuf:"varint,12,opt,name=dropped_events_count,json=droppedEventsCount,proto3" json:"dropped_events_count,omitempty"`
+	Links                  []*Span_Link    `protobuf:"bytes,13,rep,name=links,proto3" json:"links,omitempty"`
+	DroppedLinksCount      uint32          `protobuf:"varint,14,opt,name=dropped_links_count,json=droppedLinksCount,proto3" json:"dropped_links_count,omitempty"`
+	Status                 *Status         `protobuf:"bytes,15,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}        `json:"-"`
+	XXX_unrecognized       []byte          `json:"-"`
+	XXX_sizecache          int32           `json:"-"`
+}
+
+func (m *Span) Reset()         { *m = Span{} }
+func (m *Span) String() string { return proto.CompactTextString(m) }
+func (*Span) ProtoMessage()    {}
+func (*Span) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c407ac9c675a601, []int{3}
+}
+
+func (m *Span) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Span.Unmarshal(m, b)
+}
+func (m *Span) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Span.Marshal(b, m, deterministic)
+}
+func (m *Span) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Span.Merge(m, src)
+}
+func (m *Span) XXX_Size() int {
+	return xxx_messageInfo_Span.Size(m)
+}
+func (m *Span) XXX_DiscardUnknown() {
+	xxx_messageInfo_Span.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Span proto.InternalMessageInfo
+
+func (m *Span) GetTraceId() []byte {
+	if m != nil {
+		return m.TraceId
+	}
+	return nil
+}
+
+func (m *Span) GetSpanId() []byte {
+	if m != nil {
+		return m.SpanId
+	}
+	return nil
+}
+
+func (m *Span) GetTraceState() string {
+	if m != nil {
+		return m.TraceState
+	}
+	return ""
+}
+
+func (m *Span) GetParentSpanId() []byte {
+	if m != nil {
+		return m.ParentSpanId
+	}
+	return nil
+}
+
+func (m *Span) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Span) GetKind() Span_SpanKind {
+	if m != nil {
+		return m.Kind
+	}
+	return Span_SPAN_KIND_UNSPECIFIED
+}
+
+func (m *Span) GetStartTimeUnixNano() uint64 {
+	if m != nil {
+		return m.StartTimeUnixNano
+	}
+	return 0
+}
+
+func (m *Span) GetEndTimeUnixNano() uint64 {
+	if m != nil {
+		return m.EndTimeUnixNano
+	}
+	return 0
+}
+
+func (m *Span) GetAttributes() []*v11.KeyValue {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+func (m *Span) GetDroppedAttributesCount() uint32 {
+	if m != nil {
+		return m.DroppedAttributesCount
+	}
+	return 0
+}
+
+func (m *Span) GetEvents() []*Span_Event {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *Span) GetDroppedEventsCount() uint32 {
+	if m != nil {
+		return m.DroppedEventsCount
+	}
+	return 0
+}
+
+func (m *Span) GetLinks() []*Span_Link {
+	if m != nil {
+		return m.Links
+	}
+	return nil
+}
+
+func (m *Span) GetDroppedLinksCount() uint32 {
+	if m != nil {
+		return m.DroppedLinksCount
+	}
+	return 0
+}
+
+func (m *Span) GetStatus() *Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+type Span_Event struct {
+	TimeUnixNano           uint64          `protobuf:"fixed64,1,opt,name=time_unix_nano,json=timeUnixNano,proto3" json:"time_unix_nano,omitempty"`
+	Name                   string          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Attributes             []*v11.KeyValue `protobuf:"bytes,3,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	DroppedAttributesCount uint32          `protobuf:"varint,4,opt,name=dropped_attributes_count,json=droppedAttributesCount,proto3" json:"dropped_attributes_count,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}        `json:"-"`
+	XXX_unrecognized       []byte          `json:"-"`
+	XXX_sizecache          int32           `json:"-"`
+}
+
+func (m *Span_Event) Reset()         { *m = Span_Event{} }
+func (m *Span_Event) String() string { return proto.CompactTextString(m) }
+func (*Span_Event) ProtoMessage()    {}
+func (*Span_Event) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c407ac9c675a601, []int{3, 0}
+}
+
+func (m *Span_Event) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Span_Event.Unmarshal(m, b)
+}
+func (m *Span_Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Span_Event.Marshal(b, m, deterministic)
+}
+func (m *Span_Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Span_Event.Merge(m, src)
+}
+func (m *Span_Event) XXX_Size() int {
+	return xxx_messageInfo_Span_Event.Size(m)
+}
+func (m *Span_Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_Span_Event.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Span_Event proto.InternalMessageInfo
+
+func (m *Span_Event) GetTimeUnixNano() uint64 {
+	if m != nil {
+		return m.TimeUnixNano
+	}
+	return 0
+}
+
+func (m *Span_Event) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Span_Event) GetAttributes() []*v11.KeyValue {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+func (m *Span_Event) GetDroppedAttributesCount() uint32 {
+	if m != nil {
+		return m.DroppedAttributesCount
+	}
+	return 0
+}
+
+type Span_Link struct {
+	TraceId                []byte          `protobuf:"bytes,1,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
+	SpanId                 []byte          `protobuf:"bytes,2,opt,name=span_id,json=spanId,proto3" json:"span_id,omitempty"`
+	TraceState             string          `protobuf:"bytes,3,opt,name=trace_state,json=traceState,proto3" json:"trace_state,omitempty"`
+	Attributes             []*v11.KeyValue `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	DroppedAttributesCount uint32          `protobuf:"varint,5,opt,name=dropped_attributes_count,json=droppedAttributesCount,proto3" json:"dropped_attributes_count,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}        `json:"-"`
+	XXX_unrecognized       []byte          `json:"-"`
+	XXX_sizecache          int32           `json:"-"`
+}
+
+func (m *Span_Link) Reset()         { *m = Span_Link{} }
+func (m *Span_Link) String() string { return proto.CompactTextString(m) }
+func (*Span_Link) ProtoMessage()    {}
+func (*Span_Link) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c407ac9c675a601, []int{3, 1}
+}
+
+func (m *Span_Link) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Span_Link.Unmarshal(m, b)
+}
+func (m *Span_Link) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Span_Link.Marshal(b, m, deterministic)
+}
+func (m *Span_Link) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Span_Link.Merge(m, src)
+}
+func (m *Span_Link) XXX_Size() int {
+	return xxx_messageInfo_Span_Link.Size(m)
+}
+func (m *Span_Link) XXX_DiscardUnknown() {
+	xxx_messageInfo_Span_Link.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Span_Link proto.InternalMessageInfo
+
+func (m *Span_Link) GetTraceId() []byte {
+	if m != nil {
+		return m.TraceId
+	}
+	return nil
+}
+
+func (m *Span_Link) GetSpanId() []byte {
+	if m != nil {
+		return m.SpanId
+	}
+	return nil
+}
+
+func (m *Span_Link) GetTraceState() string {
+	if m != nil {
+		return m.TraceState
+	}
+	return ""
+}
+
+func (m *Span_Link) GetAttributes() []*v11.KeyValue {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+func (m *Span_Link) GetDroppedAttributesCount() uint32 {
+	if m != nil {
+		return m.DroppedAttributesCount
+	}
+	return 0
+}
+
+type Status struct {
+	Message              string            `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Code                 Status_StatusCode `protobuf:"varint,3,opt,name=code,proto3,enum=opentelemetry.proto.trace.v1.Status_StatusCode" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *Status) Reset()         { *m = Status{} }
+func (m *Status) String() string { return proto.CompactTextString(m) }
+func (*Status) ProtoMessage()    {}
+func (*Status) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c407ac9c675a601, []int{4}
+}
+
+func (m *Status) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Status.Unmarshal(m, b)
+}
+func (m *Status) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Status.Marshal(b, m, deterministic)
+}
+func (m *Status) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Status.Merge(m, src)
+}
+func (m *Status) XXX_Size() int {
+	return xxx_messageInfo_Status.Size(m)
+}
+func (m *Status) XXX_DiscardUnknown() {
+	xxx_messageInfo_Status.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Status proto.InternalMessageInfo
+
+func (m *Status) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *Status) GetCode() Status_StatusCode {
+	if m != nil {
+		return m.Code
+	}
+	return Status_STATUS_CODE_UNSET
+}
+
+func init() {
+	proto.RegisterEnum("opentelemetry.proto.trace.v1.Span_SpanKind", Span_SpanKind_name, Span_SpanKind_value)
+	proto.RegisterEnum("opentelemetry.proto.trace.v1.Status_StatusCode", Status_StatusCode_name, Status_StatusCode_value)
+	proto.RegisterType((*TracesData)(nil), "opentelemetry.proto.trace.v1.TracesData")
+	proto.RegisterType((*ResourceSpans)(nil), "opentelemetry.proto.trace.v1.ResourceSpans")
+	proto.RegisterType((*ScopeSpans)(nil), "opentelemetry.proto.trace.v1.ScopeSpans")
+	proto.RegisterType((*Span)(nil), "opentelemetry.proto.trace.v1.Span")
+	proto.RegisterType((*Span_Event)(nil), "opentelemetry.proto.trace.v1.Span.Event")
+	proto.RegisterType((*Span_Link)(nil), "opentelemetry.proto.trace.v1.Span.Link")
+	proto.RegisterType((*Status)(nil), "opentelemetry.proto.trace.v1.Status")
+}
+
+func init() {
+	proto.RegisterFile("opentelemetry/proto/trace/v1/trace.proto", fileDescriptor_5c407ac9c675a601)
+}
+
+var fileDescriptor_5c407ac9c675a601 = []byte{
+	// 902 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0xce, 0xca, 0xd4, 0x4f, 0x46, 0xb6, 0x42, 0x6f, 0x9d, 0x94, 0x31, 0x52, 0x44, 0x10, 0x02,
+	0x54, 0x6d, 0x00, 0xa9, 0x76, 0x2e, 0x39, 0xb4, 0x68, 0x1d, 0x89, 0x2d, 0x68, 0xbb, 0x92, 0xb0,
+	0x94, 0x7c, 0xe8, 0x85, 0xa5, 0xc5, 0x85, 0x43, 0x58, 0x5c, 0x12, 0xdc, 0xa5, 0x90, 0x3c, 0x4a,
+	0x8b, 0x3e, 0x41, 0x4f, 0x7d, 0x81, 0xde, 0x7a, 0xe8, 0x53, 0xf4, 0xdc, 0xbe, 0x45, 0xb1, 0xbb,
+	0xa4, 0x24, 0x1a, 0x86, 0xec, 0x8b, 0x2f, 0xe2, 0xee, 0x37, 0xf3, 0x7d, 0xdf, 0xec, 0xce, 0x10,
+	0x22, 0x74, 0xe3, 0x84, 0x32, 0x41, 0x17, 0x34, 0xa2, 0x22, 0xfd, 0xd8, 0x4f, 0xd2, 0x58, 0xc4,
+	0x7d, 0x91, 0xfa, 0x73, 0xda, 0x5f, 0x1e, 0xe9, 0x45, 0x4f, 0x81, 0xf8, 0x45, 0x29, 0x53, 0x83,
+	0x3d, 0x9d, 0xb0, 0x3c, 0x3a, 0xfc, 0xf2, 0x36, 0x9d, 0x79, 0x1c, 0x45, 0x31, 0x93, 0x42, 0x7a,
+	0xa5, 0x49, 0x87, 0xbd, 0xdb, 0x72, 0x53, 0xca, 0xe3, 0x2c, 0xd5, 0xb6, 0xc5, 0x5a, 0xe7, 0x77,
+	0x7e, 0x06, 0x98, 0x4a, 0x1f, 0x3e, 0xf4, 0x85, 0x8f, 0x09, 0xb4, 0x8a, 0xb8, 0xc7, 0x13, 0x9f,
+	0x71, 0x0b, 0xb5, 0x77, 0xba, 0xcd, 0xe3, 0xd7, 0xbd, 0x6d, 0x05, 0xf6, 0x48, 0xce, 0x71, 0x25,
+	0x85, 0xec, 0xa5, 0x9b, 0xdb, 0xce, 0xdf, 0x08, 0xf6, 0x4a, 0x09, 0xd8, 0x86, 0x46, 0x91, 0x62,
+	0xa1, 0x36, 0xea, 0x36, 0x8f, 0xbf, 0xb8, 0x55, 0x7f, 0x55, 0xea, 0x86, 0x05, 0x59, 0x51, 0xb1,
+	0x03, 0x4d, 0x3e, 0x8f, 0x93, 0xa2, 0xd2, 0x8a, 0xaa, 0xb4, 0xbb, 0xbd, 0x52, 0x57, 0x12, 0x74,
+	0x99, 0xc0, 0x57, 0x6b, 0xfc, 0x19, 0x00, 0x9f, 0xbf, 0xa7, 0x91, 0xef, 0x65, 0xe9, 0xc2, 0xda,
+	0x69, 0xa3, 0xee, 0x63, 0xf2, 0x58, 0x23, 0xb3, 0x74, 0x71, 0x5a, 0x6b, 0xfc, 0x5b, 0x37, 0xff,
+	0xab, 0x77, 0xfe, 0x40, 0x00, 0x6b, 0x05, 0xec, 0x40, 0x55, 0x69, 0xe4, 0x87, 0x78, 0x73, 0xab,
+	0x75, 0xde, 0x9d, 0xe5, 0x51, 0xcf, 0x61, 0x5c, 0xa4, 0x59, 0x44, 0x99, 0xf0, 0x45, 0x18, 0x33,
+	0x25, 0x44, 0xb4, 0x02, 0x7e, 0x0b, 0xd5, 0xcd, 0x53, 0x74, 0xee, 0x38, 0x45, 0xe2, 0x33, 0x52,
+	0xe5, 0xf7, 0x28, 0xbd, 0xf3, 0x1b, 0x80, 0x21, 0xd3, 0xf1, 0x73, 0x68, 0x28, 0xbe, 0x17, 0x06,
+	0xaa, 0xde, 0x5d, 0x52, 0x57, 0x7b, 0x27, 0xc0, 0x9f, 0x42, 0x5d, 0x6a, 0xc9, 0x48, 0x45, 0x45,
+	0x6a, 0x72, 0xeb, 0x04, 0xf8, 0x25, 0x34, 0x35, 0x87, 0x0b, 0x5f, 0xd0, 0x5c, 0x1c, 0x14, 0xe4,
+	0x4a, 0x04, 0xbf, 0x82, 0x56, 0xe2, 0xa7, 0x94, 0x09, 0xaf, 0x10, 0x30, 0x94, 0xc0, 0xae, 0x46,
+	0x5d, 0x2d, 0x83, 0xc1, 0x60, 0x7e, 0x44, 0xad, 0xaa, 0xe2, 0xab, 0x35, 0xfe, 0x16, 0x8c, 0xeb,
+	0x90, 0x05, 0x56, 0xad, 0x8d, 0xba, 0xad, 0xbb, 0xe6, 0x4b, 0xea, 0xa8, 0x9f, 0xb3, 0x90, 0x05,
+	0x44, 0x11, 0x71, 0x1f, 0x0e, 0xb8, 0xf0, 0x53, 0xe1, 0x89, 0x30, 0xa2, 0x5e, 0xc6, 0xc2, 0x0f,
+	0x1e, 0xf3, 0x59, 0x6c, 0xd5, 0xdb, 0xa8, 0x5b, 0x23, 0xfb, 0x2a, 0x36, 0x0d, 0x23, 0x3a, 0x63,
+	0xe1, 0x87, 0x91, 0xcf, 0x62, 0xfc, 0x1a, 0x30, 0x65, 0xc1, 0xcd, 0xf4, 0x86, 0x4a, 0x7f, 0x42,
+	0x59, 0x50, 0x4a, 0xfe, 0x01, 0xc0, 0x17, 0x22, 0x0d, 0x2f, 0x33, 0x41, 0xb9, 0xf5, 0x58, 0x35,
+	0xe5, 0xf3, 0x3b, 0xfa, 0x7b, 0x46, 0x3f, 0x5e, 0xf8, 0x8b, 0x8c, 0x92, 0x0d, 0x2a, 0x7e, 0x0b,
+	0x56, 0x90, 0xc6, 0x49, 0x42, 0x03, 0x6f, 0x8d, 0x7a, 0xf3, 0x38, 0x63, 0xc2, 0x82, 0x36, 0xea,
+	0xee, 0x91, 0x67, 0x79, 0xfc, 0x64, 0x15, 0x1e, 0xc8, 0x28, 0xfe, 0x0e, 0x6a, 0x74, 0x49, 0x99,
+	0xe0, 0x56, 0xf3, 0x5e, 0x93, 0x2d, 0xef, 0xc8, 0x96, 0x04, 0x92, 0xf3, 0xf0, 0x57, 0x70, 0x50,
+	0x78, 0x6b, 0x24, 0xf7, 0xdd, 0x55, 0xbe, 0x38, 0x8f, 0x29, 0x4e, 0xee, 0xf9, 0x0d, 0x54, 0x17,
+	0x21, 0xbb, 0xe6, 0xd6, 0xde, 0x96, 0x13, 0x97, 0x2d, 0xcf, 0x43, 0x76, 0x4d, 0x34, 0x0b, 0xf7,
+	0xe0, 0x93, 0xc2, 0x50, 0x01, 0xb9, 0x5f, 0x4b, 0xf9, 0xed, 0xe7, 0x21, 0x49, 0xc8, 0xed, 0xbe,
+	0x86, 0x9a, 0x9c, 0xac, 0x8c, 0x5b, 0x4f, 0xd4, 0x1b, 0xf4, 0xea, 0x0e, 0x3f, 0x95, 0x4b, 0x72,
+	0xce, 0xe1, 0x5f, 0x08, 0xaa, 0xaa, 0x78, 0x39, 0x86, 0x37, 0xda, 0x8a, 0x54, 0x5b, 0x77, 0xc5,
+	0x66, 0x4f, 0x8b, 0x31, 0xac, 0x6c, 0x8c, 0x61, 0xb9, 0xcf, 0x3b, 0x0f, 0xd3, 0x67, 0x63, 0x5b,
+	0x9f, 0x0f, 0xff, 0x41, 0x60, 0xc8, 0x3b, 0x79, 0x98, 0x37, 0xb4, 0x7c, 0x40, 0xe3, 0x61, 0x0e,
+	0x58, 0xdd, 0x76, 0xc0, 0xce, 0x2f, 0x08, 0x1a, 0xc5, 0xcb, 0x8b, 0x9f, 0xc3, 0x53, 0x77, 0x72,
+	0x32, 0xf2, 0xce, 0x9c, 0xd1, 0xd0, 0x9b, 0x8d, 0xdc, 0x89, 0x3d, 0x70, 0xbe, 0x77, 0xec, 0xa1,
+	0xf9, 0x08, 0x3f, 0x03, 0xbc, 0x0e, 0x39, 0xa3, 0xa9, 0x4d, 0x46, 0x27, 0xe7, 0x26, 0xc2, 0x07,
+	0x60, 0xae, 0x71, 0xd7, 0x26, 0x17, 0x36, 0x31, 0x2b, 0x65, 0x74, 0x70, 0xee, 0xd8, 0xa3, 0xa9,
+	0xb9, 0x53, 0xd6, 0x98, 0x90, 0xf1, 0x70, 0x36, 0xb0, 0x89, 0x69, 0x94, 0xf1, 0xc1, 0x78, 0xe4,
+	0xce, 0x7e, 0xb4, 0x89, 0x59, 0xed, 0xfc, 0x89, 0xa0, 0xa6, 0xc7, 0x0a, 0x5b, 0x50, 0x8f, 0x28,
+	0xe7, 0xfe, 0x55, 0x31, 0x21, 0xc5, 0x16, 0x0f, 0xc0, 0x98, 0xc7, 0x81, 0xbe, 0xdd, 0xd6, 0x71,
+	0xff, 0x3e, 0x43, 0x9a, 0x3f, 0x06, 0x71, 0x40, 0x89, 0x22, 0x77, 0x46, 0x00, 0x6b, 0x0c, 0x3f,
+	0x85, 0x7d, 0x77, 0x7a, 0x32, 0x9d, 0xb9, 0xde, 0x60, 0x3c, 0xb4, 0xe5, 0x45, 0xd8, 0x53, 0xf3,
+	0x11, 0xc6, 0xd0, 0xda, 0x84, 0xc7, 0x67, 0x26, 0xba, 0x99, 0x6a, 0x13, 0x32, 0x26, 0x66, 0xe5,
+	0xd4, 0x68, 0x20, 0xb3, 0xf2, 0xee, 0x57, 0x04, 0x2f, 0xc3, 0x78, 0x6b, 0x45, 0xef, 0xf4, 0x1f,
+	0xfc, 0x44, 0x82, 0x13, 0xf4, 0xd3, 0xe9, 0x55, 0x28, 0xde, 0x67, 0x97, 0xb2, 0xdd, 0xfd, 0x24,
+	0x5b, 0x64, 0x51, 0x58, 0x3c, 0x78, 0x70, 0xdd, 0x5f, 0x1e, 0xe7, 0x9f, 0x0c, 0x57, 0x71, 0x3f,
+	0x16, 0x8b, 0xa4, 0xbf, 0xed, 0x13, 0xe6, 0xf7, 0xca, 0x8b, 0x71, 0x42, 0xd9, 0x74, 0x65, 0xac,
+	0x3c, 0x7a, 0xca, 0xae, 0x77, 0x71, 0x74, 0x59, 0x53, 0xe9, 0x6f, 0xfe, 0x1f, 0x00, 0xd7, 0xed,
+	0x40, 0xf6, 0x08, 0x09, 0x00, 0x00,
+}
@@ -1310,6 +1310,690 @@ func (m *ErrorResourceInitFailed) GetInputs() *_struct.Struct {
 	return nil
 }
 
+type ConstructRequest struct {
+	Project              string                                            `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	Stack                string                                            `protobuf:"bytes,2,opt,name=stack,proto3" json:"stack,omitempty"`
+	Config               map[string]string                                 `protobuf:"bytes,3,rep,name=config,proto3" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	DryRun               bool                                              `protobuf:"varint,4,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	Parallel             int32                                             `protobuf:"varint,5,opt,name=parallel,proto3" json:"parallel,omitempty"`
+	MonitorEndpoint      string                                            `protobuf:"bytes,6,opt,name=monitorEndpoint,proto3" json:"monitorEndpoint,omitempty"`
+	Type                 string                                            `protobuf:"bytes,7,opt,name=type,proto3" json:"type,omitempty"`
+	Name                 string                                            `protobuf:"bytes,8,opt,name=name,proto3" json:"name,omitempty"`
+	Parent               string                                            `protobuf:"bytes,9,opt,name=parent,proto3" json:"parent,omitempty"`
+	Inputs               *_struct.Struct                                   `protobuf:"bytes,10,opt,name=inputs,proto3" json:"inputs,omitempty"`
+	InputDependencies    map[string]*ConstructRequest_PropertyDependencies `protobuf:"bytes,11,rep,name=inputDependencies,proto3" json:"inputDependencies,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Protect              bool                                              `protobuf:"varint,12,opt,name=protect,proto3" json:"protect,omitempty"`
+	Providers            map[string]string                                 `protobuf:"bytes,13,rep,name=providers,proto3" json:"providers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Aliases              []string                                          `protobuf:"bytes,14,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	Dependencies         []string                                          `protobuf:"bytes,15,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                          `json:"-"`
+	XXX_unrecognized     []byte                                            `json:"-"`
+	XXX_sizecache        int32                                             `json:"-"`
+}
+
+func (m *ConstructRequest) Reset()         { *m = ConstructRequest{} }
+func (m *ConstructRequest) String() string { return proto.CompactTextString(m) }
+func (*ConstructRequest) ProtoMessage()    {}
+func (*ConstructRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{21}
+}
+
+func (m *ConstructRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConstructRequest.Unmarshal(m, b)
+}
+func (m *ConstructRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConstructRequest.Marshal(b, m, deterministic)
+}
+func (m *ConstructRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConstructRequest.Merge(m, src)
+}
+func (m *ConstructRequest) XXX_Size() int {
+	return xxx_messageInfo_ConstructRequest.Size(m)
+}
+func (m *ConstructRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConstructRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConstructRequest proto.InternalMessageInfo
+
+func (m *ConstructRequest) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
+
+func (m *ConstructRequest) GetStack() string {
+	if m != nil {
+		return m.Stack
+	}
+	return ""
+}
+
+func (m *ConstructRequest) GetConfig() map[string]string {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func (m *ConstructRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *ConstructRequest) GetParallel() int32 {
+	if m != nil {
+		return m.Parallel
+	}
+	return 0
+}
+
+func (m *ConstructRequest) GetMonitorEndpoint() string {
+	if m != nil {
+		return m.MonitorEndpoint
+	}
+	return ""
+}
+
+func (m *ConstructRequest) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *ConstructRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ConstructRequest) GetParent() string {
+	if m != nil {
+		return m.Parent
+	}
+	return ""
+}
+
+func (m *ConstructRequest) GetInputs() *_struct.Struct {
+	if m != nil {
+		return m.Inputs
+	}
+	return nil
+}
+
+func (m *ConstructRequest) GetInputDependencies() map[string]*ConstructRequest_PropertyDependencies {
+	if m != nil {
+		return m.InputDependencies
+	}
+	return nil
+}
+
+func (m *ConstructRequest) GetProtect() bool {
+	if m != nil {
+		return m.Protect
+	}
+	return false
+}
+
+func (m *ConstructRequest) GetProviders() map[string]string {
+	if m != nil {
+		return m.Providers
+	}
+	return nil
+}
+
+func (m *ConstructRequest) GetAliases() []string {
+	if m != nil {
+		return m.Aliases
+	}
+	return nil
+}
+
+func (m *ConstructRequest) GetDependencies() []string {
+	if m != nil {
+		return m.Dependencies
+	}
+	return nil
+}
+
+// PropertyDependencies describes the resources that a particular property depends on.
+type ConstructRequest_PropertyDependencies struct {
+	Urns                 []string `protobuf:"bytes,1,rep,name=urns,proto3" json:"urns,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConstructRequest_PropertyDependencies) Reset()         { *m = ConstructRequest_PropertyDependencies{} }
+func (m *ConstructRequest_PropertyDependencies) String() string { return proto.CompactTextString(m) }
+func (*ConstructRequest_PropertyDependencies) ProtoMessage()    {}
+func (*ConstructRequest_PropertyDependencies) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{21, 0}
+}
+
+func (m *ConstructRequest_PropertyDependencies) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConstructRequest_PropertyDependencies.Unmarshal(m, b)
+}
+func (m *ConstructRequest_PropertyDependencies) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConstructRequest_PropertyDependencies.Marshal(b, m, deterministic)
+}
+func (m *ConstructRequest_PropertyDependencies) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConstructRequest_PropertyDependencies.Merge(m, src)
+}
+func (m *ConstructRequest_PropertyDependencies) XXX_Size() int {
+	return xxx_messageInfo_ConstructRequest_PropertyDependencies.Size(m)
+}
+func (m *ConstructRequest_PropertyDependencies) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConstructRequest_PropertyDependencies.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConstructRequest_PropertyDependencies proto.InternalMessageInfo
+
+func (m *ConstructRequest_PropertyDependencies) GetUrns() []string {
+	if m != nil {
+		return m.Urns
+	}
+	return nil
+}
+
+type ConstructResponse struct {
+	Urn                  string                                             `protobuf:"bytes,1,opt,name=urn,proto3" json:"urn,omitempty"`
+	State                *_struct.Struct                                    `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	StateDependencies    map[string]*ConstructResponse_PropertyDependencies `protobuf:"bytes,3,rep,name=stateDependencies,proto3" json:"stateDependencies,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                                           `json:"-"`
+	XXX_unrecognized     []byte                                             `json:"-"`
+	XXX_sizecache        int32                                              `json:"-"`
+}
+
+func (m *ConstructResponse) Reset()         { *m = ConstructResponse{} }
+func (m *ConstructResponse) String() string { return proto.CompactTextString(m) }
+func (*ConstructResponse) ProtoMessage()    {}
+func (*ConstructResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{22}
+}
+
+func (m *ConstructResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConstructResponse.Unmarshal(m, b)
+}
+func (m *ConstructResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConstructResponse.Marshal(b, m, deterministic)
+}
+func (m *ConstructResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConstructResponse.Merge(m, src)
+}
+func (m *ConstructResponse) XXX_Size() int {
+	return xxx_messageInfo_ConstructResponse.Size(m)
+}
+func (m *ConstructResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConstructResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConstructResponse proto.InternalMessageInfo
+
+func (m *ConstructResponse) GetUrn() string {
+	if m != nil {
+		return m.Urn
+	}
+	return ""
+}
+
+func (m *ConstructResponse) GetState() *_struct.Struct {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
+func (m *ConstructResponse) GetStateDependencies() map[string]*ConstructResponse_PropertyDependencies {
+	if m != nil {
+		return m.StateDependencies
+	}
+	return nil
+}
+
+// PropertyDependencies describes the resources that a particular property depends on.
+type ConstructResponse_PropertyDependencies struct {
+	Urns                 []string `protobuf:"bytes,1,rep,name=urns,proto3" json:"urns,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConstructResponse_PropertyDependencies) Reset() {
+	*m = ConstructResponse_PropertyDependencies{}
+}
+func (m *ConstructResponse_PropertyDependencies) String() string { return proto.CompactTextString(m) }
+func (*ConstructResponse_PropertyDependencies) ProtoMessage()    {}
+func (*ConstructResponse_PropertyDependencies) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{22, 0}
+}
+
+func (m *ConstructResponse_PropertyDependencies) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConstructResponse_PropertyDependencies.Unmarshal(m, b)
+}
+func (m *ConstructResponse_PropertyDependencies) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConstructResponse_PropertyDependencies.Marshal(b, m, deterministic)
+}
+func (m *ConstructResponse_PropertyDependencies) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConstructResponse_PropertyDependencies.Merge(m, src)
+}
+func (m *ConstructResponse_PropertyDependencies) XXX_Size() int {
+	return xxx_messageInfo_ConstructResponse_PropertyDependencies.Size(m)
+}
+func (m *ConstructResponse_PropertyDependencies) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConstructResponse_PropertyDependencies.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConstructResponse_PropertyDependencies proto.InternalMessageInfo
+
+func (m *ConstructResponse_PropertyDependencies) GetUrns() []string {
+	if m != nil {
+		return m.Urns
+	}
+	return nil
+}
+
+type GetLogsRequest struct {
+	Id                   string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Urn                  string          `protobuf:"bytes,2,opt,name=urn,proto3" json:"urn,omitempty"`
+	Properties           *_struct.Struct `protobuf:"bytes,3,opt,name=properties,proto3" json:"properties,omitempty"`
+	StartTime            int64           `protobuf:"varint,4,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime              int64           `protobuf:"varint,5,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetLogsRequest) Reset()         { *m = GetLogsRequest{} }
+func (m *GetLogsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetLogsRequest) ProtoMessage()    {}
+func (*GetLogsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{23}
+}
+
+func (m *GetLogsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLogsRequest.Unmarshal(m, b)
+}
+func (m *GetLogsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLogsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetLogsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLogsRequest.Merge(m, src)
+}
+func (m *GetLogsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetLogsRequest.Size(m)
+}
+func (m *GetLogsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLogsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLogsRequest proto.InternalMessageInfo
+
+func (m *GetLogsRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *GetLogsRequest) GetUrn() string {
+	if m != nil {
+		return m.Urn
+	}
+	return ""
+}
+
+func (m *GetLogsRequest) GetProperties() *_struct.Struct {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
+}
+
+func (m *GetLogsRequest) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *GetLogsRequest) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+type GetLogsResponse struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Timestamp            int64    `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Message              string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetLogsResponse) Reset()         { *m = GetLogsResponse{} }
+func (m *GetLogsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetLogsResponse) ProtoMessage()    {}
+func (*GetLogsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{24}
+}
+
+func (m *GetLogsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLogsResponse.Unmarshal(m, b)
+}
+func (m *GetLogsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLogsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetLogsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLogsResponse.Merge(m, src)
+}
+func (m *GetLogsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetLogsResponse.Size(m)
+}
+func (m *GetLogsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLogsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLogsResponse proto.InternalMessageInfo
+
+func (m *GetLogsResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *GetLogsResponse) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *GetLogsResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type CallRequest struct {
+	Tok                  string                                       `protobuf:"bytes,1,opt,name=tok,proto3" json:"tok,omitempty"`
+	Urn                  string                                       `protobuf:"bytes,2,opt,name=urn,proto3" json:"urn,omitempty"`
+	State                *_struct.Struct                              `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	Args                 *_struct.Struct                              `protobuf:"bytes,4,opt,name=args,proto3" json:"args,omitempty"`
+	ArgDependencies      map[string]*CallRequest_ArgumentDependencies `protobuf:"bytes,5,rep,name=argDependencies,proto3" json:"argDependencies,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Provider             string                                       `protobuf:"bytes,6,opt,name=provider,proto3" json:"provider,omitempty"`
+	Version              string                                       `protobuf:"bytes,7,opt,name=version,proto3" json:"version,omitempty"`
+	Project              string                                       `protobuf:"bytes,8,opt,name=project,proto3" json:"project,omitempty"`
+	Stack                string                                       `protobuf:"bytes,9,opt,name=stack,proto3" json:"stack,omitempty"`
+	Config               map[string]string                            `protobuf:"bytes,10,rep,name=config,proto3" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	DryRun               bool                                         `protobuf:"varint,11,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	Parallel             int32                                        `protobuf:"varint,12,opt,name=parallel,proto3" json:"parallel,omitempty"`
+	MonitorEndpoint      string                                       `protobuf:"bytes,13,opt,name=monitorEndpoint,proto3" json:"monitorEndpoint,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
+	XXX_unrecognized     []byte                                       `json:"-"`
+	XXX_sizecache        int32                                        `json:"-"`
+}
+
+func (m *CallRequest) Reset()         { *m = CallRequest{} }
+func (m *CallRequest) String() string { return proto.CompactTextString(m) }
+func (*CallRequest) ProtoMessage()    {}
+func (*CallRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{25}
+}
+
+func (m *CallRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CallRequest.Unmarshal(m, b)
+}
+func (m *CallRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CallRequest.Marshal(b, m, deterministic)
+}
+func (m *CallRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallRequest.Merge(m, src)
+}
+func (m *CallRequest) XXX_Size() int {
+	return xxx_messageInfo_CallRequest.Size(m)
+}
+func (m *CallRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallRequest proto.InternalMessageInfo
+
+func (m *CallRequest) GetTok() string {
+	if m != nil {
+		return m.Tok
+	}
+	return ""
+}
+
+func (m *CallRequest) GetUrn() string {
+	if m != nil {
+		return m.Urn
+	}
+	return ""
+}
+
+func (m *CallRequest) GetState() *_struct.Struct {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
+func (m *CallRequest) GetArgs() *_struct.Struct {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (m *CallRequest) GetArgDependencies() map[string]*CallRequest_ArgumentDependencies {
+	if m != nil {
+		return m.ArgDependencies
+	}
+	return nil
+}
+
+func (m *CallRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *CallRequest) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *CallRequest) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
+
+func (m *CallRequest) GetStack() string {
+	if m != nil {
+		return m.Stack
+	}
+	return ""
+}
+
+func (m *CallRequest) GetConfig() map[string]string {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func (m *CallRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *CallRequest) GetParallel() int32 {
+	if m != nil {
+		return m.Parallel
+	}
+	return 0
+}
+
+func (m *CallRequest) GetMonitorEndpoint() string {
+	if m != nil {
+		return m.MonitorEndpoint
+	}
+	return ""
+}
+
+// ArgumentDependencies describes the resources that a particular argument depends on.
+type CallRequest_ArgumentDependencies struct {
+	Urns                 []string `protobuf:"bytes,1,rep,name=urns,proto3" json:"urns,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CallRequest_ArgumentDependencies) Reset()         { *m = CallRequest_ArgumentDependencies{} }
+func (m *CallRequest_ArgumentDependencies) String() string { return proto.CompactTextString(m) }
+func (*CallRequest_ArgumentDependencies) ProtoMessage()    {}
+func (*CallRequest_ArgumentDependencies) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{25, 0}
+}
+
+func (m *CallRequest_ArgumentDependencies) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CallRequest_ArgumentDependencies.Unmarshal(m, b)
+}
+func (m *CallRequest_ArgumentDependencies) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CallRequest_ArgumentDependencies.Marshal(b, m, deterministic)
+}
+func (m *CallRequest_ArgumentDependencies) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallRequest_ArgumentDependencies.Merge(m, src)
+}
+func (m *CallRequest_ArgumentDependencies) XXX_Size() int {
+	return xxx_messageInfo_CallRequest_ArgumentDependencies.Size(m)
+}
+func (m *CallRequest_ArgumentDependencies) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallRequest_ArgumentDependencies.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallRequest_ArgumentDependencies proto.InternalMessageInfo
+
+func (m *CallRequest_ArgumentDependencies) GetUrns() []string {
+	if m != nil {
+		return m.Urns
+	}
+	return nil
+}
+
+type CallResponse struct {
+	Return               *_struct.Struct                             `protobuf:"bytes,1,opt,name=return,proto3" json:"return,omitempty"`
+	ReturnDependencies   map[string]*CallResponse_ReturnDependencies `protobuf:"bytes,2,rep,name=returnDependencies,proto3" json:"returnDependencies,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Failures             []*CheckFailure                             `protobuf:"bytes,3,rep,name=failures,proto3" json:"failures,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                    `json:"-"`
+	XXX_unrecognized     []byte                                      `json:"-"`
+	XXX_sizecache        int32                                       `json:"-"`
+}
+
+func (m *CallResponse) Reset()         { *m = CallResponse{} }
+func (m *CallResponse) String() string { return proto.CompactTextString(m) }
+func (*CallResponse) ProtoMessage()    {}
+func (*CallResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{26}
+}
+
+func (m *CallResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CallResponse.Unmarshal(m, b)
+}
+func (m *CallResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CallResponse.Marshal(b, m, deterministic)
+}
+func (m *CallResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallResponse.Merge(m, src)
+}
+func (m *CallResponse) XXX_Size() int {
+	return xxx_messageInfo_CallResponse.Size(m)
+}
+func (m *CallResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallResponse proto.InternalMessageInfo
+
+func (m *CallResponse) GetReturn() *_struct.Struct {
+	if m != nil {
+		return m.Return
+	}
+	return nil
+}
+
+func (m *CallResponse) GetReturnDependencies() map[string]*CallResponse_ReturnDependencies {
+	if m != nil {
+		return m.ReturnDependencies
+	}
+	return nil
+}
+
+func (m *CallResponse) GetFailures() []*CheckFailure {
+	if m != nil {
+		return m.Failures
+	}
+	return nil
+}
+
+// ReturnDependencies describes the resources that a particular return value depends on.
+type CallResponse_ReturnDependencies struct {
+	Urns                 []string `protobuf:"bytes,1,rep,name=urns,proto3" json:"urns,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CallResponse_ReturnDependencies) Reset()         { *m = CallResponse_ReturnDependencies{} }
+func (m *CallResponse_ReturnDependencies) String() string { return proto.CompactTextString(m) }
+func (*CallResponse_ReturnDependencies) ProtoMessage()    {}
+func (*CallResponse_ReturnDependencies) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{26, 0}
+}
+
+func (m *CallResponse_ReturnDependencies) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CallResponse_ReturnDependencies.Unmarshal(m, b)
+}
+func (m *CallResponse_ReturnDependencies) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CallResponse_ReturnDependencies.Marshal(b, m, deterministic)
+}
+func (m *CallResponse_ReturnDependencies) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallResponse_ReturnDependencies.Merge(m, src)
+}
+func (m *CallResponse_ReturnDependencies) XXX_Size() int {
+	return xxx_messageInfo_CallResponse_ReturnDependencies.Size(m)
+}
+func (m *CallResponse_ReturnDependencies) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallResponse_ReturnDependencies.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallResponse_ReturnDependencies proto.InternalMessageInfo
+
+func (m *CallResponse_ReturnDependencies) GetUrns() []string {
+	if m != nil {
+		return m.Urns
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("pulumirpc.PropertyDiff_Kind", PropertyDiff_Kind_name, PropertyDiff_Kind_value)
 	proto.RegisterEnum("pulumirpc.DiffResponse_DiffChanges", DiffResponse_DiffChanges_name, DiffResponse_DiffChanges_value)
@@ -1337,92 +2021,148 @@ func init() {
 	proto.RegisterType((*UpdateResponse)(nil), "pulumirpc.UpdateResponse")
 	proto.RegisterType((*DeleteRequest)(nil), "pulumirpc.DeleteRequest")
 	proto.RegisterType((*ErrorResourceInitFailed)(nil), "pulumirpc.ErrorResourceInitFailed")
+	proto.RegisterType((*ConstructRequest)(nil), "pulumirpc.ConstructRequest")
+	proto.RegisterMapType((map[string]string)(nil), "pulumirpc.ConstructRequest.ConfigEntry")
+	proto.RegisterMapType((map[string]*ConstructRequest_PropertyDependencies)(nil), "pulumirpc.ConstructRequest.InputDependenciesEntry")
+	proto.RegisterMapType((map[string]string)(nil), "pulumirpc.ConstructRequest.ProvidersEntry")
+	proto.RegisterType((*ConstructRequest_PropertyDependencies)(nil), "pulumirpc.ConstructRequest.PropertyDependencies")
+	proto.RegisterType((*ConstructResponse)(nil), "pulumirpc.ConstructResponse")
+	proto.RegisterMapType((map[string]*ConstructResponse_PropertyDependencies)(nil), "pulumirpc.ConstructResponse.StateDependenciesEntry")
+	proto.RegisterType((*ConstructResponse_PropertyDependencies)(nil), "pulumirpc.ConstructResponse.PropertyDependencies")
+	proto.RegisterType((*GetLogsRequest)(nil), "pulumirpc.GetLogsRequest")
+	proto.RegisterType((*GetLogsResponse)(nil), "pulumirpc.GetLogsResponse")
+	proto.RegisterType((*CallRequest)(nil), "pulumirpc.CallRequest")
+	proto.RegisterMapType((map[string]*CallRequest_ArgumentDependencies)(nil), "pulumirpc.CallRequest.ArgDependenciesEntry")
+	proto.RegisterMapType((map[string]string)(nil), "pulumirpc.CallRequest.ConfigEntry")
+	proto.RegisterType((*CallRequest_ArgumentDependencies)(nil), "pulumirpc.CallRequest.ArgumentDependencies")
+	proto.RegisterType((*CallResponse)(nil), "pulumirpc.CallResponse")
+	proto.RegisterMapType((map[string]*CallResponse_ReturnDependencies)(nil), "pulumirpc.CallResponse.ReturnDependenciesEntry")
+	proto.RegisterType((*CallResponse_ReturnDependencies)(nil), "pulumirpc.CallResponse.ReturnDependencies")
 }
 
 func init() { proto.RegisterFile("provider.proto", fileDescriptor_c6a9f3c02af3d1c8) }
 
 var fileDescriptor_c6a9f3c02af3d1c8 = []byte{
-	// 1274 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xdd, 0x72, 0xdb, 0x44,
-	0x14, 0xb6, 0x2c, 0xc7, 0x89, 0x8f, 0x7f, 0xaa, 0x2e, 0xd0, 0xba, 0x6e, 0x2e, 0x3c, 0x82, 0x0b,
-	0x43, 0xc1, 0xe9, 0xa4, 0x17, 0xd0, 0x4e, 0x3b, 0x25, 0x89, 0x9d, 0x36, 0xd3, 0x36, 0x0d, 0x4a,
-	0xcb, 0xcf, 0x55, 0x51, 0xa5, 0xb5, 0xa3, 0xb1, 0x2d, 0x89, 0xd5, 0xca, 0x4c, 0xb8, 0xe6, 0x82,
-	0x57, 0xe0, 0x09, 0xb8, 0x62, 0x98, 0xe1, 0x09, 0xb8, 0xe7, 0x19, 0x78, 0x04, 0xde, 0x81, 0xd9,
-	0x1f, 0xc9, 0xbb, 0xb6, 0x93, 0x3a, 0xa1, 0x03, 0x77, 0x3a, 0x7b, 0xce, 0xee, 0x39, 0xdf, 0xb7,
-	0x67, 0xbf, 0x5d, 0x41, 0x23, 0x26, 0xd1, 0x34, 0xf0, 0x31, 0xe9, 0xc6, 0x24, 0xa2, 0x11, 0xaa,
-	0xc4, 0xe9, 0x38, 0x9d, 0x04, 0x24, 0xf6, 0x5a, 0xb5, 0x78, 0x9c, 0x0e, 0x83, 0x50, 0x38, 0x5a,
-	0x37, 0x87, 0x51, 0x34, 0x1c, 0xe3, 0x2d, 0x6e, 0xbd, 0x4e, 0x07, 0x5b, 0x78, 0x12, 0xd3, 0x53,
-	0xe9, 0xdc, 0x9c, 0x77, 0x26, 0x94, 0xa4, 0x1e, 0x15, 0x5e, 0xfb, 0x63, 0xb0, 0x1e, 0x61, 0x7a,
-	0xec, 0x9d, 0xe0, 0x89, 0xeb, 0xe0, 0xef, 0x52, 0x9c, 0x50, 0xd4, 0x84, 0xf5, 0x29, 0x26, 0x49,
-	0x10, 0x85, 0x4d, 0xa3, 0x6d, 0x74, 0xd6, 0x9c, 0xcc, 0xb4, 0x6f, 0xc1, 0x55, 0x25, 0x3a, 0x89,
-	0xa3, 0x30, 0xc1, 0xe8, 0x1a, 0x94, 0x13, 0x3e, 0xc2, 0xa3, 0x2b, 0x8e, 0xb4, 0xec, 0xbf, 0x0d,
-	0xb0, 0xf6, 0xa2, 0x70, 0x10, 0x0c, 0x53, 0x82, 0xb3, 0xb5, 0x1f, 0x43, 0x65, 0xea, 0x92, 0xc0,
-	0x7d, 0x3d, 0xc6, 0x49, 0xd3, 0x68, 0x9b, 0x9d, 0xea, 0xf6, 0x47, 0xdd, 0x1c, 0x57, 0x77, 0x3e,
-	0xbe, 0xfb, 0x65, 0x16, 0xdc, 0x0f, 0x29, 0x39, 0x75, 0x66, 0x93, 0xd1, 0x2d, 0x28, 0xb9, 0x64,
-	0x98, 0x34, 0x8b, 0x6d, 0xa3, 0x53, 0xdd, 0xbe, 0xde, 0x15, 0x30, 0xbb, 0x19, 0xcc, 0xee, 0x31,
-	0x87, 0xe9, 0xf0, 0x20, 0xf4, 0x01, 0xd4, 0x5d, 0xcf, 0xc3, 0x31, 0x3d, 0xc6, 0x1e, 0xc1, 0x34,
-	0x69, 0x9a, 0x6d, 0xa3, 0xb3, 0xe1, 0xe8, 0x83, 0xad, 0xfb, 0xd0, 0xd0, 0xf3, 0x21, 0x0b, 0xcc,
-	0x11, 0x3e, 0x95, 0xc0, 0xd8, 0x27, 0x7a, 0x17, 0xd6, 0xa6, 0xee, 0x38, 0xc5, 0x3c, 0x6f, 0xc5,
-	0x11, 0xc6, 0xbd, 0xe2, 0x67, 0x86, 0x7d, 0x17, 0xae, 0x2a, 0xe5, 0x4b, 0x72, 0x16, 0x12, 0x1b,
-	0x4b, 0x12, 0xdb, 0xbf, 0x1b, 0x70, 0x23, 0x9f, 0xdb, 0x27, 0x24, 0x22, 0xcf, 0x82, 0x24, 0x09,
-	0xc2, 0xe1, 0x13, 0x7c, 0x9a, 0xa0, 0x2f, 0xa0, 0x3a, 0x99, 0x99, 0x92, 0xb5, 0xad, 0x65, 0xac,
-	0xcd, 0x4f, 0xed, 0xce, 0xbe, 0x1d, 0x75, 0x8d, 0xd6, 0x2e, 0xc0, 0xcc, 0x85, 0x10, 0x94, 0x42,
-	0x77, 0x82, 0x25, 0x4c, 0xfe, 0x8d, 0xda, 0x50, 0xf5, 0x71, 0xe2, 0x91, 0x20, 0xa6, 0xac, 0x11,
-	0x04, 0x5a, 0x75, 0xc8, 0xfe, 0xd1, 0x80, 0xfa, 0x41, 0x38, 0x8d, 0x46, 0xf9, 0xe6, 0x5a, 0x60,
-	0xd2, 0x68, 0x94, 0xb1, 0x45, 0xa3, 0xd1, 0xc5, 0x36, 0xa9, 0x05, 0x1b, 0x59, 0xc7, 0xf3, 0xfd,
-	0xa9, 0x38, 0xb9, 0xad, 0xf6, 0x64, 0x89, 0xbb, 0xf2, 0x9e, 0x9c, 0x42, 0x23, 0xab, 0x42, 0x72,
-	0xbe, 0x05, 0x65, 0x82, 0x69, 0x4a, 0x44, 0xfb, 0x9e, 0x93, 0x56, 0x86, 0xa1, 0x3b, 0xb0, 0x31,
-	0x70, 0x83, 0x71, 0x4a, 0x30, 0xab, 0xd4, 0xe4, 0x53, 0x14, 0x76, 0x4f, 0xb0, 0x37, 0xda, 0x17,
-	0x7e, 0x27, 0x0f, 0xb4, 0x7f, 0x80, 0x1a, 0xf7, 0x28, 0xe0, 0xb3, 0x94, 0x15, 0x87, 0x7d, 0x32,
-	0xf0, 0xd1, 0xd8, 0x7f, 0x33, 0x78, 0x16, 0xc4, 0x82, 0x43, 0xfc, 0xbd, 0x68, 0xcc, 0xf3, 0x82,
-	0x59, 0x90, 0x9d, 0x42, 0x5d, 0xe6, 0x9e, 0x41, 0x0e, 0xc2, 0x38, 0x95, 0xfd, 0x75, 0x1e, 0x64,
-	0x11, 0x76, 0x39, 0xc8, 0xbb, 0x12, 0xb2, 0xf4, 0xc8, 0x0d, 0x8b, 0x31, 0xa1, 0xd9, 0x11, 0xc9,
-	0x6d, 0xa6, 0x0a, 0x04, 0xbb, 0x49, 0xde, 0x3a, 0xd2, 0xb2, 0x7f, 0x33, 0xa0, 0xda, 0x0b, 0x06,
-	0x83, 0x8c, 0xb6, 0x06, 0x14, 0x03, 0x5f, 0xce, 0x2e, 0x06, 0x7e, 0x46, 0x63, 0x71, 0x91, 0x46,
-	0xf3, 0x22, 0x34, 0x96, 0x56, 0xa0, 0x91, 0x1d, 0xce, 0x60, 0x18, 0x46, 0x04, 0xef, 0x9d, 0xb8,
-	0xe1, 0x10, 0x27, 0xcd, 0xb5, 0xb6, 0xd9, 0xa9, 0x38, 0xfa, 0xa0, 0xfd, 0x87, 0x01, 0xb5, 0x23,
-	0x09, 0x8b, 0x55, 0x8e, 0x6e, 0x43, 0x69, 0x14, 0x84, 0xa2, 0xe8, 0xc6, 0xf6, 0xa6, 0xc2, 0x9b,
-	0x1a, 0xd6, 0x7d, 0x12, 0x84, 0xbe, 0xc3, 0x23, 0xd1, 0x26, 0x54, 0x38, 0xef, 0x6c, 0x9c, 0x43,
-	0xdb, 0x70, 0x66, 0x03, 0xf6, 0xb7, 0x50, 0x62, 0xb1, 0x68, 0x1d, 0xcc, 0x9d, 0x5e, 0xcf, 0x2a,
-	0xa0, 0x2b, 0x50, 0xdd, 0xe9, 0xf5, 0x5e, 0x39, 0xfd, 0xa3, 0xa7, 0x3b, 0x7b, 0x7d, 0xcb, 0x40,
-	0x00, 0xe5, 0x5e, 0xff, 0x69, 0xff, 0x45, 0xdf, 0x2a, 0x22, 0x04, 0x0d, 0xf1, 0x9d, 0xfb, 0x4d,
-	0xe6, 0x7f, 0x79, 0xd4, 0xdb, 0x79, 0xd1, 0xb7, 0x4a, 0xcc, 0x2f, 0xbe, 0x73, 0xff, 0x9a, 0xfd,
-	0x97, 0x09, 0x35, 0x41, 0xba, 0xec, 0x97, 0x16, 0x6c, 0x10, 0x1c, 0x8f, 0x5d, 0x4f, 0xaa, 0x70,
-	0xc5, 0xc9, 0x6d, 0x76, 0xd4, 0x12, 0x2a, 0x04, 0xba, 0xc8, 0x5d, 0x99, 0x89, 0x6e, 0xc3, 0x3b,
-	0x3e, 0x1e, 0x63, 0x8a, 0x77, 0xf1, 0x20, 0x62, 0x22, 0xc7, 0x67, 0x48, 0x2d, 0x5d, 0xe6, 0x42,
-	0x0f, 0x60, 0xdd, 0x93, 0xdc, 0x96, 0x38, 0x5b, 0xef, 0x2b, 0x6c, 0xa9, 0x15, 0x71, 0x43, 0x32,
-	0xee, 0x64, 0x73, 0x98, 0xd8, 0xfa, 0xc1, 0x60, 0x90, 0x6d, 0x8c, 0x30, 0xd0, 0x33, 0xa8, 0xf9,
-	0x98, 0xba, 0xc1, 0x18, 0xfb, 0x9c, 0xd0, 0x32, 0xef, 0xdf, 0x0f, 0xcf, 0x5c, 0x59, 0x89, 0x15,
-	0xb7, 0x88, 0x36, 0x1d, 0x75, 0xe0, 0xca, 0x89, 0x9b, 0xa8, 0x51, 0xcd, 0x75, 0x8e, 0x68, 0x7e,
-	0xb8, 0xf5, 0x35, 0x5c, 0x5d, 0x58, 0x6c, 0xc9, 0x15, 0xf1, 0x89, 0x7a, 0x45, 0xe8, 0x07, 0x4b,
-	0x6d, 0x10, 0xf5, 0xee, 0x78, 0x20, 0x0e, 0x85, 0x24, 0x00, 0x59, 0x50, 0xeb, 0x1d, 0xec, 0xef,
-	0xbf, 0x7a, 0x79, 0xf8, 0xe4, 0xf0, 0xf9, 0x57, 0x87, 0x56, 0x01, 0xd5, 0xa1, 0xc2, 0x47, 0x0e,
-	0x9f, 0x1f, 0xb2, 0x86, 0xc8, 0xcc, 0xe3, 0xe7, 0xcf, 0xfa, 0x56, 0xd1, 0xa6, 0x50, 0xdf, 0x23,
-	0xd8, 0xa5, 0xf8, 0x6c, 0x31, 0xfa, 0x14, 0x40, 0x9e, 0xcd, 0x00, 0xbf, 0x51, 0x92, 0x94, 0x50,
-	0xd6, 0x0e, 0x34, 0x98, 0xe0, 0x28, 0xa5, 0x7c, 0xa3, 0x0d, 0x27, 0x33, 0xed, 0x6f, 0xa0, 0x91,
-	0x65, 0x95, 0x6d, 0x35, 0x7f, 0x98, 0x2f, 0x9b, 0xd4, 0xfe, 0xd9, 0x80, 0xaa, 0x83, 0x5d, 0x7f,
-	0x75, 0x95, 0xd0, 0x53, 0x99, 0xab, 0xe3, 0x9b, 0x49, 0x67, 0x69, 0x25, 0xe9, 0xb4, 0x7f, 0x32,
-	0xa0, 0x26, 0x6a, 0x7b, 0xcb, 0xa8, 0x95, 0x52, 0xcc, 0xd5, 0x4a, 0xf9, 0xd3, 0x80, 0xfa, 0xcb,
-	0xd8, 0x57, 0x36, 0xfe, 0xff, 0x94, 0x53, 0xa5, 0x53, 0xd6, 0xb4, 0x4e, 0x59, 0x14, 0xda, 0xf2,
-	0x32, 0xa1, 0x3d, 0x80, 0x46, 0x06, 0x46, 0x32, 0xab, 0x33, 0x69, 0xac, 0xde, 0x3f, 0xec, 0x6d,
-	0xd2, 0xe3, 0x7a, 0xf4, 0x1f, 0x74, 0x90, 0x82, 0xbb, 0xa4, 0x9f, 0x90, 0x5f, 0x0d, 0xb8, 0xce,
-	0xdf, 0x64, 0x0e, 0x4e, 0xa2, 0x94, 0x78, 0xf8, 0x20, 0x0c, 0xe8, 0x3e, 0x17, 0x90, 0xb7, 0xd7,
-	0x35, 0x4d, 0x58, 0x17, 0x77, 0x2b, 0x2b, 0x9a, 0xeb, 0xb5, 0x34, 0x2f, 0xdc, 0xda, 0xdb, 0xbf,
-	0xac, 0x83, 0x95, 0x95, 0x7a, 0x94, 0x3d, 0xbd, 0x1e, 0x43, 0x25, 0x7f, 0xf4, 0xa3, 0x9b, 0x8a,
-	0x98, 0xcd, 0xff, 0x38, 0xb4, 0x36, 0x97, 0x3b, 0xc5, 0x66, 0xda, 0x05, 0xb4, 0x0b, 0x55, 0xfe,
-	0x7e, 0x10, 0xef, 0x55, 0xb4, 0xf0, 0xe2, 0xc8, 0xd6, 0x69, 0x2e, 0x3a, 0xf2, 0x35, 0x1e, 0x02,
-	0x70, 0xa5, 0x14, 0x4b, 0x5c, 0x5b, 0x10, 0x7d, 0xb1, 0xc2, 0xf5, 0x33, 0x2e, 0x03, 0xbb, 0xc0,
-	0xe0, 0xe4, 0xef, 0x65, 0x0d, 0xce, 0xfc, 0xbf, 0x87, 0x06, 0x67, 0xe1, 0x65, 0xcf, 0x4b, 0x29,
-	0x8b, 0x97, 0x27, 0x52, 0x0b, 0xd6, 0x9e, 0xc4, 0xad, 0x1b, 0x4b, 0x3c, 0xf9, 0x02, 0x8f, 0xa0,
-	0x76, 0x4c, 0x09, 0x76, 0x27, 0xff, 0x6a, 0x99, 0xdb, 0x06, 0xba, 0x0f, 0x6b, 0x9c, 0xa7, 0xcb,
-	0x51, 0x7a, 0x17, 0x4a, 0xfc, 0x22, 0xbc, 0x04, 0x99, 0x0f, 0xa1, 0x2c, 0xae, 0x00, 0xad, 0x76,
-	0xed, 0x2e, 0xd2, 0x6a, 0xd7, 0xef, 0x0b, 0x91, 0x9b, 0x69, 0xa9, 0x96, 0x5b, 0x11, 0x7e, 0x2d,
-	0xb7, 0x2a, 0xba, 0x22, 0xb7, 0x90, 0x0b, 0x2d, 0xb7, 0x26, 0x87, 0x5a, 0x6e, 0x5d, 0x5b, 0xec,
-	0x02, 0xba, 0x0f, 0x65, 0xa1, 0x11, 0xda, 0x02, 0x9a, 0x6c, 0xb4, 0xae, 0x2d, 0x1c, 0x99, 0x3e,
-	0xfb, 0xb7, 0xb6, 0x0b, 0xe8, 0x1e, 0x94, 0xf7, 0xdc, 0xd0, 0xc3, 0x63, 0x74, 0x46, 0xcc, 0x39,
-	0x73, 0x3f, 0x87, 0xfa, 0x23, 0x4c, 0x8f, 0xf8, 0x3f, 0xfc, 0x41, 0x38, 0x88, 0xce, 0x5c, 0xe2,
-	0x3d, 0xf5, 0xed, 0x90, 0x87, 0xdb, 0x85, 0xd7, 0x65, 0x1e, 0x78, 0xe7, 0x9f, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x22, 0xcd, 0xb2, 0x66, 0x24, 0x10, 0x00, 0x00,
+	// 1895 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x19, 0xcb, 0x72, 0xdb, 0xc8,
+	0x51, 0xe0, 0x4b, 0x64, 0xf3, 0x21, 0x6a, 0xb2, 0x91, 0x28, 0xac, 0x0e, 0x2a, 0x24, 0x55, 0x51,
+	0xec, 0x2c, 0xed, 0xc8, 0x87, 0xc4, 0x5b, 0xde, 0xf2, 0xca, 0x22, 0xa5, 0xa8, 0x6c, 0xcb, 0x0a,
+	0x64, 0x27, 0xd9, 0x93, 0x17, 0x26, 0x86, 0x14, 0x22, 0x10, 0xc0, 0x0e, 0x06, 0xda, 0x52, 0xce,
+	0x39, 0xe4, 0x17, 0x72, 0xce, 0x39, 0x95, 0xaa, 0x9c, 0x73, 0xc8, 0x3d, 0xbf, 0x90, 0x7c, 0x42,
+	0x2e, 0x9b, 0x1f, 0x48, 0xcd, 0x03, 0xe0, 0x0c, 0x01, 0x52, 0x94, 0xb2, 0xb5, 0xb9, 0xa1, 0xa7,
+	0x7b, 0xfa, 0x35, 0x3d, 0xdd, 0x3d, 0x0d, 0xe8, 0x44, 0x24, 0xbc, 0xf6, 0x5c, 0x4c, 0xfa, 0x11,
+	0x09, 0x69, 0x88, 0x1a, 0x51, 0xe2, 0x27, 0x53, 0x8f, 0x44, 0x23, 0xb3, 0x15, 0xf9, 0xc9, 0xc4,
+	0x0b, 0x04, 0xc2, 0xfc, 0x78, 0x12, 0x86, 0x13, 0x1f, 0x3f, 0xe2, 0xd0, 0x87, 0x64, 0xfc, 0x08,
+	0x4f, 0x23, 0x7a, 0x23, 0x91, 0xbb, 0xf3, 0xc8, 0x98, 0x92, 0x64, 0x44, 0x05, 0xd6, 0xfa, 0x09,
+	0x74, 0x4f, 0x30, 0xbd, 0x18, 0x5d, 0xe2, 0xa9, 0x63, 0xe3, 0xaf, 0x12, 0x1c, 0x53, 0xd4, 0x83,
+	0xf5, 0x6b, 0x4c, 0x62, 0x2f, 0x0c, 0x7a, 0xc6, 0x9e, 0xb1, 0x5f, 0xb5, 0x53, 0xd0, 0x7a, 0x08,
+	0x9b, 0x0a, 0x75, 0x1c, 0x85, 0x41, 0x8c, 0xd1, 0x16, 0xd4, 0x62, 0xbe, 0xc2, 0xa9, 0x1b, 0xb6,
+	0x84, 0xac, 0x7f, 0x1b, 0xd0, 0x3d, 0x0a, 0x83, 0xb1, 0x37, 0x49, 0x08, 0x4e, 0x79, 0xff, 0x02,
+	0x1a, 0xd7, 0x0e, 0xf1, 0x9c, 0x0f, 0x3e, 0x8e, 0x7b, 0xc6, 0x5e, 0x79, 0xbf, 0x79, 0xf0, 0xa0,
+	0x9f, 0xd9, 0xd5, 0x9f, 0xa7, 0xef, 0xff, 0x2a, 0x25, 0x1e, 0x06, 0x94, 0xdc, 0xd8, 0xb3, 0xcd,
+	0xe8, 0x21, 0x54, 0x1c, 0x32, 0x89, 0x7b, 0xa5, 0x3d, 0x63, 0xbf, 0x79, 0xb0, 0xdd, 0x17, 0x66,
+	0xf6, 0x53, 0x33, 0xfb, 0x17, 0xdc, 0x4c, 0x9b, 0x13, 0xa1, 0x1f, 0x42, 0xdb, 0x19, 0x8d, 0x70,
+	0x44, 0x2f, 0xf0, 0x88, 0x60, 0x1a, 0xf7, 0xca, 0x7b, 0xc6, 0x7e, 0xdd, 0xd6, 0x17, 0xcd, 0x67,
+	0xd0, 0xd1, 0xe5, 0xa1, 0x2e, 0x94, 0xaf, 0xf0, 0x8d, 0x34, 0x8c, 0x7d, 0xa2, 0x8f, 0xa0, 0x7a,
+	0xed, 0xf8, 0x09, 0xe6, 0x72, 0x1b, 0xb6, 0x00, 0x3e, 0x2d, 0xfd, 0xdc, 0xb0, 0x9e, 0xc2, 0xa6,
+	0xa2, 0xbe, 0x74, 0x4e, 0x4e, 0xb0, 0x51, 0x20, 0xd8, 0xfa, 0xab, 0x01, 0x3b, 0xd9, 0xde, 0x21,
+	0x21, 0x21, 0x79, 0xed, 0xc5, 0xb1, 0x17, 0x4c, 0x5e, 0xe2, 0x9b, 0x18, 0xfd, 0x12, 0x9a, 0xd3,
+	0x19, 0x28, 0xbd, 0xf6, 0xa8, 0xc8, 0x6b, 0xf3, 0x5b, 0xfb, 0xb3, 0x6f, 0x5b, 0xe5, 0x61, 0xbe,
+	0x00, 0x98, 0xa1, 0x10, 0x82, 0x4a, 0xe0, 0x4c, 0xb1, 0x34, 0x93, 0x7f, 0xa3, 0x3d, 0x68, 0xba,
+	0x38, 0x1e, 0x11, 0x2f, 0xa2, 0x2c, 0x10, 0x84, 0xb5, 0xea, 0x92, 0xf5, 0x7b, 0x03, 0xda, 0xa7,
+	0xc1, 0x75, 0x78, 0x95, 0x1d, 0x6e, 0x17, 0xca, 0x34, 0xbc, 0x4a, 0xbd, 0x45, 0xc3, 0xab, 0xbb,
+	0x1d, 0x92, 0x09, 0xf5, 0x34, 0xe2, 0xf9, 0xf9, 0x34, 0xec, 0x0c, 0x56, 0x63, 0xb2, 0xc2, 0x51,
+	0x59, 0x4c, 0x5e, 0x43, 0x27, 0xd5, 0x42, 0xfa, 0xfc, 0x11, 0xd4, 0x08, 0xa6, 0x09, 0x11, 0xe1,
+	0xbb, 0x44, 0xac, 0x24, 0x43, 0x4f, 0xa0, 0x3e, 0x76, 0x3c, 0x3f, 0x21, 0x98, 0x69, 0x5a, 0xe6,
+	0x5b, 0x14, 0xef, 0x5e, 0xe2, 0xd1, 0xd5, 0xb1, 0xc0, 0xdb, 0x19, 0xa1, 0xf5, 0x3b, 0x68, 0x71,
+	0x8c, 0x62, 0x7c, 0x2a, 0xb2, 0x61, 0xb3, 0x4f, 0x66, 0x7c, 0xe8, 0xbb, 0xb7, 0x1b, 0xcf, 0x88,
+	0x18, 0x71, 0x80, 0xbf, 0x16, 0x81, 0xb9, 0x8c, 0x98, 0x11, 0x59, 0x09, 0xb4, 0xa5, 0xec, 0x99,
+	0xc9, 0x5e, 0x10, 0x25, 0x32, 0xbe, 0x96, 0x99, 0x2c, 0xc8, 0xee, 0x67, 0xf2, 0x0b, 0x68, 0xa9,
+	0x18, 0x79, 0x60, 0x11, 0x26, 0x34, 0xbd, 0x22, 0x19, 0xcc, 0xb2, 0x02, 0xc1, 0x4e, 0x9c, 0x85,
+	0x8e, 0x84, 0xac, 0xbf, 0x18, 0xd0, 0x1c, 0x78, 0xe3, 0x71, 0xea, 0xb6, 0x0e, 0x94, 0x3c, 0x57,
+	0xee, 0x2e, 0x79, 0x6e, 0xea, 0xc6, 0x52, 0xde, 0x8d, 0xe5, 0xbb, 0xb8, 0xb1, 0xb2, 0x82, 0x1b,
+	0xd9, 0xe5, 0xf4, 0x26, 0x41, 0x48, 0xf0, 0xd1, 0xa5, 0x13, 0x4c, 0x70, 0xdc, 0xab, 0xee, 0x95,
+	0xf7, 0x1b, 0xb6, 0xbe, 0x68, 0xfd, 0xdd, 0x80, 0xd6, 0xb9, 0x34, 0x8b, 0x69, 0x8e, 0x1e, 0x43,
+	0xe5, 0xca, 0x0b, 0x84, 0xd2, 0x9d, 0x83, 0x5d, 0xc5, 0x6f, 0x2a, 0x59, 0xff, 0xa5, 0x17, 0xb8,
+	0x36, 0xa7, 0x44, 0xbb, 0xd0, 0xe0, 0x7e, 0x67, 0xeb, 0xdc, 0xb4, 0xba, 0x3d, 0x5b, 0xb0, 0xbe,
+	0x84, 0x0a, 0xa3, 0x45, 0xeb, 0x50, 0x3e, 0x1c, 0x0c, 0xba, 0x6b, 0x68, 0x03, 0x9a, 0x87, 0x83,
+	0xc1, 0x7b, 0x7b, 0x78, 0xfe, 0xea, 0xf0, 0x68, 0xd8, 0x35, 0x10, 0x40, 0x6d, 0x30, 0x7c, 0x35,
+	0x7c, 0x3b, 0xec, 0x96, 0x10, 0x82, 0x8e, 0xf8, 0xce, 0xf0, 0x65, 0x86, 0x7f, 0x77, 0x3e, 0x38,
+	0x7c, 0x3b, 0xec, 0x56, 0x18, 0x5e, 0x7c, 0x67, 0xf8, 0xaa, 0xf5, 0xaf, 0x32, 0xb4, 0x84, 0xd3,
+	0x65, 0xbc, 0x98, 0x50, 0x27, 0x38, 0xf2, 0x9d, 0x91, 0xcc, 0xc2, 0x0d, 0x3b, 0x83, 0xd9, 0x55,
+	0x8b, 0xa9, 0x48, 0xd0, 0x25, 0x8e, 0x4a, 0x41, 0xf4, 0x18, 0xbe, 0xe7, 0x62, 0x1f, 0x53, 0xfc,
+	0x02, 0x8f, 0x43, 0x96, 0xe4, 0xf8, 0x0e, 0x99, 0x4b, 0x8b, 0x50, 0xe8, 0x33, 0x58, 0x1f, 0x49,
+	0xdf, 0x56, 0xb8, 0xb7, 0x7e, 0xa0, 0x78, 0x4b, 0xd5, 0x88, 0x03, 0xd2, 0xe3, 0x76, 0xba, 0x87,
+	0x25, 0x5b, 0xd7, 0x1b, 0x8f, 0xd3, 0x83, 0x11, 0x00, 0x7a, 0x0d, 0x2d, 0x17, 0x53, 0xc7, 0xf3,
+	0xb1, 0xcb, 0x1d, 0x5a, 0xe3, 0xf1, 0xfb, 0xe3, 0x85, 0x9c, 0x15, 0x5a, 0x51, 0x45, 0xb4, 0xed,
+	0x68, 0x1f, 0x36, 0x2e, 0x9d, 0x58, 0xa5, 0xea, 0xad, 0x73, 0x8b, 0xe6, 0x97, 0xcd, 0xdf, 0xc0,
+	0x66, 0x8e, 0x59, 0x41, 0x89, 0xf8, 0x44, 0x2d, 0x11, 0xfa, 0xc5, 0x52, 0x03, 0x44, 0xad, 0x1d,
+	0x9f, 0x41, 0x53, 0x71, 0x00, 0xea, 0x42, 0x6b, 0x70, 0x7a, 0x7c, 0xfc, 0xfe, 0xdd, 0xd9, 0xcb,
+	0xb3, 0x37, 0xbf, 0x3e, 0xeb, 0xae, 0xa1, 0x36, 0x34, 0xf8, 0xca, 0xd9, 0x9b, 0x33, 0x16, 0x10,
+	0x29, 0x78, 0xf1, 0xe6, 0xf5, 0xb0, 0x5b, 0xb2, 0x28, 0xb4, 0x8f, 0x08, 0x76, 0x28, 0x5e, 0x9c,
+	0x8c, 0x7e, 0x06, 0x20, 0xef, 0xa6, 0x87, 0x6f, 0x4d, 0x49, 0x0a, 0x29, 0x0b, 0x07, 0xea, 0x4d,
+	0x71, 0x98, 0x50, 0x7e, 0xd0, 0x86, 0x9d, 0x82, 0xd6, 0x17, 0xd0, 0x49, 0xa5, 0xca, 0xb0, 0x9a,
+	0xbf, 0xcc, 0xf7, 0x15, 0x6a, 0xfd, 0xd1, 0x80, 0xa6, 0x8d, 0x1d, 0x77, 0xf5, 0x2c, 0xa1, 0x8b,
+	0x2a, 0xaf, 0x6e, 0xdf, 0x2c, 0x75, 0x56, 0x56, 0x4a, 0x9d, 0xd6, 0x1f, 0x0c, 0x68, 0x09, 0xdd,
+	0xbe, 0x65, 0xab, 0x15, 0x55, 0xca, 0xab, 0xa9, 0xf2, 0x0f, 0x03, 0xda, 0xef, 0x22, 0x57, 0x39,
+	0xf8, 0xff, 0x67, 0x3a, 0x55, 0x22, 0xa5, 0xaa, 0x45, 0x4a, 0x3e, 0xd1, 0xd6, 0x8a, 0x12, 0xed,
+	0x29, 0x74, 0x52, 0x63, 0xa4, 0x67, 0x75, 0x4f, 0x1a, 0xab, 0xc7, 0x0f, 0xeb, 0x4d, 0x06, 0x3c,
+	0x1f, 0x7d, 0x07, 0x11, 0xa4, 0xd8, 0x5d, 0xd1, 0x6f, 0xc8, 0x9f, 0x0d, 0xd8, 0xe6, 0x3d, 0x99,
+	0x8d, 0xe3, 0x30, 0x21, 0x23, 0x7c, 0x1a, 0x78, 0xf4, 0x98, 0x27, 0x90, 0x6f, 0x2f, 0x6a, 0x7a,
+	0xb0, 0x2e, 0x6a, 0x2b, 0x53, 0x9a, 0xe7, 0x6b, 0x09, 0xde, 0x3d, 0xb4, 0xff, 0x53, 0xe3, 0x2d,
+	0xbb, 0x78, 0x21, 0x28, 0xcf, 0x81, 0x88, 0x84, 0xbf, 0xc5, 0x23, 0x2a, 0xb5, 0x4d, 0x41, 0x96,
+	0x9e, 0x63, 0xea, 0x8c, 0xae, 0xd2, 0x5e, 0x98, 0x03, 0xe8, 0x39, 0xd4, 0x46, 0xbc, 0x21, 0xe5,
+	0xea, 0x34, 0x0f, 0x7e, 0xa4, 0x77, 0xaa, 0x1a, 0x73, 0xd9, 0xba, 0x8a, 0xb4, 0x2c, 0xb7, 0xb1,
+	0xd6, 0xc1, 0x25, 0x37, 0x76, 0x22, 0x5a, 0xbd, 0xba, 0x2d, 0x21, 0xde, 0x6e, 0x38, 0xc4, 0xf1,
+	0x7d, 0xec, 0xf3, 0x00, 0xab, 0xda, 0x19, 0xcc, 0x92, 0xf8, 0x34, 0x0c, 0x3c, 0x1a, 0x92, 0x61,
+	0xe0, 0x46, 0xa1, 0x17, 0xd0, 0x5e, 0x8d, 0x2b, 0x35, 0xbf, 0xcc, 0x9a, 0x5d, 0x7a, 0x13, 0x61,
+	0x9e, 0xe3, 0x1b, 0x36, 0xff, 0xce, 0x1a, 0xe0, 0xba, 0xd2, 0x00, 0x6f, 0x41, 0x2d, 0x72, 0x08,
+	0x0e, 0x68, 0xaf, 0xc1, 0x57, 0x25, 0xa4, 0x38, 0x15, 0x56, 0x6b, 0xb5, 0xbe, 0x84, 0x4d, 0xfe,
+	0x35, 0xc0, 0x11, 0x0e, 0x5c, 0x1c, 0x8c, 0xd8, 0xf9, 0x36, 0xb9, 0x6b, 0x0e, 0x96, 0xb9, 0xe6,
+	0x74, 0x7e, 0x93, 0xf0, 0x52, 0x9e, 0x99, 0x3c, 0x21, 0xca, 0x4e, 0xa8, 0xc5, 0x3d, 0x96, 0x82,
+	0xec, 0xb9, 0x95, 0xb6, 0xd0, 0x71, 0xaf, 0x5d, 0xf4, 0xdc, 0xd2, 0x65, 0x9e, 0xa7, 0xc4, 0xf2,
+	0xb9, 0x95, 0x6d, 0x66, 0x32, 0x1c, 0xdf, 0x73, 0x62, 0x1c, 0xf7, 0x3a, 0x22, 0xca, 0x24, 0x88,
+	0x2c, 0x56, 0x8e, 0x15, 0xd3, 0x36, 0x38, 0x5a, 0x5b, 0x33, 0x1f, 0xc0, 0x47, 0x59, 0xe9, 0x53,
+	0x35, 0x47, 0x50, 0x49, 0x48, 0x90, 0xf6, 0x20, 0xfc, 0xdb, 0x7c, 0x0a, 0x4d, 0x25, 0x2a, 0xee,
+	0xf2, 0x04, 0x33, 0xaf, 0x61, 0xab, 0xd8, 0x6b, 0x05, 0x5c, 0x8e, 0xf5, 0x2a, 0xfd, 0xf8, 0x16,
+	0xb7, 0xe4, 0x74, 0x57, 0xe5, 0x3e, 0x83, 0x8e, 0xee, 0xb9, 0x3b, 0x3d, 0x1c, 0xff, 0x59, 0x82,
+	0x4d, 0x45, 0xa4, 0xcc, 0x7d, 0xf9, 0x12, 0xfe, 0x09, 0xbf, 0x6e, 0x14, 0xdf, 0x96, 0x1c, 0x04,
+	0x15, 0x72, 0x60, 0x93, 0x7f, 0x68, 0x71, 0x27, 0xae, 0xe4, 0x93, 0x62, 0x63, 0x85, 0xe4, 0xfe,
+	0xc5, 0xfc, 0x2e, 0x19, 0x78, 0x39, 0x6e, 0x77, 0x3a, 0xd6, 0xaf, 0x61, 0xab, 0x98, 0x71, 0x81,
+	0xaf, 0x4e, 0xf4, 0xb3, 0xf9, 0xe9, 0x52, 0x75, 0x6f, 0x39, 0x1c, 0xeb, 0x4f, 0x06, 0x74, 0x4e,
+	0x30, 0x7d, 0x15, 0x4e, 0xe2, 0xef, 0xa0, 0x18, 0xec, 0x42, 0x23, 0xa6, 0x0e, 0xa1, 0x6f, 0xbd,
+	0x29, 0xe6, 0xf9, 0xab, 0x6c, 0xcf, 0x16, 0xd8, 0x2d, 0xc2, 0x81, 0xcb, 0x71, 0x55, 0x8e, 0x4b,
+	0x41, 0xeb, 0x0b, 0xd8, 0xc8, 0x94, 0x5c, 0xd0, 0x57, 0xec, 0x42, 0x83, 0x15, 0x96, 0x98, 0x3a,
+	0xd3, 0x88, 0xeb, 0x5a, 0xb6, 0x67, 0x0b, 0x8c, 0xf5, 0x14, 0xc7, 0xb1, 0x33, 0xc1, 0xf2, 0xf1,
+	0x9c, 0x82, 0xd6, 0xdf, 0xaa, 0xd0, 0x3c, 0x72, 0x7c, 0x7f, 0xf1, 0x33, 0x3d, 0x6f, 0x7f, 0x16,
+	0x6b, 0xe5, 0x95, 0x62, 0x2d, 0x7d, 0xe7, 0x57, 0x56, 0x79, 0xe7, 0xbf, 0x83, 0x0d, 0x87, 0x4c,
+	0xb4, 0xb0, 0xac, 0xf2, 0xb0, 0x7c, 0xa8, 0x9e, 0xf3, 0x4c, 0xe1, 0xfe, 0x21, 0x99, 0xe4, 0xa2,
+	0xc6, 0x9e, 0xe7, 0xa1, 0x8d, 0x0f, 0x6a, 0x8b, 0xc7, 0x07, 0xeb, 0xda, 0xf8, 0x40, 0xad, 0x6e,
+	0xf5, 0x05, 0xd5, 0xad, 0xa1, 0x56, 0xb7, 0x4f, 0xb3, 0xea, 0x06, 0x5c, 0x67, 0x6b, 0x81, 0xce,
+	0xcb, 0x0b, 0x5b, 0x73, 0x61, 0x61, 0x6b, 0xdd, 0x5e, 0xd8, 0xda, 0x85, 0x85, 0x8d, 0x5d, 0xc6,
+	0x43, 0x32, 0x49, 0xa6, 0x38, 0xa0, 0xb7, 0x5e, 0xc6, 0x90, 0xd3, 0xae, 0x72, 0x15, 0x0f, 0xf5,
+	0xab, 0xb8, 0xe4, 0x88, 0x72, 0x92, 0xd5, 0x0c, 0x79, 0xff, 0xa4, 0x6e, 0x7d, 0x53, 0x82, 0x96,
+	0x10, 0x75, 0xdf, 0xf9, 0xce, 0x7b, 0x40, 0xe2, 0x4b, 0x8b, 0xb9, 0x52, 0x7e, 0x8e, 0xa6, 0x48,
+	0xe9, 0xdb, 0xb9, 0x1d, 0xe2, 0x30, 0x0b, 0x58, 0x69, 0xd3, 0x94, 0xf2, 0x8a, 0xd3, 0x14, 0x73,
+	0x1f, 0x50, 0x5e, 0x46, 0xe1, 0x69, 0x7d, 0x05, 0xdb, 0x0b, 0xb4, 0x29, 0x70, 0xe4, 0xe7, 0xfa,
+	0x81, 0x3d, 0x58, 0xdd, 0x3e, 0xc5, 0xe9, 0x07, 0xdf, 0xd4, 0xa1, 0x9b, 0x36, 0xad, 0x69, 0x69,
+	0x63, 0xdd, 0x44, 0x36, 0xfe, 0x45, 0x1f, 0x2b, 0x8c, 0xe7, 0x47, 0xc8, 0xe6, 0x6e, 0x31, 0x52,
+	0x88, 0xb6, 0xd6, 0xd0, 0x0b, 0x68, 0x72, 0xaf, 0x88, 0x98, 0x40, 0x39, 0x6f, 0xa5, 0x7c, 0x7a,
+	0x79, 0x44, 0xc6, 0xe3, 0x39, 0x00, 0x7f, 0x33, 0xcb, 0xbb, 0x95, 0x7b, 0xfe, 0x0b, 0x0e, 0xdb,
+	0x0b, 0xc6, 0x02, 0xd6, 0x1a, 0x33, 0x27, 0x9b, 0x9c, 0x6a, 0xe6, 0xcc, 0x4f, 0xa1, 0xcd, 0xdd,
+	0x62, 0xa4, 0xa2, 0x4a, 0x4d, 0xcc, 0x20, 0x91, 0xaa, 0xb0, 0x36, 0x1c, 0x35, 0x77, 0x0a, 0x30,
+	0x19, 0x83, 0x13, 0x68, 0x5d, 0x50, 0x82, 0x9d, 0xe9, 0xff, 0xc4, 0xe6, 0xb1, 0x21, 0x6d, 0x12,
+	0x15, 0x72, 0xde, 0x26, 0xad, 0xa7, 0x31, 0x77, 0x8b, 0x91, 0x99, 0x4a, 0x4f, 0xa1, 0xc2, 0xe2,
+	0x45, 0x73, 0xac, 0x72, 0xe3, 0xcd, 0xed, 0xdc, 0x7a, 0xb6, 0x75, 0x00, 0xeb, 0xb2, 0x96, 0xa1,
+	0x1d, 0x3d, 0x10, 0x94, 0x22, 0x6c, 0x9a, 0x45, 0x28, 0xc5, 0x94, 0x67, 0x50, 0xe5, 0x47, 0x7e,
+	0xbf, 0xe8, 0x78, 0x0a, 0x15, 0x3e, 0xdd, 0xb9, 0x47, 0x5c, 0x3c, 0x87, 0x9a, 0x98, 0x6b, 0x68,
+	0xc7, 0xa0, 0x0d, 0x58, 0xcc, 0x9d, 0x02, 0x8c, 0x2a, 0x9b, 0x0d, 0x08, 0x34, 0xd9, 0xca, 0x34,
+	0xc3, 0xdc, 0xce, 0xad, 0xab, 0xb2, 0xc5, 0x1b, 0x58, 0x93, 0xad, 0xbd, 0xf1, 0xcd, 0x9d, 0x02,
+	0x4c, 0xc6, 0xe0, 0x19, 0xd4, 0xc4, 0xc3, 0x57, 0x63, 0xa0, 0xbd, 0x85, 0xcd, 0xad, 0x5c, 0xc2,
+	0x1c, 0xb2, 0x1f, 0x46, 0xd6, 0x1a, 0xab, 0x6e, 0x47, 0x4e, 0x30, 0xc2, 0x3e, 0x5a, 0x40, 0xb3,
+	0x64, 0xef, 0xe7, 0xd0, 0x3e, 0xc1, 0xf4, 0x9c, 0xff, 0x98, 0x3a, 0x0d, 0xc6, 0xe1, 0x42, 0x16,
+	0xdf, 0x57, 0x07, 0x62, 0x19, 0xb9, 0xb5, 0xf6, 0xa1, 0xc6, 0x09, 0x9f, 0xfc, 0x77, 0x00, 0xa9,
+	0xe7, 0x74, 0x66, 0xf9, 0x1a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1450,6 +2190,16 @@ type ResourceProviderClient interface {
 	// StreamInvoke dynamically executes a built-in function in the provider, which returns a stream
 	// of responses.
 	StreamInvoke(ctx context.Context, in *InvokeRequest, opts ...grpc.CallOption) (ResourceProvider_StreamInvokeClient, error)
+	// Construct creates a new instance of the provided component resource and returns its state. The provider
+	// registers the component and any of its children with the resource monitor at monitorEndpoint.
+	Construct(ctx context.Context, in *ConstructRequest, opts ...grpc.CallOption) (*ConstructResponse, error)
+	// Call dynamically executes a method in the provider associated with a resource. The method receives the
+	// resource's URN and current state along with its arguments, and returns its results along with the resources
+	// that each result depends on.
+	Call(ctx context.Context, in *CallRequest, opts ...grpc.CallOption) (*CallResponse, error)
+	// GetLogs streams the log entries produced by a resource, such as the output of a function or a container.
+	// Providers that do not support logs for a resource should return an Unimplemented error.
+	GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (ResourceProvider_GetLogsClient, error)
 	// Check validates that the given property bag is valid for a resource of the given type and returns the inputs
 	// that should be passed to successive calls to Diff, Create, or Update for this resource. As a rule, the provider
 	// inputs returned by a call to Check should preserve the original representation of the properties as present in
@@ -1559,6 +2309,56 @@ func (x *resourceProviderStreamInvokeClient) Recv() (*InvokeResponse, error) {
 	return m, nil
 }
 
+func (c *resourceProviderClient) Construct(ctx context.Context, in *ConstructRequest, opts ...grpc.CallOption) (*ConstructResponse, error) {
+	out := new(ConstructResponse)
+	err := c.cc.Invoke(ctx, "/pulumirpc.ResourceProvider/Construct", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceProviderClient) Call(ctx context.Context, in *CallRequest, opts ...grpc.CallOption) (*CallResponse, error) {
+	out := new(CallResponse)
+	err := c.cc.Invoke(ctx, "/pulumirpc.ResourceProvider/Call", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceProviderClient) GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (ResourceProvider_GetLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ResourceProvider_serviceDesc.Streams[1], "/pulumirpc.ResourceProvider/GetLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &resourceProviderGetLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ResourceProvider_GetLogsClient interface {
+	Recv() (*GetLogsResponse, error)
+	grpc.ClientStream
+}
+
+type resourceProviderGetLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *resourceProviderGetLogsClient) Recv() (*GetLogsResponse, error) {
+	m := new(GetLogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *resourceProviderClient) Check(ctx context.Context, in *CheckRequest, opts ...grpc.CallOption) (*CheckResponse, error) {
 	out := new(CheckResponse)
 	err := c.cc.Invoke(ctx, "/pulumirpc.ResourceProvider/Check", in, out, opts...)
@@ -1646,6 +2446,16 @@ type ResourceProviderServer interface {
 	// StreamInvoke dynamically executes a built-in function in the provider, which returns a stream
 	// of responses.
 	StreamInvoke(*InvokeRequest, ResourceProvider_StreamInvokeServer) error
+	// Construct creates a new instance of the provided component resource and returns its state. The provider
+	// registers the component and any of its children with the resource monitor at monitorEndpoint.
+	Construct(context.Context, *ConstructRequest) (*ConstructResponse, error)
+	// Call dynamically executes a method in the provider associated with a resource. The method receives the
+	// resource's URN and current state along with its arguments, and returns its results along with the resources
+	// that each result depends on.
+	Call(context.Context, *CallRequest) (*CallResponse, error)
+	// GetLogs streams the log entries produced by a resource, such as the output of a function or a container.
+	// Providers that do not support logs for a resource should return an Unimplemented error.
+	GetLogs(*GetLogsRequest, ResourceProvider_GetLogsServer) error
 	// Check validates that the given property bag is valid for a resource of the given type and returns the inputs
 	// that should be passed to successive calls to Diff, Create, or Update for this resource. As a rule, the provider
 	// inputs returned by a call to Check should preserve the original representation of the properties as present in
@@ -1692,6 +2502,15 @@ func (*UnimplementedResourceProviderServer) Invoke(ctx context.Context, req *Inv
 func (*UnimplementedResourceProviderServer) StreamInvoke(req *InvokeRequest, srv ResourceProvider_StreamInvokeServer) error {
 	return status.Errorf(codes.Unimplemented, "method StreamInvoke not implemented")
 }
+func (*UnimplementedResourceProviderServer) Construct(ctx context.Context, req *ConstructRequest) (*ConstructResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Construct not implemented")
+}
+func (*UnimplementedResourceProviderServer) Call(ctx context.Context, req *CallRequest) (*CallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Call not implemented")
+}
+func (*UnimplementedResourceProviderServer) GetLogs(req *GetLogsRequest, srv ResourceProvider_GetLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetLogs not implemented")
+}
 func (*UnimplementedResourceProviderServer) Check(ctx context.Context, req *CheckRequest) (*CheckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Check not implemented")
 }
@@ -1832,6 +2651,63 @@ func (x *resourceProviderStreamInvokeServer) Send(m *InvokeResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ResourceProvider_Construct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConstructRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceProviderServer).Construct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pulumirpc.ResourceProvider/Construct",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceProviderServer).Construct(ctx, req.(*ConstructRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceProvider_Call_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceProviderServer).Call(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pulumirpc.ResourceProvider/Call",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceProviderServer).Call(ctx, req.(*CallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceProvider_GetLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ResourceProviderServer).GetLogs(m, &resourceProviderGetLogsServer{stream})
+}
+
+type ResourceProvider_GetLogsServer interface {
+	Send(*GetLogsResponse) error
+	grpc.ServerStream
+}
+
+type resourceProviderGetLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *resourceProviderGetLogsServer) Send(m *GetLogsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _ResourceProvider_Check_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CheckRequest)
 	if err := dec(in); err != nil {
@@ -2000,6 +2876,14 @@ var _ResourceProvider_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Invoke",
 			Handler:    _ResourceProvider_Invoke_Handler,
 		},
+		{
+			MethodName: "Construct",
+			Handler:    _ResourceProvider_Construct_Handler,
+		},
+		{
+			MethodName: "Call",
+			Handler:    _ResourceProvider_Call_Handler,
+		},
 		{
 			MethodName: "Check",
 			Handler:    _ResourceProvider_Check_Handler,
@@ -2039,6 +2923,11 @@ var _ResourceProvider_serviceDesc = grpc.ServiceDesc{
 			Handler:       _ResourceProvider_StreamInvoke_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetLogs",
+			Handler:       _ResourceProvider_GetLogs_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "provider.proto",
 }
@@ -295,6 +295,11 @@ type RegisterResourceRequest struct {
 	CustomTimeouts             *RegisterResourceRequest_CustomTimeouts                  `protobuf:"bytes,17,opt,name=customTimeouts,proto3" json:"customTimeouts,omitempty"`
 	DeleteBeforeReplaceDefined bool                                                     `protobuf:"varint,18,opt,name=deleteBeforeReplaceDefined,proto3" json:"deleteBeforeReplaceDefined,omitempty"`
 	SupportsPartialValues      bool                                                     `protobuf:"varint,19,opt,name=supportsPartialValues,proto3" json:"supportsPartialValues,omitempty"`
+	Remote                     bool                                                     `protobuf:"varint,20,opt,name=remote,proto3" json:"remote,omitempty"`
+	Providers                  map[string]string                                        `protobuf:"bytes,21,rep,name=providers,proto3" json:"providers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	RetryPolicy                *RegisterResourceRequest_RetryPolicy                     `protobuf:"bytes,22,opt,name=retryPolicy,proto3" json:"retryPolicy,omitempty"`
+	RetainOnDelete             bool                                                     `protobuf:"varint,23,opt,name=retainOnDelete,proto3" json:"retainOnDelete,omitempty"`
+	DeletedWith                string                                                   `protobuf:"bytes,24,opt,name=deletedWith,proto3" json:"deletedWith,omitempty"`
 	XXX_NoUnkeyedLiteral       struct{}                                                 `json:"-"`
 	XXX_unrecognized           []byte                                                   `json:"-"`
 	XXX_sizecache              int32                                                    `json:"-"`
@@ -458,6 +463,41 @@ func (m *RegisterResourceRequest) GetSupportsPartialValues() bool {
 	return false
 }
 
+func (m *RegisterResourceRequest) GetRemote() bool {
+	if m != nil {
+		return m.Remote
+	}
+	return false
+}
+
+func (m *RegisterResourceRequest) GetProviders() map[string]string {
+	if m != nil {
+		return m.Providers
+	}
+	return nil
+}
+
+func (m *RegisterResourceRequest) GetRetryPolicy() *RegisterResourceRequest_RetryPolicy {
+	if m != nil {
+		return m.RetryPolicy
+	}
+	return nil
+}
+
+func (m *RegisterResourceRequest) GetRetainOnDelete() bool {
+	if m != nil {
+		return m.RetainOnDelete
+	}
+	return false
+}
+
+func (m *RegisterResourceRequest) GetDeletedWith() string {
+	if m != nil {
+		return m.DeletedWith
+	}
+	return ""
+}
+
 // PropertyDependencies describes the resources that a particular property depends on.
 type RegisterResourceRequest_PropertyDependencies struct {
 	Urns                 []string `protobuf:"bytes,1,rep,name=urns,proto3" json:"urns,omitempty"`
@@ -560,17 +600,74 @@ func (m *RegisterResourceRequest_CustomTimeouts) GetDelete() string {
 	return ""
 }
 
+// RetryPolicy allows a user to have failed create, update, and delete operations retried.
+type RegisterResourceRequest_RetryPolicy struct {
+	MaxAttempts          int32    `protobuf:"varint,1,opt,name=maxAttempts,proto3" json:"maxAttempts,omitempty"`
+	Backoff              string   `protobuf:"bytes,2,opt,name=backoff,proto3" json:"backoff,omitempty"`
+	ErrorMatchers        []string `protobuf:"bytes,3,rep,name=errorMatchers,proto3" json:"errorMatchers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegisterResourceRequest_RetryPolicy) Reset()         { *m = RegisterResourceRequest_RetryPolicy{} }
+func (m *RegisterResourceRequest_RetryPolicy) String() string { return proto.CompactTextString(m) }
+func (*RegisterResourceRequest_RetryPolicy) ProtoMessage()    {}
+func (*RegisterResourceRequest_RetryPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1b72f771c35e3b8, []int{4, 2}
+}
+
+func (m *RegisterResourceRequest_RetryPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterResourceRequest_RetryPolicy.Unmarshal(m, b)
+}
+func (m *RegisterResourceRequest_RetryPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterResourceRequest_RetryPolicy.Marshal(b, m, deterministic)
+}
+func (m *RegisterResourceRequest_RetryPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterResourceRequest_RetryPolicy.Merge(m, src)
+}
+func (m *RegisterResourceRequest_RetryPolicy) XXX_Size() int {
+	return xxx_messageInfo_RegisterResourceRequest_RetryPolicy.Size(m)
+}
+func (m *RegisterResourceRequest_RetryPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterResourceRequest_RetryPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterResourceRequest_RetryPolicy proto.InternalMessageInfo
+
+func (m *RegisterResourceRequest_RetryPolicy) GetMaxAttempts() int32 {
+	if m != nil {
+		return m.MaxAttempts
+	}
+	return 0
+}
+
+func (m *RegisterResourceRequest_RetryPolicy) GetBackoff() string {
+	if m != nil {
+		return m.Backoff
+	}
+	return ""
+}
+
+func (m *RegisterResourceRequest_RetryPolicy) GetErrorMatchers() []string {
+	if m != nil {
+		return m.ErrorMatchers
+	}
+	return nil
+}
+
 // RegisterResourceResponse is returned by the engine after a resource has finished being initialized.  It includes the
 // auto-assigned URN, the provider-assigned ID, and any other properties initialized by the engine.
 type RegisterResourceResponse struct {
-	Urn                  string          `protobuf:"bytes,1,opt,name=urn,proto3" json:"urn,omitempty"`
-	Id                   string          `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Object               *_struct.Struct `protobuf:"bytes,3,opt,name=object,proto3" json:"object,omitempty"`
-	Stable               bool            `protobuf:"varint,4,opt,name=stable,proto3" json:"stable,omitempty"`
-	Stables              []string        `protobuf:"bytes,5,rep,name=stables,proto3" json:"stables,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Urn                  string                                                    `protobuf:"bytes,1,opt,name=urn,proto3" json:"urn,omitempty"`
+	Id                   string                                                    `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Object               *_struct.Struct                                           `protobuf:"bytes,3,opt,name=object,proto3" json:"object,omitempty"`
+	Stable               bool                                                      `protobuf:"varint,4,opt,name=stable,proto3" json:"stable,omitempty"`
+	Stables              []string                                                  `protobuf:"bytes,5,rep,name=stables,proto3" json:"stables,omitempty"`
+	PropertyDependencies map[string]*RegisterResourceResponse_PropertyDependencies `protobuf:"bytes,6,rep,name=propertyDependencies,proto3" json:"propertyDependencies,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                                                  `json:"-"`
+	XXX_unrecognized     []byte                                                    `json:"-"`
+	XXX_sizecache        int32                                                     `json:"-"`
 }
 
 func (m *RegisterResourceResponse) Reset()         { *m = RegisterResourceResponse{} }
@@ -633,6 +730,57 @@ func (m *RegisterResourceResponse) GetStables() []string {
 	return nil
 }
 
+func (m *RegisterResourceResponse) GetPropertyDependencies() map[string]*RegisterResourceResponse_PropertyDependencies {
+	if m != nil {
+		return m.PropertyDependencies
+	}
+	return nil
+}
+
+// PropertyDependencies describes the resources that a particular property depends on.
+type RegisterResourceResponse_PropertyDependencies struct {
+	Urns                 []string `protobuf:"bytes,1,rep,name=urns,proto3" json:"urns,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegisterResourceResponse_PropertyDependencies) Reset() {
+	*m = RegisterResourceResponse_PropertyDependencies{}
+}
+func (m *RegisterResourceResponse_PropertyDependencies) String() string {
+	return proto.CompactTextString(m)
+}
+func (*RegisterResourceResponse_PropertyDependencies) ProtoMessage() {}
+func (*RegisterResourceResponse_PropertyDependencies) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1b72f771c35e3b8, []int{5, 0}
+}
+
+func (m *RegisterResourceResponse_PropertyDependencies) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterResourceResponse_PropertyDependencies.Unmarshal(m, b)
+}
+func (m *RegisterResourceResponse_PropertyDependencies) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterResourceResponse_PropertyDependencies.Marshal(b, m, deterministic)
+}
+func (m *RegisterResourceResponse_PropertyDependencies) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterResourceResponse_PropertyDependencies.Merge(m, src)
+}
+func (m *RegisterResourceResponse_PropertyDependencies) XXX_Size() int {
+	return xxx_messageInfo_RegisterResourceResponse_PropertyDependencies.Size(m)
+}
+func (m *RegisterResourceResponse_PropertyDependencies) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterResourceResponse_PropertyDependencies.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterResourceResponse_PropertyDependencies proto.InternalMessageInfo
+
+func (m *RegisterResourceResponse_PropertyDependencies) GetUrns() []string {
+	if m != nil {
+		return m.Urns
+	}
+	return nil
+}
+
 // RegisterResourceOutputsRequest adds extra resource outputs created by the program after registration has occurred.
 type RegisterResourceOutputsRequest struct {
 	Urn                  string          `protobuf:"bytes,1,opt,name=urn,proto3" json:"urn,omitempty"`
@@ -688,72 +836,88 @@ func init() {
 	proto.RegisterType((*ReadResourceResponse)(nil), "pulumirpc.ReadResourceResponse")
 	proto.RegisterType((*RegisterResourceRequest)(nil), "pulumirpc.RegisterResourceRequest")
 	proto.RegisterMapType((map[string]*RegisterResourceRequest_PropertyDependencies)(nil), "pulumirpc.RegisterResourceRequest.PropertyDependenciesEntry")
+	proto.RegisterMapType((map[string]string)(nil), "pulumirpc.RegisterResourceRequest.ProvidersEntry")
 	proto.RegisterType((*RegisterResourceRequest_PropertyDependencies)(nil), "pulumirpc.RegisterResourceRequest.PropertyDependencies")
 	proto.RegisterType((*RegisterResourceRequest_CustomTimeouts)(nil), "pulumirpc.RegisterResourceRequest.CustomTimeouts")
+	proto.RegisterType((*RegisterResourceRequest_RetryPolicy)(nil), "pulumirpc.RegisterResourceRequest.RetryPolicy")
 	proto.RegisterType((*RegisterResourceResponse)(nil), "pulumirpc.RegisterResourceResponse")
+	proto.RegisterMapType((map[string]*RegisterResourceResponse_PropertyDependencies)(nil), "pulumirpc.RegisterResourceResponse.PropertyDependenciesEntry")
+	proto.RegisterType((*RegisterResourceResponse_PropertyDependencies)(nil), "pulumirpc.RegisterResourceResponse.PropertyDependencies")
 	proto.RegisterType((*RegisterResourceOutputsRequest)(nil), "pulumirpc.RegisterResourceOutputsRequest")
 }
 
 func init() { proto.RegisterFile("resource.proto", fileDescriptor_d1b72f771c35e3b8) }
 
 var fileDescriptor_d1b72f771c35e3b8 = []byte{
-	// 882 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x51, 0x6f, 0x23, 0x35,
-	0x10, 0xbe, 0x4d, 0x7a, 0x69, 0x32, 0xed, 0xa5, 0xc5, 0x2d, 0xa9, 0x6f, 0x41, 0xa5, 0x2c, 0x3c,
-	0x04, 0x1e, 0xd2, 0xbb, 0x82, 0x74, 0x07, 0x42, 0x20, 0x71, 0x77, 0xa0, 0x7b, 0x38, 0x71, 0x6c,
-	0x11, 0x02, 0x24, 0x90, 0xdc, 0xdd, 0x69, 0x6e, 0xe9, 0x66, 0x6d, 0x6c, 0x6f, 0xa5, 0xbc, 0xf1,
-	0xca, 0xaf, 0xe0, 0x2f, 0xf1, 0x5b, 0xf8, 0x05, 0xc8, 0x5e, 0x3b, 0x64, 0xb3, 0x9b, 0x36, 0x70,
-	0x6f, 0x9e, 0x6f, 0xc6, 0xb3, 0xf6, 0x37, 0xdf, 0x8c, 0x17, 0x86, 0x12, 0x15, 0x2f, 0x65, 0x82,
-	0x13, 0x21, 0xb9, 0xe6, 0x64, 0x20, 0xca, 0xbc, 0x9c, 0x65, 0x52, 0x24, 0xe1, 0x5b, 0x53, 0xce,
-	0xa7, 0x39, 0x9e, 0x5a, 0xc7, 0x45, 0x79, 0x79, 0x8a, 0x33, 0xa1, 0xe7, 0x55, 0x5c, 0xf8, 0xf6,
-	0xaa, 0x53, 0x69, 0x59, 0x26, 0xda, 0x79, 0x87, 0x42, 0xf2, 0xeb, 0x2c, 0x45, 0x59, 0xd9, 0xd1,
-	0x18, 0x46, 0xe7, 0xa5, 0x10, 0x5c, 0x6a, 0xf5, 0x15, 0x32, 0x5d, 0x4a, 0x8c, 0xf1, 0xb7, 0x12,
-	0x95, 0x26, 0x43, 0xe8, 0x64, 0x29, 0x0d, 0x4e, 0x82, 0xf1, 0x20, 0xee, 0x64, 0x69, 0xf4, 0x09,
-	0x1c, 0x35, 0x22, 0x95, 0xe0, 0x85, 0x42, 0x72, 0x0c, 0xf0, 0x8a, 0x29, 0xe7, 0xb5, 0x5b, 0xfa,
-	0xf1, 0x12, 0x12, 0xfd, 0xdd, 0x81, 0x83, 0x18, 0x59, 0x1a, 0xbb, 0x1b, 0xad, 0xf9, 0x04, 0x21,
-	0xb0, 0xa5, 0xe7, 0x02, 0x69, 0xc7, 0x22, 0x76, 0x6d, 0xb0, 0x82, 0xcd, 0x90, 0x76, 0x2b, 0xcc,
-	0xac, 0xc9, 0x08, 0x7a, 0x82, 0x49, 0x2c, 0x34, 0xdd, 0xb2, 0xa8, 0xb3, 0xc8, 0x23, 0x00, 0x21,
-	0xb9, 0x40, 0xa9, 0x33, 0x54, 0xf4, 0xee, 0x49, 0x30, 0xde, 0x39, 0x3b, 0x9a, 0x54, 0x7c, 0x4c,
-	0x3c, 0x1f, 0x93, 0x73, 0xcb, 0x47, 0xbc, 0x14, 0x4a, 0x22, 0xd8, 0x4d, 0x51, 0x60, 0x91, 0x62,
-	0x91, 0x98, 0xad, 0xbd, 0x93, 0xee, 0x78, 0x10, 0xd7, 0x30, 0x12, 0x42, 0xdf, 0x73, 0x47, 0xb7,
-	0xed, 0x67, 0x17, 0x36, 0xa1, 0xb0, 0x7d, 0x8d, 0x52, 0x65, 0xbc, 0xa0, 0x7d, 0xeb, 0xf2, 0x26,
-	0x79, 0x1f, 0xee, 0xb1, 0x24, 0x41, 0xa1, 0xcf, 0x31, 0x91, 0xa8, 0x15, 0x1d, 0x58, 0x76, 0xea,
-	0x20, 0x79, 0x0c, 0x47, 0x2c, 0x4d, 0x33, 0x9d, 0xf1, 0x82, 0xe5, 0x15, 0xf8, 0x4d, 0xa9, 0x45,
-	0xa9, 0x15, 0x05, 0x7b, 0x94, 0x75, 0x6e, 0xf3, 0x65, 0x96, 0x67, 0x4c, 0xa1, 0xa2, 0x3b, 0x36,
-	0xd2, 0x9b, 0x11, 0x83, 0xc3, 0x3a, 0xe7, 0xae, 0x58, 0xfb, 0xd0, 0x2d, 0x65, 0xe1, 0x58, 0x37,
-	0xcb, 0x15, 0xda, 0x3a, 0x1b, 0xd3, 0x16, 0xfd, 0xd5, 0x87, 0xa3, 0x18, 0xa7, 0x99, 0xd2, 0x28,
-	0x57, 0x6b, 0xeb, 0x6b, 0x19, 0xb4, 0xd4, 0xb2, 0xd3, 0x5a, 0xcb, 0x6e, 0xad, 0x96, 0x23, 0xe8,
-	0x25, 0xa5, 0xd2, 0x7c, 0x66, 0x6b, 0xdc, 0x8f, 0x9d, 0x45, 0x4e, 0xa1, 0xc7, 0x2f, 0x7e, 0xc5,
-	0x44, 0xdf, 0x56, 0x5f, 0x17, 0x66, 0x18, 0x32, 0x2e, 0xb3, 0xa3, 0x67, 0x33, 0x79, 0xb3, 0x51,
-	0xf5, 0xed, 0x5b, 0xaa, 0xde, 0x5f, 0xa9, 0xba, 0x80, 0x43, 0x47, 0xc6, 0xfc, 0xe9, 0x72, 0x9e,
-	0xc1, 0x49, 0x77, 0xbc, 0x73, 0xf6, 0xd9, 0x64, 0xd1, 0xb0, 0x93, 0x35, 0x24, 0x4d, 0x5e, 0xb6,
-	0x6c, 0x7f, 0x56, 0x68, 0x39, 0x8f, 0x5b, 0x33, 0x93, 0x07, 0x70, 0x90, 0x62, 0x8e, 0x1a, 0xbf,
-	0xc4, 0x4b, 0x6e, 0x1a, 0x50, 0xe4, 0x2c, 0x41, 0x0a, 0xf6, 0x5e, 0x6d, 0xae, 0x65, 0x65, 0xee,
-	0x34, 0x94, 0x99, 0x4d, 0x0b, 0x2e, 0xf1, 0xc9, 0x2b, 0x56, 0x4c, 0x51, 0xd1, 0x5d, 0x7b, 0xfd,
-	0x3a, 0xd8, 0xd4, 0xef, 0xbd, 0xff, 0xa8, 0xdf, 0xe1, 0xc6, 0xfa, 0xdd, 0xab, 0xe9, 0xd7, 0x30,
-	0x9f, 0xcd, 0xcc, 0xf8, 0x78, 0x9e, 0xd2, 0xfd, 0x8a, 0x79, 0x6f, 0x93, 0x1f, 0x61, 0x58, 0xc9,
-	0xe1, 0xbb, 0x6c, 0x86, 0xdc, 0x7c, 0xe6, 0x0d, 0x2b, 0x86, 0x87, 0x1b, 0x70, 0xfe, 0xa4, 0xb6,
-	0x31, 0x5e, 0x49, 0x44, 0x3e, 0x87, 0xb0, 0x85, 0xc7, 0xa7, 0x78, 0x99, 0x15, 0x98, 0x52, 0x62,
-	0x6f, 0x7f, 0x43, 0x04, 0xf9, 0x18, 0xde, 0x54, 0x6e, 0x4c, 0xbe, 0x64, 0x52, 0x67, 0x2c, 0xff,
-	0x9e, 0xe5, 0x25, 0x2a, 0x7a, 0x60, 0xb7, 0xb6, 0x3b, 0xc3, 0x0f, 0xe1, 0xb0, 0x4d, 0x0b, 0xa6,
-	0x63, 0x4a, 0x59, 0x28, 0x1a, 0x58, 0x6e, 0xec, 0x3a, 0xfc, 0x01, 0x86, 0xf5, 0x3b, 0xd8, 0x5e,
-	0x91, 0xc8, 0xb4, 0xef, 0x36, 0x67, 0x19, 0xbc, 0x14, 0xa9, 0xc1, 0xab, 0x8e, 0x73, 0x96, 0xc1,
-	0xab, 0x1b, 0xf8, 0x9e, 0xab, 0xac, 0xf0, 0xf7, 0x00, 0xee, 0xaf, 0x95, 0xa4, 0x19, 0x1c, 0x57,
-	0x38, 0xf7, 0x83, 0xe3, 0x0a, 0xe7, 0xe4, 0x05, 0xdc, 0xbd, 0x36, 0xe7, 0x77, 0x33, 0xe3, 0xd1,
-	0xff, 0x54, 0x7c, 0x5c, 0x65, 0xf9, 0xb4, 0xf3, 0x38, 0x88, 0xfe, 0x0c, 0x80, 0x36, 0xf7, 0xae,
-	0x1d, 0x5d, 0xd5, 0x0b, 0xd2, 0x59, 0xbc, 0x20, 0xff, 0x4e, 0x87, 0xee, 0x66, 0xd3, 0x61, 0x04,
-	0x3d, 0xa5, 0xd9, 0x45, 0x8e, 0x7e, 0xcc, 0x54, 0x96, 0xd1, 0x65, 0xb5, 0x32, 0xef, 0x88, 0xd5,
-	0xa5, 0x33, 0x23, 0x84, 0xe3, 0xd5, 0x03, 0x3a, 0x31, 0xfb, 0xd1, 0xd7, 0x3c, 0xe6, 0x43, 0xd8,
-	0xe6, 0xae, 0x1f, 0x6e, 0x19, 0xaf, 0x3e, 0xee, 0xec, 0x8f, 0x2d, 0xd8, 0xf3, 0xf9, 0x5f, 0xf0,
-	0x22, 0xd3, 0x5c, 0x92, 0x9f, 0x60, 0x6f, 0xe5, 0x09, 0x26, 0xef, 0x2e, 0x71, 0xde, 0xfe, 0x90,
-	0x87, 0xd1, 0x4d, 0x21, 0x15, 0xb3, 0xd1, 0x1d, 0xf2, 0x05, 0xf4, 0x9e, 0x17, 0xd7, 0xfc, 0x0a,
-	0x09, 0x5d, 0x8a, 0xaf, 0x20, 0x9f, 0xe9, 0x7e, 0x8b, 0x67, 0x91, 0xe0, 0x6b, 0xd8, 0x3d, 0xd7,
-	0x12, 0xd9, 0xec, 0xb5, 0xd2, 0x3c, 0x08, 0xc8, 0xb7, 0xb0, 0xbb, 0xfc, 0x70, 0x91, 0xe3, 0x9a,
-	0xac, 0x1a, 0x7f, 0x11, 0xe1, 0x3b, 0x6b, 0xfd, 0x8b, 0xb3, 0xfd, 0x0c, 0xfb, 0xab, 0x35, 0x23,
-	0xd1, 0xed, 0x6a, 0x0d, 0xdf, 0xbb, 0x31, 0x66, 0x91, 0xfe, 0x97, 0xe6, 0x33, 0xe8, 0xe7, 0xdb,
-	0x07, 0x37, 0x64, 0xa8, 0xcb, 0x26, 0x1c, 0x35, 0x34, 0xf1, 0xcc, 0xfc, 0xd6, 0x45, 0x77, 0x2e,
-	0x7a, 0x16, 0xf9, 0xe8, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x00, 0xa9, 0x5c, 0x60, 0x13, 0x0a,
-	0x00, 0x00,
+	// 1077 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0x6e, 0x92, 0x36, 0x6d, 0x4e, 0xba, 0x69, 0x99, 0x76, 0x93, 0x59, 0x83, 0x4a, 0x30, 0x08,
+	0x15, 0x2e, 0xd2, 0xdd, 0x82, 0xb4, 0x5d, 0xb4, 0x80, 0xa0, 0x5d, 0xd0, 0x5e, 0x94, 0x16, 0x17,
+	0xf1, 0x27, 0x81, 0x34, 0xb5, 0x4f, 0x53, 0x53, 0xc7, 0xe3, 0x9d, 0x19, 0x57, 0xe4, 0x0e, 0x24,
+	0x9e, 0x0c, 0xf1, 0x44, 0x3c, 0x01, 0x9a, 0x19, 0x3b, 0xb5, 0x13, 0xa7, 0x4d, 0x97, 0xbb, 0x39,
+	0xbf, 0xe3, 0xf3, 0x9d, 0x6f, 0xce, 0x8c, 0xa1, 0x23, 0x50, 0xf2, 0x54, 0xf8, 0x38, 0x48, 0x04,
+	0x57, 0x9c, 0xb4, 0x92, 0x34, 0x4a, 0x47, 0xa1, 0x48, 0x7c, 0xe7, 0xcd, 0x21, 0xe7, 0xc3, 0x08,
+	0xf7, 0x8c, 0xe1, 0x3c, 0xbd, 0xd8, 0xc3, 0x51, 0xa2, 0xc6, 0xd6, 0xcf, 0x79, 0x6b, 0xda, 0x28,
+	0x95, 0x48, 0x7d, 0x95, 0x59, 0x3b, 0x89, 0xe0, 0xd7, 0x61, 0x80, 0xc2, 0xca, 0xee, 0x2e, 0x74,
+	0xcf, 0xd2, 0x24, 0xe1, 0x42, 0xc9, 0xaf, 0x90, 0xa9, 0x54, 0xa0, 0x87, 0xaf, 0x52, 0x94, 0x8a,
+	0x74, 0xa0, 0x1e, 0x06, 0xb4, 0xd6, 0xaf, 0xed, 0xb6, 0xbc, 0x7a, 0x18, 0xb8, 0xcf, 0xa0, 0x37,
+	0xe3, 0x29, 0x13, 0x1e, 0x4b, 0x24, 0x3b, 0x00, 0x97, 0x4c, 0x66, 0x56, 0x13, 0xb2, 0xe6, 0x15,
+	0x34, 0xee, 0xbf, 0x75, 0xd8, 0xf2, 0x90, 0x05, 0x5e, 0x56, 0xd1, 0x9c, 0x2d, 0x08, 0x81, 0x65,
+	0x35, 0x4e, 0x90, 0xd6, 0x8d, 0xc6, 0xac, 0xb5, 0x2e, 0x66, 0x23, 0xa4, 0x0d, 0xab, 0xd3, 0x6b,
+	0xd2, 0x85, 0x66, 0xc2, 0x04, 0xc6, 0x8a, 0x2e, 0x1b, 0x6d, 0x26, 0x91, 0xa7, 0x00, 0x89, 0xe0,
+	0x09, 0x0a, 0x15, 0xa2, 0xa4, 0x2b, 0xfd, 0xda, 0x6e, 0x7b, 0xbf, 0x37, 0xb0, 0x78, 0x0c, 0x72,
+	0x3c, 0x06, 0x67, 0x06, 0x0f, 0xaf, 0xe0, 0x4a, 0x5c, 0x58, 0x0f, 0x30, 0xc1, 0x38, 0xc0, 0xd8,
+	0xd7, 0xa1, 0xcd, 0x7e, 0x63, 0xb7, 0xe5, 0x95, 0x74, 0xc4, 0x81, 0xb5, 0x1c, 0x3b, 0xba, 0x6a,
+	0xb6, 0x9d, 0xc8, 0x84, 0xc2, 0xea, 0x35, 0x0a, 0x19, 0xf2, 0x98, 0xae, 0x19, 0x53, 0x2e, 0x92,
+	0xf7, 0xe0, 0x01, 0xf3, 0x7d, 0x4c, 0xd4, 0x19, 0xfa, 0x02, 0x95, 0xa4, 0x2d, 0x83, 0x4e, 0x59,
+	0x49, 0x0e, 0xa0, 0xc7, 0x82, 0x20, 0x54, 0x21, 0x8f, 0x59, 0x64, 0x95, 0x27, 0xa9, 0x4a, 0x52,
+	0x25, 0x29, 0x98, 0x4f, 0x99, 0x67, 0xd6, 0x3b, 0xb3, 0x28, 0x64, 0x12, 0x25, 0x6d, 0x1b, 0xcf,
+	0x5c, 0x74, 0x19, 0x6c, 0x97, 0x31, 0xcf, 0x9a, 0xb5, 0x09, 0x8d, 0x54, 0xc4, 0x19, 0xea, 0x7a,
+	0x39, 0x05, 0x5b, 0x7d, 0x61, 0xd8, 0xdc, 0xbf, 0xd6, 0xa1, 0xe7, 0xe1, 0x30, 0x94, 0x0a, 0xc5,
+	0x74, 0x6f, 0xf3, 0x5e, 0xd6, 0x2a, 0x7a, 0x59, 0xaf, 0xec, 0x65, 0xa3, 0xd4, 0xcb, 0x2e, 0x34,
+	0xfd, 0x54, 0x2a, 0x3e, 0x32, 0x3d, 0x5e, 0xf3, 0x32, 0x89, 0xec, 0x41, 0x93, 0x9f, 0xff, 0x86,
+	0xbe, 0xba, 0xab, 0xbf, 0x99, 0x9b, 0x46, 0x48, 0x9b, 0x74, 0x44, 0xd3, 0x64, 0xca, 0xc5, 0x99,
+	0xae, 0xaf, 0xde, 0xd1, 0xf5, 0xb5, 0xa9, 0xae, 0x27, 0xb0, 0x9d, 0x81, 0x31, 0x3e, 0x2a, 0xe6,
+	0x69, 0xf5, 0x1b, 0xbb, 0xed, 0xfd, 0xe7, 0x83, 0xc9, 0x81, 0x1d, 0xcc, 0x01, 0x69, 0x70, 0x5a,
+	0x11, 0xfe, 0x22, 0x56, 0x62, 0xec, 0x55, 0x66, 0x26, 0x8f, 0x61, 0x2b, 0xc0, 0x08, 0x15, 0x7e,
+	0x89, 0x17, 0x5c, 0xa0, 0x87, 0x49, 0xc4, 0x7c, 0xa4, 0x60, 0xea, 0xaa, 0x32, 0x15, 0x99, 0xd9,
+	0x9e, 0x61, 0x66, 0x38, 0x8c, 0xb9, 0xc0, 0xc3, 0x4b, 0x16, 0x0f, 0x51, 0xd2, 0x75, 0x53, 0x7e,
+	0x59, 0x39, 0xcb, 0xdf, 0x07, 0xf7, 0xe4, 0x6f, 0x67, 0x61, 0xfe, 0x6e, 0x94, 0xf8, 0xab, 0x91,
+	0x0f, 0x47, 0x09, 0x17, 0xea, 0x65, 0x40, 0x37, 0x2d, 0xf2, 0xb9, 0x4c, 0x7e, 0x82, 0x8e, 0xa5,
+	0xc3, 0x77, 0xe1, 0x08, 0xb9, 0xde, 0xe6, 0x0d, 0x43, 0x86, 0x27, 0x0b, 0x60, 0x7e, 0x58, 0x0a,
+	0xf4, 0xa6, 0x12, 0x91, 0xcf, 0xc0, 0xa9, 0xc0, 0xf1, 0x08, 0x2f, 0xc2, 0x18, 0x03, 0x4a, 0x4c,
+	0xf5, 0xb7, 0x78, 0x90, 0x8f, 0xe1, 0xa1, 0xcc, 0xc6, 0xe4, 0x29, 0x13, 0x2a, 0x64, 0xd1, 0xf7,
+	0x2c, 0x4a, 0x51, 0xd2, 0x2d, 0x13, 0x5a, 0x6d, 0xd4, 0x6c, 0x17, 0x38, 0xe2, 0x0a, 0xe9, 0xb6,
+	0x65, 0xbb, 0x95, 0xc8, 0x09, 0xb4, 0x72, 0xba, 0x49, 0xfa, 0xb0, 0xdf, 0x58, 0xb0, 0xc6, 0xd3,
+	0x3c, 0xc6, 0x92, 0xe9, 0x26, 0x07, 0x39, 0x85, 0xb6, 0x40, 0x25, 0xc6, 0xa7, 0x3c, 0x0a, 0xfd,
+	0x31, 0xed, 0x1a, 0xd8, 0x06, 0x0b, 0xa4, 0xf4, 0x6e, 0xa2, 0xbc, 0x62, 0x0a, 0xf2, 0xbe, 0xbe,
+	0xa9, 0x14, 0x0b, 0xe3, 0x93, 0xf8, 0xc8, 0xc0, 0x42, 0x7b, 0xa6, 0x84, 0x29, 0x2d, 0xe9, 0x43,
+	0xdb, 0xc2, 0x16, 0xfc, 0x10, 0xaa, 0x4b, 0x4a, 0x4d, 0x4b, 0x8b, 0x2a, 0xe7, 0x43, 0xd8, 0xae,
+	0x3a, 0x10, 0x7a, 0x6c, 0xa4, 0x22, 0x96, 0xb4, 0x66, 0x08, 0x62, 0xd6, 0xce, 0x8f, 0xd0, 0x29,
+	0x37, 0xd2, 0x0c, 0x0c, 0x81, 0x4c, 0xe5, 0x23, 0x27, 0x93, 0xb4, 0x3e, 0x4d, 0x02, 0xa6, 0xf2,
+	0xb1, 0x93, 0x49, 0x5a, 0x6f, 0x37, 0xcf, 0x07, 0x8f, 0x95, 0x1c, 0x0e, 0xed, 0x42, 0xad, 0xfa,
+	0xb3, 0x47, 0xec, 0xf7, 0x2f, 0x94, 0xd2, 0x77, 0xac, 0x34, 0xb9, 0x57, 0xbc, 0xa2, 0x4a, 0x53,
+	0xf8, 0x9c, 0xf9, 0x57, 0xfc, 0xe2, 0x22, 0xdb, 0x21, 0x17, 0xf5, 0xe1, 0x41, 0x21, 0xb8, 0x38,
+	0x66, 0xca, 0xbf, 0xd4, 0x1d, 0x6c, 0xd8, 0x23, 0x56, 0x52, 0x3a, 0x7f, 0xd4, 0xe0, 0xd1, 0xdc,
+	0x41, 0xa0, 0xc7, 0xf5, 0x15, 0x8e, 0xf3, 0x71, 0x7d, 0x85, 0x63, 0x72, 0x0c, 0x2b, 0xd7, 0x9a,
+	0x35, 0xd9, 0xa4, 0x7e, 0xfa, 0x9a, 0x73, 0xc6, 0xb3, 0x59, 0x3e, 0xa9, 0x1f, 0xd4, 0x9c, 0xe7,
+	0xd0, 0x29, 0x53, 0xa6, 0x62, 0xdb, 0xed, 0xe2, 0xb6, 0xad, 0x42, 0xb4, 0xfb, 0x77, 0x03, 0xe8,
+	0xec, 0xce, 0x73, 0xaf, 0x1b, 0x7b, 0xeb, 0xd7, 0x27, 0xb7, 0xfe, 0xcd, 0x44, 0x6f, 0x2c, 0x36,
+	0xd1, 0xbb, 0xd0, 0x94, 0x8a, 0x9d, 0x47, 0x98, 0x5f, 0x0d, 0x56, 0xd2, 0x8d, 0xb0, 0x2b, 0x7d,
+	0xf7, 0x9b, 0x59, 0x92, 0x89, 0xe4, 0xd5, 0x9c, 0x49, 0xdd, 0x34, 0x27, 0xea, 0xd3, 0x5b, 0x11,
+	0xb4, 0x75, 0xdc, 0x77, 0x54, 0xdf, 0x8b, 0xcc, 0x7f, 0xde, 0x93, 0x01, 0xdf, 0x94, 0x19, 0x70,
+	0xf0, 0xba, 0xdf, 0x5f, 0x6c, 0x22, 0xc2, 0xce, 0x74, 0x6c, 0x36, 0xa3, 0xf3, 0x1b, 0x7d, 0xb6,
+	0x93, 0x4f, 0x60, 0x95, 0x67, 0x63, 0xfe, 0x8e, 0x57, 0x43, 0xee, 0xb7, 0xff, 0xcf, 0x32, 0x6c,
+	0xe4, 0xf9, 0x8f, 0x79, 0x1c, 0x2a, 0x2e, 0xc8, 0xcf, 0xb0, 0x31, 0xf5, 0xb2, 0x24, 0xef, 0x14,
+	0x4a, 0xaa, 0x7e, 0x9f, 0x3a, 0xee, 0x6d, 0x2e, 0xb6, 0x68, 0x77, 0x89, 0x7c, 0x0e, 0xcd, 0x97,
+	0xf1, 0x35, 0xbf, 0x42, 0x42, 0x0b, 0xfe, 0x56, 0x95, 0x67, 0x7a, 0x54, 0x61, 0x99, 0x24, 0xf8,
+	0x1a, 0xd6, 0xcf, 0x94, 0x40, 0x36, 0xfa, 0x5f, 0x69, 0x1e, 0xd7, 0xc8, 0x33, 0x58, 0x3e, 0x64,
+	0x51, 0x44, 0xba, 0x05, 0x37, 0xad, 0xc8, 0xc3, 0x7b, 0x33, 0xfa, 0xc9, 0x37, 0x7c, 0x0b, 0xeb,
+	0xc5, 0xa7, 0x1c, 0xd9, 0x29, 0x35, 0x7c, 0xe6, 0x5d, 0xed, 0xbc, 0x3d, 0xd7, 0x3e, 0x49, 0xf9,
+	0x0b, 0x6c, 0x4e, 0xb7, 0x9b, 0xb8, 0x77, 0x4f, 0x12, 0xe7, 0xdd, 0x05, 0xb8, 0xe6, 0x2e, 0x91,
+	0x5f, 0xa1, 0x37, 0x87, 0x4d, 0xe4, 0x83, 0x5b, 0x32, 0x94, 0x19, 0xe7, 0x74, 0x67, 0xe8, 0xf4,
+	0x42, 0xff, 0xe8, 0xb8, 0x4b, 0xe7, 0x4d, 0xa3, 0xf9, 0xe8, 0xbf, 0x01, 0x00, 0x46, 0xde, 0xea,
+	0xab, 0x25, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -771,6 +935,7 @@ type ResourceMonitorClient interface {
 	SupportsFeature(ctx context.Context, in *SupportsFeatureRequest, opts ...grpc.CallOption) (*SupportsFeatureResponse, error)
 	Invoke(ctx context.Context, in *InvokeRequest, opts ...grpc.CallOption) (*InvokeResponse, error)
 	StreamInvoke(ctx context.Context, in *InvokeRequest, opts ...grpc.CallOption) (ResourceMonitor_StreamInvokeClient, error)
+	Call(ctx context.Context, in *CallRequest, opts ...grpc.CallOption) (*CallResponse, error)
 	ReadResource(ctx context.Context, in *ReadResourceRequest, opts ...grpc.CallOption) (*ReadResourceResponse, error)
 	RegisterResource(ctx context.Context, in *RegisterResourceRequest, opts ...grpc.CallOption) (*RegisterResourceResponse, error)
 	RegisterResourceOutputs(ctx context.Context, in *RegisterResourceOutputsRequest, opts ...grpc.CallOption) (*empty.Empty, error)
@@ -834,6 +999,15 @@ func (x *resourceMonitorStreamInvokeClient) Recv() (*InvokeResponse, error) {
 	return m, nil
 }
 
+func (c *resourceMonitorClient) Call(ctx context.Context, in *CallRequest, opts ...grpc.CallOption) (*CallResponse, error) {
+	out := new(CallResponse)
+	err := c.cc.Invoke(ctx, "/pulumirpc.ResourceMonitor/Call", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *resourceMonitorClient) ReadResource(ctx context.Context, in *ReadResourceRequest, opts ...grpc.CallOption) (*ReadResourceResponse, error) {
 	out := new(ReadResourceResponse)
 	err := c.cc.Invoke(ctx, "/pulumirpc.ResourceMonitor/ReadResource", in, out, opts...)
@@ -866,6 +1040,7 @@ type ResourceMonitorServer interface {
 	SupportsFeature(context.Context, *SupportsFeatureRequest) (*SupportsFeatureResponse, error)
 	Invoke(context.Context, *InvokeRequest) (*InvokeResponse, error)
 	StreamInvoke(*InvokeRequest, ResourceMonitor_StreamInvokeServer) error
+	Call(context.Context, *CallRequest) (*CallResponse, error)
 	ReadResource(context.Context, *ReadResourceRequest) (*ReadResourceResponse, error)
 	RegisterResource(context.Context, *RegisterResourceRequest) (*RegisterResourceResponse, error)
 	RegisterResourceOutputs(context.Context, *RegisterResourceOutputsRequest) (*empty.Empty, error)
@@ -884,6 +1059,9 @@ func (*UnimplementedResourceMonitorServer) Invoke(ctx context.Context, req *Invo
 func (*UnimplementedResourceMonitorServer) StreamInvoke(req *InvokeRequest, srv ResourceMonitor_StreamInvokeServer) error {
 	return status.Errorf(codes.Unimplemented, "method StreamInvoke not implemented")
 }
+func (*UnimplementedResourceMonitorServer) Call(ctx context.Context, req *CallRequest) (*CallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Call not implemented")
+}
 func (*UnimplementedResourceMonitorServer) ReadResource(ctx context.Context, req *ReadResourceRequest) (*ReadResourceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReadResource not implemented")
 }
@@ -955,6 +1133,24 @@ func (x *resourceMonitorStreamInvokeServer) Send(m *InvokeResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ResourceMonitor_Call_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceMonitorServer).Call(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pulumirpc.ResourceMonitor/Call",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceMonitorServer).Call(ctx, req.(*CallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ResourceMonitor_ReadResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReadResourceRequest)
 	if err := dec(in); err != nil {
@@ -1021,6 +1217,10 @@ var _ResourceMonitor_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Invoke",
 			Handler:    _ResourceMonitor_Invoke_Handler,
 		},
+		{
+			MethodName: "Call",
+			Handler:    _ResourceMonitor_Call_Handler,
+		},
 		{
 			MethodName: "ReadResource",
 			Handler:    _ResourceMonitor_ReadResource_Handler,
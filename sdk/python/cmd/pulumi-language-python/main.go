@@ -36,6 +36,7 @@ import (
 
 	pbempty "github.com/golang/protobuf/ptypes/empty"
 	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v2/go/common/resource/plugin"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/cmdutil"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/contract"
 	"github.com/pulumi/pulumi/sdk/v2/go/common/util/logging"
@@ -160,29 +161,9 @@ func (host *pythonLanguageHost) Run(ctx context.Context, req *pulumirpc.RunReque
 
 	// Now simply spawn a process to execute the requested program, wiring up stdout/stderr directly.
 	var errResult string
-	var pythonCmds []string
-	var pythonPath string
-
-	if pythonCmd := os.Getenv("PULUMI_PYTHON_CMD"); pythonCmd != "" {
-		pythonCmds = []string{pythonCmd}
-	} else {
-		// Look for "python3" by default, but fallback to `python` if not found as some Python 3
-		// distributions (in particular the default python.org Windows installation) do not include
-		// a `python3` binary.
-		pythonCmds = []string{"python3", "python"}
-	}
-
-	for _, pythonCmd := range pythonCmds {
-		pythonPath, err = exec.LookPath(pythonCmd)
-		// Break on the first cmd we find on the path (if any)
-		if err == nil {
-			break
-		}
-	}
+	pythonPath, err := findPython()
 	if err != nil {
-		return nil, fmt.Errorf(
-			"Failed to locate any of %q on your PATH.  Have you installed Python 3.6 or greater?",
-			pythonCmds)
+		return nil, err
 	}
 
 	cmd := exec.Command(pythonPath, args...)
@@ -220,6 +201,58 @@ func (host *pythonLanguageHost) Run(ctx context.Context, req *pulumirpc.RunReque
 	return &pulumirpc.RunResponse{Error: errResult}, nil
 }
 
+// findPython returns the path of the Python executable used to run programs and install their dependencies.
+func findPython() (string, error) {
+	var pythonCmds []string
+	if pythonCmd := os.Getenv("PULUMI_PYTHON_CMD"); pythonCmd != "" {
+		pythonCmds = []string{pythonCmd}
+	} else {
+		// Look for "python3" by default, but fallback to `python` if not found as some Python 3
+		// distributions (in particular the default python.org Windows installation) do not include
+		// a `python3` binary.
+		pythonCmds = []string{"python3", "python"}
+	}
+
+	for _, pythonCmd := range pythonCmds {
+		// Return the first cmd we find on the path (if any)
+		if pythonPath, err := exec.LookPath(pythonCmd); err == nil {
+			return pythonPath, nil
+		}
+	}
+	return "", fmt.Errorf(
+		"Failed to locate any of %q on your PATH.  Have you installed Python 3.6 or greater?",
+		pythonCmds)
+}
+
+// InstallDependencies installs the packages listed in the program's requirements.txt using pip. The packages are
+// installed into the environment of the Python executable on the PATH, which is typically an active virtualenv.
+func (host *pythonLanguageHost) InstallDependencies(
+	req *pulumirpc.InstallDependenciesRequest, server pulumirpc.LanguageRuntime_InstallDependenciesServer) error {
+
+	requirements := filepath.Join(req.GetDirectory(), "requirements.txt")
+	if _, err := os.Stat(requirements); err != nil {
+		if os.IsNotExist(err) {
+			logging.V(5).Infof("No requirements.txt found in %s; skipping dependency installation", req.GetDirectory())
+			return nil
+		}
+		return err
+	}
+
+	pythonPath, err := findPython()
+	if err != nil {
+		return err
+	}
+
+	stdout, stderr := plugin.InstallDependenciesWriters(server)
+	cmd := exec.Command(pythonPath, "-m", "pip", "install", "-r", "requirements.txt")
+	cmd.Dir = req.GetDirectory()
+	cmd.Stdout, cmd.Stderr = stdout, stderr
+	if err := cmd.Run(); err != nil {
+		return errors.Wrap(err, "`pip install -r requirements.txt` failed to install dependencies")
+	}
+	return nil
+}
+
 // constructArguments constructs a command-line for `pulumi-language-python`
 // by enumerating all of the optional and non-optional arguments present
 // in a RunRequest.
@@ -597,7 +597,8 @@ class Resource:
                  name: str,
                  custom: bool,
                  props: Optional['Inputs'] = None,
-                 opts: Optional[ResourceOptions] = None) -> None:
+                 opts: Optional[ResourceOptions] = None,
+                 remote: bool = False) -> None:
         """
         :param str t: The type of this resource.
         :param str name: The name of this resource.
@@ -605,6 +606,8 @@ class Resource:
         :param Optional[dict] props: An optional list of input properties to use as inputs for the resource.
         :param Optional[ResourceOptions] opts: Optional set of :class:`pulumi.ResourceOptions` to use for this
                resource.
+        :param bool remote: True if this is a component resource that is constructed by its provider rather than
+               by this program.
         """
         if props is None:
             props = {}
@@ -708,7 +711,7 @@ class Resource:
                     "Cannot read an existing resource unless it has a custom provider")
             read_resource(cast('CustomResource', self), t, name, props, opts)
         else:
-            register_resource(self, t, name, custom, props, opts)
+            register_resource(self, t, name, custom, props, opts, remote)
 
     def _convert_providers(self, provider: Optional['ProviderResource'], providers: Optional[Union[Mapping[str, 'ProviderResource'], List['ProviderResource']]]) -> Mapping[str, 'ProviderResource']:
         if provider is not None:
@@ -815,15 +818,18 @@ class ComponentResource(Resource):
                  t: str,
                  name: str,
                  props: Optional[dict] = None,
-                 opts: Optional[ResourceOptions] = None) -> None:
+                 opts: Optional[ResourceOptions] = None,
+                 remote: bool = False) -> None:
         """
         :param str t: The type of this resource.
         :param str name: The name of this resource.
         :param Optional[dict] props: An optional list of input properties to use as inputs for the resource.
         :param Optional[ResourceOptions] opts: Optional set of :class:`pulumi.ResourceOptions` to use for this
                resource.
+        :param bool remote: True if this component is constructed by its provider rather than by this program. The
+               outputs of a remote component are resolved from the outputs its provider registers.
         """
-        Resource.__init__(self, t, name, False, props, opts)
+        Resource.__init__(self, t, name, False, props, opts, remote)
         self.id = None
 
     def register_outputs(self, outputs):
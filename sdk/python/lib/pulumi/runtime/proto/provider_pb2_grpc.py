@@ -47,6 +47,21 @@ class ResourceProviderStub(object):
         request_serializer=provider__pb2.InvokeRequest.SerializeToString,
         response_deserializer=provider__pb2.InvokeResponse.FromString,
         )
+    self.Construct = channel.unary_unary(
+        '/pulumirpc.ResourceProvider/Construct',
+        request_serializer=provider__pb2.ConstructRequest.SerializeToString,
+        response_deserializer=provider__pb2.ConstructResponse.FromString,
+        )
+    self.Call = channel.unary_unary(
+        '/pulumirpc.ResourceProvider/Call',
+        request_serializer=provider__pb2.CallRequest.SerializeToString,
+        response_deserializer=provider__pb2.CallResponse.FromString,
+        )
+    self.GetLogs = channel.unary_stream(
+        '/pulumirpc.ResourceProvider/GetLogs',
+        request_serializer=provider__pb2.GetLogsRequest.SerializeToString,
+        response_deserializer=provider__pb2.GetLogsResponse.FromString,
+        )
     self.Check = channel.unary_unary(
         '/pulumirpc.ResourceProvider/Check',
         request_serializer=provider__pb2.CheckRequest.SerializeToString,
@@ -137,6 +152,31 @@ class ResourceProviderServicer(object):
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
+  def Construct(self, request, context):
+    """Construct creates a new instance of the provided component resource and returns its state. The provider
+    registers the component and any of its children with the resource monitor at monitorEndpoint.
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def Call(self, request, context):
+    """Call dynamically executes a method in the provider associated with a resource. The method receives the
+    resource's URN and current state along with its arguments, and returns its results along with the resources
+    that each result depends on.
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def GetLogs(self, request, context):
+    """GetLogs streams the log entries produced by a resource, such as the output of a function or a container.
+    Providers that do not support logs for a resource should return an Unimplemented error.
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
   def Check(self, request, context):
     """Check validates that the given property bag is valid for a resource of the given type and returns the inputs
     that should be passed to successive calls to Diff, Create, or Update for this resource. As a rule, the provider
@@ -232,6 +272,21 @@ def add_ResourceProviderServicer_to_server(servicer, server):
           request_deserializer=provider__pb2.InvokeRequest.FromString,
           response_serializer=provider__pb2.InvokeResponse.SerializeToString,
       ),
+      'Construct': grpc.unary_unary_rpc_method_handler(
+          servicer.Construct,
+          request_deserializer=provider__pb2.ConstructRequest.FromString,
+          response_serializer=provider__pb2.ConstructResponse.SerializeToString,
+      ),
+      'Call': grpc.unary_unary_rpc_method_handler(
+          servicer.Call,
+          request_deserializer=provider__pb2.CallRequest.FromString,
+          response_serializer=provider__pb2.CallResponse.SerializeToString,
+      ),
+      'GetLogs': grpc.unary_stream_rpc_method_handler(
+          servicer.GetLogs,
+          request_deserializer=provider__pb2.GetLogsRequest.FromString,
+          response_serializer=provider__pb2.GetLogsResponse.SerializeToString,
+      ),
       'Check': grpc.unary_unary_rpc_method_handler(
           servicer.Check,
           request_deserializer=provider__pb2.CheckRequest.FromString,
@@ -266,14 +266,21 @@ def read_resource(res: 'CustomResource', ty: str, name: str, props: 'Inputs', op
 # pylint: disable=too-many-locals,too-many-statements
 
 
-def register_resource(res: 'Resource', ty: str, name: str, custom: bool, props: 'Inputs', opts: Optional['ResourceOptions']):
+def register_resource(res: 'Resource',
+                      ty: str,
+                      name: str,
+                      custom: bool,
+                      props: 'Inputs',
+                      opts: Optional['ResourceOptions'],
+                      remote: bool = False):
     """
     registerResource registers a new resource object with a given type t and name.  It returns the
     auto-generated URN and the ID that will resolve after the deployment has completed.  All
     properties will be initialized to property objects that the registration operation will resolve
-    at the right time (or remain unresolved for deployments).
+    at the right time (or remain unresolved for deployments).  If remote is true, the resource is a
+    component resource that is constructed by its provider.
     """
-    log.debug(f"registering resource: ty={ty}, name={name}, custom={custom}")
+    log.debug(f"registering resource: ty={ty}, name={name}, custom={custom}, remote={remote}")
     monitor = settings.get_monitor()
 
     # Prepare the resource.
@@ -386,6 +393,7 @@ def register_resource(res: 'Resource', ty: str, name: str, custom: bool, props:
                 customTimeouts=custom_timeouts,
                 aliases=resolver.aliases,
                 supportsPartialValues=True,
+                remote=remote,
             )
 
             from ..resource import create_urn # pylint: disable=import-outside-toplevel
@@ -18,6 +18,7 @@ out of RPC calls.
 import asyncio
 import functools
 import inspect
+from enum import Enum
 from typing import List, Any, Callable, Dict, Optional, TYPE_CHECKING, cast
 
 from google.protobuf import struct_pb2
@@ -193,6 +194,10 @@ async def serialize_property(value: 'Input[Any]',
 
         return obj
 
+    # Enum members are serialized as their underlying values.
+    if isinstance(value, Enum):
+        return value.value
+
     # Ensure that we have a value that Protobuf understands.
     if not isLegalProtobufValue(value):
         raise ValueError(f"unexpected input of type {type(value).__name__}")
@@ -13,6 +13,7 @@
 # limitations under the License.
 import asyncio
 import unittest
+from enum import Enum
 from typing import Any, Optional
 
 from google.protobuf import struct_pb2
@@ -258,6 +259,19 @@ class NextSerializationTests(unittest.TestCase):
         self.assertEqual(rpc._special_archive_sig, prop[rpc._special_sig_key])
         self.assertEqual("foo.tar.gz", prop["path"])
 
+    @async_test
+    async def test_enum(self):
+        class Size(float, Enum):
+            SMALL = 1.5
+            LARGE = 4
+
+        class Color(str, Enum):
+            RED = "red"
+
+        prop = await rpc.serialize_property([Size.SMALL, Size.LARGE, Color.RED], [])
+        self.assertEqual([1.5, 4, "red"], prop)
+        self.assertNotIsInstance(prop[2], Enum)
+
     @async_test
     async def test_bad_inputs(self):
         class MyClass: